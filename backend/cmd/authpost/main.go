@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -45,7 +46,7 @@ func main() {
 	healthPort := cfg.Port + 100
 	healthMux := http.NewServeMux()
 	healthMux.HandleFunc("/health", healthChecker.HealthHandler())
-	healthMux.HandleFunc("/health/detailed", healthChecker.DetailedHealthHandler(service.GetDB(), nil))
+	healthMux.HandleFunc("/health/detailed", healthChecker.DetailedHealthHandler(service.GetDB(), service.GetRedis()))
 
 	healthServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", healthPort),
@@ -68,6 +69,22 @@ func main() {
 	grpcServer := grpc.NewServer()
 	pb.RegisterAuthenticateAndPostServer(grpcServer, service)
 
+	// Start purging expired posts from the trash in background
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	go service.RunTrashPurger(purgeCtx)
+
+	// Start publishing scheduled posts in background
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	go service.RunPostScheduler(schedulerCtx)
+
+	// Start fetching link previews in background
+	previewerCtx, stopPreviewer := context.WithCancel(context.Background())
+	go service.RunLinkPreviewer(previewerCtx)
+
+	// Start deleting expired stories in background
+	expirerCtx, stopExpirer := context.WithCancel(context.Background())
+	go service.RunStoryExpirer(expirerCtx)
+
 	// Setup graceful shutdown
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
@@ -75,6 +92,10 @@ func main() {
 	go func() {
 		<-c
 		log.Println("Gracefully shutting down AuthPost service...")
+		stopPurge()
+		stopScheduler()
+		stopPreviewer()
+		stopExpirer()
 		grpcServer.GracefulStop()
 		healthServer.Close()
 		log.Println("AuthPost service stopped")
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/hoangNguyenDev3/WanderSphere/backend/configs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/autocomplete"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/utils"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -19,9 +21,10 @@ func main() {
 	// Define command line flags
 	var (
 		configPath = flag.String("config", "/app/config.yaml", "Path to config file")
-		command    = flag.String("cmd", "help", "Command to execute: help, migration-status, migration-up, migration-down, migration-reset, kafka-topics, kafka-create-topic, redis-status")
+		command    = flag.String("cmd", "help", "Command to execute: help, migration-status, migration-up, migration-down, migration-reset, kafka-topics, kafka-create-topic, redis-status, autocomplete-rebuild, moderator-grant, moderator-revoke")
 		topicName  = flag.String("topic", "", "Kafka topic name for topic operations")
 		service    = flag.String("service", "authpost", "Service to operate on: authpost, newsfeed, newsfeed_publishing, webapp")
+		userName   = flag.String("user", "", "User name for moderator operations")
 	)
 	flag.Parse()
 
@@ -48,6 +51,13 @@ func main() {
 		handleKafkaCreateTopic(*configPath, *service, *topicName)
 	case "redis-status":
 		handleRedisStatus(*configPath, *service)
+	case "autocomplete-rebuild":
+		handleAutocompleteRebuild(*configPath)
+	case "moderator-grant", "moderator-revoke":
+		if *userName == "" {
+			log.Fatalf("User name is required for %s command", *command)
+		}
+		handleSetModerator(*configPath, *userName, *command == "moderator-grant")
 	default:
 		fmt.Printf("Unknown command: %s\n", *command)
 		printHelp()
@@ -68,11 +78,15 @@ Commands:
   kafka-topics       List all Kafka topics
   kafka-create-topic Create a new Kafka topic (requires -topic flag)
   redis-status       Show Redis connection pool status
+  autocomplete-rebuild Rebuild the user autocomplete index in Redis from the database
+  moderator-grant    Allow a user to moderate places (requires -user flag)
+  moderator-revoke   Remove the moderator rights of a user (requires -user flag)
 
 Options:
   -config <path>     Path to config file (default: /app/config.yaml)
   -service <name>    Service name for Redis/Kafka operations (authpost, newsfeed, newsfeed_publishing, webapp)
   -topic <name>      Topic name for Kafka operations
+  -user <name>       User name for moderator operations
 
 Examples:
   # Check migration status
@@ -88,7 +102,13 @@ Examples:
   system_admin -cmd kafka-create-topic -service newsfeed_publishing -topic test_topic
 
   # Check Redis status
-  system_admin -cmd redis-status -service webapp`)
+  system_admin -cmd redis-status -service webapp
+
+  # Rebuild the user autocomplete index, sign-ups and name changes meanwhile are kept
+  system_admin -cmd autocomplete-rebuild
+
+  # Make a user a moderator
+  system_admin -cmd moderator-grant -user alice`)
 }
 
 func handleMigrationStatus(configPath string) {
@@ -284,6 +304,66 @@ func handleRedisStatus(configPath, service string) {
 	fmt.Printf("  Timeouts: %d\n", stats.Timeouts)
 }
 
+func handleAutocompleteRebuild(configPath string) {
+	fmt.Println("🔄 Rebuilding user autocomplete index...")
+
+	cfg, err := configs.GetAuthenticateAndPostConfig(configPath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	db, err := connectToDatabase(&cfg.Postgres)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer closeDatabase(db)
+
+	redisClient, err := utils.NewRedisClient(&cfg.Redis)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer redisClient.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	count, err := autocomplete.NewUserIndex(redisClient).Rebuild(ctx, db)
+	if err != nil {
+		log.Fatalf("Rebuild failed: %v", err)
+	}
+
+	fmt.Printf("✅ Indexed %d users\n", count)
+}
+
+func handleSetModerator(configPath string, userName string, isModerator bool) {
+	fmt.Printf("🔄 Updating moderator rights of %s...\n", userName)
+
+	cfg, err := configs.GetAuthenticateAndPostConfig(configPath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	db, err := connectToDatabase(&cfg.Postgres)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer closeDatabase(db)
+
+	result := db.Model(&types.User{}).Where("user_name = ?", userName).Update("is_moderator", isModerator)
+	if result.Error != nil {
+		log.Fatalf("Update failed: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		log.Fatalf("User %s not found", userName)
+	}
+
+	if isModerator {
+		fmt.Printf("✅ %s is now a moderator\n", userName)
+	} else {
+		fmt.Printf("✅ %s is no longer a moderator\n", userName)
+	}
+}
+
 // Helper functions
 func connectToDatabase(cfg *configs.PostgresConfig) (*gorm.DB, error) {
 	postgresConfig := postgres.Config{DSN: cfg.DSN}
@@ -71,14 +71,23 @@ type LoggerConfig struct {
 	Path  string `yaml:"path"`
 }
 
+// LinkPreviewConfig represents the configuration of the link preview fetcher, zero values use the defaults
+type LinkPreviewConfig struct {
+	Disabled       bool `yaml:"disabled"`
+	TimeoutSeconds int  `yaml:"timeout_seconds"`
+	MaxBodyKB      int  `yaml:"max_body_kb"`
+}
+
 // AuthenticateAndPostConfig represents the configuration for the authenticate and post service
 type AuthenticateAndPostConfig struct {
-	Port               int            `yaml:"port"`
-	Logger             LoggerConfig   `yaml:"logger"`
-	Postgres           PostgresConfig `yaml:"postgres"`
-	Redis              RedisConfig    `yaml:"redis"`
-	NewsfeedPublishing HostConfig     `yaml:"newsfeed_publishing"`
-	Auth               AuthConfig     `yaml:"auth"`
+	Port               int               `yaml:"port"`
+	Logger             LoggerConfig      `yaml:"logger"`
+	Postgres           PostgresConfig    `yaml:"postgres"`
+	Redis              RedisConfig       `yaml:"redis"`
+	NewsfeedPublishing HostConfig        `yaml:"newsfeed_publishing"`
+	S3                 S3Config          `yaml:"s3"`
+	Auth               AuthConfig        `yaml:"auth"`
+	LinkPreview        LinkPreviewConfig `yaml:"link_preview"`
 }
 
 // NewsfeedConfig represents the configuration for the newsfeed service
@@ -1,4 +1,5 @@
-// Package docs Code generated by swaggo/swag. DO NOT EDIT
+// Code generated by swaggo/swag. DO NOT EDIT.
+
 package docs
 
 import "github.com/swaggo/swag"
@@ -216,7 +217,7 @@ const docTemplate = `{
         },
         "/friends/{user_id}/posts": {
             "get": {
-                "description": "Get all posts of a user",
+                "description": "Get the posts of a user that the current viewer is allowed to see",
                 "consumes": [
                     "application/json"
                 ],
@@ -258,14 +259,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/newsfeed": {
+        "/hashtags/{tag}": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get the current user's newsfeed",
+                "description": "Get the usage statistics of a hashtag, computed over public posts",
                 "consumes": [
                     "application/json"
                 ],
@@ -273,24 +269,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "newsfeed"
+                    "hashtags"
+                ],
+                "summary": "Get hashtag details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hashtag, with or without the leading #",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user's newsfeed",
                 "responses": {
                     "200": {
-                        "description": "User's newsfeed",
+                        "description": "Hashtag details",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.HashtagInfoResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Hashtag not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -304,14 +303,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Create a new post with text and optional images",
+        "/hashtags/{tag}/posts": {
+            "get": {
+                "description": "Get the posts using a hashtag that the current viewer is allowed to see, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -319,35 +313,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "hashtags"
                 ],
-                "summary": "Create a new post",
+                "summary": "Get hashtag posts",
                 "parameters": [
                     {
-                        "description": "Post creation parameters",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostRequest"
-                        }
+                        "type": "string",
+                        "description": "Hashtag, with or without the leading #",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post created successfully",
+                        "description": "Hashtag posts",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.HashtagPostsResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Invalid cursor or limit",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Hashtag not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -361,14 +365,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/url": {
+        "/newsfeed": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a presigned URL to directly upload a file to S3",
+                "description": "Get a page of the current user's newsfeed. posts_ids lists the posts, items lists the posts, published trips, reviews and reposts in feed order. With hydrate, the posts and reposts of items come with their content, author, media, counts and viewer flags, reposts also come with the shared post, and the newsfeed is read with cursor instead of page: posts in the trash or that the user cannot read right now are left out and the page is filled with the following items, next_cursor is where the next page starts and is 0 at the end of the newsfeed. Hidden posts are kept in the newsfeed and show up again once visible. A page of a newsfeed with many hidden posts may be short, keep reading until next_cursor is 0. Items published while reading a newsfeed with cursors push the others back and may show up again on the next page.",
                 "consumes": [
                     "application/json"
                 ],
@@ -376,25 +380,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "newsfeed"
                 ],
-                "summary": "Get a presigned S3 URL for file upload",
+                "summary": "Get user's newsfeed",
                 "parameters": [
                     {
-                        "description": "File details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number, starting at 1, without hydrate",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "next_cursor of the previous page, with hydrate",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page, 10 by default and at most 50",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Return the posts of the items",
+                        "name": "hydrate",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Presigned URL",
+                        "description": "User's newsfeed",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedResponse"
                         }
                     },
                     "400": {
@@ -418,9 +439,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{post_id}": {
+        "/places": {
             "get": {
-                "description": "Get detailed information about a post",
+                "description": "Find places by name, best matches first, or near a point, closest first. At least one of q and lat/lng is required.",
                 "consumes": [
                     "application/json"
                 ],
@@ -428,27 +449,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "places"
                 ],
-                "summary": "Get post details",
+                "summary": "Search places",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place name, at most 200 characters",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "restaurant",
+                            "cafe",
+                            "bar",
+                            "hotel",
+                            "attraction",
+                            "museum",
+                            "park",
+                            "beach",
+                            "mountain",
+                            "airport",
+                            "station",
+                            "shop",
+                            "city",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Place category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude in degrees, requires lng",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude in degrees, requires lat",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 50,
+                        "description": "Search radius in km around lat/lng, at most 500",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Maximum number of places, 20 by default and at most 50",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post details",
+                        "description": "Matching places",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlacesResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid post ID or post not found",
+                        "description": "Invalid query, location or limit",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -461,13 +528,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Edit an existing post",
+                "description": "Add a place to the catalog so posts can check in at it",
                 "consumes": [
                     "application/json"
                 ],
@@ -475,36 +542,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "places"
                 ],
-                "summary": "Edit post",
+                "summary": "Create a place",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Post edit parameters",
+                        "description": "Place creation parameters",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPostRequest"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post updated successfully",
+                        "description": "Place created successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or post not found",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -522,14 +582,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Add a comment to an existing post",
+            }
+        },
+        "/places/{place_id}": {
+            "get": {
+                "description": "Get a place with its check-in statistics, computed over public posts. A merged place returns the place it was merged into.",
                 "consumes": [
                     "application/json"
                 ],
@@ -537,42 +594,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "places"
                 ],
-                "summary": "Comment on a post",
+                "summary": "Get place details",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Place ID",
+                        "name": "place_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Comment content",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostCommentRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated post with new comment",
+                        "description": "Place details",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or post not found",
+                        "description": "Invalid place ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Place not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -584,14 +632,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/places/{place_id}/merge": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete an existing post",
+                "description": "Move the check-ins of a duplicate place to the target place and remove the duplicate. Only moderators may merge places.",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,27 +649,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "places"
                 ],
-                "summary": "Delete post",
+                "summary": "Merge a duplicate place",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "ID of the duplicate place",
+                        "name": "place_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Place the duplicate is merged into",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MergePlaceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post deleted successfully",
+                        "description": "Places merged successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MergePlacesResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or post not found",
+                        "description": "Validation error or same place",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -630,6 +689,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
+                    "403": {
+                        "description": "Not a moderator",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Place not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -639,14 +710,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{post_id}/likes": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Like an existing post",
+        "/places/{place_id}/posts": {
+            "get": {
+                "description": "Get the posts checked in at a place that the current viewer is allowed to see, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -654,33 +720,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "places"
                 ],
-                "summary": "Like a post",
+                "summary": "Get place check-ins",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Place ID",
+                        "name": "place_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post liked successfully",
+                        "description": "Place check-ins",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlacePostsResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or post not found",
+                        "description": "Invalid place ID, cursor or limit",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Place not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -694,14 +772,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/edit": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update user profile information",
+        "/places/{place_id}/reviews": {
+            "get": {
+                "description": "Get the reviews of a place in the requested order, with the rating summary of the place",
                 "consumes": [
                     "application/json"
                 ],
@@ -709,35 +782,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "reviews"
                 ],
-                "summary": "Edit user profile",
+                "summary": "Get place reviews",
                 "parameters": [
                     {
-                        "description": "User information to update",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditUserRequest"
-                        }
+                        "type": "integer",
+                        "description": "Place ID",
+                        "name": "place_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "helpful",
+                            "highest",
+                            "lowest"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Order of the reviews",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 50",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated successfully",
+                        "description": "Place reviews",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceReviewsResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or user not found",
+                        "description": "Invalid place ID, sort, cursor or limit",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Place not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -749,11 +845,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/login": {
+            },
             "post": {
-                "description": "Login with username and password",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rate a place from 1 to 5 stars with an optional text. A user has at most one review per place, edit it to change it. The review is added to the newsfeeds of the reviewer's followers.",
                 "consumes": [
                     "application/json"
                 ],
@@ -761,45 +860,117 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "reviews"
                 ],
-                "summary": "Authenticate a user",
+                "summary": "Review a place",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "login",
+                        "type": "integer",
+                        "description": "Place ID",
+                        "name": "place_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rating and review text",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginRequest"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceReviewRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Review created successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceReviewResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or authentication failed",
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Place not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The user already reviewed this place, review_id is their review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceReviewResponse"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     }
                 }
             }
         },
-        "/users/signup": {
+        "/posts": {
+            "get": {
+                "description": "Get the posts of a list, such as a page of the newsfeed, in one request. Posts that do not exist or that the current viewer is not allowed to see are left out, the others keep the order of ids. Comments, liked users, polls and link previews are only returned by the post details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get several posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated post IDs, at most 100",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid or too many ids",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Create a new user account",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new post with text and optional images. Set quote_of_post_id to quote a public post. Set publish_at, an RFC3339 time within a year, to schedule the post: it stays hidden from everyone but its author until then. Set poll to attach a poll of 2 to 6 options, closing between 5 minutes and 30 days after the post is published.",
                 "consumes": [
                     "application/json"
                 ],
@@ -807,29 +978,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Register a new user",
+                "summary": "Create a new post",
                 "parameters": [
                     {
-                        "description": "User registration information",
-                        "name": "user",
+                        "description": "Post creation parameters",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateUserRequest"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User created successfully",
+                        "description": "Post created successfully",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error or user already exists",
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -843,9 +1020,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{user_id}": {
+        "/posts/drafts": {
             "get": {
-                "description": "Get detailed information about a user",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the current user's drafts, the last edited first",
                 "consumes": [
                     "application/json"
                 ],
@@ -853,27 +1035,214 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "drafts"
                 ],
-                "summary": "Get user details",
+                "summary": "Get drafts",
+                "responses": {
+                    "200": {
+                        "description": "Drafts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.DraftsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Save an unpublished post for the current user. Drafts are never shown to anyone else nor sent to newsfeeds, and the images they reference are kept until the draft is deleted or published.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Save a draft",
+                "parameters": [
+                    {
+                        "description": "Draft content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Draft saved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateDraftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Too many drafts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/drafts/{draft_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the fields of a draft of the current user that are set in the request, an empty media list removes its media",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Edit a draft",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Draft ID",
+                        "name": "draft_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Draft edit parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditDraftRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User details",
+                        "description": "Draft updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfoResponse"
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID or user not found",
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Draft not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a draft of the current user, its images are no longer kept for it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Delete a draft",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Draft ID",
+                        "name": "draft_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Draft deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Draft not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
                         }
@@ -886,246 +1255,5450 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CommentResponse": {
+        },
+        "/posts/drafts/{draft_id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Turn a draft of the current user into a post, which is then sent to newsfeeds like any new post. Set publish_at, an RFC3339 time within a year, to schedule the post instead. The draft is removed once published.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drafts"
+                ],
+                "summary": "Publish a draft",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Draft ID",
+                        "name": "draft_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Publication time",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PublishDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Draft published successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or empty draft",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Draft not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/nearby": {
+            "get": {
+                "description": "List the geotagged posts the current viewer is allowed to see within a radius of a point, closest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get nearby posts",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude in degrees",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude in degrees",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "default": 10,
+                        "description": "Search radius in km, at most 500",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of posts, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Nearby posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NearbyPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location, radius or limit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/scheduled": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the current user's posts waiting to be published, the next to be published first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get scheduled posts",
+                "responses": {
+                    "200": {
+                        "description": "Scheduled posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ScheduledPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/trash": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the current user's deleted posts that can still be restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get trashed posts",
+                "responses": {
+                    "200": {
+                        "description": "Trashed posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TrashedPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/url": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a presigned URL to directly upload a file to S3",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get a presigned S3 URL for file upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File name",
+                        "name": "file_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File type",
+                        "name": "file_type",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Presigned URL",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}": {
+            "get": {
+                "description": "Get detailed information about a post the current viewer is allowed to see. The previews of the links of the post appear once their pages were fetched in background.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get post details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post details",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid post ID or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Edit an existing post. media replaces the media of the post, an empty list removes them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Edit post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post edit parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a comment to an existing post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Comment on a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated post with new comment",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move an existing post to the trash. It can be restored for 30 days before it is permanently removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Delete post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/bookmark": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Save a post to the bookmarks of the current user, optionally into one of their collections. Saving a saved post again moves it to the requested collection. Bookmarks are private.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Save a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Collection to save the post into",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddBookmarkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post saved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a post from the bookmarks of the current user, and from its collection",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Unsave a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post removed from the bookmarks",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not bookmarked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/likes": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Like an existing post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Like a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post liked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/pin": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Pin one of the current user's posts to the top of their profile. Up to three posts can be pinned. Private and scheduled posts and reposts cannot be pinned, a pin is removed when its post is deleted or made private.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Pin a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post pinned successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not pinnable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Too many pinned posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove the pin of one of the current user's posts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Unpin a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post unpinned successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found or not pinned",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/poll/vote": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Vote for one option of the poll of a post, or several for multiple choice polls. Voting again replaces the previous vote until the poll closes. The results are visible once the user voted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Vote on a poll",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Chosen options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VotePollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Poll with the vote counted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or invalid options",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or poll not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Poll closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/repost": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Share a public post as is with the followers of the current user. Reposting a repost shares its original post. Followers whose newsfeed already has the post do not get it twice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Repost a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post reposted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.RepostPostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "The post is not public",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already reposted, repost_id is the existing repost",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.RepostPostResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the repost of a post by the current user, post_id is the reposted post or the repost itself",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Undo a repost",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Repost deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or post not reposted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a post from the trash and publish it again to the followers' newsfeeds. A cancelled scheduled post is scheduled again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Restore post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post restored successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Post not found in trash",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List every version of a post, oldest first. Only the author can see the revisions of a post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get post revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post revisions",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostRevisionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to see the revisions of this post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/revisions/{revision}/revert": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore the content of an earlier revision of a post. The reverted content is recorded as a new revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Revert post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post reverted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Post or revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{post_id}/schedule": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move the publication of a scheduled post of the current user to another time, within a year. Its poll closes as much later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Reschedule a post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New publication time",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ReschedulePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post rescheduled successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Post already published",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a scheduled post of the current user to the trash before it is published. Restoring it from the trash schedules it again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Cancel a scheduled post",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled post cancelled successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Post already published",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{review_id}": {
+            "get": {
+                "description": "Get a review of a place, for example a review item of the newsfeed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Get a place review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Review details",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid review ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the rating or the text of a review. Only the author may edit it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Edit a place review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPlaceReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Review edited successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a review. The author and moderators may delete it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Delete a place review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Review deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid review ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Neither the author nor a moderator",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{review_id}/helpful": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mark a review as helpful. Voting twice has no effect and authors cannot vote on their own reviews.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Vote a review helpful",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vote recorded",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid review ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Own review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove the helpful vote of the current user from a review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Remove a helpful vote",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vote removed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid review ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Own review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Full-text search over the posts the current viewer is allowed to see, or fuzzy search over user names and full names. Best matches come first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search posts or users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query, at most 200 characters",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "posts",
+                            "users"
+                        ],
+                        "type": "string",
+                        "default": "posts",
+                        "description": "What to search for",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search results",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query, type, cursor or limit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the live stories of the current user and of the users they follow, grouped by user. The current user comes first, then the users with stories the current user has not seen, the most recent story first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stories"
+                ],
+                "summary": "Get the story tray",
+                "responses": {
+                    "200": {
+                        "description": "Story tray retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryTrayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Post an image as a story of the current user. Stories are shown to the user's followers for 24 hours, then deleted with their image.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stories"
+                ],
+                "summary": "Post a story",
+                "parameters": [
+                    {
+                        "description": "Story image",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateStoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Story posted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateStoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or media is not an image",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{story_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete one of the current user's stories before it expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stories"
+                ],
+                "summary": "Delete a story",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Story ID",
+                        "name": "story_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Story deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the story",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Story not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{story_id}/view": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mark a story as seen by the current user, who is added to its viewers. Viewing a story again keeps the time of the first view.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stories"
+                ],
+                "summary": "Mark a story as seen",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Story ID",
+                        "name": "story_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Story marked as seen",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Story not found, expired or not visible",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{story_id}/viewers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the users who saw one of the current user's stories, most recent view first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stories"
+                ],
+                "summary": "Get the viewers of a story",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Story ID",
+                        "name": "story_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Viewers retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryViewersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author of the story",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Story not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a draft trip. Drafts are only visible to their owner and members until they are published.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Create a trip",
+                "parameters": [
+                    {
+                        "description": "Trip creation parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateTripRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trip created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateTripResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or place not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the pending trip invitations of the current user, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Get trip invitations",
+                "responses": {
+                    "200": {
+                        "description": "Pending invitations",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripInvitationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}": {
+            "get": {
+                "description": "Get a trip with its visited places, its members and the timeline of the posts the current viewer is allowed to see, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Get a trip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trip details",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the fields of a trip that are set. Only the owner and editors may edit a trip.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Edit a trip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Trip fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditTripRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trip edited successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or place not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner or an editor of the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/invitation/accept": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accept an invitation to a trip. The owner is notified and the current user can add their posts to the trip.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Accept a trip invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invitation accepted",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/invitation/decline": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decline an invitation to a trip, the owner may invite the current user again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Decline a trip invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invitation declined",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/members": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Invite a user to a trip as a viewer or an editor. The invitee is notified and becomes a member after accepting. Only the owner may invite.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Invite a user to a trip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User to invite and their role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.InviteTripMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User invited successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error, invitee not found or already a member",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/members/{user_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change the role of a member or of a pending invitation. Only the owner may change roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Change the role of a trip member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Member user ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UpdateTripMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role changed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a member or cancel an invitation. The owner may remove anyone, members may remove themselves to leave the trip. The posts of the removed member leave the trip.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Remove a trip member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Member user ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip or user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to remove this member",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/posts/{post_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take a post out of a trip, the post itself is kept. The owner and editors may remove any post, authors may remove their own posts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Remove a post from a trip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post removed from the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip or post ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not allowed to remove this post",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip not found or post not in the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/{trip_id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Make a draft trip visible according to its visibility. Followers allowed to see it get it in their newsfeed. Publishing a published trip does nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "Publish a trip",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trip published successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid trip ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the trip",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Trip not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/autocomplete": {
+            "get": {
+                "description": "Get the users whose user name, first name, last name or full name starts with the prefix, for mention pickers and the search box. Users the signed-in viewer follows come first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Autocomplete users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Prefix to complete, case-insensitive, a leading @ is ignored",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of users, 10 by default and at most 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching users",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AutocompleteUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid prefix or limit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/edit": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user profile information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit user profile",
+                "parameters": [
+                    {
+                        "description": "User information to update",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Login with username and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Authenticate a user",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/bookmarks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the posts saved by the current user, newest first. Posts that were deleted or that the user can no longer see are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Get saved posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only the posts of this collection",
+                        "name": "collection_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved posts",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid collection ID, cursor or limit",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/collections": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the bookmark collections of the current user by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Get bookmark collections",
+                "responses": {
+                    "200": {
+                        "description": "Bookmark collections",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a private collection to organize saved posts. Names are unique per user, regardless of case.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Create a bookmark collection",
+                "parameters": [
+                    {
+                        "description": "Collection name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collection created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateBookmarkCollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A collection with this name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/collections/{collection_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rename a bookmark collection of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Rename a bookmark collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New collection name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collection renamed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A collection with this name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a bookmark collection of the current user. Its posts stay saved, out of any collection.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookmarks"
+                ],
+                "summary": "Delete a bookmark collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Collection deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Collection not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/signup": {
+            "post": {
+                "description": "Create a new user account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration information",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or user already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/travel-visits": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a visit to a country, or to a city of a country, to the travel map of the current user without posting about it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel"
+                ],
+                "summary": "Add a visit to the travel map",
+                "parameters": [
+                    {
+                        "description": "Visited country and city",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddTravelVisitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Visit added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddTravelVisitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or visit date in the future",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/travel-visits/{visit_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a visit the current user added by hand. Visits coming from posts are removed with the location of their post.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel"
+                ],
+                "summary": "Delete a visit from the travel map",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Visit ID",
+                        "name": "visit_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Visit deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid visit ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Visit not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}": {
+            "get": {
+                "description": "Get detailed information about a user. Email and date of birth are only returned to the user themselves; following/followed_by describe the relationship with the signed-in viewer. pinned_post_ids lists the posts pinned to the profile that the viewer can see, most recently pinned first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User details",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/travel-map": {
+            "get": {
+                "description": "Get the countries and cities a user visited, with their first visit and the number of located posts, built from the user's check-ins and the visits they added by hand. Only the visits the current viewer is allowed to see are counted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel"
+                ],
+                "summary": "Get the travel map of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Travel map",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelMapResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/travel-stats": {
+            "get": {
+                "description": "Get the number of countries, cities and places a user visited, their located posts and the distance travelled between them in order. Only the visits the current viewer is allowed to see are counted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel"
+                ],
+                "summary": "Get the travel stats of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Travel stats",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddBookmarkRequest": {
+            "type": "object",
+            "properties": {
+                "collection_id": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddTravelVisitRequest": {
+            "type": "object",
+            "required": [
+                "country"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                },
+                "visited_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AddTravelVisitResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "visit_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.AutocompleteUsersResponse": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfoResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionResponse": {
+            "type": "object",
+            "properties": {
+                "bookmarks_count": {
+                    "type": "integer"
+                },
+                "collection_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionsResponse": {
+            "type": "object",
+            "properties": {
+                "collections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkCollectionResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkResponse": {
+            "type": "object",
+            "properties": {
+                "collection_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarksResponse": {
+            "type": "object",
+            "properties": {
+                "bookmarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.BookmarkResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "integer"
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MentionSpanResponse"
+                    }
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateBookmarkCollectionResponse": {
+            "type": "object",
+            "properties": {
+                "collection_id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateDraftRequest": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationRequest"
+                },
+                "media": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest"
+                    }
+                },
+                "place_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quote_of_post_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "trip_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateDraftResponse": {
+            "type": "object",
+            "properties": {
+                "draft_id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "latitude",
+                "longitude",
+                "name"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "restaurant",
+                        "cafe",
+                        "bar",
+                        "hotel",
+                        "attraction",
+                        "museum",
+                        "park",
+                        "beach",
+                        "mountain",
+                        "airport",
+                        "station",
+                        "shop",
+                        "city",
+                        "other"
+                    ]
+                },
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "place_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceReviewRequest": {
+            "type": "object",
+            "required": [
+                "rating"
+            ],
+            "properties": {
+                "content_text": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePlaceReviewResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "review_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostCommentRequest": {
+            "type": "object",
+            "required": [
+                "content_text"
+            ],
+            "properties": {
+                "content_text": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostRequest": {
+            "type": "object",
+            "required": [
+                "content_text"
+            ],
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationRequest"
+                },
+                "media": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest"
+                    }
+                },
+                "place_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "poll": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollRequest"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "quote_of_post_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "trip_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                },
+                "visible": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateStoryRequest": {
+            "type": "object",
+            "required": [
+                "media"
+            ],
+            "properties": {
+                "media": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateStoryResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "story_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateTripRequest": {
+            "type": "object",
+            "required": [
+                "start_date",
+                "title"
+            ],
+            "properties": {
+                "cover_image": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "place_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateTripResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "date_of_birth",
+                "email",
+                "password",
+                "user_name"
+            ],
+            "properties": {
+                "date_of_birth": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.DraftResponse": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "draft_id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationResponse"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
+                },
+                "place_id": {
+                    "type": "integer"
+                },
+                "quote_of_post_id": {
+                    "type": "integer"
+                },
+                "trip_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.DraftsResponse": {
+            "type": "object",
+            "properties": {
+                "drafts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.DraftResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditDraftRequest": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationRequest"
+                },
+                "media": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest"
+                    }
+                },
+                "place_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quote_of_post_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "remove_location": {
+                    "type": "boolean"
+                },
+                "remove_place": {
+                    "type": "boolean"
+                },
+                "remove_quote": {
+                    "type": "boolean"
+                },
+                "remove_trip": {
+                    "type": "boolean"
+                },
+                "trip_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPlaceReviewRequest": {
+            "type": "object",
+            "properties": {
+                "content_text": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPostRequest": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationRequest"
+                },
+                "media": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest"
+                    }
+                },
+                "place_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "remove_location": {
+                    "type": "boolean"
+                },
+                "remove_place": {
+                    "type": "boolean"
+                },
+                "remove_trip": {
+                    "type": "boolean"
+                },
+                "trip_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                },
+                "visible": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditTripRequest": {
+            "type": "object",
+            "properties": {
+                "cover_image": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "place_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "remove_end_date": {
+                    "type": "boolean"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                },
+                "visibility": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditUserRequest": {
+            "type": "object",
+            "properties": {
+                "cover_picture": {
+                    "type": "string"
+                },
+                "date_of_birth": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "profile_picture": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlResponse": {
+            "type": "object",
+            "properties": {
+                "expiration_time": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.HashtagInfoResponse": {
+            "type": "object",
+            "properties": {
+                "author_count": {
+                    "type": "integer"
+                },
+                "first_used_at": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "recent_post_count": {
+                    "type": "integer"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.HashtagPostsResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "integer"
+                },
+                "posts_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.InviteTripMemberRequest": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "viewer",
+                        "editor"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LinkPreviewResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationRequest": {
+            "type": "object",
+            "required": [
+                "latitude",
+                "longitude"
+            ],
+            "properties": {
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "place_name": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationResponse": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "place_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "user_name"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfo"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "alt_text": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "blurhash": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "height": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "mime_type": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "width": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse": {
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string"
+                },
+                "blurhash": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MentionSpanResponse": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MergePlaceRequest": {
+            "type": "object",
+            "required": [
+                "target_place_id"
+            ],
+            "properties": {
+                "target_place_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MergePlacesResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "moved_posts_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NearbyPostResponse": {
+            "type": "object",
+            "properties": {
+                "distance_km": {
+                    "type": "number"
+                },
+                "post_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NearbyPostsResponse": {
+            "type": "object",
+            "properties": {
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NearbyPostResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedItemResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "post": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostSummaryResponse"
+                },
+                "repost_of": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostSummaryResponse"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedResponse": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedItemResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "integer"
+                },
+                "posts_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlacePostsResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "integer"
+                },
+                "posts_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "check_in_count": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "distance_km": {
+                    "type": "number"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "place_id": {
+                    "type": "integer"
+                },
+                "rating_average": {
+                    "type": "number"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "visitor_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceReviewResponse": {
+            "type": "object",
+            "properties": {
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "helpful_count": {
+                    "type": "integer"
+                },
+                "place_id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "review_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "voted_helpful": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceReviewResponse"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.RatingSummaryResponse"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlacesResponse": {
+            "type": "object",
+            "properties": {
+                "places": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollOptionResponse": {
+            "type": "object",
+            "properties": {
+                "option_id": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "voter_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "votes_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollRequest": {
+            "type": "object",
+            "required": [
+                "expires_at",
+                "options"
+            ],
+            "properties": {
+                "anonymous": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "multiple_choice": {
+                    "type": "boolean"
+                },
+                "options": {
+                    "type": "array",
+                    "maxItems": 6,
+                    "minItems": 2,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollResponse": {
+            "type": "object",
+            "properties": {
+                "anonymous": {
+                    "type": "boolean"
+                },
+                "closed": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "multiple_choice": {
+                    "type": "boolean"
+                },
+                "my_option_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollOptionResponse"
+                    }
+                },
+                "results_visible": {
+                    "type": "boolean"
+                },
+                "voters_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostAuthorResponse": {
+            "type": "object",
+            "properties": {
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "profile_picture": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse": {
+            "type": "object",
+            "properties": {
+                "bookmarked_by_me": {
+                    "type": "boolean"
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CommentResponse"
+                    }
+                },
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "liked_by_me": {
+                    "type": "boolean"
+                },
+                "link_previews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LinkPreviewResponse"
+                    }
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationResponse"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MentionSpanResponse"
+                    }
+                },
+                "place": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse"
+                },
+                "poll": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PollResponse"
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "quote_count": {
+                    "type": "integer"
+                },
+                "quote_of_post_id": {
+                    "type": "integer"
+                },
+                "repost_count": {
+                    "type": "integer"
+                },
+                "repost_of_post_id": {
+                    "type": "integer"
+                },
+                "reposted_by_me": {
+                    "type": "boolean"
+                },
+                "trip_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "users_liked": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
+                },
+                "revision": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostRevisionResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostAuthorResponse"
+                },
+                "bookmarked_by_me": {
+                    "type": "boolean"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "liked_by_me": {
+                    "type": "boolean"
+                },
+                "location": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LocationResponse"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MentionSpanResponse"
+                    }
+                },
+                "place": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse"
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "quote_count": {
+                    "type": "integer"
+                },
+                "quote_of_post_id": {
+                    "type": "integer"
+                },
+                "repost_count": {
+                    "type": "integer"
+                },
+                "repost_of_post_id": {
+                    "type": "integer"
+                },
+                "reposted_by_me": {
+                    "type": "boolean"
+                },
+                "trip_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostsResponse": {
+            "type": "object",
+            "properties": {
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostSummaryResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PublishDraftRequest": {
+            "type": "object",
+            "properties": {
+                "publish_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.RatingSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "counts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.RepostPostResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "repost_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ReschedulePostRequest": {
+            "type": "object",
+            "required": [
+                "publish_at"
+            ],
+            "properties": {
+                "publish_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ScheduledPostResponse": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "publish_at": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ScheduledPostsResponse": {
+            "type": "object",
+            "properties": {
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ScheduledPostResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "posts_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfoResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryResponse": {
             "type": "object",
             "properties": {
-                "comment_id": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "content_text": {
+                "expires_at": {
                     "type": "string"
                 },
-                "post_id": {
+                "media": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                },
+                "seen": {
+                    "type": "boolean"
+                },
+                "story_id": {
                     "type": "integer"
                 },
                 "user_id": {
                     "type": "integer"
+                },
+                "view_count": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostCommentRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryTrayEntryResponse": {
             "type": "object",
-            "required": [
-                "content_text"
-            ],
             "properties": {
-                "content_text": {
-                    "type": "string"
+                "author": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostAuthorResponse"
+                },
+                "has_unseen": {
+                    "type": "boolean"
+                },
+                "stories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryResponse"
+                    }
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreatePostRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryTrayResponse": {
             "type": "object",
-            "required": [
-                "content_text"
-            ],
             "properties": {
-                "content_image_path": {
+                "entries": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryTrayEntryResponse"
                     }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryViewerResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostAuthorResponse"
                 },
-                "content_text": {
+                "viewed_at": {
                     "type": "string"
-                },
-                "visible": {
-                    "type": "boolean"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CreateUserRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryViewersResponse": {
             "type": "object",
-            "required": [
-                "date_of_birth",
-                "email",
-                "password",
-                "user_name"
-            ],
             "properties": {
-                "date_of_birth": {
-                    "type": "string"
+                "viewers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.StoryViewerResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TrashedPostResponse": {
+            "type": "object",
+            "properties": {
+                "content_image_path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "email": {
+                "content_text": {
                     "type": "string"
                 },
-                "first_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "last_name": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string"
+                "media": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MediaResponse"
+                    }
                 },
-                "user_name": {
+                "post_id": {
+                    "type": "integer"
+                },
+                "purge_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditPostRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TrashedPostsResponse": {
             "type": "object",
             "properties": {
-                "content_image_path": {
+                "posts": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TrashedPostResponse"
+                    }
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelMapResponse": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VisitedCountryResponse"
                     }
                 },
-                "content_text": {
-                    "type": "string"
-                },
-                "visible": {
-                    "type": "boolean"
+                "visits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelVisitResponse"
+                    }
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.EditUserRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelStatsResponse": {
             "type": "object",
             "properties": {
-                "cover_picture": {
-                    "type": "string"
+                "cities_count": {
+                    "type": "integer"
                 },
-                "date_of_birth": {
-                    "type": "string"
+                "countries_count": {
+                    "type": "integer"
                 },
-                "first_name": {
-                    "type": "string"
+                "distance_km": {
+                    "type": "number"
                 },
-                "last_name": {
+                "first_visited_at": {
                     "type": "string"
                 },
-                "password": {
+                "last_visited_at": {
                     "type": "string"
                 },
-                "profile_picture": {
-                    "type": "string"
+                "places_count": {
+                    "type": "integer"
+                },
+                "posts_count": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.ErrorResponse": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TravelVisitResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
+                "city": {
+                    "type": "string"
                 },
-                "error": {
+                "country": {
                     "type": "string"
                 },
-                "message": {
+                "visibility": {
+                    "type": "string"
+                },
+                "visit_id": {
+                    "type": "integer"
+                },
+                "visited_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripInvitationResponse": {
             "type": "object",
-            "required": [
-                "file_name",
-                "file_type"
-            ],
             "properties": {
-                "file_name": {
+                "created_at": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "integer"
+                },
+                "role": {
                     "type": "string"
                 },
-                "file_type": {
+                "title": {
                     "type": "string"
+                },
+                "trip_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.GetS3PresignedUrlResponse": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripInvitationsResponse": {
             "type": "object",
             "properties": {
-                "expiration_time": {
-                    "type": "string"
-                },
-                "url": {
-                    "type": "string"
+                "invitations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripInvitationResponse"
+                    }
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginRequest": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripMemberResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "user_name"
-            ],
             "properties": {
-                "password": {
-                    "type": "string"
+                "pending": {
+                    "type": "boolean"
                 },
-                "user_name": {
+                "role": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.LoginResponse": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripPostResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "created_at": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UserDetailInfo"
+                "place_id": {
+                    "type": "integer"
+                },
+                "post_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.MessageResponse": {
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "cover_image": {
                     "type": "string"
                 },
-                "status": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.NewsfeedResponse": {
-            "type": "object",
-            "properties": {
-                "posts_ids": {
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "members": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripMemberResponse"
                     }
-                }
-            }
-        },
-        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PostDetailInfoResponse": {
-            "type": "object",
-            "properties": {
-                "comments": {
+                },
+                "places": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.CommentResponse"
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.PlaceResponse"
                     }
                 },
-                "content_image_path": {
+                "published_at": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "timeline": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.TripPostResponse"
                     }
                 },
-                "content_text": {
-                    "type": "string"
-                },
-                "created_at": {
+                "title": {
                     "type": "string"
                 },
-                "post_id": {
+                "trip_id": {
                     "type": "integer"
                 },
                 "user_id": {
                     "type": "integer"
                 },
-                "users_liked": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.UpdateTripMemberRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "viewer",
+                        "editor"
+                    ]
                 }
             }
         },
@@ -1173,9 +6746,21 @@ const docTemplate = `{
                 "first_name": {
                     "type": "string"
                 },
+                "followed_by": {
+                    "type": "boolean"
+                },
+                "following": {
+                    "type": "boolean"
+                },
                 "last_name": {
                     "type": "string"
                 },
+                "pinned_post_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "profile_picture": {
                     "type": "string"
                 },
@@ -1219,6 +6804,55 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VisitedCityResponse": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "first_visited_at": {
+                    "type": "string"
+                },
+                "posts_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VisitedCountryResponse": {
+            "type": "object",
+            "properties": {
+                "cities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VisitedCityResponse"
+                    }
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_visited_at": {
+                    "type": "string"
+                },
+                "posts_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_hoangNguyenDev3_WanderSphere_backend_internal_pkg_types.VotePollRequest": {
+            "type": "object",
+            "required": [
+                "option_ids"
+            ],
+            "properties": {
+                "option_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -31,15 +31,181 @@ type EditUserRequest struct {
 
 // CreatePostRequest represents a post creation request
 type CreatePostRequest struct {
-	ContentText      string   `json:"content_text" example:"Hello world!"`
-	ContentImagePath []string `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
-	Visible          bool     `json:"visible" example:"true"`
+	ContentText      string           `json:"content_text" example:"Hello world!"`
+	ContentImagePath []string         `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaRequest   `json:"media"`
+	Visible          bool             `json:"visible" example:"true"`
+	Visibility       string           `json:"visibility" example:"followers"`
+	Location         *LocationRequest `json:"location"`
+	PlaceId          int64            `json:"place_id" example:"42"`
+	TripId           int64            `json:"trip_id" example:"7"`
+	QuoteOfPostId    int64            `json:"quote_of_post_id" example:"99"`
+	PublishAt        string           `json:"publish_at" example:"2024-05-01T07:00:00Z"`
+	Poll             *PollRequest     `json:"poll"`
+}
+
+// MediaRequest represents an uploaded image or video, in display order
+type MediaRequest struct {
+	URL      string `json:"url" example:"https://example.com/image.jpg"`
+	MimeType string `json:"mime_type" example:"image/jpeg"`
+	Width    int32  `json:"width" example:"1080"`
+	Height   int32  `json:"height" example:"1350"`
+	AltText  string `json:"alt_text" example:"Sunset over the Douro river"`
+	Blurhash string `json:"blurhash" example:"LEHV6nWB2yk8pyo0adR*.7kCMdnj"`
+}
+
+// PollRequest represents the poll of a new post, with 2 to 6 options
+type PollRequest struct {
+	Options        []string `json:"options" example:"[\"Lisbon\",\"Porto\"]"`
+	ExpiresAt      string   `json:"expires_at" example:"2024-05-02T07:00:00Z"`
+	MultipleChoice bool     `json:"multiple_choice" example:"false"`
+	Anonymous      bool     `json:"anonymous" example:"true"`
+}
+
+// VotePollRequest represents the options a user votes for
+type VotePollRequest struct {
+	OptionIds []int64 `json:"option_ids" example:"31"`
+}
+
+// ReschedulePostRequest represents a new publication time of a scheduled post
+type ReschedulePostRequest struct {
+	PublishAt string `json:"publish_at" example:"2024-05-02T07:00:00Z"`
+}
+
+// LocationRequest represents the location of a post, coordinates are WGS84 degrees
+type LocationRequest struct {
+	Latitude  float64 `json:"latitude" example:"35.0116"`
+	Longitude float64 `json:"longitude" example:"135.7681"`
+	PlaceName string  `json:"place_name" example:"Kyoto"`
+}
+
+// CreateDraftRequest represents an unpublished post, every field is optional
+type CreateDraftRequest struct {
+	ContentText      string           `json:"content_text" example:"Day one in Porto:"`
+	ContentImagePath []string         `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaRequest   `json:"media"`
+	Visibility       string           `json:"visibility" example:"followers"`
+	Location         *LocationRequest `json:"location"`
+	PlaceId          int64            `json:"place_id" example:"42"`
+	TripId           int64            `json:"trip_id" example:"7"`
+	QuoteOfPostId    int64            `json:"quote_of_post_id" example:"99"`
+}
+
+// EditDraftRequest represents a draft update request, only the fields that are set are updated
+type EditDraftRequest struct {
+	ContentText      string           `json:"content_text" example:"Day one in Porto: pastéis de nata"`
+	ContentImagePath []string         `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaRequest   `json:"media"`
+	Visibility       string           `json:"visibility" example:"public"`
+	Location         *LocationRequest `json:"location"`
+	RemoveLocation   bool             `json:"remove_location" example:"false"`
+	PlaceId          int64            `json:"place_id" example:"42"`
+	RemovePlace      bool             `json:"remove_place" example:"false"`
+	TripId           int64            `json:"trip_id" example:"7"`
+	RemoveTrip       bool             `json:"remove_trip" example:"false"`
+	QuoteOfPostId    int64            `json:"quote_of_post_id" example:"99"`
+	RemoveQuote      bool             `json:"remove_quote" example:"false"`
+}
+
+// PublishDraftRequest represents the publication of a draft, publish_at schedules it
+type PublishDraftRequest struct {
+	PublishAt string `json:"publish_at" example:"2024-05-01T07:00:00Z"`
 }
 
 // EditPostRequest represents a post update request
 type EditPostRequest struct {
-	ContentText string `json:"content_text" example:"Updated post content"`
-	Visible     bool   `json:"visible" example:"true"`
+	ContentText    string           `json:"content_text" example:"Updated post content"`
+	Media          []MediaRequest   `json:"media"`
+	Visible        bool             `json:"visible" example:"true"`
+	Visibility     string           `json:"visibility" example:"private"`
+	Location       *LocationRequest `json:"location"`
+	RemoveLocation bool             `json:"remove_location" example:"false"`
+	PlaceId        int64            `json:"place_id" example:"42"`
+	RemovePlace    bool             `json:"remove_place" example:"false"`
+	TripId         int64            `json:"trip_id" example:"7"`
+	RemoveTrip     bool             `json:"remove_trip" example:"false"`
+}
+
+// CreatePlaceRequest represents a place creation request, coordinates are WGS84 degrees
+type CreatePlaceRequest struct {
+	Name      string  `json:"name" example:"Fushimi Inari Taisha"`
+	Category  string  `json:"category" example:"attraction" enums:"restaurant,cafe,bar,hotel,attraction,museum,park,beach,mountain,airport,station,shop,city,other"`
+	Country   string  `json:"country" example:"Japan"`
+	City      string  `json:"city" example:"Kyoto"`
+	Latitude  float64 `json:"latitude" example:"34.9671"`
+	Longitude float64 `json:"longitude" example:"135.7727"`
+}
+
+// MergePlaceRequest represents a request to merge a duplicate place into another place
+type MergePlaceRequest struct {
+	TargetPlaceId int64 `json:"target_place_id" example:"42"`
+}
+
+// CreateTripRequest represents a trip creation request, dates are YYYY-MM-DD
+type CreateTripRequest struct {
+	Title      string  `json:"title" example:"Two weeks in Japan"`
+	StartDate  string  `json:"start_date" example:"2024-04-01"`
+	EndDate    string  `json:"end_date" example:"2024-04-14"`
+	CoverImage string  `json:"cover_image" example:"https://example.com/kyoto.jpg"`
+	Visibility string  `json:"visibility" example:"public" enums:"public,followers,private"`
+	PlaceIds   []int64 `json:"place_ids" example:"[42,43]"`
+}
+
+// EditTripRequest represents a trip update request, place_ids replaces the visited places when it is set
+type EditTripRequest struct {
+	Title         string  `json:"title" example:"Three weeks in Japan"`
+	StartDate     string  `json:"start_date" example:"2024-04-01"`
+	EndDate       string  `json:"end_date" example:"2024-04-21"`
+	RemoveEndDate bool    `json:"remove_end_date" example:"false"`
+	CoverImage    string  `json:"cover_image" example:"https://example.com/osaka.jpg"`
+	Visibility    string  `json:"visibility" example:"followers" enums:"public,followers,private"`
+	PlaceIds      []int64 `json:"place_ids" example:"[42,43,44]"`
+}
+
+// InviteTripMemberRequest represents an invitation of a user to a trip
+type InviteTripMemberRequest struct {
+	UserId int64  `json:"user_id" example:"456"`
+	Role   string `json:"role" example:"editor" enums:"viewer,editor"`
+}
+
+// UpdateTripMemberRequest represents a change of the role of a trip member
+type UpdateTripMemberRequest struct {
+	Role string `json:"role" example:"viewer" enums:"viewer,editor"`
+}
+
+// CreatePlaceReviewRequest represents a review of a place
+type CreatePlaceReviewRequest struct {
+	Rating      int32  `json:"rating" example:"5" minimum:"1" maximum:"5"`
+	ContentText string `json:"content_text" example:"Go early in the morning to walk the gates without the crowds."`
+}
+
+// EditPlaceReviewRequest represents an edit of a review, only the fields that are set are updated
+type EditPlaceReviewRequest struct {
+	Rating      *int32  `json:"rating,omitempty" example:"4" minimum:"1" maximum:"5"`
+	ContentText *string `json:"content_text,omitempty" example:"Still great, but very busy after 9am."`
+}
+
+// AddBookmarkRequest represents a saved post, collection_id 0 keeps it out of any collection
+type AddBookmarkRequest struct {
+	CollectionId int64 `json:"collection_id" example:"3"`
+}
+
+// BookmarkCollectionRequest represents the name of a bookmark collection
+type BookmarkCollectionRequest struct {
+	Name string `json:"name" example:"Japan ideas" maxLength:"100"`
+}
+
+// AddTravelVisitRequest represents a visit added to the travel map by hand
+type AddTravelVisitRequest struct {
+	Country    string `json:"country" example:"Japan"`
+	City       string `json:"city" example:"Kyoto"`
+	VisitedAt  string `json:"visited_at" example:"2019-10-03"`
+	Visibility string `json:"visibility" example:"public" enums:"public,followers,private"`
+}
+
+// CreateStoryRequest represents a story creation request, the media must be an image
+type CreateStoryRequest struct {
+	Media MediaRequest `json:"media"`
 }
 
 // CreatePostCommentRequest represents a comment creation request
@@ -74,21 +240,264 @@ type ErrorResponse struct {
 
 // PostDetailInfoResponse represents detailed information about a post
 type PostDetailInfoResponse struct {
-	PostID           int64             `json:"post_id" example:"123"`
-	UserID           int64             `json:"user_id" example:"456"`
-	ContentText      string            `json:"content_text" example:"This is a post"`
+	PostID           int64                 `json:"post_id" example:"123"`
+	UserID           int64                 `json:"user_id" example:"456"`
+	ContentText      string                `json:"content_text" example:"This is a post"`
+	ContentImagePath []string              `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaResponse       `json:"media"`
+	Visibility       string                `json:"visibility" example:"public"`
+	CreatedAt        string                `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	Comments         []CommentResponse     `json:"comments"`
+	UsersLiked       []int64               `json:"users_liked" example:"[789,101]"`
+	LikedByMe        bool                  `json:"liked_by_me" example:"false"`
+	EditedAt         string                `json:"edited_at,omitempty" example:"2023-01-02T12:00:00Z"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *LocationResponse     `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripId           int64                 `json:"trip_id,omitempty" example:"7"`
+	RepostOfPostId   int64                 `json:"repost_of_post_id,omitempty" example:"99"`
+	QuoteOfPostId    int64                 `json:"quote_of_post_id,omitempty" example:"98"`
+	RepostCount      int64                 `json:"repost_count" example:"3"`
+	QuoteCount       int64                 `json:"quote_count" example:"1"`
+	RepostedByMe     bool                  `json:"reposted_by_me" example:"false"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me" example:"true"`
+	PublishAt        string                `json:"publish_at,omitempty" example:"2024-05-01T07:00:00Z"`
+	Poll             *PollResponse         `json:"poll,omitempty"`
+	LinkPreviews     []LinkPreviewResponse `json:"link_previews"`
+}
+
+// LinkPreviewResponse represents the preview of a link found in a post
+type LinkPreviewResponse struct {
+	URL         string `json:"url" example:"https://example.com/douro-valley"`
+	Title       string `json:"title,omitempty" example:"Douro Valley"`
+	Description string `json:"description,omitempty" example:"Vineyards along the Douro river"`
+	ImageURL    string `json:"image_url,omitempty" example:"https://example.com/douro.jpg"`
+	SiteName    string `json:"site_name,omitempty" example:"Example Travel"`
+}
+
+// PollResponse represents the poll of a post, the tallies are only set once results are visible
+type PollResponse struct {
+	Options        []PollOptionResponse `json:"options"`
+	ExpiresAt      string               `json:"expires_at" example:"2024-05-02T07:00:00Z"`
+	MultipleChoice bool                 `json:"multiple_choice" example:"false"`
+	Anonymous      bool                 `json:"anonymous" example:"false"`
+	Closed         bool                 `json:"closed" example:"false"`
+	VotersCount    int64                `json:"voters_count" example:"12"`
+	ResultsVisible bool                 `json:"results_visible" example:"true"`
+	MyOptionIds    []int64              `json:"my_option_ids" example:"31"`
+}
+
+// PollOptionResponse represents an option of a poll
+type PollOptionResponse struct {
+	OptionId   int64   `json:"option_id" example:"31"`
+	Text       string  `json:"text" example:"Lisbon"`
+	VotesCount int64   `json:"votes_count" example:"7"`
+	VoterIds   []int64 `json:"voter_ids,omitempty" example:"5"`
+}
+
+// RepostPostResponse represents a successful repost, repost_id is the post created for the repost
+type RepostPostResponse struct {
+	Message  string `json:"message" example:"OK"`
+	RepostId int64  `json:"repost_id" example:"124"`
+}
+
+// MediaResponse represents an image or video of a post
+type MediaResponse struct {
+	URL      string `json:"url" example:"https://example.com/image.jpg"`
+	MimeType string `json:"mime_type,omitempty" example:"image/jpeg"`
+	Width    int32  `json:"width,omitempty" example:"1080"`
+	Height   int32  `json:"height,omitempty" example:"1350"`
+	AltText  string `json:"alt_text,omitempty" example:"Sunset over the Douro river"`
+	Blurhash string `json:"blurhash,omitempty" example:"LEHV6nWB2yk8pyo0adR*.7kCMdnj"`
+}
+
+// LocationResponse represents where a post was written
+type LocationResponse struct {
+	Latitude  float64 `json:"latitude" example:"35.0116"`
+	Longitude float64 `json:"longitude" example:"135.7681"`
+	PlaceName string  `json:"place_name,omitempty" example:"Kyoto"`
+}
+
+// NearbyPostResponse represents a post close to the requested point
+type NearbyPostResponse struct {
+	PostId     int64   `json:"post_id" example:"123"`
+	DistanceKm float64 `json:"distance_km" example:"1.25"`
+}
+
+// PostsResponse represents the posts of a list, in the requested order
+type PostsResponse struct {
+	Posts []PostSummaryResponse `json:"posts"`
+}
+
+// PostSummaryResponse represents a post as shown in a list
+type PostSummaryResponse struct {
+	PostID           int64                 `json:"post_id" example:"123"`
+	UserID           int64                 `json:"user_id" example:"456"`
+	Author           *PostAuthorResponse   `json:"author,omitempty"`
+	ContentText      string                `json:"content_text" example:"This is a post"`
+	ContentImagePath []string              `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaResponse       `json:"media"`
+	Visibility       string                `json:"visibility" example:"public"`
+	CreatedAt        string                `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	EditedAt         string                `json:"edited_at,omitempty" example:"2023-01-02T12:00:00Z"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *LocationResponse     `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripId           int64                 `json:"trip_id,omitempty" example:"7"`
+	RepostOfPostId   int64                 `json:"repost_of_post_id,omitempty" example:"99"`
+	QuoteOfPostId    int64                 `json:"quote_of_post_id,omitempty" example:"98"`
+	LikeCount        int64                 `json:"like_count" example:"12"`
+	CommentCount     int64                 `json:"comment_count" example:"4"`
+	RepostCount      int64                 `json:"repost_count" example:"3"`
+	QuoteCount       int64                 `json:"quote_count" example:"1"`
+	LikedByMe        bool                  `json:"liked_by_me" example:"false"`
+	RepostedByMe     bool                  `json:"reposted_by_me" example:"false"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me" example:"true"`
+}
+
+// PostAuthorResponse represents the public profile of the author of a post
+type PostAuthorResponse struct {
+	UserID         int64  `json:"user_id" example:"456"`
+	UserName       string `json:"user_name" example:"johndoe"`
+	FirstName      string `json:"first_name" example:"John"`
+	LastName       string `json:"last_name" example:"Doe"`
+	ProfilePicture string `json:"profile_picture,omitempty" example:"https://example.com/profile.jpg"`
+}
+
+// CreateStoryResponse represents a new story
+type CreateStoryResponse struct {
+	StoryID   int64  `json:"story_id" example:"42"`
+	ExpiresAt string `json:"expires_at" example:"2023-01-02T12:00:00Z"`
+}
+
+// StoryTrayResponse represents the users with live stories, the current user first then unseen stories first
+type StoryTrayResponse struct {
+	Entries []StoryTrayEntryResponse `json:"entries"`
+}
+
+// StoryTrayEntryResponse represents the live stories of a user, oldest first
+type StoryTrayEntryResponse struct {
+	Author    PostAuthorResponse `json:"author"`
+	Stories   []StoryResponse    `json:"stories"`
+	HasUnseen bool               `json:"has_unseen" example:"true"`
+}
+
+// StoryResponse represents a story, view_count is only set for its author
+type StoryResponse struct {
+	StoryID   int64         `json:"story_id" example:"42"`
+	UserID    int64         `json:"user_id" example:"456"`
+	Media     MediaResponse `json:"media"`
+	CreatedAt string        `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	ExpiresAt string        `json:"expires_at" example:"2023-01-02T12:00:00Z"`
+	Seen      bool          `json:"seen" example:"false"`
+	ViewCount int64         `json:"view_count,omitempty" example:"12"`
+}
+
+// StoryViewersResponse represents who saw a story, most recent view first
+type StoryViewersResponse struct {
+	Viewers []StoryViewerResponse `json:"viewers"`
+}
+
+// StoryViewerResponse represents a user who saw a story
+type StoryViewerResponse struct {
+	User     PostAuthorResponse `json:"user"`
+	ViewedAt string             `json:"viewed_at" example:"2023-01-01T13:00:00Z"`
+}
+
+// NearbyPostsResponse represents nearby posts, closest first
+type NearbyPostsResponse struct {
+	Posts []NearbyPostResponse `json:"posts"`
+}
+
+// PostRevisionResponse represents one version of a post's content
+type PostRevisionResponse struct {
+	Revision         int32           `json:"revision" example:"2"`
+	ContentText      string          `json:"content_text" example:"This is an edited post"`
+	ContentImagePath []string        `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaResponse `json:"media"`
+	Visibility       string          `json:"visibility" example:"public"`
+	CreatedAt        string          `json:"created_at" example:"2023-01-02T12:00:00Z"`
+}
+
+// PostRevisionsResponse represents a response with the revisions of a post
+type PostRevisionsResponse struct {
+	Revisions []PostRevisionResponse `json:"revisions"`
+}
+
+// TrashedPostResponse represents a deleted post that can still be restored
+type TrashedPostResponse struct {
+	PostID           int64           `json:"post_id" example:"123"`
+	ContentText      string          `json:"content_text" example:"This is a post"`
+	ContentImagePath []string        `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaResponse `json:"media"`
+	CreatedAt        string          `json:"created_at" example:"2023-01-01T12:00:00Z"`
+	DeletedAt        string          `json:"deleted_at" example:"2023-01-02T12:00:00Z"`
+	PurgeAt          string          `json:"purge_at" example:"2023-02-01T12:00:00Z"`
+}
+
+// TrashedPostsResponse represents a response with the current user's trashed posts
+type TrashedPostsResponse struct {
+	Posts []TrashedPostResponse `json:"posts"`
+}
+
+// ScheduledPostResponse represents a post waiting to be published
+type ScheduledPostResponse struct {
+	PostID           int64           `json:"post_id" example:"123"`
+	ContentText      string          `json:"content_text" example:"Landing in Lisbon tomorrow!"`
+	ContentImagePath []string        `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
+	Media            []MediaResponse `json:"media"`
+	Visibility       string          `json:"visibility" example:"public"`
+	CreatedAt        string          `json:"created_at" example:"2024-04-30T20:00:00Z"`
+	PublishAt        string          `json:"publish_at" example:"2024-05-01T07:00:00Z"`
+}
+
+// ScheduledPostsResponse represents the current user's scheduled posts, the next to be published first
+type ScheduledPostsResponse struct {
+	Posts []ScheduledPostResponse `json:"posts"`
+}
+
+// DraftResponse represents an unpublished post
+type DraftResponse struct {
+	DraftID          int64             `json:"draft_id" example:"12"`
+	ContentText      string            `json:"content_text" example:"Day one in Porto:"`
 	ContentImagePath []string          `json:"content_image_path" example:"[\"https://example.com/image.jpg\"]"`
-	CreatedAt        string            `json:"created_at" example:"2023-01-01T12:00:00Z"`
-	Comments         []CommentResponse `json:"comments"`
-	UsersLiked       []int64           `json:"users_liked" example:"[789,101]"`
+	Media            []MediaResponse   `json:"media"`
+	Visibility       string            `json:"visibility" example:"public"`
+	Location         *LocationResponse `json:"location,omitempty"`
+	PlaceId          int64             `json:"place_id,omitempty" example:"42"`
+	TripId           int64             `json:"trip_id,omitempty" example:"7"`
+	QuoteOfPostId    int64             `json:"quote_of_post_id,omitempty" example:"99"`
+	CreatedAt        string            `json:"created_at" example:"2024-04-30T20:00:00Z"`
+	UpdatedAt        string            `json:"updated_at" example:"2024-04-30T20:05:00Z"`
+}
+
+// DraftsResponse represents the current user's drafts, the last edited first
+type DraftsResponse struct {
+	Drafts []DraftResponse `json:"drafts"`
+}
+
+// CreateDraftResponse represents a successful draft creation response
+type CreateDraftResponse struct {
+	Message string `json:"message" example:"OK"`
+	DraftId int64  `json:"draft_id" example:"12"`
 }
 
 // CommentResponse represents a comment on a post
 type CommentResponse struct {
-	CommentId   int64  `json:"comment_id" example:"123"`
-	UserId      int64  `json:"user_id" example:"456"`
-	PostId      int64  `json:"post_id" example:"789"`
-	ContentText string `json:"content_text" example:"Great post!"`
+	CommentId   int64                 `json:"comment_id" example:"123"`
+	UserId      int64                 `json:"user_id" example:"456"`
+	PostId      int64                 `json:"post_id" example:"789"`
+	ContentText string                `json:"content_text" example:"Great post!"`
+	Mentions    []MentionSpanResponse `json:"mentions"`
+}
+
+// MentionSpanResponse represents a resolved @username in a text.
+// Start and End are code point offsets of the "@username" span, End exclusive.
+type MentionSpanResponse struct {
+	UserID   int64  `json:"user_id" example:"456"`
+	UserName string `json:"user_name" example:"johndoe"`
+	Start    int32  `json:"start" example:"0"`
+	End      int32  `json:"end" example:"8"`
 }
 
 // UserDetailInfo represents detailed user information
@@ -105,14 +514,22 @@ type UserDetailInfo struct {
 
 // UserDetailInfoResponse represents a response with user details
 type UserDetailInfoResponse struct {
-	UserID         int64  `json:"user_id" example:"123"`
-	UserName       string `json:"user_name" example:"johndoe"`
-	FirstName      string `json:"first_name" example:"John"`
-	LastName       string `json:"last_name" example:"Doe"`
-	DateOfBirth    string `json:"date_of_birth" example:"1990-01-01"`
-	Email          string `json:"email" example:"john.doe@example.com"`
-	ProfilePicture string `json:"profile_picture,omitempty" example:"https://example.com/profile.jpg"`
-	CoverPicture   string `json:"cover_picture,omitempty" example:"https://example.com/cover.jpg"`
+	UserID         int64   `json:"user_id" example:"123"`
+	UserName       string  `json:"user_name" example:"johndoe"`
+	FirstName      string  `json:"first_name" example:"John"`
+	LastName       string  `json:"last_name" example:"Doe"`
+	DateOfBirth    string  `json:"date_of_birth,omitempty" example:"1990-01-01"`
+	Email          string  `json:"email,omitempty" example:"john.doe@example.com"`
+	ProfilePicture string  `json:"profile_picture,omitempty" example:"https://example.com/profile.jpg"`
+	CoverPicture   string  `json:"cover_picture,omitempty" example:"https://example.com/cover.jpg"`
+	Following      bool    `json:"following" example:"true"`
+	FollowedBy     bool    `json:"followed_by" example:"false"`
+	PinnedPostIds  []int64 `json:"pinned_post_ids,omitempty" example:"42,17"`
+}
+
+// AutocompleteUsersResponse represents the users matching an autocomplete prefix
+type AutocompleteUsersResponse struct {
+	Users []UserDetailInfoResponse `json:"users"`
 }
 
 // GetS3PresignedUrlResponse represents a response with a presigned S3 URL
@@ -136,7 +553,248 @@ type UserPostsResponse struct {
 	PostsIds []int64 `json:"posts_ids" example:"[123,456]"`
 }
 
-// NewsfeedResponse represents a response with a user's newsfeed
+// HashtagInfoResponse represents the usage statistics of a hashtag
+type HashtagInfoResponse struct {
+	Tag             string `json:"tag" example:"kyoto"`
+	PostCount       int64  `json:"post_count" example:"42"`
+	AuthorCount     int64  `json:"author_count" example:"17"`
+	RecentPostCount int64  `json:"recent_post_count" example:"5"`
+	FirstUsedAt     string `json:"first_used_at,omitempty" example:"2023-01-01T12:00:00Z"`
+	LastUsedAt      string `json:"last_used_at,omitempty" example:"2023-03-01T12:00:00Z"`
+}
+
+// HashtagPostsResponse represents a page of posts using a hashtag
+type HashtagPostsResponse struct {
+	PostsIds   []int64 `json:"posts_ids" example:"[456,123]"`
+	NextCursor int64   `json:"next_cursor" example:"123"`
+}
+
+// CreatePlaceResponse represents a place creation response
+type CreatePlaceResponse struct {
+	Message string `json:"message" example:"OK"`
+	PlaceId int64  `json:"place_id" example:"42"`
+}
+
+// PlaceResponse represents a place of the catalog with its public check-in statistics
+type PlaceResponse struct {
+	PlaceId       int64   `json:"place_id" example:"42"`
+	Name          string  `json:"name" example:"Fushimi Inari Taisha"`
+	Category      string  `json:"category" example:"attraction"`
+	Country       string  `json:"country,omitempty" example:"Japan"`
+	City          string  `json:"city,omitempty" example:"Kyoto"`
+	Latitude      float64 `json:"latitude" example:"34.9671"`
+	Longitude     float64 `json:"longitude" example:"135.7727"`
+	CreatedBy     int64   `json:"created_by" example:"1"`
+	CreatedAt     string  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	CheckInCount  int64   `json:"check_in_count" example:"128"`
+	VisitorCount  int64   `json:"visitor_count" example:"97"`
+	RatingCount   int64   `json:"rating_count" example:"35"`
+	RatingAverage float64 `json:"rating_average" example:"4.6"`
+	DistanceKm    float64 `json:"distance_km,omitempty" example:"2.4"`
+}
+
+// PlacesResponse represents the places matching a search
+type PlacesResponse struct {
+	Places []PlaceResponse `json:"places"`
+}
+
+// PlacePostsResponse represents a page of check-ins at a place
+type PlacePostsResponse struct {
+	PostsIds   []int64 `json:"posts_ids" example:"[125,124,120]"`
+	NextCursor int64   `json:"next_cursor" example:"120"`
+}
+
+// CreateTripResponse represents a trip creation response
+type CreateTripResponse struct {
+	Message string `json:"message" example:"OK"`
+	TripId  int64  `json:"trip_id" example:"7"`
+}
+
+// TripResponse represents a trip with its visited places and the timeline of its posts, oldest first
+type TripResponse struct {
+	TripId      int64                `json:"trip_id" example:"7"`
+	UserId      int64                `json:"user_id" example:"1"`
+	Title       string               `json:"title" example:"Two weeks in Japan"`
+	StartDate   string               `json:"start_date" example:"2024-04-01"`
+	EndDate     string               `json:"end_date,omitempty" example:"2024-04-14"`
+	CoverImage  string               `json:"cover_image,omitempty" example:"https://example.com/kyoto.jpg"`
+	Visibility  string               `json:"visibility" example:"public"`
+	PublishedAt string               `json:"published_at,omitempty" example:"2024-04-15T09:00:00Z"`
+	CreatedAt   string               `json:"created_at" example:"2024-03-20T18:00:00Z"`
+	Places      []PlaceResponse      `json:"places"`
+	Timeline    []TripPostResponse   `json:"timeline"`
+	Members     []TripMemberResponse `json:"members"`
+}
+
+// TripPostResponse represents a post of a trip timeline, place_id is set for check-ins
+type TripPostResponse struct {
+	PostId    int64  `json:"post_id" example:"123"`
+	UserId    int64  `json:"user_id" example:"1"`
+	CreatedAt string `json:"created_at" example:"2024-04-02T10:30:00Z"`
+	PlaceId   int64  `json:"place_id,omitempty" example:"42"`
+}
+
+// TripMemberResponse represents a member of a trip, pending invitations are only listed to the owner
+type TripMemberResponse struct {
+	UserId  int64  `json:"user_id" example:"456"`
+	Role    string `json:"role" example:"editor" enums:"viewer,editor"`
+	Pending bool   `json:"pending" example:"false"`
+}
+
+// TripInvitationResponse represents a pending invitation to a trip
+type TripInvitationResponse struct {
+	TripId    int64  `json:"trip_id" example:"7"`
+	Title     string `json:"title" example:"Two weeks in Japan"`
+	InvitedBy int64  `json:"invited_by" example:"1"`
+	Role      string `json:"role" example:"viewer"`
+	CreatedAt string `json:"created_at" example:"2024-03-21T08:00:00Z"`
+}
+
+// TripInvitationsResponse represents the pending trip invitations of the current user
+type TripInvitationsResponse struct {
+	Invitations []TripInvitationResponse `json:"invitations"`
+}
+
+// AddTravelVisitResponse represents a travel visit creation response
+type AddTravelVisitResponse struct {
+	Message string `json:"message" example:"OK"`
+	VisitId int64  `json:"visit_id" example:"15"`
+}
+
+// TravelMapResponse represents the countries and cities visited by a user
+type TravelMapResponse struct {
+	Countries []VisitedCountryResponse `json:"countries"`
+	Visits    []TravelVisitResponse    `json:"visits"`
+}
+
+// VisitedCountryResponse represents a visited country
+type VisitedCountryResponse struct {
+	Country        string                `json:"country" example:"Japan"`
+	FirstVisitedAt string                `json:"first_visited_at" example:"2024-04-01T09:30:00Z"`
+	PostsCount     int64                 `json:"posts_count" example:"8"`
+	Cities         []VisitedCityResponse `json:"cities"`
+}
+
+// VisitedCityResponse represents a visited city
+type VisitedCityResponse struct {
+	City           string `json:"city" example:"Kyoto"`
+	FirstVisitedAt string `json:"first_visited_at" example:"2024-04-03T11:00:00Z"`
+	PostsCount     int64  `json:"posts_count" example:"3"`
+}
+
+// TravelVisitResponse represents a visit added by hand
+type TravelVisitResponse struct {
+	VisitId    int64  `json:"visit_id" example:"15"`
+	Country    string `json:"country" example:"Japan"`
+	City       string `json:"city,omitempty" example:"Kyoto"`
+	VisitedAt  string `json:"visited_at" example:"2019-10-03"`
+	Visibility string `json:"visibility" example:"public" enums:"public,followers,private"`
+}
+
+// TravelStatsResponse represents the travel totals of a user
+type TravelStatsResponse struct {
+	CountriesCount int64   `json:"countries_count" example:"4"`
+	CitiesCount    int64   `json:"cities_count" example:"9"`
+	PlacesCount    int64   `json:"places_count" example:"23"`
+	PostsCount     int64   `json:"posts_count" example:"41"`
+	DistanceKm     float64 `json:"distance_km" example:"18234.5"`
+	FirstVisitedAt string  `json:"first_visited_at,omitempty" example:"2019-10-03T00:00:00Z"`
+	LastVisitedAt  string  `json:"last_visited_at,omitempty" example:"2024-04-14T18:20:00Z"`
+}
+
+// CreatePlaceReviewResponse represents a review creation response
+type CreatePlaceReviewResponse struct {
+	Message  string `json:"message" example:"OK"`
+	ReviewId int64  `json:"review_id" example:"31"`
+}
+
+// PlaceReviewResponse represents a review of a place
+type PlaceReviewResponse struct {
+	ReviewId     int64  `json:"review_id" example:"31"`
+	PlaceId      int64  `json:"place_id" example:"42"`
+	UserId       int64  `json:"user_id" example:"123"`
+	Rating       int32  `json:"rating" example:"5"`
+	ContentText  string `json:"content_text" example:"Go early in the morning to walk the gates without the crowds."`
+	HelpfulCount int64  `json:"helpful_count" example:"12"`
+	VotedHelpful bool   `json:"voted_helpful" example:"false"`
+	CreatedAt    string `json:"created_at" example:"2024-04-02T08:15:00Z"`
+	EditedAt     string `json:"edited_at,omitempty" example:"2024-04-03T10:00:00Z"`
+}
+
+// RatingSummaryResponse represents the ratings of a place, counts are per star from 1 to 5
+type RatingSummaryResponse struct {
+	Count   int64   `json:"count" example:"35"`
+	Average float64 `json:"average" example:"4.6"`
+	Counts  []int64 `json:"counts" example:"0,1,2,6,26"`
+}
+
+// PlaceReviewsResponse represents a page of the reviews of a place
+type PlaceReviewsResponse struct {
+	Reviews    []PlaceReviewResponse `json:"reviews"`
+	Summary    RatingSummaryResponse `json:"summary"`
+	NextCursor string                `json:"next_cursor" example:"NTozMQ"`
+}
+
+// BookmarkResponse represents a saved post
+type BookmarkResponse struct {
+	PostId       int64  `json:"post_id" example:"123"`
+	CollectionId int64  `json:"collection_id" example:"3"`
+	CreatedAt    string `json:"created_at" example:"2024-04-02T08:15:00Z"`
+}
+
+// BookmarksResponse represents a page of saved posts, newest first
+type BookmarksResponse struct {
+	Bookmarks  []BookmarkResponse `json:"bookmarks"`
+	NextCursor int64              `json:"next_cursor" example:"57"`
+}
+
+// BookmarkCollectionResponse represents a collection of saved posts
+type BookmarkCollectionResponse struct {
+	CollectionId   int64  `json:"collection_id" example:"3"`
+	Name           string `json:"name" example:"Japan ideas"`
+	BookmarksCount int64  `json:"bookmarks_count" example:"14"`
+	CreatedAt      string `json:"created_at" example:"2024-03-20T18:00:00Z"`
+}
+
+// BookmarkCollectionsResponse represents the bookmark collections of the current user
+type BookmarkCollectionsResponse struct {
+	Collections []BookmarkCollectionResponse `json:"collections"`
+}
+
+// CreateBookmarkCollectionResponse represents a successful collection creation response
+type CreateBookmarkCollectionResponse struct {
+	Message      string `json:"message" example:"OK"`
+	CollectionId int64  `json:"collection_id" example:"3"`
+}
+
+// MergePlacesResponse represents a place merge response
+type MergePlacesResponse struct {
+	Message         string `json:"message" example:"OK"`
+	MovedPostsCount int64  `json:"moved_posts_count" example:"12"`
+}
+
+// SearchResponse represents a page of search results.
+// posts_ids is set when searching posts and users when searching users.
+type SearchResponse struct {
+	Type       string                   `json:"type" example:"posts"`
+	PostsIds   []int64                  `json:"posts_ids,omitempty" example:"[456,123]"`
+	Users      []UserDetailInfoResponse `json:"users,omitempty"`
+	NextCursor string                   `json:"next_cursor" example:"MC4xOjEyMw"`
+}
+
+// NewsfeedResponse represents a page of a user's newsfeed
 type NewsfeedResponse struct {
-	PostsIds []int64 `json:"posts_ids" example:"[123,456]"`
+	PostsIds    []int64                `json:"posts_ids" example:"[123,456]"`
+	Items       []NewsfeedItemResponse `json:"items"`
+	TotalPages  int32                  `json:"total_pages" example:"5"`
+	CurrentPage int32                  `json:"current_page" example:"1"`
+}
+
+// NewsfeedItemResponse represents an entry of the newsfeed, a post, a published trip, a place review or a repost.
+// post is only set for posts and reposts of a hydrated newsfeed, repost_of is the post shared by a repost.
+type NewsfeedItemResponse struct {
+	Type     string               `json:"type" example:"trip" enums:"post,trip,review,repost"`
+	Id       int64                `json:"id" example:"7"`
+	Post     *PostSummaryResponse `json:"post,omitempty"`
+	RepostOf *PostSummaryResponse `json:"repost_of,omitempty"`
 }
@@ -0,0 +1,148 @@
+package authpost
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/autocomplete"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultAutocompleteLimit and maxAutocompleteLimit bound the number of users returned by AutocompleteUsers
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 20
+)
+
+func (a *AuthenticateAndPostService) AutocompleteUsers(ctx context.Context, info *pb_aap.AutocompleteUsersRequest) (*pb_aap.AutocompleteUsersResponse, error) {
+	prefix := autocomplete.NormalizePrefix(info.GetPrefix())
+	if prefix == "" || utf8.RuneCountInString(prefix) > maxUserNameLength {
+		return &pb_aap.AutocompleteUsersResponse{Status: pb_aap.AutocompleteUsersResponse_INVALID_PREFIX}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	} else if limit > maxAutocompleteLimit {
+		limit = maxAutocompleteLimit
+	}
+
+	// Up to limit followed users are moved to the front, twice the limit still fills the page
+	candidates, err := a.autocompleteCandidates(ctx, prefix, 2*limit)
+	if err != nil {
+		a.logger.Error("Error looking up autocomplete candidates", zap.Error(err))
+		return nil, err
+	}
+
+	// Users the viewer follows come first, the order is otherwise kept. They are looked up on their own
+	// since a common prefix has more matches than candidates.
+	if info.GetViewerId() > 0 {
+		var followedIds []int64
+		err := a.db.Model(&types.User{}).
+			Joins("JOIN following ON following.user_id = users.id AND following.follower_id = ?", info.GetViewerId()).
+			Scopes(usersMatchingPrefix(prefix)).
+			Order("lower(users.user_name)").
+			Limit(limit).
+			Pluck("users.id", &followedIds).Error
+		if err != nil {
+			return nil, err
+		}
+		followed := make(map[int64]bool, len(followedIds))
+		for _, id := range followedIds {
+			followed[id] = true
+		}
+
+		boosted := followedIds
+		for _, id := range candidates {
+			if !followed[id] {
+				boosted = append(boosted, id)
+			}
+		}
+		candidates = boosted
+	}
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	users, err := a.getSearchUsers(candidates, info.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb_aap.AutocompleteUsersResponse{
+		Status: pb_aap.AutocompleteUsersResponse_OK,
+		Users:  users,
+	}, nil
+}
+
+// autocompleteCandidates returns the ids of up to count users matching a normalized prefix from the Redis
+// index, or from the database when Redis is unavailable
+func (a *AuthenticateAndPostService) autocompleteCandidates(ctx context.Context, prefix string, count int) ([]int64, error) {
+	if a.userIndex != nil {
+		ids, err := a.userIndex.Search(ctx, prefix, int64(count))
+		if err == nil {
+			return ids, nil
+		}
+		a.logger.Warn("User autocomplete index unavailable, falling back to the database", zap.Error(err))
+	}
+
+	var ids []int64
+	err := a.db.Model(&types.User{}).
+		Scopes(usersMatchingPrefix(prefix)).
+		Order("lower(users.user_name)").
+		Limit(count).
+		Pluck("users.id", &ids).Error
+	return ids, err
+}
+
+// usersMatchingPrefix restricts a users query to the users whose user name, first name or last name
+// starts with a normalized prefix, like the terms of the autocomplete index. The trigram indexes of the
+// search migration also serve these prefix patterns.
+func usersMatchingPrefix(prefix string) func(db *gorm.DB) *gorm.DB {
+	pattern := escapeLikePattern(prefix) + "%"
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("users.user_name ILIKE ? OR "+userFullName+" ILIKE ? OR "+userFullName+" ILIKE ?",
+			pattern, pattern, "% "+pattern)
+	}
+}
+
+// ensureUserIndex builds the autocomplete index when it does not exist yet, e.g. on the first start
+func (a *AuthenticateAndPostService) ensureUserIndex(ctx context.Context) {
+	if a.userIndex == nil {
+		return
+	}
+	exists, err := a.userIndex.Exists(ctx)
+	if err != nil || exists {
+		return
+	}
+
+	count, err := a.userIndex.Rebuild(ctx, a.db)
+	if err != nil {
+		a.logger.Error("Failed to build the user autocomplete index", zap.Error(err))
+		return
+	}
+	a.logger.Info("Built the user autocomplete index", zap.Int("users", count))
+}
+
+// indexUser adds a new user to the autocomplete index. Failures are only logged,
+// system_admin -cmd autocomplete-rebuild brings the index back in sync.
+func (a *AuthenticateAndPostService) indexUser(ctx context.Context, user types.User) {
+	if a.userIndex == nil {
+		return
+	}
+	if err := a.userIndex.Add(ctx, user); err != nil {
+		a.logger.Error("Failed to add user to the autocomplete index", zap.Error(err), zap.Int64("user_id", user.ID))
+	}
+}
+
+// reindexUser updates the autocomplete index after the names of a user changed
+func (a *AuthenticateAndPostService) reindexUser(ctx context.Context, old types.User, user types.User) {
+	if a.userIndex == nil {
+		return
+	}
+	if err := a.userIndex.Update(ctx, old, user); err != nil {
+		a.logger.Error("Failed to update user in the autocomplete index", zap.Error(err), zap.Int64("user_id", user.ID))
+	}
+}
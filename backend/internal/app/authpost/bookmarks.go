@@ -0,0 +1,331 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxCollectionNameLength bounds bookmark_collections.name, in characters
+	maxCollectionNameLength = 100
+
+	// defaultBookmarksLimit and maxBookmarksLimit bound the page size of GetBookmarks
+	defaultBookmarksLimit = 20
+	maxBookmarksLimit     = 100
+)
+
+// validCollectionName checks a trimmed collection name
+func validCollectionName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxCollectionNameLength
+}
+
+// findBookmarkCollection finds a collection of the user
+func (a *AuthenticateAndPostService) findBookmarkCollection(userId int64, collectionId int64) (exist bool, collection types.BookmarkCollection) {
+	result := a.db.Where("id = ? AND user_id = ?", collectionId, userId).First(&collection)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.BookmarkCollection{}
+	}
+	return result.Error == nil, collection
+}
+
+// collectionNameTaken checks if the user has another collection with the same name, regardless of case
+func (a *AuthenticateAndPostService) collectionNameTaken(userId int64, name string, exceptId int64) (bool, error) {
+	var count int64
+	err := a.db.Model(&types.BookmarkCollection{}).
+		Where("user_id = ? AND lower(name) = lower(?) AND id <> ?", userId, name, exceptId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// visibleBookmarksOf restricts a bookmarks query to the bookmarks of the user on posts
+// that still exist and that the user is allowed to read
+func visibleBookmarksOf(userId int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Joins("JOIN posts ON posts.id = bookmarks.post_id AND posts.deleted_at IS NULL").
+			Scopes(visiblePostsTo(userId)).
+			Where("bookmarks.user_id = ?", userId)
+	}
+}
+
+// hasBookmarked checks if the user saved the post
+func (a *AuthenticateAndPostService) hasBookmarked(userId int64, postId int64) bool {
+	if userId <= 0 {
+		return false
+	}
+
+	var count int64
+	err := a.db.Model(&types.Bookmark{}).
+		Where("user_id = ? AND post_id = ?", userId, postId).
+		Count(&count).Error
+	return err == nil && count > 0
+}
+
+func (a *AuthenticateAndPostService) AddBookmark(ctx context.Context, info *pb_aap.AddBookmarkRequest) (*pb_aap.AddBookmarkResponse, error) {
+	a.logger.Debug("start adding bookmark", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end adding bookmark")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.AddBookmarkResponse{Status: pb_aap.AddBookmarkResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || !a.canViewPost(user.ID, post) {
+		return &pb_aap.AddBookmarkResponse{Status: pb_aap.AddBookmarkResponse_POST_NOT_FOUND}, nil
+	}
+
+	var collectionId *int64
+	if info.GetCollectionId() != 0 {
+		exist, collection := a.findBookmarkCollection(user.ID, info.GetCollectionId())
+		if !exist {
+			return &pb_aap.AddBookmarkResponse{Status: pb_aap.AddBookmarkResponse_COLLECTION_NOT_FOUND}, nil
+		}
+		collectionId = &collection.ID
+	}
+
+	// Saving a post again only moves it to the requested collection
+	err := a.db.Exec(
+		`INSERT INTO bookmarks (created_at, updated_at, user_id, post_id, collection_id) VALUES (NOW(), NOW(), ?, ?, ?)
+		ON CONFLICT (user_id, post_id) DO UPDATE SET collection_id = EXCLUDED.collection_id, updated_at = NOW()`,
+		user.ID, post.ID, collectionId,
+	).Error
+	if err != nil {
+		a.logger.Error("Error adding bookmark", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.AddBookmarkResponse{Status: pb_aap.AddBookmarkResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RemoveBookmark(ctx context.Context, info *pb_aap.RemoveBookmarkRequest) (*pb_aap.RemoveBookmarkResponse, error) {
+	a.logger.Debug("start removing bookmark", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end removing bookmark")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RemoveBookmarkResponse{Status: pb_aap.RemoveBookmarkResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where("user_id = ? AND post_id = ?", user.ID, info.GetPostId()).Delete(&types.Bookmark{})
+	if result.Error != nil {
+		a.logger.Error("Error removing bookmark", zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.RemoveBookmarkResponse{Status: pb_aap.RemoveBookmarkResponse_NOT_BOOKMARKED}, nil
+	}
+
+	return &pb_aap.RemoveBookmarkResponse{Status: pb_aap.RemoveBookmarkResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetBookmarks(ctx context.Context, info *pb_aap.GetBookmarksRequest) (*pb_aap.GetBookmarksResponse, error) {
+	a.logger.Debug("start getting bookmarks", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end getting bookmarks")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetBookmarksResponse{Status: pb_aap.GetBookmarksResponse_USER_NOT_FOUND}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultBookmarksLimit
+	} else if limit > maxBookmarksLimit {
+		limit = maxBookmarksLimit
+	}
+
+	query := a.db.Model(&types.Bookmark{}).Scopes(visibleBookmarksOf(user.ID))
+	if info.GetCollectionId() != 0 {
+		if exist, _ := a.findBookmarkCollection(user.ID, info.GetCollectionId()); !exist {
+			return &pb_aap.GetBookmarksResponse{Status: pb_aap.GetBookmarksResponse_COLLECTION_NOT_FOUND}, nil
+		}
+		query = query.Where("bookmarks.collection_id = ?", info.GetCollectionId())
+	}
+	if info.GetCursor() > 0 {
+		query = query.Where("bookmarks.id < ?", info.GetCursor())
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	var bookmarks []types.Bookmark
+	err := query.Select("bookmarks.*").Order("bookmarks.id DESC").Limit(limit + 1).Find(&bookmarks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor int64
+	if len(bookmarks) > limit {
+		bookmarks = bookmarks[:limit]
+		nextCursor = bookmarks[limit-1].ID
+	}
+
+	result := make([]*pb_aap.Bookmark, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		var collectionId int64
+		if bookmark.CollectionID != nil {
+			collectionId = *bookmark.CollectionID
+		}
+		result = append(result, &pb_aap.Bookmark{
+			PostId:       bookmark.PostID,
+			CollectionId: collectionId,
+			CreatedAt:    timestamppb.New(bookmark.CreatedAt),
+		})
+	}
+
+	return &pb_aap.GetBookmarksResponse{
+		Status:     pb_aap.GetBookmarksResponse_OK,
+		Bookmarks:  result,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) CreateBookmarkCollection(ctx context.Context, info *pb_aap.CreateBookmarkCollectionRequest) (*pb_aap.CreateBookmarkCollectionResponse, error) {
+	a.logger.Debug("start creating bookmark collection", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end creating bookmark collection")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateBookmarkCollectionResponse{Status: pb_aap.CreateBookmarkCollectionResponse_USER_NOT_FOUND}, nil
+	}
+	name := strings.TrimSpace(info.GetName())
+	if !validCollectionName(name) {
+		return &pb_aap.CreateBookmarkCollectionResponse{Status: pb_aap.CreateBookmarkCollectionResponse_INVALID_NAME}, nil
+	}
+	taken, err := a.collectionNameTaken(user.ID, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return &pb_aap.CreateBookmarkCollectionResponse{Status: pb_aap.CreateBookmarkCollectionResponse_ALREADY_EXISTS}, nil
+	}
+
+	collection := types.BookmarkCollection{
+		UserID: user.ID,
+		Name:   name,
+	}
+	err = a.db.Create(&collection).Error
+	if isUniqueViolation(err) {
+		// A concurrent request of the user took the name first
+		return &pb_aap.CreateBookmarkCollectionResponse{Status: pb_aap.CreateBookmarkCollectionResponse_ALREADY_EXISTS}, nil
+	} else if err != nil {
+		a.logger.Error("Error creating bookmark collection", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.CreateBookmarkCollectionResponse{
+		Status:       pb_aap.CreateBookmarkCollectionResponse_OK,
+		CollectionId: collection.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetBookmarkCollections(ctx context.Context, info *pb_aap.GetBookmarkCollectionsRequest) (*pb_aap.GetBookmarkCollectionsResponse, error) {
+	a.logger.Debug("start getting bookmark collections", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end getting bookmark collections")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetBookmarkCollectionsResponse{Status: pb_aap.GetBookmarkCollectionsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var collections []types.BookmarkCollection
+	if err := a.db.Where("user_id = ?", user.ID).Order("lower(name), id").Find(&collections).Error; err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		CollectionID int64
+		Count        int64
+	}
+	err := a.db.Model(&types.Bookmark{}).
+		Scopes(visibleBookmarksOf(user.ID)).
+		Where("bookmarks.collection_id IS NOT NULL").
+		Select("bookmarks.collection_id, COUNT(*) AS count").
+		Group("bookmarks.collection_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	countOf := make(map[int64]int64, len(counts))
+	for _, row := range counts {
+		countOf[row.CollectionID] = row.Count
+	}
+
+	result := make([]*pb_aap.BookmarkCollection, 0, len(collections))
+	for _, collection := range collections {
+		result = append(result, &pb_aap.BookmarkCollection{
+			CollectionId:   collection.ID,
+			Name:           collection.Name,
+			BookmarksCount: countOf[collection.ID],
+			CreatedAt:      timestamppb.New(collection.CreatedAt),
+		})
+	}
+
+	return &pb_aap.GetBookmarkCollectionsResponse{
+		Status:      pb_aap.GetBookmarkCollectionsResponse_OK,
+		Collections: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) EditBookmarkCollection(ctx context.Context, info *pb_aap.EditBookmarkCollectionRequest) (*pb_aap.EditBookmarkCollectionResponse, error) {
+	a.logger.Debug("start editing bookmark collection", zap.Int64("collection_id", info.GetCollectionId()))
+	defer a.logger.Debug("end editing bookmark collection")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_USER_NOT_FOUND}, nil
+	}
+	exist, collection := a.findBookmarkCollection(user.ID, info.GetCollectionId())
+	if !exist {
+		return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_COLLECTION_NOT_FOUND}, nil
+	}
+	name := strings.TrimSpace(info.GetName())
+	if !validCollectionName(name) {
+		return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_INVALID_NAME}, nil
+	}
+	taken, err := a.collectionNameTaken(user.ID, name, collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_ALREADY_EXISTS}, nil
+	}
+
+	err = a.db.Model(&collection).Update("name", name).Error
+	if isUniqueViolation(err) {
+		// A concurrent request of the user took the name first
+		return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_ALREADY_EXISTS}, nil
+	} else if err != nil {
+		a.logger.Error("Error editing bookmark collection", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.EditBookmarkCollectionResponse{Status: pb_aap.EditBookmarkCollectionResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteBookmarkCollection(ctx context.Context, info *pb_aap.DeleteBookmarkCollectionRequest) (*pb_aap.DeleteBookmarkCollectionResponse, error) {
+	a.logger.Debug("start deleting bookmark collection", zap.Int64("collection_id", info.GetCollectionId()))
+	defer a.logger.Debug("end deleting bookmark collection")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteBookmarkCollectionResponse{Status: pb_aap.DeleteBookmarkCollectionResponse_USER_NOT_FOUND}, nil
+	}
+	exist, collection := a.findBookmarkCollection(user.ID, info.GetCollectionId())
+	if !exist {
+		return &pb_aap.DeleteBookmarkCollectionResponse{Status: pb_aap.DeleteBookmarkCollectionResponse_COLLECTION_NOT_FOUND}, nil
+	}
+
+	// The bookmarks of the collection are kept, the foreign key takes them out of it
+	if err := a.db.Delete(&collection).Error; err != nil {
+		a.logger.Error("Error deleting bookmark collection", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.DeleteBookmarkCollectionResponse{Status: pb_aap.DeleteBookmarkCollectionResponse_OK}, nil
+}
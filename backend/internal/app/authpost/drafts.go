@@ -0,0 +1,396 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// maxDraftsPerUser bounds the number of drafts a user can keep
+const maxDraftsPerUser = 100
+
+// errDraftGone aborts the publication of a draft that was published or deleted concurrently
+var errDraftGone = errors.New("draft no longer exists")
+
+// findDraft finds a draft of the user
+func (a *AuthenticateAndPostService) findDraft(userId int64, draftId int64) (exist bool, draft types.PostDraft) {
+	result := a.db.Preload("Media", orderedMedia).Where("id = ? AND user_id = ?", draftId, userId).First(&draft)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.PostDraft{}
+	}
+	return result.Error == nil, draft
+}
+
+// setDraftLocation geotags a draft, a nil location removes its location
+func setDraftLocation(draft *types.PostDraft, location *pb_aap.Location) {
+	if location == nil {
+		draft.Latitude = nil
+		draft.Longitude = nil
+		draft.PlaceName = ""
+		return
+	}
+
+	latitude := location.GetLatitude()
+	longitude := location.GetLongitude()
+	draft.Latitude = &latitude
+	draft.Longitude = &longitude
+	draft.PlaceName = strings.TrimSpace(location.GetPlaceName())
+}
+
+// draftLocationToProto returns the location of a draft, nil when it is not geotagged
+func draftLocationToProto(draft types.PostDraft) *pb_aap.Location {
+	if draft.Latitude == nil || draft.Longitude == nil {
+		return nil
+	}
+	return &pb_aap.Location{
+		Latitude:  *draft.Latitude,
+		Longitude: *draft.Longitude,
+		PlaceName: draft.PlaceName,
+	}
+}
+
+// draftToProto converts a draft into its protobuf representation
+func draftToProto(draft types.PostDraft) *pb_aap.Draft {
+	result := &pb_aap.Draft{
+		DraftId:     draft.ID,
+		ContentText: draft.ContentText,
+		Visibility:  visibilityToProto(draft.Visibility),
+		Location:    draftLocationToProto(draft),
+		CreatedAt:   timestamppb.New(draft.CreatedAt),
+		UpdatedAt:   timestamppb.New(draft.UpdatedAt),
+		Media:       mediaToProto(draftMedia(draft)),
+	}
+	if draft.PlaceID != nil {
+		result.PlaceId = *draft.PlaceID
+	}
+	if draft.TripID != nil {
+		result.TripId = *draft.TripID
+	}
+	if draft.QuoteOfID != nil {
+		result.QuoteOfPostId = *draft.QuoteOfID
+	}
+	return result
+}
+
+// draftMedia returns the media of a draft in display order
+func draftMedia(draft types.PostDraft) []types.Media {
+	media := make([]types.Media, 0, len(draft.Media))
+	for _, item := range draft.Media {
+		media = append(media, item.Media)
+	}
+	return media
+}
+
+// setDraftMedia replaces the media of a draft in memory, saveDraft stores them
+func setDraftMedia(draft *types.PostDraft, media []types.Media) {
+	draft.Media = make([]types.PostDraftMedia, 0, len(media))
+	for i, item := range media {
+		draft.Media = append(draft.Media, types.PostDraftMedia{DraftID: draft.ID, Position: i, Media: item})
+	}
+}
+
+// saveDraft stores a draft together with its media
+func (a *AuthenticateAndPostService) saveDraft(draft *types.PostDraft) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Media").Save(draft).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("draft_id = ?", draft.ID).Delete(&types.PostDraftMedia{}).Error; err != nil {
+			return err
+		}
+		if len(draft.Media) == 0 {
+			return nil
+		}
+		for i := range draft.Media {
+			draft.Media[i].DraftID = draft.ID
+			draft.Media[i].Position = i
+		}
+		return tx.Create(&draft.Media).Error
+	})
+}
+
+func (a *AuthenticateAndPostService) CreateDraft(ctx context.Context, info *pb_aap.CreateDraftRequest) (*pb_aap.CreateDraftResponse, error) {
+	a.logger.Debug("start creating draft")
+	defer a.logger.Debug("end creating draft")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_USER_NOT_FOUND}, nil
+	}
+
+	var count int64
+	if err := a.db.Model(&types.PostDraft{}).Where("user_id = ?", user.ID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count >= maxDraftsPerUser {
+		return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_TOO_MANY_DRAFTS}, nil
+	}
+
+	if info.Location != nil && !validLocation(info.GetLocation()) {
+		return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_INVALID_LOCATION}, nil
+	}
+	media, ok := a.newMedia(info.GetMedia())
+	if !ok {
+		return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_INVALID_MEDIA}, nil
+	}
+	visibility, ok := requestedVisibility(info.Visibility, nil)
+	if !ok {
+		visibility = types.PostVisibilityPublic
+	}
+
+	draft := types.PostDraft{
+		UserID:      user.ID,
+		ContentText: info.GetContentText(),
+		Visibility:  visibility,
+	}
+	setDraftMedia(&draft, media)
+	setDraftLocation(&draft, info.GetLocation())
+	if info.GetPlaceId() != 0 {
+		exist, place := a.findPlace(info.GetPlaceId())
+		if !exist {
+			return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_PLACE_NOT_FOUND}, nil
+		}
+		draft.PlaceID = &place.ID
+	}
+	if info.GetTripId() != 0 {
+		exist, trip := a.findTrip(info.GetTripId())
+		if !exist || a.tripRole(user.ID, trip) == "" {
+			return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_TRIP_NOT_FOUND}, nil
+		}
+		draft.TripID = &trip.ID
+	}
+	if info.GetQuoteOfPostId() != 0 {
+		exist, quoted, allowed := a.findSharedPost(user.ID, info.GetQuoteOfPostId())
+		if !exist || !allowed {
+			return &pb_aap.CreateDraftResponse{Status: pb_aap.CreateDraftResponse_QUOTED_POST_NOT_FOUND}, nil
+		}
+		draft.QuoteOfID = &quoted.ID
+	}
+
+	if err := a.saveDraft(&draft); err != nil {
+		a.logger.Error("Error creating draft", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.CreateDraftResponse{
+		Status:  pb_aap.CreateDraftResponse_OK,
+		DraftId: draft.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetDrafts(ctx context.Context, info *pb_aap.GetDraftsRequest) (*pb_aap.GetDraftsResponse, error) {
+	a.logger.Debug("start getting drafts")
+	defer a.logger.Debug("end getting drafts")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetDraftsResponse{Status: pb_aap.GetDraftsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var drafts []types.PostDraft
+	err := a.db.Preload("Media", orderedMedia).Where("user_id = ?", user.ID).Order("updated_at DESC, id DESC").Find(&drafts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.Draft, 0, len(drafts))
+	for _, draft := range drafts {
+		result = append(result, draftToProto(draft))
+	}
+
+	return &pb_aap.GetDraftsResponse{
+		Status: pb_aap.GetDraftsResponse_OK,
+		Drafts: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) EditDraft(ctx context.Context, info *pb_aap.EditDraftRequest) (*pb_aap.EditDraftResponse, error) {
+	a.logger.Debug("start editing draft", zap.Int64("draft_id", info.GetDraftId()))
+	defer a.logger.Debug("end editing draft")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_USER_NOT_FOUND}, nil
+	}
+	exist, draft := a.findDraft(user.ID, info.GetDraftId())
+	if !exist {
+		return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_DRAFT_NOT_FOUND}, nil
+	}
+
+	// Apply updates
+	previousMedia := draftMedia(draft)
+	if info.ContentText != nil {
+		draft.ContentText = info.GetContentText()
+	}
+	if info.Media != nil {
+		media, ok := a.newMedia(info.GetMedia().GetMedia())
+		if !ok {
+			return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_INVALID_MEDIA}, nil
+		}
+		setDraftMedia(&draft, media)
+	}
+	if visibility, ok := requestedVisibility(info.Visibility, nil); ok {
+		draft.Visibility = visibility
+	}
+	if info.GetRemoveLocation() {
+		setDraftLocation(&draft, nil)
+	} else if info.Location != nil {
+		if !validLocation(info.GetLocation()) {
+			return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_INVALID_LOCATION}, nil
+		}
+		setDraftLocation(&draft, info.GetLocation())
+	}
+	if info.GetRemovePlace() {
+		draft.PlaceID = nil
+	} else if info.PlaceId != nil {
+		exist, place := a.findPlace(info.GetPlaceId())
+		if !exist {
+			return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_PLACE_NOT_FOUND}, nil
+		}
+		draft.PlaceID = &place.ID
+	}
+	if info.GetRemoveTrip() {
+		draft.TripID = nil
+	} else if info.TripId != nil {
+		exist, trip := a.findTrip(info.GetTripId())
+		if !exist || a.tripRole(user.ID, trip) == "" {
+			return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_TRIP_NOT_FOUND}, nil
+		}
+		draft.TripID = &trip.ID
+	}
+	if info.GetRemoveQuote() {
+		draft.QuoteOfID = nil
+	} else if info.QuoteOfPostId != nil {
+		exist, quoted, allowed := a.findSharedPost(user.ID, info.GetQuoteOfPostId())
+		if !exist || !allowed {
+			return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_QUOTED_POST_NOT_FOUND}, nil
+		}
+		draft.QuoteOfID = &quoted.ID
+	}
+
+	if err := a.saveDraft(&draft); err != nil {
+		a.logger.Error("Error editing draft", zap.Error(err))
+		return nil, err
+	}
+	// Uploads the edit removed are deleted unless a post or another draft uses them
+	a.deleteUnusedMedia(previousMedia)
+
+	return &pb_aap.EditDraftResponse{Status: pb_aap.EditDraftResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteDraft(ctx context.Context, info *pb_aap.DeleteDraftRequest) (*pb_aap.DeleteDraftResponse, error) {
+	a.logger.Debug("start deleting draft", zap.Int64("draft_id", info.GetDraftId()))
+	defer a.logger.Debug("end deleting draft")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteDraftResponse{Status: pb_aap.DeleteDraftResponse_USER_NOT_FOUND}, nil
+	}
+
+	exist, draft := a.findDraft(user.ID, info.GetDraftId())
+	if !exist {
+		return &pb_aap.DeleteDraftResponse{Status: pb_aap.DeleteDraftResponse_DRAFT_NOT_FOUND}, nil
+	}
+
+	// The media of the draft go with it, its uploads are deleted unless a post or another draft uses them
+	result := a.db.Where("id = ? AND user_id = ?", draft.ID, user.ID).Delete(&types.PostDraft{})
+	if result.Error != nil {
+		a.logger.Error("Error deleting draft", zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.DeleteDraftResponse{Status: pb_aap.DeleteDraftResponse_DRAFT_NOT_FOUND}, nil
+	}
+	a.deleteUnusedMedia(draftMedia(draft))
+
+	return &pb_aap.DeleteDraftResponse{Status: pb_aap.DeleteDraftResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) PublishDraft(ctx context.Context, info *pb_aap.PublishDraftRequest) (*pb_aap.PublishDraftResponse, error) {
+	a.logger.Debug("start publishing draft", zap.Int64("draft_id", info.GetDraftId()))
+	defer a.logger.Debug("end publishing draft")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_USER_NOT_FOUND}, nil
+	}
+	exist, draft := a.findDraft(user.ID, info.GetDraftId())
+	if !exist {
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_DRAFT_NOT_FOUND}, nil
+	}
+	if strings.TrimSpace(draft.ContentText) == "" {
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_EMPTY_DRAFT}, nil
+	}
+
+	// The draft is checked again as a create request, its place, trip or quoted post may be gone by now
+	visibility := visibilityToProto(draft.Visibility)
+	request := &pb_aap.CreatePostRequest{
+		UserId:      user.ID,
+		ContentText: draft.ContentText,
+		Visibility:  &visibility,
+		Location:    draftLocationToProto(draft),
+		PublishAt:   info.GetPublishAt(),
+		Media:       mediaToProto(draftMedia(draft)),
+	}
+	if draft.PlaceID != nil {
+		request.PlaceId = *draft.PlaceID
+	}
+	if draft.TripID != nil {
+		request.TripId = *draft.TripID
+	}
+	if draft.QuoteOfID != nil {
+		request.QuoteOfPostId = *draft.QuoteOfID
+	}
+	post, status := a.newPost(request)
+	switch status {
+	case pb_aap.CreatePostResponse_OK:
+	case pb_aap.CreatePostResponse_INVALID_LOCATION:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_INVALID_LOCATION}, nil
+	case pb_aap.CreatePostResponse_PLACE_NOT_FOUND:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_PLACE_NOT_FOUND}, nil
+	case pb_aap.CreatePostResponse_TRIP_NOT_FOUND:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_TRIP_NOT_FOUND}, nil
+	case pb_aap.CreatePostResponse_QUOTED_POST_NOT_FOUND:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_QUOTED_POST_NOT_FOUND}, nil
+	case pb_aap.CreatePostResponse_INVALID_PUBLISH_AT:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_INVALID_PUBLISH_AT}, nil
+	case pb_aap.CreatePostResponse_INVALID_MEDIA:
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_INVALID_MEDIA}, nil
+	default:
+		return nil, errors.New("unexpected status creating post from draft: " + status.String())
+	}
+
+	// Removing the draft in the same transaction publishes it at most once
+	var mentioned []int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", draft.ID, user.ID).Delete(&types.PostDraft{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errDraftGone
+		}
+		var err error
+		mentioned, err = insertPost(tx, &post)
+		return err
+	})
+	if errors.Is(err, errDraftGone) {
+		return &pb_aap.PublishDraftResponse{Status: pb_aap.PublishDraftResponse_DRAFT_NOT_FOUND}, nil
+	} else if err != nil {
+		a.logger.Error("Error publishing draft", zap.Error(err))
+		return nil, err
+	}
+
+	a.announcePost(ctx, post, mentioned)
+
+	return &pb_aap.PublishDraftResponse{
+		Status: pb_aap.PublishDraftResponse_OK,
+		PostId: post.ID,
+	}, nil
+}
@@ -182,13 +182,18 @@ func (a *AuthenticateAndPostService) GetUserPosts(ctx context.Context, info *pb_
 		return &pb_aap.GetUserPostsResponse{Status: pb_aap.GetUserPostsResponse_USER_NOT_FOUND}, nil
 	}
 
-	var user types.User
-	a.db.Preload("Posts").First(&user, info.GetUserId())
-
-	// Return
+	// Only return the posts the viewer is allowed to read
 	var posts_ids []int64
-	for _, post := range user.Posts {
-		posts_ids = append(posts_ids, int64(post.ID))
+	err := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(info.GetViewerId())).
+		Where("posts.user_id = ?", info.GetUserId()).
+		Order("posts.id").
+		Pluck("posts.id", &posts_ids).Error
+	if err != nil {
+		a.logger.Error("Failed to load user posts",
+			zap.Int64("user_id", info.GetUserId()),
+			zap.Error(err))
+		return nil, err
 	}
 
 	return &pb_aap.GetUserPostsResponse{
@@ -0,0 +1,253 @@
+package authpost
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+)
+
+const (
+	// geohashPrecision is the length of the geohashes stored in posts.geohash, about 5m x 5m
+	geohashPrecision = 9
+	geohashBase32    = "0123456789bcdefghjkmnpqrstuvwxyz"
+	// kmPerDegree is the length of one degree of latitude
+	kmPerDegree = 111.32
+	// earthRadiusKm is the Earth's mean radius
+	earthRadiusKm = 6371.0
+	// maxPlaceNameLength matches the size of posts.place_name
+	maxPlaceNameLength = 200
+	// maxNearbyRadiusKm bounds the radius of GetNearbyPosts
+	maxNearbyRadiusKm = 500
+	// defaultNearbyLimit and maxNearbyLimit bound the page size of GetNearbyPosts
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+)
+
+// validCoordinates checks that a latitude and longitude are WGS84 degrees
+func validCoordinates(latitude float64, longitude float64) bool {
+	return !math.IsNaN(latitude) && !math.IsNaN(longitude) &&
+		latitude >= -90 && latitude <= 90 &&
+		longitude >= -180 && longitude <= 180
+}
+
+// validLocation checks the coordinates and the place name of a requested location
+func validLocation(location *pb_aap.Location) bool {
+	return validCoordinates(location.GetLatitude(), location.GetLongitude()) &&
+		utf8.RuneCountInString(strings.TrimSpace(location.GetPlaceName())) <= maxPlaceNameLength
+}
+
+// setPostLocation geotags a post, a nil location removes its location
+func setPostLocation(post *types.Post, location *pb_aap.Location) {
+	if location == nil {
+		post.Latitude = nil
+		post.Longitude = nil
+		post.PlaceName = ""
+		post.Geohash = nil
+		return
+	}
+
+	latitude := location.GetLatitude()
+	longitude := location.GetLongitude()
+	geohash := encodeGeohash(latitude, longitude, geohashPrecision)
+	post.Latitude = &latitude
+	post.Longitude = &longitude
+	post.PlaceName = strings.TrimSpace(location.GetPlaceName())
+	post.Geohash = &geohash
+}
+
+// postLocationToProto returns the location of a post, nil when it is not geotagged
+func postLocationToProto(post types.Post) *pb_aap.Location {
+	if post.Latitude == nil || post.Longitude == nil {
+		return nil
+	}
+	return &pb_aap.Location{
+		Latitude:  *post.Latitude,
+		Longitude: *post.Longitude,
+		PlaceName: post.PlaceName,
+	}
+}
+
+// sameLocation checks whether two posts have the same location
+func sameLocation(a types.Post, b types.Post) bool {
+	if (a.Latitude == nil) != (b.Latitude == nil) || (a.Longitude == nil) != (b.Longitude == nil) {
+		return false
+	}
+	if a.Latitude != nil && *a.Latitude != *b.Latitude {
+		return false
+	}
+	if a.Longitude != nil && *a.Longitude != *b.Longitude {
+		return false
+	}
+	return a.PlaceName == b.PlaceName
+}
+
+// encodeGeohash returns the geohash of a point with the given number of characters
+func encodeGeohash(latitude float64, longitude float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	var geohash strings.Builder
+	bit, ch, even := 0, 0, true
+	for geohash.Len() < precision {
+		// Bits alternate between longitude and latitude, starting with longitude
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if longitude >= mid {
+				ch |= 1 << (4 - bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if latitude >= mid {
+				ch |= 1 << (4 - bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			geohash.WriteByte(geohashBase32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return geohash.String()
+}
+
+// geohashCellSize returns the height and width in degrees of the cells of a geohash precision
+func geohashCellSize(precision int) (latDegrees float64, lngDegrees float64) {
+	bits := 5 * precision
+	lngBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lngBits))
+}
+
+// nearbyGeohashPrefixes returns geohash prefixes whose cells cover every point within radiusKm of a point.
+// It picks the finest precision whose cells are at least radiusKm wide and returns the cell of the point
+// with its neighbours. A nil result means the radius is too large for prefixes to narrow the search.
+func nearbyGeohashPrefixes(latitude float64, longitude float64, radiusKm float64) []string {
+	// Cells are narrowest on the side of the circle closest to a pole
+	farthestLatitude := math.Min(90, math.Abs(latitude)+radiusKm/kmPerDegree)
+	lngScale := math.Cos(farthestLatitude * math.Pi / 180)
+
+	for precision := geohashPrecision; precision >= 1; precision-- {
+		latDegrees, lngDegrees := geohashCellSize(precision)
+		if latDegrees*kmPerDegree < radiusKm || lngDegrees*kmPerDegree*lngScale < radiusKm {
+			continue
+		}
+
+		var prefixes []string
+		seen := make(map[string]bool, 9)
+		for dy := -1; dy <= 1; dy++ {
+			lat := math.Max(-90, math.Min(90, latitude+float64(dy)*latDegrees))
+			for dx := -1; dx <= 1; dx++ {
+				lng := longitude + float64(dx)*lngDegrees
+				if lng < -180 {
+					lng += 360
+				} else if lng >= 180 {
+					lng -= 360
+				}
+				prefix := encodeGeohash(lat, lng, precision)
+				if !seen[prefix] {
+					seen[prefix] = true
+					prefixes = append(prefixes, prefix)
+				}
+			}
+		}
+		return prefixes
+	}
+	return nil
+}
+
+// distanceKmSQL is the haversine distance in km, on a sphere of the Earth's mean radius, between the
+// latitude and longitude columns of table and the point bound to its three placeholders,
+// in order latitude, latitude, longitude
+func distanceKmSQL(table string) string {
+	return "2 * 6371.0 * ASIN(LEAST(1, SQRT(" +
+		"POWER(SIN(RADIANS(" + table + ".latitude - ?) / 2), 2) + " +
+		"COS(RADIANS(?)) * COS(RADIANS(" + table + ".latitude)) * " +
+		"POWER(SIN(RADIANS(" + table + ".longitude - ?) / 2), 2))))"
+}
+
+// distanceKm is the haversine distance in km between two points, the Go counterpart of distanceKmSQL
+func distanceKm(latitude1 float64, longitude1 float64, latitude2 float64, longitude2 float64) float64 {
+	radians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	sinLatitude := math.Sin(radians(latitude2-latitude1) / 2)
+	sinLongitude := math.Sin(radians(longitude2-longitude1) / 2)
+	h := sinLatitude*sinLatitude + math.Cos(radians(latitude1))*math.Cos(radians(latitude2))*sinLongitude*sinLongitude
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// geohashPrefixCondition returns the condition matching a geohash column against prefixes, with its arguments
+func geohashPrefixCondition(column string, prefixes []string) (string, []interface{}) {
+	conditions := make([]string, 0, len(prefixes))
+	args := make([]interface{}, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		conditions = append(conditions, column+" LIKE ?")
+		args = append(args, prefix+"%")
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+func (a *AuthenticateAndPostService) GetNearbyPosts(ctx context.Context, info *pb_aap.GetNearbyPostsRequest) (*pb_aap.GetNearbyPostsResponse, error) {
+	a.logger.Debug("start getting nearby posts")
+	defer a.logger.Debug("end getting nearby posts")
+
+	latitude, longitude, radiusKm := info.GetLatitude(), info.GetLongitude(), info.GetRadiusKm()
+	if !validCoordinates(latitude, longitude) || !(radiusKm > 0 && radiusKm <= maxNearbyRadiusKm) {
+		return &pb_aap.GetNearbyPostsResponse{Status: pb_aap.GetNearbyPostsResponse_INVALID_LOCATION}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultNearbyLimit
+	} else if limit > maxNearbyLimit {
+		limit = maxNearbyLimit
+	}
+
+	candidates := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(info.GetViewerId())).
+		Select("posts.id, "+distanceKmSQL("posts")+" AS distance_km", latitude, latitude, longitude).
+		Where("posts.geohash IS NOT NULL")
+	if prefixes := nearbyGeohashPrefixes(latitude, longitude, radiusKm); prefixes != nil {
+		condition, args := geohashPrefixCondition("posts.geohash", prefixes)
+		candidates = candidates.Where(condition, args...)
+	}
+
+	var nearby []struct {
+		ID         int64
+		DistanceKm float64
+	}
+	err := a.db.Table("(?) AS candidates", candidates).
+		Select("candidates.id, candidates.distance_km").
+		Where("candidates.distance_km <= ?", radiusKm).
+		Order("candidates.distance_km, candidates.id DESC").
+		Limit(limit).
+		Scan(&nearby).Error
+	if err != nil {
+		a.logger.Error("Error getting nearby posts", zap.Error(err))
+		return nil, err
+	}
+
+	posts := make([]*pb_aap.NearbyPost, 0, len(nearby))
+	for _, post := range nearby {
+		posts = append(posts, &pb_aap.NearbyPost{
+			PostId:     post.ID,
+			DistanceKm: post.DistanceKm,
+		})
+	}
+	return &pb_aap.GetNearbyPostsResponse{
+		Status: pb_aap.GetNearbyPostsResponse_OK,
+		Posts:  posts,
+	}, nil
+}
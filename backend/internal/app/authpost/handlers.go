@@ -112,6 +112,7 @@ func (s *AuthenticateAndPostService) CreateUser(ctx context.Context, req *pb.Cre
 		s.logger.Error("Error creating user", zap.Error(result.Error))
 		return nil, result.Error
 	}
+	s.indexUser(ctx, user)
 
 	return &pb.CreateUserResponse{
 		Status: pb.CreateUserResponse_OK,
@@ -131,7 +132,7 @@ func (s *AuthenticateAndPostService) GetUserDetailInfo(ctx context.Context, req
 		}, nil
 	}
 
-	s.logger.Debug("GetUserDetailInfo request received", zap.Int64("user_id", req.UserId))
+	s.logger.Debug("GetUserDetailInfo request received", zap.Int64("user_id", req.UserId), zap.Int64("viewer_id", req.GetViewerId()))
 
 	// Find user
 	exists, user := s.findUserById(req.UserId)
@@ -141,19 +142,37 @@ func (s *AuthenticateAndPostService) GetUserDetailInfo(ctx context.Context, req
 		}, nil
 	}
 
+	info := &pb.UserDetailInfo{
+		UserId:         user.ID,
+		UserName:       user.UserName,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		ProfilePicture: user.ProfilePicture,
+		CoverPicture:   user.CoverPicture,
+	}
+
+	// Private fields are only returned to the user themselves,
+	// relationship flags only to other signed-in viewers
+	viewerId := req.GetViewerId()
+	if viewerId == user.ID {
+		info.DateOfBirth = timestamppb.New(user.DateOfBirth)
+		info.Email = user.Email
+	} else if viewerId > 0 {
+		info.Following = s.isFollowing(user.ID, viewerId)
+		info.FollowedBy = s.isFollowing(viewerId, user.ID)
+	}
+
+	pinnedPostIds, err := s.getPinnedPostIds(user.ID, viewerId)
+	if err != nil {
+		s.logger.Error("Failed to load pinned posts", zap.Int64("user_id", user.ID), zap.Error(err))
+		return nil, err
+	}
+	info.PinnedPostIds = pinnedPostIds
+
 	// Return user details
 	return &pb.GetUserDetailInfoResponse{
 		Status: pb.GetUserDetailInfoResponse_OK,
-		User: &pb.UserDetailInfo{
-			UserId:         user.ID,
-			UserName:       user.UserName,
-			FirstName:      user.FirstName,
-			LastName:       user.LastName,
-			DateOfBirth:    timestamppb.New(user.DateOfBirth),
-			Email:          user.Email,
-			ProfilePicture: user.ProfilePicture,
-			CoverPicture:   user.CoverPicture,
-		},
+		User:   info,
 	}, nil
 }
 
@@ -180,6 +199,7 @@ func (s *AuthenticateAndPostService) EditUser(ctx context.Context, req *pb.EditU
 	}
 
 	// Update fields
+	original := user
 	if req.FirstName != nil {
 		user.FirstName = *req.FirstName
 	}
@@ -216,6 +236,9 @@ func (s *AuthenticateAndPostService) EditUser(ctx context.Context, req *pb.EditU
 		s.logger.Error("Error updating user", zap.Error(result.Error))
 		return nil, result.Error
 	}
+	if user.FirstName != original.FirstName || user.LastName != original.LastName {
+		s.reindexUser(ctx, original, user)
+	}
 
 	return &pb.EditUserResponse{
 		Status: pb.EditUserResponse_OK,
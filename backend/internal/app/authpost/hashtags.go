@@ -0,0 +1,187 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxHashtagLength matches the size of hashtags.tag
+	maxHashtagLength = 100
+	// defaultHashtagPostsLimit and maxHashtagPostsLimit bound the page size of GetHashtagPosts
+	defaultHashtagPostsLimit = 20
+	maxHashtagPostsLimit     = 100
+	// recentHashtagWindow is the window of HashtagInfo.recent_post_count
+	recentHashtagWindow = 7 * 24 * time.Hour
+)
+
+// hashtagPattern matches '#' followed by letters, digits and underscores with at least one letter.
+// The '#' must not follow a word character, '&' or '/' so URL fragments and HTML entities are skipped.
+// Keep it in sync with the backfill in migrations/0005_hashtags.up.sql.
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/])#([\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*)`)
+
+// normalizeHashtag lower-cases a tag and strips its leading '#'
+func normalizeHashtag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// extractHashtags returns the distinct normalized hashtags of a text in order of appearance
+func extractHashtags(text string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, match := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		tag := normalizeHashtag(match[1])
+		if utf8.RuneCountInString(tag) > maxHashtagLength || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// syncPostHashtags replaces the hashtags linked to a post with the ones found in its text
+func syncPostHashtags(tx *gorm.DB, post *types.Post) error {
+	if err := tx.Where("post_id = ?", post.ID).Delete(&types.PostHashtag{}).Error; err != nil {
+		return err
+	}
+
+	tags := extractHashtags(post.ContentText)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	hashtags := make([]types.Hashtag, 0, len(tags))
+	for _, tag := range tags {
+		hashtags = append(hashtags, types.Hashtag{Tag: tag})
+	}
+	err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "tag"}}, DoNothing: true}).
+		Create(&hashtags).Error
+	if err != nil {
+		return err
+	}
+
+	// Tags that already existed are not returned by the insert, so look all of them up
+	var hashtagIds []int64
+	if err := tx.Model(&types.Hashtag{}).Where("tag IN ?", tags).Pluck("id", &hashtagIds).Error; err != nil {
+		return err
+	}
+
+	links := make([]types.PostHashtag, 0, len(hashtagIds))
+	for _, hashtagId := range hashtagIds {
+		links = append(links, types.PostHashtag{PostID: post.ID, HashtagID: hashtagId})
+	}
+	return tx.Create(&links).Error
+}
+
+// findHashtag checks if a hashtag with the normalized tag exists in database
+func (a *AuthenticateAndPostService) findHashtag(tag string) (exist bool, hashtag types.Hashtag) {
+	result := a.db.Where("tag = ?", normalizeHashtag(tag)).First(&hashtag)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.Hashtag{}
+	}
+	return result.Error == nil, hashtag
+}
+
+func (a *AuthenticateAndPostService) GetHashtagPosts(ctx context.Context, info *pb_aap.GetHashtagPostsRequest) (*pb_aap.GetHashtagPostsResponse, error) {
+	a.logger.Debug("start getting hashtag posts", zap.String("tag", info.GetTag()))
+	defer a.logger.Debug("end getting hashtag posts")
+
+	exist, hashtag := a.findHashtag(info.GetTag())
+	if !exist {
+		return &pb_aap.GetHashtagPostsResponse{Status: pb_aap.GetHashtagPostsResponse_HASHTAG_NOT_FOUND}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultHashtagPostsLimit
+	} else if limit > maxHashtagPostsLimit {
+		limit = maxHashtagPostsLimit
+	}
+
+	query := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(info.GetViewerId())).
+		Joins("JOIN post_hashtags ph ON ph.post_id = posts.id").
+		Where("ph.hashtag_id = ?", hashtag.ID)
+	if info.GetCursor() > 0 {
+		query = query.Where("posts.id < ?", info.GetCursor())
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	var postsIds []int64
+	err := query.Order("posts.id DESC").Limit(limit+1).Pluck("posts.id", &postsIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor int64
+	if len(postsIds) > limit {
+		postsIds = postsIds[:limit]
+		nextCursor = postsIds[limit-1]
+	}
+
+	return &pb_aap.GetHashtagPostsResponse{
+		Status:     pb_aap.GetHashtagPostsResponse_OK,
+		PostsIds:   postsIds,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetHashtagInfo(ctx context.Context, info *pb_aap.GetHashtagInfoRequest) (*pb_aap.GetHashtagInfoResponse, error) {
+	a.logger.Debug("start getting hashtag info", zap.String("tag", info.GetTag()))
+	defer a.logger.Debug("end getting hashtag info")
+
+	exist, hashtag := a.findHashtag(info.GetTag())
+	if !exist {
+		return &pb_aap.GetHashtagInfoResponse{Status: pb_aap.GetHashtagInfoResponse_HASHTAG_NOT_FOUND}, nil
+	}
+
+	var stats struct {
+		PostCount       int64
+		AuthorCount     int64
+		RecentPostCount int64
+		FirstUsedAt     *time.Time
+		LastUsedAt      *time.Time
+	}
+	err := a.db.Model(&types.Post{}).
+		Select("COUNT(*) AS post_count, "+
+			"COUNT(DISTINCT posts.user_id) AS author_count, "+
+			"COUNT(*) FILTER (WHERE posts.created_at >= ?) AS recent_post_count, "+
+			"MIN(posts.created_at) AS first_used_at, "+
+			"MAX(posts.created_at) AS last_used_at", time.Now().Add(-recentHashtagWindow)).
+		Joins("JOIN post_hashtags ph ON ph.post_id = posts.id").
+		Where("ph.hashtag_id = ? AND posts.visibility = ? AND posts.publish_at IS NULL", hashtag.ID, types.PostVisibilityPublic).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb_aap.HashtagInfo{
+		Tag:             hashtag.Tag,
+		PostCount:       stats.PostCount,
+		AuthorCount:     stats.AuthorCount,
+		RecentPostCount: stats.RecentPostCount,
+	}
+	if stats.FirstUsedAt != nil {
+		result.FirstUsedAt = timestamppb.New(*stats.FirstUsedAt)
+	}
+	if stats.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*stats.LastUsedAt)
+	}
+
+	return &pb_aap.GetHashtagInfoResponse{
+		Status:  pb_aap.GetHashtagInfoResponse_OK,
+		Hashtag: result,
+	}, nil
+}
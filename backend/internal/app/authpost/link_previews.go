@@ -0,0 +1,143 @@
+package authpost
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/linkpreview"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxLinksPerPost bounds the number of links of a post that get a preview
+	maxLinksPerPost = 3
+	// linkPreviewTTL is how long a fetched preview is used before the page is fetched again
+	linkPreviewTTL = 7 * 24 * time.Hour
+	// linkPreviewRetryAfter is how long a failed fetch is cached
+	linkPreviewRetryAfter = time.Hour
+	linkPreviewQueueSize  = 256
+	linkPreviewWorkers    = 4
+)
+
+// syncPostLinks replaces the links recorded for a post with the ones found in its text
+func syncPostLinks(tx *gorm.DB, post *types.Post) error {
+	if err := tx.Where("post_id = ?", post.ID).Delete(&types.PostLink{}).Error; err != nil {
+		return err
+	}
+
+	urls := linkpreview.ExtractURLs(post.ContentText, maxLinksPerPost)
+	if len(urls) == 0 {
+		return nil
+	}
+	links := make([]types.PostLink, 0, len(urls))
+	for i, url := range urls {
+		links = append(links, types.PostLink{PostID: post.ID, Position: i, URL: url})
+	}
+	return tx.Create(&links).Error
+}
+
+// queueLinkPreviews schedules fetching the previews of the links of a post.
+// Links are dropped when the queue is full, they get a preview the next time a post uses them.
+func (a *AuthenticateAndPostService) queueLinkPreviews(post types.Post) {
+	if a.linkQueue == nil {
+		return
+	}
+	for _, url := range linkpreview.ExtractURLs(post.ContentText, maxLinksPerPost) {
+		select {
+		case a.linkQueue <- url:
+		default:
+			a.logger.Warn("Link preview queue full, dropping link", zap.String("url", url))
+		}
+	}
+}
+
+// RunLinkPreviewer fetches the queued link previews until ctx is cancelled
+func (a *AuthenticateAndPostService) RunLinkPreviewer(ctx context.Context) {
+	if a.linkQueue == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < linkPreviewWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case url := <-a.linkQueue:
+					if err := a.refreshLinkPreview(ctx, url); err != nil {
+						a.logger.Error("Error saving link preview", zap.String("url", url), zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// refreshLinkPreview fetches the preview of a link unless the cached one is still fresh
+func (a *AuthenticateAndPostService) refreshLinkPreview(ctx context.Context, url string) error {
+	var cached types.LinkPreview
+	result := a.db.Where("url = ?", url).Limit(1).Find(&cached)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		ttl := linkPreviewTTL
+		if cached.Failed {
+			ttl = linkPreviewRetryAfter
+		}
+		if time.Since(cached.FetchedAt) < ttl {
+			return nil
+		}
+	}
+
+	preview := types.LinkPreview{URL: url, FetchedAt: time.Now()}
+	fetched, err := a.linkFetcher.Fetch(ctx, url)
+	if err != nil {
+		a.logger.Debug("Failed to fetch link preview", zap.String("url", url), zap.Error(err))
+		preview.Failed = true
+	} else {
+		preview.Title = fetched.Title
+		preview.Description = fetched.Description
+		preview.ImageURL = fetched.ImageURL
+		preview.SiteName = fetched.SiteName
+	}
+
+	return a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "url"}},
+		UpdateAll: true,
+	}).Create(&preview).Error
+}
+
+// getLinkPreviews returns the fetched previews of the links of a post, in order of appearance
+func (a *AuthenticateAndPostService) getLinkPreviews(postId int64) ([]*pb_aap.LinkPreview, error) {
+	var previews []types.LinkPreview
+	err := a.db.
+		Joins("JOIN post_links ON post_links.url = link_previews.url").
+		Where("post_links.post_id = ? AND NOT link_previews.failed", postId).
+		Order("post_links.position").
+		Find(&previews).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.LinkPreview, 0, len(previews))
+	for _, preview := range previews {
+		result = append(result, &pb_aap.LinkPreview{
+			Url:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageUrl:    preview.ImageURL,
+			SiteName:    preview.SiteName,
+		})
+	}
+	return result, nil
+}
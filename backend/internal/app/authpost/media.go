@@ -0,0 +1,278 @@
+package authpost
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/configs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxMediaPerPost bounds the number of images and videos of a post or draft
+	maxMediaPerPost    = 10
+	maxMediaURLLength  = 2048
+	maxMimeTypeLength  = 100
+	maxAltTextLength   = 1000
+	maxBlurhashLength  = 100
+	maxMediaDimensions = 100000
+
+	// uploadPathPrefix is where the webapp serves an upload under its base64 encoded key,
+	// keep it in sync with the webapp binary handler
+	uploadPathPrefix = "/api/v1/binaries/"
+)
+
+// orderedMedia preloads media in display order
+func orderedMedia(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// newMedia checks the media of a request, it returns false when one of them is invalid
+func (a *AuthenticateAndPostService) newMedia(input []*pb_aap.PostMedia) ([]types.Media, bool) {
+	if len(input) > maxMediaPerPost {
+		return nil, false
+	}
+
+	media := make([]types.Media, 0, len(input))
+	for _, item := range input {
+		link := strings.TrimSpace(item.GetUrl())
+		mimeType := strings.ToLower(strings.TrimSpace(item.GetMimeType()))
+		if link == "" || len(link) > maxMediaURLLength {
+			return nil, false
+		}
+		if mimeType != "" && (len(mimeType) > maxMimeTypeLength ||
+			!(strings.HasPrefix(mimeType, "image/") || strings.HasPrefix(mimeType, "video/"))) {
+			return nil, false
+		}
+		if item.GetWidth() < 0 || item.GetWidth() > maxMediaDimensions ||
+			item.GetHeight() < 0 || item.GetHeight() > maxMediaDimensions {
+			return nil, false
+		}
+		altText := strings.TrimSpace(item.GetAltText())
+		if utf8.RuneCountInString(altText) > maxAltTextLength || len(item.GetBlurhash()) > maxBlurhashLength {
+			return nil, false
+		}
+
+		media = append(media, types.Media{
+			URL:        link,
+			StorageKey: a.mediaObjectKey(link),
+			MimeType:   mimeType,
+			Width:      item.GetWidth(),
+			Height:     item.GetHeight(),
+			AltText:    altText,
+			Blurhash:   item.GetBlurhash(),
+		})
+	}
+	return media, true
+}
+
+// mediaToProto converts media into their protobuf representation
+func mediaToProto(media []types.Media) []*pb_aap.PostMedia {
+	result := make([]*pb_aap.PostMedia, 0, len(media))
+	for _, item := range media {
+		result = append(result, &pb_aap.PostMedia{
+			Url:      item.URL,
+			MimeType: item.MimeType,
+			Width:    item.Width,
+			Height:   item.Height,
+			AltText:  item.AltText,
+			Blurhash: item.Blurhash,
+		})
+	}
+	return result
+}
+
+// postMedia returns the media of a post in display order
+func postMedia(post types.Post) []types.Media {
+	media := make([]types.Media, 0, len(post.Media))
+	for _, item := range post.Media {
+		media = append(media, item.Media)
+	}
+	return media
+}
+
+// setPostMedia replaces the media of a post in memory, syncPostMedia stores them
+func setPostMedia(post *types.Post, media []types.Media) {
+	post.Media = make([]types.PostMedia, 0, len(media))
+	for i, item := range media {
+		post.Media = append(post.Media, types.PostMedia{PostID: post.ID, Position: i, Media: item})
+	}
+}
+
+// samePostMedia reports whether two posts have the same media in the same order
+func samePostMedia(a types.Post, b types.Post) bool {
+	if len(a.Media) != len(b.Media) {
+		return false
+	}
+	for i := range a.Media {
+		if a.Media[i].Media != b.Media[i].Media {
+			return false
+		}
+	}
+	return true
+}
+
+// syncPostMedia replaces the stored media of a post with post.Media
+func syncPostMedia(tx *gorm.DB, post *types.Post) error {
+	if err := tx.Where("post_id = ?", post.ID).Delete(&types.PostMedia{}).Error; err != nil {
+		return err
+	}
+	if len(post.Media) == 0 {
+		return nil
+	}
+	for i := range post.Media {
+		post.Media[i].PostID = post.ID
+		post.Media[i].Position = i
+	}
+	return tx.Create(&post.Media).Error
+}
+
+// loadPostMedia fills post.Media from the database
+func (a *AuthenticateAndPostService) loadPostMedia(post *types.Post) error {
+	return a.db.Where("post_id = ?", post.ID).Order("position").Find(&post.Media).Error
+}
+
+// mediaKey returns the object key of an upload, media migrated from image paths only have a url
+func (a *AuthenticateAndPostService) mediaKey(media types.Media) string {
+	if media.StorageKey != "" {
+		return media.StorageKey
+	}
+	return a.mediaObjectKey(media.URL)
+}
+
+// deleteUnusedMedia removes uploads from S3 once no post, revision, draft, story nor profile uses them
+// anymore. It is called after the rows referencing the media are gone.
+func (a *AuthenticateAndPostService) deleteUnusedMedia(media []types.Media) {
+	if a.mediaStorage == nil || len(media) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(media))
+	urls := make([]string, 0, len(media))
+	seen := make(map[string]bool)
+	for _, item := range media {
+		key := a.mediaKey(item)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+		urls = append(urls, item.URL)
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	// Media migrated from image paths have no storage key, they are matched on their url
+	inUse := make(map[string]bool)
+	for _, model := range []interface{}{&types.PostMedia{}, &types.PostRevisionMedia{}, &types.PostDraftMedia{}, &types.Story{}} {
+		var used []types.Media
+		err := a.db.Model(model).
+			Select("url, storage_key").
+			Where("storage_key IN ? OR (storage_key = '' AND url IN ?)", keys, urls).
+			Find(&used).Error
+		if err != nil {
+			a.logger.Warn("Failed to check the uses of media, keeping it", zap.Error(err))
+			return
+		}
+		for _, item := range used {
+			inUse[a.mediaKey(item)] = true
+		}
+	}
+
+	// Profile and cover pictures are kept as an object key or any url of it, the candidates are
+	// narrowed down to the pictures with the same key. Deleted users keep theirs.
+	candidates := append([]string{}, urls...)
+	for _, key := range keys {
+		candidates = append(candidates, key, uploadPathPrefix+base64.URLEncoding.EncodeToString([]byte(key)))
+	}
+	pictureConds := a.db.Where("profile_picture IN ? OR cover_picture IN ?", candidates, candidates)
+	for _, key := range keys {
+		pictureConds = pictureConds.Or("profile_picture LIKE ? OR cover_picture LIKE ?", "%/"+key, "%/"+key)
+	}
+	var pictures []types.User
+	err := a.db.Unscoped().Model(&types.User{}).
+		Select("profile_picture, cover_picture").
+		Where(pictureConds).
+		Find(&pictures).Error
+	if err != nil {
+		a.logger.Warn("Failed to check the uses of media, keeping it", zap.Error(err))
+		return
+	}
+	for _, user := range pictures {
+		inUse[a.mediaObjectKey(user.ProfilePicture)] = true
+		inUse[a.mediaObjectKey(user.CoverPicture)] = true
+	}
+
+	for _, key := range keys {
+		if inUse[key] {
+			continue
+		}
+		if err := a.mediaStorage.DeleteBinary(key); err != nil {
+			a.logger.Warn("Failed to delete unused media",
+				zap.String("key", key),
+				zap.Error(err))
+		}
+	}
+}
+
+// mediaHosts returns the hosts serving the objects of the media bucket, either the configured
+// endpoint or the regional and global S3 hosts
+func mediaHosts(cfg configs.S3Config) []string {
+	if cfg.Endpoint != "" {
+		endpoint := cfg.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "//" + endpoint
+		}
+		if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+			return []string{strings.ToLower(u.Hostname())}
+		}
+		return nil
+	}
+
+	hosts := []string{"s3.amazonaws.com"}
+	if cfg.Region != "" {
+		hosts = append(hosts, "s3."+cfg.Region+".amazonaws.com", "s3-"+cfg.Region+".amazonaws.com")
+	}
+	return hosts
+}
+
+// mediaObjectKey turns a stored media path, an object key, an upload url of the webapp or an S3 URL,
+// into an object key. Media hosted outside of the media bucket have no key and are never deleted.
+func (a *AuthenticateAndPostService) mediaObjectKey(path string) string {
+	u, err := url.Parse(path)
+	if err != nil || (u.Scheme != "" && u.Host == "") {
+		return ""
+	}
+	if u.Host == "" {
+		if encoded, ok := strings.CutPrefix(u.Path, uploadPathPrefix); ok {
+			key, err := base64.URLEncoding.DecodeString(encoded)
+			if err != nil {
+				return ""
+			}
+			return string(key)
+		}
+		return strings.TrimPrefix(u.Path, "/")
+	}
+	if a.mediaBucket == "" {
+		return ""
+	}
+
+	// Virtual-hosted URLs carry the bucket name in the host, path-style URLs in the first path segment
+	host := strings.ToLower(u.Hostname())
+	key := strings.TrimPrefix(u.Path, "/")
+	for _, mediaHost := range a.mediaHosts {
+		if host == strings.ToLower(a.mediaBucket)+"."+mediaHost {
+			return key
+		}
+		if key, ok := strings.CutPrefix(key, a.mediaBucket+"/"); ok && host == mediaHost {
+			return key
+		}
+	}
+	return ""
+}
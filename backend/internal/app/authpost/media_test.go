@@ -0,0 +1,40 @@
+package authpost
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/configs"
+)
+
+func TestMediaObjectKey(t *testing.T) {
+	upload := uploadPathPrefix + base64.URLEncoding.EncodeToString([]byte("uploads/photo.jpg"))
+	tests := []struct {
+		name string
+		cfg  configs.S3Config
+		path string
+		want string
+	}{
+		{"object key", configs.S3Config{}, "uploads/photo.jpg", "uploads/photo.jpg"},
+		{"webapp upload url", configs.S3Config{}, upload, "uploads/photo.jpg"},
+		{"invalid webapp upload url", configs.S3Config{}, uploadPathPrefix + "%%%", ""},
+		{"virtual-hosted url", configs.S3Config{Region: "eu-west-1"}, "https://wander.s3.eu-west-1.amazonaws.com/uploads/photo.jpg", "uploads/photo.jpg"},
+		{"path-style url", configs.S3Config{Region: "eu-west-1"}, "https://s3.eu-west-1.amazonaws.com/wander/uploads/photo.jpg", "uploads/photo.jpg"},
+		{"path-style url of another bucket", configs.S3Config{Region: "eu-west-1"}, "https://s3.eu-west-1.amazonaws.com/other/uploads/photo.jpg", ""},
+		{"virtual-hosted url of another bucket", configs.S3Config{}, "https://other.s3.amazonaws.com/uploads/photo.jpg", ""},
+		{"endpoint url", configs.S3Config{Endpoint: "http://minio:9000"}, "http://minio:9000/wander/uploads/photo.jpg", "uploads/photo.jpg"},
+		{"endpoint without scheme", configs.S3Config{Endpoint: "minio:9000"}, "http://minio:9000/wander/uploads/photo.jpg", "uploads/photo.jpg"},
+		{"S3 url with an endpoint", configs.S3Config{Endpoint: "http://minio:9000"}, "https://wander.s3.amazonaws.com/uploads/photo.jpg", ""},
+		{"other host", configs.S3Config{}, "https://example.com/wander/uploads/photo.jpg", ""},
+		{"other host with the bucket path", configs.S3Config{}, "https://example.com/uploads/photo.jpg", ""},
+		{"data url", configs.S3Config{}, "data:image/png;base64,AAAA", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &AuthenticateAndPostService{mediaBucket: "wander", mediaHosts: mediaHosts(test.cfg)}
+			if got := service.mediaObjectKey(test.path); got != test.want {
+				t.Errorf("Expected key %q for %s, got %q", test.want, test.path, got)
+			}
+		})
+	}
+}
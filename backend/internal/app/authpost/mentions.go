@@ -0,0 +1,186 @@
+package authpost
+
+import (
+	"context"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxUserNameLength matches the size of users.user_name
+const maxUserNameLength = 50
+
+// mentionPattern matches '@' followed by the characters allowed in a user name.
+// The '@' must not follow a word character, '.', '/' or '-' so e-mail addresses and URLs are skipped.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@./-])@([A-Za-z0-9_-]+)`)
+
+// mentionMatch is an @username found in a text, Start and End are code point offsets
+type mentionMatch struct {
+	UserName string
+	Start    int32
+	End      int32
+}
+
+// extractMentions returns the @username spans of a text in order of appearance
+func extractMentions(text string) []mentionMatch {
+	var matches []mentionMatch
+	for _, index := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		userName := text[index[2]:index[3]]
+		if len(userName) > maxUserNameLength {
+			continue
+		}
+		// The span starts at the '@' right before the captured name
+		start := utf8.RuneCountInString(text[:index[2]-1])
+		matches = append(matches, mentionMatch{
+			UserName: userName,
+			Start:    int32(start),
+			End:      int32(start + 1 + utf8.RuneCountInString(userName)),
+		})
+	}
+	return matches
+}
+
+// syncMentions replaces the mentions stored for a post text, or for a comment text when commentId is set,
+// with the ones found in text. Names that do not belong to an existing user are ignored.
+// It returns the users that were not mentioned in the previous version of the text, the author excluded.
+func syncMentions(tx *gorm.DB, postId int64, commentId *int64, authorId int64, text string) ([]int64, error) {
+	scope := tx.Where("post_id = ?", postId)
+	if commentId != nil {
+		scope = scope.Where("comment_id = ?", *commentId)
+	} else {
+		scope = scope.Where("comment_id IS NULL")
+	}
+
+	var previous []int64
+	if err := scope.Model(&types.Mention{}).Pluck("user_id", &previous).Error; err != nil {
+		return nil, err
+	}
+	if len(previous) > 0 {
+		if err := scope.Delete(&types.Mention{}).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	matches := extractMentions(text)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	userNames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		userNames = append(userNames, match.UserName)
+	}
+	var users []types.User
+	if err := tx.Select("id", "user_name").Where("user_name IN ?", userNames).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	userIds := make(map[string]int64, len(users))
+	for _, user := range users {
+		userIds[user.UserName] = user.ID
+	}
+
+	var mentions []types.Mention
+	notified := make(map[int64]bool, len(previous)+1)
+	notified[authorId] = true
+	for _, id := range previous {
+		notified[id] = true
+	}
+	var mentioned []int64
+	for _, match := range matches {
+		userId, ok := userIds[match.UserName]
+		if !ok {
+			continue
+		}
+		mentions = append(mentions, types.Mention{
+			PostID:    postId,
+			CommentID: commentId,
+			UserID:    userId,
+			AuthorID:  authorId,
+			Start:     match.Start,
+			End:       match.End,
+		})
+		if !notified[userId] {
+			notified[userId] = true
+			mentioned = append(mentioned, userId)
+		}
+	}
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	if err := tx.Create(&mentions).Error; err != nil {
+		return nil, err
+	}
+	return mentioned, nil
+}
+
+// publishMentions emits a mention event for the mentioned users that can read the post.
+// Users who cannot see the post are not told about it, so a mention never leaks a post's existence.
+func (a *AuthenticateAndPostService) publishMentions(ctx context.Context, post types.Post, commentId int64, authorId int64, userIds []int64) {
+	if a.nfPubClient == nil || len(userIds) == 0 {
+		return
+	}
+
+	recipients := make([]int64, 0, len(userIds))
+	for _, userId := range userIds {
+		if a.canViewPost(userId, post) {
+			recipients = append(recipients, userId)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	_, err := a.nfPubClient.PublishMention(ctx, &pb_nfp.PublishMentionRequest{
+		UserId:           authorId,
+		MentionedUserIds: recipients,
+		PostId:           post.ID,
+		CommentId:        commentId,
+	})
+	if err != nil {
+		a.logger.Error("Error publishing mentions", zap.Error(err), zap.Int64("post_id", post.ID))
+	}
+}
+
+// getMentionSpans returns the mention spans of a post's text and of each of its comments, by comment id
+func (a *AuthenticateAndPostService) getMentionSpans(postId int64) ([]*pb_aap.MentionSpan, map[int64][]*pb_aap.MentionSpan, error) {
+	var rows []struct {
+		CommentID *int64
+		UserID    int64
+		UserName  string
+		Start     int32
+		End       int32
+	}
+	err := a.db.Model(&types.Mention{}).
+		Select("mentions.comment_id, mentions.user_id, users.user_name, "+
+			"mentions.mention_start AS start, mentions.mention_end AS end").
+		Joins("JOIN users ON users.id = mentions.user_id AND users.deleted_at IS NULL").
+		Where("mentions.post_id = ?", postId).
+		Order("mentions.comment_id NULLS FIRST, mentions.mention_start").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postSpans []*pb_aap.MentionSpan
+	commentSpans := make(map[int64][]*pb_aap.MentionSpan)
+	for _, row := range rows {
+		span := &pb_aap.MentionSpan{
+			UserId:   row.UserID,
+			UserName: row.UserName,
+			Start:    row.Start,
+			End:      row.End,
+		}
+		if row.CommentID == nil {
+			postSpans = append(postSpans, span)
+		} else {
+			commentSpans[*row.CommentID] = append(commentSpans[*row.CommentID], span)
+		}
+	}
+	return postSpans, commentSpans, nil
+}
@@ -0,0 +1,149 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxPinnedPosts bounds the number of posts a user can pin to their profile
+const maxPinnedPosts = 3
+
+// errTooManyPins aborts pinning a post when the user already pinned maxPinnedPosts posts
+var errTooManyPins = errors.New("too many pinned posts")
+
+// errNotPinnable aborts pinning a post that was trashed or made private in the meantime
+var errNotPinnable = errors.New("post not pinnable")
+
+// getPinnedPostIds returns the posts pinned by a user that viewerId can read, most recently pinned first
+func (a *AuthenticateAndPostService) getPinnedPostIds(userId int64, viewerId int64) ([]int64, error) {
+	var postIds []int64
+	err := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(viewerId)).
+		Where("posts.user_id = ? AND posts.pinned_at IS NOT NULL", userId).
+		Order("posts.pinned_at DESC").
+		Pluck("posts.id", &postIds).Error
+	return postIds, err
+}
+
+// unpinPost clears the pin of a post, used when the post stops being visible on the profile
+func unpinPost(tx *gorm.DB, postId int64) error {
+	return tx.Model(&types.Post{}).
+		Where("id = ? AND pinned_at IS NOT NULL", postId).
+		Update("pinned_at", nil).Error
+}
+
+func (a *AuthenticateAndPostService) PinPost(ctx context.Context, info *pb_aap.PinPostRequest) (*pb_aap.PinPostResponse, error) {
+	a.logger.Debug("start pinning post", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end pinning post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_POST_NOT_FOUND}, nil
+	}
+	if post.UserID != user.ID || post.RepostOfID != nil {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_NOT_ALLOWED}, nil
+	}
+	if post.Visibility == types.PostVisibilityPrivate || post.PublishAt != nil {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_NOT_PINNABLE}, nil
+	}
+	if post.PinnedAt != nil {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_OK}, nil
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the user so concurrent pins cannot exceed the limit
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&types.User{}, user.ID).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&types.Post{}).
+			Where("user_id = ? AND pinned_at IS NOT NULL AND id <> ?", user.ID, post.ID).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count >= maxPinnedPosts {
+			return errTooManyPins
+		}
+
+		// The post is only pinned while it is still the same published, non private post
+		result := tx.Model(&types.Post{}).
+			Where("id = ? AND pinned_at IS NULL AND publish_at IS NULL AND visibility <> ?", post.ID, types.PostVisibilityPrivate).
+			Update("pinned_at", time.Now())
+		if result.Error != nil || result.RowsAffected > 0 {
+			return result.Error
+		}
+
+		// A concurrent pin of the post got the lock first, otherwise the post changed in the meantime
+		var pinned int64
+		err = tx.Model(&types.Post{}).
+			Where("id = ? AND pinned_at IS NOT NULL", post.ID).
+			Count(&pinned).Error
+		if err != nil {
+			return err
+		}
+		if pinned == 0 {
+			return errNotPinnable
+		}
+		return nil
+	})
+	if errors.Is(err, errTooManyPins) {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_TOO_MANY_PINS}, nil
+	} else if errors.Is(err, errNotPinnable) {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_NOT_PINNABLE}, nil
+	} else if err != nil {
+		a.logger.Error("Error pinning post", zap.Error(err))
+		return nil, err
+	}
+
+	a.logger.Info("Post pinned",
+		zap.Int64("post_id", post.ID),
+		zap.Int64("user_id", user.ID))
+	return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) UnpinPost(ctx context.Context, info *pb_aap.UnpinPostRequest) (*pb_aap.UnpinPostResponse, error) {
+	a.logger.Debug("start unpinning post", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end unpinning post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_POST_NOT_FOUND}, nil
+	}
+	if post.UserID != user.ID {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_NOT_ALLOWED}, nil
+	}
+
+	result := a.db.Model(&types.Post{}).
+		Where("id = ? AND pinned_at IS NOT NULL", post.ID).
+		Update("pinned_at", nil)
+	if result.Error != nil {
+		a.logger.Error("Error unpinning post", zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_NOT_PINNED}, nil
+	}
+
+	a.logger.Info("Post unpinned",
+		zap.Int64("post_id", post.ID),
+		zap.Int64("user_id", user.ID))
+	return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_OK}, nil
+}
@@ -0,0 +1,435 @@
+package authpost
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxPlaceCountryLength and maxPlaceCityLength match the sizes of places.country and places.city
+	maxPlaceCountryLength = 100
+	maxPlaceCityLength    = 100
+	// defaultPlaceSearchLimit and maxPlaceSearchLimit bound the number of results of SearchPlaces
+	defaultPlaceSearchLimit = 20
+	maxPlaceSearchLimit     = 50
+	// defaultPlaceSearchRadiusKm is the radius of SearchPlaces when near is set without a radius
+	defaultPlaceSearchRadiusKm = 50
+	// defaultPlacePostsLimit and maxPlacePostsLimit bound the page size of GetPlacePosts
+	defaultPlacePostsLimit = 20
+	maxPlacePostsLimit     = 100
+)
+
+// placeStats are the check-in statistics of a place, computed over public posts, and the summary of its reviews
+type placeStats struct {
+	PlaceID       int64
+	CheckInCount  int64
+	VisitorCount  int64
+	RatingCount   int64
+	RatingAverage float64
+}
+
+// normalizePlaceCategory lower-cases a category, returning false when it is not one of types.PlaceCategories
+func normalizePlaceCategory(category string) (string, bool) {
+	category = strings.ToLower(strings.TrimSpace(category))
+	for _, known := range types.PlaceCategories {
+		if category == known {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// findPlace checks if a place exists in database. A place that was merged resolves to the place it was merged into.
+func (a *AuthenticateAndPostService) findPlace(placeId int64) (exist bool, place types.Place) {
+	result := a.db.Unscoped().First(&place, placeId)
+	if result.Error != nil {
+		return false, types.Place{}
+	}
+	if !place.DeletedAt.Valid {
+		return true, place
+	}
+	if place.MergedIntoID == nil {
+		return false, types.Place{}
+	}
+
+	// Merging repoints earlier merges, so the target is never a merged place itself
+	var target types.Place
+	result = a.db.First(&target, *place.MergedIntoID)
+	if result.Error != nil {
+		return false, types.Place{}
+	}
+	return true, target
+}
+
+// placeToProto converts a place, without its statistics
+func placeToProto(place types.Place) *pb_aap.Place {
+	return &pb_aap.Place{
+		PlaceId:   place.ID,
+		Name:      place.Name,
+		Category:  place.Category,
+		Country:   place.Country,
+		City:      place.City,
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		CreatedBy: place.CreatedBy,
+		CreatedAt: timestamppb.New(place.CreatedAt),
+	}
+}
+
+// placeLocation is the location given to a check-in without coordinates of its own
+func placeLocation(place types.Place) *pb_aap.Location {
+	return &pb_aap.Location{
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		PlaceName: place.Name,
+	}
+}
+
+// samePlace checks whether two posts are checked in at the same place
+func samePlace(a types.Post, b types.Post) bool {
+	if a.PlaceID == nil || b.PlaceID == nil {
+		return a.PlaceID == nil && b.PlaceID == nil
+	}
+	return *a.PlaceID == *b.PlaceID
+}
+
+// getPlaceStats returns the check-in statistics of the places, by place id
+func (a *AuthenticateAndPostService) getPlaceStats(placeIds []int64) (map[int64]placeStats, error) {
+	result := make(map[int64]placeStats, len(placeIds))
+	if len(placeIds) == 0 {
+		return result, nil
+	}
+
+	var rows []placeStats
+	err := a.db.Model(&types.Post{}).
+		Select("posts.place_id, COUNT(*) AS check_in_count, COUNT(DISTINCT posts.user_id) AS visitor_count").
+		Where("posts.place_id IN ? AND posts.visibility = ? AND posts.publish_at IS NULL", placeIds, types.PostVisibilityPublic).
+		Group("posts.place_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.PlaceID] = row
+	}
+
+	var ratings []placeStats
+	err = a.db.Model(&types.PlaceReview{}).
+		Select("place_reviews.place_id, COUNT(*) AS rating_count, AVG(place_reviews.rating) AS rating_average").
+		Where("place_reviews.place_id IN ?", placeIds).
+		Group("place_reviews.place_id").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, rating := range ratings {
+		stats := result[rating.PlaceID]
+		stats.PlaceID = rating.PlaceID
+		stats.RatingCount = rating.RatingCount
+		stats.RatingAverage = rating.RatingAverage
+		result[rating.PlaceID] = stats
+	}
+	return result, nil
+}
+
+func (a *AuthenticateAndPostService) CreatePlace(ctx context.Context, info *pb_aap.CreatePlaceRequest) (*pb_aap.CreatePlaceResponse, error) {
+	a.logger.Debug("start creating place", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end creating place")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreatePlaceResponse{Status: pb_aap.CreatePlaceResponse_USER_NOT_FOUND}, nil
+	}
+
+	name := strings.TrimSpace(info.GetName())
+	country := strings.TrimSpace(info.GetCountry())
+	city := strings.TrimSpace(info.GetCity())
+	category, ok := normalizePlaceCategory(info.GetCategory())
+	if !ok || name == "" || utf8.RuneCountInString(name) > maxPlaceNameLength ||
+		utf8.RuneCountInString(country) > maxPlaceCountryLength ||
+		utf8.RuneCountInString(city) > maxPlaceCityLength ||
+		!validCoordinates(info.GetLatitude(), info.GetLongitude()) {
+		return &pb_aap.CreatePlaceResponse{Status: pb_aap.CreatePlaceResponse_INVALID_PLACE}, nil
+	}
+
+	place := types.Place{
+		Name:      name,
+		Category:  category,
+		Country:   country,
+		City:      city,
+		Latitude:  info.GetLatitude(),
+		Longitude: info.GetLongitude(),
+		Geohash:   encodeGeohash(info.GetLatitude(), info.GetLongitude(), geohashPrecision),
+		CreatedBy: info.GetUserId(),
+	}
+	if err := a.db.Create(&place).Error; err != nil {
+		a.logger.Error("Error creating place", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.CreatePlaceResponse{
+		Status:  pb_aap.CreatePlaceResponse_OK,
+		PlaceId: place.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) SearchPlaces(ctx context.Context, info *pb_aap.SearchPlacesRequest) (*pb_aap.SearchPlacesResponse, error) {
+	a.logger.Debug("start searching places", zap.String("query", info.GetQuery()))
+	defer a.logger.Debug("end searching places")
+
+	query := strings.TrimSpace(info.GetQuery())
+	near := info.GetNear()
+	if (query == "" && near == nil) || utf8.RuneCountInString(query) > maxSearchQueryLength ||
+		(near != nil && !validCoordinates(near.GetLatitude(), near.GetLongitude())) {
+		return &pb_aap.SearchPlacesResponse{Status: pb_aap.SearchPlacesResponse_INVALID_QUERY}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultPlaceSearchLimit
+	} else if limit > maxPlaceSearchLimit {
+		limit = maxPlaceSearchLimit
+	}
+
+	places := a.db.Model(&types.Place{})
+	if info.GetCategory() != "" {
+		category, ok := normalizePlaceCategory(info.GetCategory())
+		if !ok {
+			return &pb_aap.SearchPlacesResponse{Status: pb_aap.SearchPlacesResponse_INVALID_QUERY}, nil
+		}
+		places = places.Where("places.category = ?", category)
+	}
+	if query != "" {
+		pattern := "%" + escapeLikePattern(query) + "%"
+		places = places.Where("places.name ILIKE ? OR places.name % ?", pattern, query)
+	}
+
+	if near != nil {
+		radiusKm := info.GetRadiusKm()
+		if radiusKm <= 0 {
+			radiusKm = defaultPlaceSearchRadiusKm
+		} else if radiusKm > maxNearbyRadiusKm {
+			radiusKm = maxNearbyRadiusKm
+		}
+
+		latitude, longitude := near.GetLatitude(), near.GetLongitude()
+		if prefixes := nearbyGeohashPrefixes(latitude, longitude, radiusKm); prefixes != nil {
+			condition, args := geohashPrefixCondition("places.geohash", prefixes)
+			places = places.Where(condition, args...)
+		}
+		places = places.
+			Select("places.*, "+distanceKmSQL("places")+" AS distance_km", latitude, latitude, longitude).
+			Where(distanceKmSQL("places")+" <= ?", latitude, latitude, longitude, radiusKm).
+			Order("distance_km, places.id DESC")
+	} else {
+		places = places.
+			Select("places.*, similarity(places.name, ?) AS rank", query).
+			Order("rank DESC, places.id DESC")
+	}
+
+	var rows []struct {
+		types.Place
+		DistanceKm float64
+	}
+	if err := places.Limit(limit).Scan(&rows).Error; err != nil {
+		a.logger.Error("Error searching places", zap.Error(err))
+		return nil, err
+	}
+
+	placeIds := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		placeIds = append(placeIds, row.ID)
+	}
+	stats, err := a.getPlaceStats(placeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.Place, 0, len(rows))
+	for _, row := range rows {
+		place := placeToProto(row.Place)
+		place.CheckInCount = stats[row.ID].CheckInCount
+		place.VisitorCount = stats[row.ID].VisitorCount
+		place.RatingCount = stats[row.ID].RatingCount
+		place.RatingAverage = stats[row.ID].RatingAverage
+		place.DistanceKm = row.DistanceKm
+		result = append(result, place)
+	}
+	return &pb_aap.SearchPlacesResponse{
+		Status: pb_aap.SearchPlacesResponse_OK,
+		Places: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetPlace(ctx context.Context, info *pb_aap.GetPlaceRequest) (*pb_aap.GetPlaceResponse, error) {
+	a.logger.Debug("start getting place", zap.Int64("place_id", info.GetPlaceId()))
+	defer a.logger.Debug("end getting place")
+
+	exist, place := a.findPlace(info.GetPlaceId())
+	if !exist {
+		return &pb_aap.GetPlaceResponse{Status: pb_aap.GetPlaceResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	stats, err := a.getPlaceStats([]int64{place.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := placeToProto(place)
+	result.CheckInCount = stats[place.ID].CheckInCount
+	result.VisitorCount = stats[place.ID].VisitorCount
+	result.RatingCount = stats[place.ID].RatingCount
+	result.RatingAverage = stats[place.ID].RatingAverage
+	return &pb_aap.GetPlaceResponse{
+		Status: pb_aap.GetPlaceResponse_OK,
+		Place:  result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetPlacePosts(ctx context.Context, info *pb_aap.GetPlacePostsRequest) (*pb_aap.GetPlacePostsResponse, error) {
+	a.logger.Debug("start getting place posts", zap.Int64("place_id", info.GetPlaceId()))
+	defer a.logger.Debug("end getting place posts")
+
+	exist, place := a.findPlace(info.GetPlaceId())
+	if !exist {
+		return &pb_aap.GetPlacePostsResponse{Status: pb_aap.GetPlacePostsResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultPlacePostsLimit
+	} else if limit > maxPlacePostsLimit {
+		limit = maxPlacePostsLimit
+	}
+
+	query := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(info.GetViewerId())).
+		Where("posts.place_id = ?", place.ID)
+	if info.GetCursor() > 0 {
+		query = query.Where("posts.id < ?", info.GetCursor())
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	var postsIds []int64
+	err := query.Order("posts.id DESC").Limit(limit+1).Pluck("posts.id", &postsIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor int64
+	if len(postsIds) > limit {
+		postsIds = postsIds[:limit]
+		nextCursor = postsIds[limit-1]
+	}
+
+	return &pb_aap.GetPlacePostsResponse{
+		Status:     pb_aap.GetPlacePostsResponse_OK,
+		PostsIds:   postsIds,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) MergePlaces(ctx context.Context, info *pb_aap.MergePlacesRequest) (*pb_aap.MergePlacesResponse, error) {
+	a.logger.Debug("start merging places",
+		zap.Int64("source_place_id", info.GetSourcePlaceId()),
+		zap.Int64("target_place_id", info.GetTargetPlaceId()))
+	defer a.logger.Debug("end merging places")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.MergePlacesResponse{Status: pb_aap.MergePlacesResponse_USER_NOT_FOUND}, nil
+	}
+	if !user.IsModerator {
+		return &pb_aap.MergePlacesResponse{Status: pb_aap.MergePlacesResponse_NOT_ALLOWED}, nil
+	}
+	if info.GetSourcePlaceId() == info.GetTargetPlaceId() {
+		return &pb_aap.MergePlacesResponse{Status: pb_aap.MergePlacesResponse_SAME_PLACE}, nil
+	}
+
+	// Places that were already merged are not found, so merges never chain
+	var source, target types.Place
+	if err := a.db.First(&source, info.GetSourcePlaceId()).Error; err != nil {
+		return &pb_aap.MergePlacesResponse{Status: pb_aap.MergePlacesResponse_PLACE_NOT_FOUND}, nil
+	}
+	if err := a.db.First(&target, info.GetTargetPlaceId()).Error; err != nil {
+		return &pb_aap.MergePlacesResponse{Status: pb_aap.MergePlacesResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	var movedPosts int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// Posts in the trash are moved too so restoring them keeps their check-in
+		result := tx.Unscoped().Model(&types.Post{}).
+			Where("place_id = ?", source.ID).
+			Update("place_id", target.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		movedPosts = result.RowsAffected
+
+		// Travel maps follow the check-ins to the country and city of the target
+		err := tx.Model(&types.TravelVisit{}).
+			Where("place_id = ?", source.ID).
+			Updates(map[string]interface{}{"place_id": target.ID, "country": target.Country, "city": target.City}).Error
+		if err != nil {
+			return err
+		}
+
+		// Users who reviewed both places keep their review of the target only
+		err = tx.Where("place_id = ? AND user_id IN (?)", source.ID,
+			tx.Model(&types.PlaceReview{}).Select("user_id").Where("place_id = ?", target.ID)).
+			Delete(&types.PlaceReview{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&types.PlaceReview{}).Where("place_id = ?", source.ID).Update("place_id", target.ID).Error
+		if err != nil {
+			return err
+		}
+
+		// Trips that visited both places keep the target only
+		err = tx.Where("place_id = ? AND trip_id IN (?)", source.ID,
+			tx.Model(&types.TripPlace{}).Select("trip_id").Where("place_id = ?", target.ID)).
+			Delete(&types.TripPlace{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&types.TripPlace{}).Where("place_id = ?", source.ID).Update("place_id", target.ID).Error
+		if err != nil {
+			return err
+		}
+
+		// Places merged into the source now resolve to the target directly
+		err = tx.Unscoped().Model(&types.Place{}).
+			Where("merged_into_id = ?", source.ID).
+			Update("merged_into_id", target.ID).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&source).Update("merged_into_id", target.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&source).Error
+	})
+	if err != nil {
+		a.logger.Error("Error merging places", zap.Error(err))
+		return nil, err
+	}
+
+	a.logger.Info("Places merged",
+		zap.Int64("source_place_id", source.ID),
+		zap.Int64("target_place_id", target.ID),
+		zap.Int64("moved_posts", movedPosts),
+		zap.Int64("user_id", user.ID))
+	return &pb_aap.MergePlacesResponse{
+		Status:          pb_aap.MergePlacesResponse_OK,
+		MovedPostsCount: movedPosts,
+	}, nil
+}
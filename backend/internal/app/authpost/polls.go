@@ -0,0 +1,225 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 6
+	maxPollOptionLength = 100
+	// minPollDuration and maxPollDuration bound how long a poll takes votes once its post is published
+	minPollDuration = 5 * time.Minute
+	maxPollDuration = 30 * 24 * time.Hour
+)
+
+// errPollClosed aborts a vote on a poll that closed in the meantime
+var errPollClosed = errors.New("poll closed")
+
+// newPoll builds the poll described by a create request for a post published at opensAt.
+// It returns false when the poll is invalid.
+func newPoll(input *pb_aap.PollInput, opensAt time.Time) (types.Poll, bool) {
+	if len(input.GetOptions()) < minPollOptions || len(input.GetOptions()) > maxPollOptions {
+		return types.Poll{}, false
+	}
+	if input.ExpiresAt == nil {
+		return types.Poll{}, false
+	}
+	expiresAt := input.GetExpiresAt().AsTime()
+	if expiresAt.Before(opensAt.Add(minPollDuration)) || expiresAt.After(opensAt.Add(maxPollDuration)) {
+		return types.Poll{}, false
+	}
+
+	poll := types.Poll{
+		ExpiresAt:      expiresAt,
+		MultipleChoice: input.GetMultipleChoice(),
+		Anonymous:      input.GetAnonymous(),
+	}
+	seen := make(map[string]bool)
+	for i, option := range input.GetOptions() {
+		text := strings.TrimSpace(option)
+		if text == "" || utf8.RuneCountInString(text) > maxPollOptionLength || seen[strings.ToLower(text)] {
+			return types.Poll{}, false
+		}
+		seen[strings.ToLower(text)] = true
+		poll.Options = append(poll.Options, types.PollOption{Position: i, Text: text})
+	}
+	return poll, true
+}
+
+// findPoll finds the poll of a post with its options in order
+func (a *AuthenticateAndPostService) findPoll(postId int64) (exist bool, poll types.Poll, err error) {
+	err = a.db.
+		Preload("Options", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Where("post_id = ?", postId).
+		First(&poll).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, types.Poll{}, nil
+	}
+	return err == nil, poll, err
+}
+
+// getPoll returns the poll of a post as seen by viewerId, nil when the post has no poll
+func (a *AuthenticateAndPostService) getPoll(post types.Post, viewerId int64) (*pb_aap.Poll, error) {
+	exist, poll, err := a.findPoll(post.ID)
+	if err != nil || !exist {
+		return nil, err
+	}
+
+	var myOptionIds []int64
+	if viewerId > 0 {
+		err := a.db.Model(&types.PollVote{}).
+			Where("post_id = ? AND user_id = ?", post.ID, viewerId).
+			Pluck("option_id", &myOptionIds).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	var votersCount int64
+	if err := a.db.Model(&types.PollBallot{}).Where("post_id = ?", post.ID).Count(&votersCount).Error; err != nil {
+		return nil, err
+	}
+
+	closed := !time.Now().Before(poll.ExpiresAt)
+	resultsVisible := closed || len(myOptionIds) > 0 || (viewerId > 0 && viewerId == post.UserID)
+
+	votesCount := make(map[int64]int64)
+	voterIds := make(map[int64][]int64)
+	if resultsVisible {
+		var tallies []struct {
+			OptionID   int64
+			VotesCount int64
+		}
+		err := a.db.Model(&types.PollVote{}).
+			Select("option_id, COUNT(*) AS votes_count").
+			Where("post_id = ?", post.ID).
+			Group("option_id").
+			Scan(&tallies).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, tally := range tallies {
+			votesCount[tally.OptionID] = tally.VotesCount
+		}
+
+		if !poll.Anonymous {
+			var votes []types.PollVote
+			if err := a.db.Where("post_id = ?", post.ID).Order("user_id").Find(&votes).Error; err != nil {
+				return nil, err
+			}
+			for _, vote := range votes {
+				voterIds[vote.OptionID] = append(voterIds[vote.OptionID], vote.UserID)
+			}
+		}
+	}
+
+	options := make([]*pb_aap.PollOption, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		options = append(options, &pb_aap.PollOption{
+			OptionId:   option.ID,
+			Text:       option.Text,
+			VotesCount: votesCount[option.ID],
+			VoterIds:   voterIds[option.ID],
+		})
+	}
+
+	return &pb_aap.Poll{
+		Options:        options,
+		ExpiresAt:      timestamppb.New(poll.ExpiresAt),
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+		Closed:         closed,
+		VotersCount:    votersCount,
+		ResultsVisible: resultsVisible,
+		MyOptionIds:    myOptionIds,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) VotePoll(ctx context.Context, info *pb_aap.VotePollRequest) (*pb_aap.VotePollResponse, error) {
+	a.logger.Debug("start voting on poll", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end voting on poll")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || !a.canViewPost(user.ID, post) || post.PublishAt != nil {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POST_NOT_FOUND}, nil
+	}
+	exist, poll, err := a.findPoll(post.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_NOT_FOUND}, nil
+	}
+
+	// Check the chosen options
+	pollOptions := make(map[int64]bool, len(poll.Options))
+	for _, option := range poll.Options {
+		pollOptions[option.ID] = true
+	}
+	chosen := make(map[int64]bool)
+	for _, optionId := range info.GetOptionIds() {
+		if !pollOptions[optionId] || chosen[optionId] {
+			return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_INVALID_OPTIONS}, nil
+		}
+		chosen[optionId] = true
+	}
+	if len(chosen) == 0 || (!poll.MultipleChoice && len(chosen) > 1) {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_INVALID_OPTIONS}, nil
+	}
+
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		// Upserting the ballot locks it until the transaction ends, so concurrent votes of the
+		// same user are applied one after the other. The expiry is checked against the database clock.
+		result := tx.Exec(
+			`INSERT INTO poll_ballots (post_id, user_id, created_at, updated_at)
+			SELECT post_id, ?, NOW(), NOW() FROM polls WHERE post_id = ? AND expires_at > NOW()
+			ON CONFLICT (post_id, user_id) DO UPDATE SET updated_at = NOW()`,
+			user.ID, post.ID,
+		)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errPollClosed
+		}
+
+		if err := tx.Where("post_id = ? AND user_id = ?", post.ID, user.ID).Delete(&types.PollVote{}).Error; err != nil {
+			return err
+		}
+		votes := make([]types.PollVote, 0, len(chosen))
+		for _, optionId := range info.GetOptionIds() {
+			votes = append(votes, types.PollVote{PostID: post.ID, UserID: user.ID, OptionID: optionId})
+		}
+		return tx.Create(&votes).Error
+	})
+	if errors.Is(err, errPollClosed) {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_CLOSED}, nil
+	} else if err != nil {
+		a.logger.Error("Error voting on poll", zap.Error(err))
+		return nil, err
+	}
+
+	result, err := a.getPoll(post, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.VotePollResponse{
+		Status: pb_aap.VotePollResponse_OK,
+		Poll:   result,
+	}, nil
+}
@@ -0,0 +1,283 @@
+package authpost
+
+import (
+	"context"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxPostsByIds bounds the number of posts loaded by a single GetPostsByIds call
+const maxPostsByIds = 100
+
+// postCount is a per-post count read with a GROUP BY
+type postCount struct {
+	PostID int64
+	Count  int64
+}
+
+// countByPost runs a count query grouped by post and returns the counts by post id
+func (a *AuthenticateAndPostService) countByPost(model interface{}, postColumn string, joins string, where string, args ...interface{}) (map[int64]int64, error) {
+	var rows []postCount
+	query := a.db.Model(model).Select(postColumn + " AS post_id, COUNT(*) AS count")
+	if joins != "" {
+		query = query.Joins(joins)
+	}
+	err := query.Where(where, args...).Group(postColumn).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+// getPostMentionSpans returns the mention spans of the text of several posts, by post id
+func (a *AuthenticateAndPostService) getPostMentionSpans(postIds []int64) (map[int64][]*pb_aap.MentionSpan, error) {
+	var rows []struct {
+		PostID   int64
+		UserID   int64
+		UserName string
+		Start    int32
+		End      int32
+	}
+	err := a.db.Model(&types.Mention{}).
+		Select("mentions.post_id, mentions.user_id, users.user_name, "+
+			"mentions.mention_start AS start, mentions.mention_end AS end").
+		Joins("JOIN users ON users.id = mentions.user_id AND users.deleted_at IS NULL").
+		Where("mentions.post_id IN ? AND mentions.comment_id IS NULL", postIds).
+		Order("mentions.post_id, mentions.mention_start").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	spans := make(map[int64][]*pb_aap.MentionSpan)
+	for _, row := range rows {
+		spans[row.PostID] = append(spans[row.PostID], &pb_aap.MentionSpan{
+			UserId:   row.UserID,
+			UserName: row.UserName,
+			Start:    row.Start,
+			End:      row.End,
+		})
+	}
+	return spans, nil
+}
+
+// getPostPlaces returns the places posts are checked in at, by place id. A place that was merged
+// resolves to the place it was merged into, like findPlace.
+func (a *AuthenticateAndPostService) getPostPlaces(posts []types.Post) (map[int64]types.Place, error) {
+	var placeIds []int64
+	for _, post := range posts {
+		if post.PlaceID != nil {
+			placeIds = append(placeIds, *post.PlaceID)
+		}
+	}
+	places := make(map[int64]types.Place)
+	if len(placeIds) == 0 {
+		return places, nil
+	}
+
+	var rows []types.Place
+	if err := a.db.Unscoped().Where("id IN ?", placeIds).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	var mergedIds []int64
+	for _, place := range rows {
+		if place.DeletedAt.Valid && place.MergedIntoID != nil {
+			mergedIds = append(mergedIds, *place.MergedIntoID)
+		}
+	}
+	targets := make(map[int64]types.Place)
+	if len(mergedIds) > 0 {
+		// Merging repoints earlier merges, so the target is never a merged place itself
+		var merged []types.Place
+		if err := a.db.Where("id IN ?", mergedIds).Find(&merged).Error; err != nil {
+			return nil, err
+		}
+		for _, target := range merged {
+			targets[target.ID] = target
+		}
+	}
+
+	for _, place := range rows {
+		if !place.DeletedAt.Valid {
+			places[place.ID] = place
+		} else if place.MergedIntoID != nil {
+			if target, ok := targets[*place.MergedIntoID]; ok {
+				places[place.ID] = target
+			}
+		}
+	}
+	return places, nil
+}
+
+// userToPostAuthor converts a user into the author shown with their posts and stories
+func userToPostAuthor(user types.User) *pb_aap.PostAuthor {
+	return &pb_aap.PostAuthor{
+		UserId:         user.ID,
+		UserName:       user.UserName,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		ProfilePicture: user.ProfilePicture,
+	}
+}
+
+// getPostSummaries loads the posts the viewer may read among postIds, in the order of postIds.
+// The number of queries does not depend on the number of posts.
+func (a *AuthenticateAndPostService) getPostSummaries(postIds []int64, viewerId int64) ([]*pb_aap.PostSummary, error) {
+	if len(postIds) == 0 {
+		return []*pb_aap.PostSummary{}, nil
+	}
+
+	var posts []types.Post
+	err := a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(viewerId)).
+		Where("posts.id IN ?", postIds).
+		Preload("Media", orderedMedia).
+		Find(&posts).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 {
+		return []*pb_aap.PostSummary{}, nil
+	}
+
+	ids := make([]int64, 0, len(posts))
+	authorIds := make([]int64, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+		authorIds = append(authorIds, post.UserID)
+	}
+
+	var users []types.User
+	if err := a.db.Where("id IN ?", authorIds).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	authors := make(map[int64]types.User, len(users))
+	for _, user := range users {
+		authors[user.ID] = user
+	}
+
+	likeCounts, err := a.countByPost(&types.Like{}, "likes.post_id",
+		"JOIN users ON users.id = likes.user_id AND users.deleted_at IS NULL",
+		"likes.post_id IN ?", ids)
+	if err != nil {
+		return nil, err
+	}
+	commentCounts, err := a.countByPost(&types.Comment{}, "post_id", "", "post_id IN ?", ids)
+	if err != nil {
+		return nil, err
+	}
+	repostCounts, err := a.countByPost(&types.Post{}, "repost_of_id", "", "repost_of_id IN ?", ids)
+	if err != nil {
+		return nil, err
+	}
+	quoteCounts, err := a.countByPost(&types.Post{}, "quote_of_id", "", "quote_of_id IN ?", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	likedByMe := make(map[int64]int64)
+	repostedByMe := make(map[int64]int64)
+	bookmarkedByMe := make(map[int64]int64)
+	if viewerId > 0 {
+		likedByMe, err = a.countByPost(&types.Like{}, "post_id", "", "post_id IN ? AND user_id = ?", ids, viewerId)
+		if err != nil {
+			return nil, err
+		}
+		repostedByMe, err = a.countByPost(&types.Post{}, "repost_of_id", "", "repost_of_id IN ? AND user_id = ?", ids, viewerId)
+		if err != nil {
+			return nil, err
+		}
+		bookmarkedByMe, err = a.countByPost(&types.Bookmark{}, "post_id", "", "post_id IN ? AND user_id = ?", ids, viewerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mentions, err := a.getPostMentionSpans(ids)
+	if err != nil {
+		return nil, err
+	}
+	places, err := a.getPostPlaces(posts)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[int64]*pb_aap.PostSummary, len(posts))
+	for _, post := range posts {
+		summary := &pb_aap.PostSummary{
+			PostId:         post.ID,
+			UserId:         post.UserID,
+			ContentText:    post.ContentText,
+			Media:          mediaToProto(postMedia(post)),
+			Visibility:     visibilityToProto(post.Visibility),
+			CreatedAt:      timestamppb.New(post.CreatedAt),
+			Mentions:       mentions[post.ID],
+			Location:       postLocationToProto(post),
+			LikeCount:      likeCounts[post.ID],
+			CommentCount:   commentCounts[post.ID],
+			RepostCount:    repostCounts[post.ID],
+			QuoteCount:     quoteCounts[post.ID],
+			LikedByMe:      likedByMe[post.ID] > 0,
+			RepostedByMe:   repostedByMe[post.ID] > 0,
+			BookmarkedByMe: bookmarkedByMe[post.ID] > 0,
+		}
+		if author, ok := authors[post.UserID]; ok {
+			summary.Author = userToPostAuthor(author)
+		}
+		if post.EditedAt != nil {
+			summary.EditedAt = timestamppb.New(*post.EditedAt)
+		}
+		if post.PlaceID != nil {
+			if place, ok := places[*post.PlaceID]; ok {
+				summary.Place = placeToProto(place)
+			}
+		}
+		if post.TripID != nil {
+			summary.TripId = *post.TripID
+		}
+		if post.RepostOfID != nil {
+			summary.RepostOfPostId = *post.RepostOfID
+		}
+		if post.QuoteOfID != nil {
+			summary.QuoteOfPostId = *post.QuoteOfID
+		}
+		summaries[post.ID] = summary
+	}
+
+	result := make([]*pb_aap.PostSummary, 0, len(summaries))
+	for _, postId := range postIds {
+		if summary, ok := summaries[postId]; ok {
+			result = append(result, summary)
+			delete(summaries, postId)
+		}
+	}
+	return result, nil
+}
+
+func (a *AuthenticateAndPostService) GetPostsByIds(ctx context.Context, info *pb_aap.GetPostsByIdsRequest) (*pb_aap.GetPostsByIdsResponse, error) {
+	a.logger.Debug("start getting posts by ids", zap.Int("count", len(info.GetPostIds())))
+	defer a.logger.Debug("end getting posts by ids")
+
+	if len(info.GetPostIds()) > maxPostsByIds {
+		return &pb_aap.GetPostsByIdsResponse{Status: pb_aap.GetPostsByIdsResponse_TOO_MANY_POSTS}, nil
+	}
+
+	posts, err := a.getPostSummaries(info.GetPostIds(), info.GetViewerId())
+	if err != nil {
+		a.logger.Error("Error getting posts by ids", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.GetPostsByIdsResponse{
+		Status: pb_aap.GetPostsByIdsResponse_OK,
+		Posts:  posts,
+	}, nil
+}
@@ -2,15 +2,15 @@ package authpost
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"strings"
-
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
 	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
 	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func (a *AuthenticateAndPostService) CreatePost(ctx context.Context, info *pb_aap.CreatePostRequest) (*pb_aap.CreatePostResponse, error) {
@@ -22,51 +22,150 @@ func (a *AuthenticateAndPostService) CreatePost(ctx context.Context, info *pb_aa
 		return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_USER_NOT_FOUND}, nil
 	}
 
-	// Process image paths
-	var contentImagePath string
-	if len(info.GetContentImagePath()) > 0 {
-		// For consistency with the database schema, we're joining multiple paths
-		// with a space separator. When retrieving, we'll split this string back to a slice.
-		contentImagePath = strings.Join(info.GetContentImagePath(), " ")
-		a.logger.Debug("Using image paths", zap.String("paths", contentImagePath))
+	newPost, status := a.newPost(info)
+	if status != pb_aap.CreatePostResponse_OK {
+		return &pb_aap.CreatePostResponse{Status: status}, nil
+	}
+	var poll *types.Poll
+	if info.Poll != nil {
+		// The poll of a scheduled post only opens once the post is published
+		opensAt := time.Now()
+		if newPost.PublishAt != nil {
+			opensAt = *newPost.PublishAt
+		}
+		pending, ok := newPoll(info.GetPoll(), opensAt)
+		if !ok {
+			return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_INVALID_POLL}, nil
+		}
+		poll = &pending
 	}
 
-	newPost := types.Post{
-		UserID:           info.GetUserId(),
-		ContentText:      info.GetContentText(),
-		ContentImagePath: contentImagePath,
+	var mentioned []int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		mentioned, err = insertPost(tx, &newPost)
+		if err != nil || poll == nil {
+			return err
+		}
+		poll.PostID = newPost.ID
+		return tx.Create(poll).Error
+	})
+	if err != nil {
+		a.logger.Error("Error creating post", zap.Error(err))
+		return nil, err
 	}
 
-	// Handle visibility - if not visible, set DeletedAt to current time
-	if !info.GetVisible() {
-		now := time.Now()
-		newPost.DeletedAt = &now
+	a.announcePost(ctx, newPost, mentioned)
+
+	responsePostId := int64(newPost.ID)
+
+	return &pb_aap.CreatePostResponse{
+		Status: pb_aap.CreatePostResponse_OK,
+		PostId: responsePostId,
+	}, nil
+}
+
+// newPost builds the post described by a create request, the status tells why the request is invalid
+func (a *AuthenticateAndPostService) newPost(info *pb_aap.CreatePostRequest) (types.Post, pb_aap.CreatePostResponse_CreatePostStatus) {
+	media, ok := a.newMedia(info.GetMedia())
+	if !ok {
+		return types.Post{}, pb_aap.CreatePostResponse_INVALID_MEDIA
+	}
+	if info.Location != nil && !validLocation(info.GetLocation()) {
+		return types.Post{}, pb_aap.CreatePostResponse_INVALID_LOCATION
 	}
 
-	result := a.db.Create(&newPost)
-	if result.Error != nil {
-		a.logger.Error("Error creating post", zap.Error(result.Error))
-		return nil, result.Error
+	// Resolve visibility, falling back to the deprecated visible flag for older clients
+	visible := info.GetVisible()
+	visibility, _ := requestedVisibility(info.Visibility, &visible)
+
+	newPost := types.Post{
+		UserID:      info.GetUserId(),
+		ContentText: info.GetContentText(),
+		Visibility:  visibility,
+	}
+	setPostMedia(&newPost, media)
+	setPostLocation(&newPost, info.GetLocation())
+	if info.GetPlaceId() != 0 {
+		exist, place := a.findPlace(info.GetPlaceId())
+		if !exist {
+			return types.Post{}, pb_aap.CreatePostResponse_PLACE_NOT_FOUND
+		}
+		newPost.PlaceID = &place.ID
+		if info.Location == nil {
+			setPostLocation(&newPost, placeLocation(place))
+		}
+	}
+	if info.GetTripId() != 0 {
+		// Posts can only be added to the trips their author owns or is a member of
+		exist, trip := a.findTrip(info.GetTripId())
+		if !exist || a.tripRole(newPost.UserID, trip) == "" {
+			return types.Post{}, pb_aap.CreatePostResponse_TRIP_NOT_FOUND
+		}
+		newPost.TripID = &trip.ID
+	}
+	if info.GetQuoteOfPostId() != 0 {
+		exist, quoted, allowed := a.findSharedPost(newPost.UserID, info.GetQuoteOfPostId())
+		if !exist || !allowed {
+			return types.Post{}, pb_aap.CreatePostResponse_QUOTED_POST_NOT_FOUND
+		}
+		newPost.QuoteOfID = &quoted.ID
 	}
+	if info.PublishAt != nil {
+		publishAt := info.GetPublishAt().AsTime()
+		if !validPublishAt(publishAt) {
+			return types.Post{}, pb_aap.CreatePostResponse_INVALID_PUBLISH_AT
+		}
+		newPost.PublishAt = &publishAt
+	}
+
+	return newPost, pb_aap.CreatePostResponse_OK
+}
+
+// insertPost stores a new post with its media, hashtags, links, travel visit, mentions and first revision.
+// It returns the users mentioned in the post.
+func insertPost(tx *gorm.DB, post *types.Post) ([]int64, error) {
+	if err := tx.Omit("Media").Create(post).Error; err != nil {
+		return nil, err
+	}
+	if err := syncPostMedia(tx, post); err != nil {
+		return nil, err
+	}
+	if err := syncPostHashtags(tx, post); err != nil {
+		return nil, err
+	}
+	if err := syncPostLinks(tx, post); err != nil {
+		return nil, err
+	}
+	if err := syncTravelVisit(tx, post); err != nil {
+		return nil, err
+	}
+	mentioned, err := syncMentions(tx, post.ID, nil, post.UserID, post.ContentText)
+	if err != nil {
+		return nil, err
+	}
+	// The initial content is the first revision of the post
+	return mentioned, createPostRevision(tx, post)
+}
 
-	// Send user_id and post_id to NewsfeedPublishingClient to announce to followers
-	if a.nfPubClient != nil {
+// announcePost sends a new post to the newsfeeds of the author's followers, notifies the mentioned users
+// and fetches the previews of its links
+func (a *AuthenticateAndPostService) announcePost(ctx context.Context, post types.Post, mentioned []int64) {
+	// Send user_id and post_id to NewsfeedPublishingClient to announce to followers.
+	// Private posts are never fanned out since no follower is allowed to read them,
+	// scheduled posts are announced by the post scheduler once they are published.
+	if a.nfPubClient != nil && post.Visibility != types.PostVisibilityPrivate && post.PublishAt == nil {
 		_, err := a.nfPubClient.PublishPost(ctx, &pb_nfp.PublishPostRequest{
-			UserId: newPost.UserID,
-			PostId: int64(newPost.ID),
+			UserId: post.UserID,
+			PostId: post.ID,
 		})
 		if err != nil {
-			a.logger.Error("Error publishing post to newsfeed", zap.Error(err))
+			a.logger.Error("Error publishing post to newsfeed", zap.Error(err), zap.Int64("post_id", post.ID))
 			// Continue anyway, as the post is created - async event can be retried
 		}
 	}
-
-	responsePostId := int64(newPost.ID)
-
-	return &pb_aap.CreatePostResponse{
-		Status: pb_aap.CreatePostResponse_OK,
-		PostId: responsePostId,
-	}, nil
+	a.publishMentions(ctx, post, 0, post.UserID, mentioned)
+	a.queueLinkPreviews(post)
 }
 
 func (a *AuthenticateAndPostService) EditPost(ctx context.Context, info *pb_aap.EditPostRequest) (*pb_aap.EditPostResponse, error) {
@@ -94,32 +193,83 @@ func (a *AuthenticateAndPostService) EditPost(ctx context.Context, info *pb_aap.
 			zap.Int64("post_id", info.GetPostId()))
 		return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_NOT_ALLOWED}, nil
 	}
+	// Reposts have no content of their own to edit
+	if post.RepostOfID != nil {
+		return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_NOT_ALLOWED}, nil
+	}
+
+	if err := a.loadPostMedia(&post); err != nil {
+		return nil, err
+	}
 
 	// Apply updates
+	original := post
 	if info.ContentText != nil {
 		post.ContentText = info.GetContentText()
 		a.logger.Debug("updating post content text", zap.String("new_content", post.ContentText))
 	}
-	if info.ContentImagePath != nil {
-		post.ContentImagePath = info.GetContentImagePath()
-		a.logger.Debug("updating post image path", zap.String("new_path", post.ContentImagePath))
-	}
-	if info.Visible != nil {
-		if info.GetVisible() {
-			post.DeletedAt = nil // Not deleted - visible
-			a.logger.Debug("making post visible")
-		} else {
-			now := time.Now()
-			post.DeletedAt = &now // Deleted - not visible
-			a.logger.Debug("making post invisible")
+	if info.Media != nil {
+		media, ok := a.newMedia(info.GetMedia().GetMedia())
+		if !ok {
+			return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_INVALID_MEDIA}, nil
+		}
+		setPostMedia(&post, media)
+		a.logger.Debug("updating post media", zap.Int("count", len(media)))
+	}
+	if visibility, ok := requestedVisibility(info.Visibility, info.Visible); ok {
+		post.Visibility = visibility
+		a.logger.Debug("updating post visibility", zap.String("visibility", post.Visibility))
+	}
+	if info.GetRemoveLocation() {
+		setPostLocation(&post, nil)
+	} else if info.Location != nil {
+		if !validLocation(info.GetLocation()) {
+			return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_INVALID_LOCATION}, nil
+		}
+		setPostLocation(&post, info.GetLocation())
+	}
+	if info.GetRemovePlace() {
+		post.PlaceID = nil
+	} else if info.PlaceId != nil {
+		exist, place := a.findPlace(info.GetPlaceId())
+		if !exist {
+			return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_PLACE_NOT_FOUND}, nil
+		}
+		post.PlaceID = &place.ID
+		if info.Location == nil && !info.GetRemoveLocation() {
+			setPostLocation(&post, placeLocation(place))
+		}
+	}
+	if info.GetRemoveTrip() {
+		post.TripID = nil
+	} else if info.TripId != nil {
+		exist, trip := a.findTrip(info.GetTripId())
+		if !exist || a.tripRole(post.UserID, trip) == "" {
+			return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_TRIP_NOT_FOUND}, nil
 		}
+		post.TripID = &trip.ID
 	}
 
-	err := a.db.Save(&post).Error
-	if err != nil {
+	// Edits that change nothing do not add a revision
+	if post.ContentText == original.ContentText &&
+		samePostMedia(post, original) &&
+		post.Visibility == original.Visibility &&
+		sameLocation(post, original) &&
+		samePlace(post, original) &&
+		sameTrip(post, original) {
+		return &pb_aap.EditPostResponse{
+			Status: pb_aap.EditPostResponse_OK,
+		}, nil
+	}
+
+	mentioned, err := a.savePostRevision(ctx, &post)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb_aap.EditPostResponse{Status: pb_aap.EditPostResponse_POST_NOT_FOUND}, nil
+	} else if err != nil {
 		a.logger.Error("error saving edited post", zap.Error(err), zap.Int64("post_id", info.GetPostId()))
 		return nil, err
 	}
+	a.publishMentions(ctx, post, 0, post.UserID, mentioned)
 
 	a.logger.Info("post edited successfully", zap.Int64("post_id", info.GetPostId()), zap.Int64("user_id", info.GetUserId()))
 	return &pb_aap.EditPostResponse{
@@ -144,60 +294,29 @@ func (a *AuthenticateAndPostService) DeletePost(ctx context.Context, info *pb_aa
 		return &pb_aap.DeletePostResponse{Status: pb_aap.DeletePostResponse_NOT_ALLOWED}, nil
 	}
 
-	// Start a transaction to ensure data consistency
-	tx := a.db.Begin()
-	if tx.Error != nil {
-		a.logger.Error("Error starting transaction for post deletion", zap.Error(tx.Error))
-		return nil, tx.Error
-	}
-
-	a.logger.Debug("Starting cascading delete for post", zap.Int64("post_id", info.GetPostId()))
-
-	// First delete all comments for this post
-	commentsResult := tx.Where("post_id = ?", info.GetPostId()).Delete(&types.Comment{})
-	if commentsResult.Error != nil {
-		tx.Rollback()
-		a.logger.Error("Error deleting comments for post", zap.Error(commentsResult.Error))
-		return nil, commentsResult.Error
-	}
-	a.logger.Debug("Deleted comments", zap.Int64("count", commentsResult.RowsAffected))
-
-	// Then delete all likes for this post
-	likesResult := tx.Where("post_id = ?", info.GetPostId()).Delete(&types.Like{})
-	if likesResult.Error != nil {
-		tx.Rollback()
-		a.logger.Error("Error deleting likes for post", zap.Error(likesResult.Error))
-		return nil, likesResult.Error
-	}
-	a.logger.Debug("Deleted likes", zap.Int64("count", likesResult.RowsAffected))
-
-	// Finally delete the post itself using the transaction
-	postResult := tx.Where("id = ?", info.GetPostId()).Delete(&types.Post{})
-	if postResult.Error != nil {
-		tx.Rollback()
-		a.logger.Error("Error deleting post", zap.Error(postResult.Error))
-		return nil, postResult.Error
-	}
-	a.logger.Debug("Deleted post", zap.Int64("count", postResult.RowsAffected))
-
-	// Commit the transaction
-	err := tx.Commit().Error
+	// Move the post to the trash. Comments and likes are kept so a restore brings
+	// them back; they are removed together with the post when the trash is purged.
+	// The post leaves the travel map of its author until it is restored, and loses its pin.
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := unpinPost(tx, post.ID); err != nil {
+			return err
+		}
+		if err := tx.Delete(&post).Error; err != nil {
+			return err
+		}
+		return removeTravelVisit(tx, post.ID)
+	})
 	if err != nil {
-		a.logger.Error("Error committing post deletion transaction", zap.Error(err))
+		a.logger.Error("Error moving post to trash", zap.Error(err))
 		return nil, err
 	}
 
-	a.logger.Info("Post deleted successfully", zap.Int64("post_id", info.GetPostId()))
-
-	// Note: In a real implementation, we would need to:
-	// 1. Add a dependency on the newsfeed service client
-	// 2. Call a method to invalidate the cache for this post
-	// Something like: a.newsfeedClient.RemovePostFromNewsfeed(ctx, post.ID)
-	// But for now we'll just log that we would do this
-	a.logger.Info("Post deleted, should invalidate newsfeed cache",
+	a.logger.Info("Post moved to trash",
 		zap.Int64("post_id", int64(post.ID)),
 		zap.Int64("user_id", post.UserID))
 
+	a.unpublishRepost(ctx, post)
+
 	return &pb_aap.DeletePostResponse{
 		Status: pb_aap.DeletePostResponse_OK,
 	}, nil
@@ -207,17 +326,29 @@ func (a *AuthenticateAndPostService) GetPostDetailInfo(ctx context.Context, info
 	a.logger.Debug("start getting post")
 	defer a.logger.Debug("end getting post")
 
-	exist, _ := a.findPostById(info.GetPostId())
+	exist, post := a.findPostById(info.GetPostId())
 	if !exist {
 		return &pb_aap.GetPostDetailInfoResponse{Status: pb_aap.GetPostDetailInfoResponse_POST_NOT_FOUND}, nil
 	}
 
-	var post types.Post
-	result := a.db.Preload("Comments").Preload("LikedUsers").First(&post, info.GetPostId())
+	// Posts the viewer may not read are reported as missing so their existence is not leaked
+	if !a.canViewPost(info.GetViewerId(), post) {
+		a.logger.Debug("viewer not allowed to read post",
+			zap.Int64("viewer_id", info.GetViewerId()),
+			zap.Int64("post_id", info.GetPostId()))
+		return &pb_aap.GetPostDetailInfoResponse{Status: pb_aap.GetPostDetailInfoResponse_POST_NOT_FOUND}, nil
+	}
+
+	result := a.db.Preload("Comments").Preload("LikedUsers").Preload("Media", orderedMedia).First(&post, info.GetPostId())
 	if result.Error != nil {
 		return nil, result.Error
 	}
 
+	postMentions, commentMentions, err := a.getMentionSpans(post.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	var comments []*pb_aap.Comment
 	for i := range post.Comments {
 		comments = append(comments, &pb_aap.Comment{
@@ -225,27 +356,92 @@ func (a *AuthenticateAndPostService) GetPostDetailInfo(ctx context.Context, info
 			UserId:      post.Comments[i].UserID,
 			ContentText: post.Comments[i].ContentText,
 			PostId:      int64(post.ID),
+			Mentions:    commentMentions[post.Comments[i].ID],
 		})
 	}
 
 	var likedUsers []*pb_aap.Like
+	likedByMe := false
 	for i := range post.LikedUsers {
 		likedUsers = append(likedUsers, &pb_aap.Like{
 			UserId: int64(post.LikedUsers[i].ID),
 			PostId: int64(post.ID),
 		})
+		if info.GetViewerId() > 0 && int64(post.LikedUsers[i].ID) == info.GetViewerId() {
+			likedByMe = true
+		}
+	}
+
+	var editedAt *timestamppb.Timestamp
+	if post.EditedAt != nil {
+		editedAt = timestamppb.New(*post.EditedAt)
+	}
+
+	var place *pb_aap.Place
+	if post.PlaceID != nil {
+		if exist, checkIn := a.findPlace(*post.PlaceID); exist {
+			place = placeToProto(checkIn)
+		}
+	}
+
+	var tripId int64
+	if post.TripID != nil {
+		tripId = *post.TripID
+	}
+
+	var repostOfPostId, quoteOfPostId int64
+	if post.RepostOfID != nil {
+		repostOfPostId = *post.RepostOfID
+	}
+	if post.QuoteOfID != nil {
+		quoteOfPostId = *post.QuoteOfID
+	}
+	repostCount, quoteCount, err := a.getShareCounts(post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishAt *timestamppb.Timestamp
+	if post.PublishAt != nil {
+		publishAt = timestamppb.New(*post.PublishAt)
+	}
+
+	poll, err := a.getPoll(post, info.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
+	linkPreviews, err := a.getLinkPreviews(post.ID)
+	if err != nil {
+		return nil, err
 	}
 
 	return &pb_aap.GetPostDetailInfoResponse{
 		Status: pb_aap.GetPostDetailInfoResponse_OK,
 		Post: &pb_aap.PostDetailInfo{
-			PostId:           int64(post.ID),
-			UserId:           post.UserID,
-			ContentText:      post.ContentText,
-			ContentImagePath: strings.Split(post.ContentImagePath, " "),
-			CreatedAt:        timestamppb.New(post.CreatedAt),
-			Comments:         comments,
-			LikedUsers:       likedUsers,
+			PostId:         int64(post.ID),
+			UserId:         post.UserID,
+			ContentText:    post.ContentText,
+			Media:          mediaToProto(postMedia(post)),
+			Visible:        post.Visibility == types.PostVisibilityPublic,
+			Visibility:     visibilityToProto(post.Visibility),
+			CreatedAt:      timestamppb.New(post.CreatedAt),
+			Comments:       comments,
+			LikedUsers:     likedUsers,
+			LikedByMe:      likedByMe,
+			EditedAt:       editedAt,
+			Mentions:       postMentions,
+			Location:       postLocationToProto(post),
+			Place:          place,
+			TripId:         tripId,
+			RepostOfPostId: repostOfPostId,
+			QuoteOfPostId:  quoteOfPostId,
+			RepostCount:    repostCount,
+			QuoteCount:     quoteCount,
+			RepostedByMe:   a.hasReposted(info.GetViewerId(), post.ID),
+			BookmarkedByMe: a.hasBookmarked(info.GetViewerId(), post.ID),
+			PublishAt:      publishAt,
+			Poll:           poll,
+			LinkPreviews:   linkPreviews,
 		},
 	}, nil
 }
@@ -258,8 +454,8 @@ func (a *AuthenticateAndPostService) CommentPost(ctx context.Context, info *pb_a
 	if !exist {
 		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_USER_NOT_FOUND}, nil
 	}
-	exist, _ = a.findPostById(info.GetPostId())
-	if !exist {
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || !a.canViewPost(info.GetUserId(), post) {
 		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_POST_NOT_FOUND}, nil
 	}
 
@@ -268,10 +464,19 @@ func (a *AuthenticateAndPostService) CommentPost(ctx context.Context, info *pb_a
 		UserID:      info.GetUserId(),
 		ContentText: info.GetContentText(),
 	}
-	err := a.db.Create(&newComment).Error
+	var mentioned []int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newComment).Error; err != nil {
+			return err
+		}
+		var err error
+		mentioned, err = syncMentions(tx, post.ID, &newComment.ID, newComment.UserID, newComment.ContentText)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	a.publishMentions(ctx, post, newComment.ID, newComment.UserID, mentioned)
 
 	return &pb_aap.CommentPostResponse{
 		Status:    pb_aap.CommentPostResponse_OK,
@@ -287,12 +492,11 @@ func (a *AuthenticateAndPostService) LikePost(ctx context.Context, info *pb_aap.
 	if !exist {
 		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_USER_NOT_FOUND}, nil
 	}
-	exist, _ = a.findPostById(info.GetPostId())
-	if !exist {
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || !a.canViewPost(info.GetUserId(), post) {
 		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_POST_NOT_FOUND}, nil
 	}
 
-	var post types.Post
 	err := a.db.Preload("LikedUsers").First(&post, info.GetPostId()).Error
 	if err != nil {
 		return nil, err
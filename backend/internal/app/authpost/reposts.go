@@ -0,0 +1,169 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// findSharedPost resolves the post a user wants to repost or quote, a repost stands for its original post.
+// allowed is false when the user may not read the post or the post is not public yet.
+func (a *AuthenticateAndPostService) findSharedPost(userId int64, postId int64) (exist bool, post types.Post, allowed bool) {
+	exist, post = a.findPostById(postId)
+	if exist && post.RepostOfID != nil {
+		exist, post = a.findPostById(*post.RepostOfID)
+	}
+	if !exist || !a.canViewPost(userId, post) {
+		return false, types.Post{}, false
+	}
+	return true, post, post.Visibility == types.PostVisibilityPublic && post.PublishAt == nil
+}
+
+// getShareCounts counts the live reposts and quotes of a post
+func (a *AuthenticateAndPostService) getShareCounts(postId int64) (reposts int64, quotes int64, err error) {
+	if err := a.db.Model(&types.Post{}).Where("repost_of_id = ?", postId).Count(&reposts).Error; err != nil {
+		return 0, 0, err
+	}
+	if err := a.db.Model(&types.Post{}).Where("quote_of_id = ?", postId).Count(&quotes).Error; err != nil {
+		return 0, 0, err
+	}
+	return reposts, quotes, nil
+}
+
+// hasReposted checks if the user has a live repost of the post
+func (a *AuthenticateAndPostService) hasReposted(userId int64, postId int64) bool {
+	if userId <= 0 {
+		return false
+	}
+
+	var count int64
+	err := a.db.Model(&types.Post{}).
+		Where("repost_of_id = ? AND user_id = ?", postId, userId).
+		Count(&count).Error
+	return err == nil && count > 0
+}
+
+// publishRepost announces a repost to the followers of the reposter
+func (a *AuthenticateAndPostService) publishRepost(ctx context.Context, repost types.Post) {
+	if a.nfPubClient == nil || repost.RepostOfID == nil {
+		return
+	}
+
+	_, err := a.nfPubClient.PublishRepost(ctx, &pb_nfp.PublishRepostRequest{
+		UserId:         repost.UserID,
+		RepostId:       repost.ID,
+		OriginalPostId: *repost.RepostOfID,
+	})
+	if err != nil {
+		a.logger.Error("Error publishing repost to newsfeed", zap.Error(err))
+	}
+}
+
+// unpublishRepost takes a trashed repost back from the followers of the reposter, so the post
+// can be reposted to them again
+func (a *AuthenticateAndPostService) unpublishRepost(ctx context.Context, repost types.Post) {
+	if a.nfPubClient == nil || repost.RepostOfID == nil {
+		return
+	}
+
+	_, err := a.nfPubClient.UnpublishRepost(ctx, &pb_nfp.UnpublishRepostRequest{
+		UserId:         repost.UserID,
+		RepostId:       repost.ID,
+		OriginalPostId: *repost.RepostOfID,
+	})
+	if err != nil {
+		a.logger.Error("Error unpublishing repost from newsfeed", zap.Error(err))
+	}
+}
+
+func (a *AuthenticateAndPostService) RepostPost(ctx context.Context, info *pb_aap.RepostPostRequest) (*pb_aap.RepostPostResponse, error) {
+	a.logger.Debug("start reposting post", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end reposting post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RepostPostResponse{Status: pb_aap.RepostPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, original, allowed := a.findSharedPost(user.ID, info.GetPostId())
+	if !exist {
+		return &pb_aap.RepostPostResponse{Status: pb_aap.RepostPostResponse_POST_NOT_FOUND}, nil
+	}
+	if !allowed {
+		return &pb_aap.RepostPostResponse{Status: pb_aap.RepostPostResponse_NOT_ALLOWED}, nil
+	}
+
+	var existing types.Post
+	err := a.db.Where("repost_of_id = ? AND user_id = ?", original.ID, user.ID).First(&existing).Error
+	if err == nil {
+		return &pb_aap.RepostPostResponse{
+			Status:   pb_aap.RepostPostResponse_ALREADY_REPOSTED,
+			RepostId: existing.ID,
+		}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// A repost is a post of the reposter without content, it is as public as the post it shares
+	repost := types.Post{
+		UserID:     user.ID,
+		Visibility: types.PostVisibilityPublic,
+		RepostOfID: &original.ID,
+	}
+	err = a.db.Create(&repost).Error
+	if isUniqueViolation(err) {
+		// A concurrent request of the user reposted the post first
+		if err := a.db.Where("repost_of_id = ? AND user_id = ?", original.ID, user.ID).First(&existing).Error; err != nil {
+			return nil, err
+		}
+		return &pb_aap.RepostPostResponse{
+			Status:   pb_aap.RepostPostResponse_ALREADY_REPOSTED,
+			RepostId: existing.ID,
+		}, nil
+	} else if err != nil {
+		a.logger.Error("Error creating repost", zap.Error(err))
+		return nil, err
+	}
+
+	a.publishRepost(ctx, repost)
+
+	return &pb_aap.RepostPostResponse{
+		Status:   pb_aap.RepostPostResponse_OK,
+		RepostId: repost.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) UndoRepost(ctx context.Context, info *pb_aap.UndoRepostRequest) (*pb_aap.UndoRepostResponse, error) {
+	a.logger.Debug("start undoing repost", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end undoing repost")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UndoRepostResponse{Status: pb_aap.UndoRepostResponse_USER_NOT_FOUND}, nil
+	}
+
+	// post_id is the reposted post or the repost itself, the original may already be deleted
+	var repost types.Post
+	err := a.db.
+		Where("user_id = ? AND (repost_of_id = ? OR (id = ? AND repost_of_id IS NOT NULL))", user.ID, info.GetPostId(), info.GetPostId()).
+		First(&repost).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb_aap.UndoRepostResponse{Status: pb_aap.UndoRepostResponse_NOT_REPOSTED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Reposts go to the trash like any other post
+	if err := a.db.Delete(&repost).Error; err != nil {
+		a.logger.Error("Error deleting repost", zap.Error(err))
+		return nil, err
+	}
+
+	a.unpublishRepost(ctx, repost)
+
+	return &pb_aap.UndoRepostResponse{Status: pb_aap.UndoRepostResponse_OK}, nil
+}
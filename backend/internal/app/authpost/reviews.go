@@ -0,0 +1,442 @@
+package authpost
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// minReviewRating and maxReviewRating bound the stars of a review
+	minReviewRating = 1
+	maxReviewRating = 5
+	// maxReviewTextLength bounds the text of a review
+	maxReviewTextLength = 5000
+	// defaultPlaceReviewsLimit and maxPlaceReviewsLimit bound the page size of GetPlaceReviews
+	defaultPlaceReviewsLimit = 20
+	maxPlaceReviewsLimit     = 50
+)
+
+// reviewPosition is the position of a review in a sorted list, Key is the value of the sort column
+type reviewPosition struct {
+	Key int64
+	ID  int64
+}
+
+// encodeReviewCursor encodes the position after a review as an opaque cursor
+func encodeReviewCursor(position reviewPosition) string {
+	value := strconv.FormatInt(position.Key, 10) + ":" + strconv.FormatInt(position.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// decodeReviewCursor decodes a cursor made by encodeReviewCursor
+func decodeReviewCursor(cursor string) (reviewPosition, bool) {
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return reviewPosition{}, false
+	}
+	key, id, found := strings.Cut(string(value), ":")
+	if !found {
+		return reviewPosition{}, false
+	}
+	parsedKey, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return reviewPosition{}, false
+	}
+	parsedId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return reviewPosition{}, false
+	}
+	return reviewPosition{Key: parsedKey, ID: parsedId}, true
+}
+
+// validReview checks the rating and the text of a review
+func validReview(review types.PlaceReview) bool {
+	return review.Rating >= minReviewRating && review.Rating <= maxReviewRating &&
+		utf8.RuneCountInString(review.ContentText) <= maxReviewTextLength
+}
+
+// findReview checks if a review exists in database
+func (a *AuthenticateAndPostService) findReview(reviewId int64) (exist bool, review types.PlaceReview) {
+	result := a.db.First(&review, reviewId)
+	if result.Error != nil {
+		return false, types.PlaceReview{}
+	}
+	return true, review
+}
+
+// reviewToProto converts a review, votedHelpful tells whether the viewer voted it helpful
+func reviewToProto(review types.PlaceReview, votedHelpful bool) *pb_aap.PlaceReview {
+	result := &pb_aap.PlaceReview{
+		ReviewId:     review.ID,
+		PlaceId:      review.PlaceID,
+		UserId:       review.UserID,
+		Rating:       review.Rating,
+		ContentText:  review.ContentText,
+		HelpfulCount: review.HelpfulCount,
+		VotedHelpful: votedHelpful,
+		CreatedAt:    timestamppb.New(review.CreatedAt),
+	}
+	if review.EditedAt != nil {
+		result.EditedAt = timestamppb.New(*review.EditedAt)
+	}
+	return result
+}
+
+// getHelpfulVotes returns the reviews among reviewIds the viewer voted helpful
+func (a *AuthenticateAndPostService) getHelpfulVotes(viewerId int64, reviewIds []int64) (map[int64]bool, error) {
+	voted := make(map[int64]bool)
+	if viewerId <= 0 || len(reviewIds) == 0 {
+		return voted, nil
+	}
+
+	var votedIds []int64
+	err := a.db.Model(&types.ReviewVote{}).
+		Where("user_id = ? AND review_id IN ?", viewerId, reviewIds).
+		Pluck("review_id", &votedIds).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range votedIds {
+		voted[id] = true
+	}
+	return voted, nil
+}
+
+// getRatingSummary aggregates the ratings of a place
+func (a *AuthenticateAndPostService) getRatingSummary(placeId int64) (*pb_aap.RatingSummary, error) {
+	var rows []struct {
+		Rating int32
+		Count  int64
+	}
+	err := a.db.Model(&types.PlaceReview{}).
+		Select("rating, COUNT(*) AS count").
+		Where("place_id = ?", placeId).
+		Group("rating").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &pb_aap.RatingSummary{Counts: make([]int64, maxReviewRating)}
+	var total int64
+	for _, row := range rows {
+		if row.Rating < minReviewRating || row.Rating > maxReviewRating {
+			continue
+		}
+		summary.Counts[row.Rating-1] = row.Count
+		summary.Count += row.Count
+		total += int64(row.Rating) * row.Count
+	}
+	if summary.Count > 0 {
+		summary.Average = float64(total) / float64(summary.Count)
+	}
+	return summary, nil
+}
+
+func (a *AuthenticateAndPostService) CreatePlaceReview(ctx context.Context, info *pb_aap.CreatePlaceReviewRequest) (*pb_aap.CreatePlaceReviewResponse, error) {
+	a.logger.Debug("start creating place review", zap.Int64("place_id", info.GetPlaceId()))
+	defer a.logger.Debug("end creating place review")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreatePlaceReviewResponse{Status: pb_aap.CreatePlaceReviewResponse_USER_NOT_FOUND}, nil
+	}
+	exist, place := a.findPlace(info.GetPlaceId())
+	if !exist {
+		return &pb_aap.CreatePlaceReviewResponse{Status: pb_aap.CreatePlaceReviewResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	review := types.PlaceReview{
+		PlaceID:     place.ID,
+		UserID:      user.ID,
+		Rating:      info.GetRating(),
+		ContentText: strings.TrimSpace(info.GetContentText()),
+	}
+	if !validReview(review) {
+		return &pb_aap.CreatePlaceReviewResponse{Status: pb_aap.CreatePlaceReviewResponse_INVALID_REVIEW}, nil
+	}
+
+	var existing types.PlaceReview
+	err := a.db.Where("place_id = ? AND user_id = ?", place.ID, user.ID).First(&existing).Error
+	if err == nil {
+		return &pb_aap.CreatePlaceReviewResponse{
+			Status:   pb_aap.CreatePlaceReviewResponse_ALREADY_REVIEWED,
+			ReviewId: existing.ID,
+		}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	err = a.db.Create(&review).Error
+	if isUniqueViolation(err) {
+		// A concurrent request of the user reviewed the place first
+		if err := a.db.Where("place_id = ? AND user_id = ?", place.ID, user.ID).First(&existing).Error; err != nil {
+			return nil, err
+		}
+		return &pb_aap.CreatePlaceReviewResponse{
+			Status:   pb_aap.CreatePlaceReviewResponse_ALREADY_REVIEWED,
+			ReviewId: existing.ID,
+		}, nil
+	} else if err != nil {
+		a.logger.Error("Error creating place review", zap.Error(err))
+		return nil, err
+	}
+
+	// Reviews are public, so they are announced to every follower of the reviewer
+	if a.nfPubClient != nil {
+		_, err := a.nfPubClient.PublishReview(ctx, &pb_nfp.PublishReviewRequest{
+			UserId:   review.UserID,
+			ReviewId: review.ID,
+		})
+		if err != nil {
+			a.logger.Error("Error publishing review to newsfeed", zap.Error(err))
+		}
+	}
+
+	return &pb_aap.CreatePlaceReviewResponse{
+		Status:   pb_aap.CreatePlaceReviewResponse_OK,
+		ReviewId: review.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) EditPlaceReview(ctx context.Context, info *pb_aap.EditPlaceReviewRequest) (*pb_aap.EditPlaceReviewResponse, error) {
+	a.logger.Debug("start editing place review", zap.Int64("review_id", info.GetReviewId()))
+	defer a.logger.Debug("end editing place review")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_USER_NOT_FOUND}, nil
+	}
+	exist, review := a.findReview(info.GetReviewId())
+	if !exist {
+		return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_REVIEW_NOT_FOUND}, nil
+	}
+	if review.UserID != user.ID {
+		return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_NOT_ALLOWED}, nil
+	}
+
+	edited := review
+	if info.Rating != nil {
+		edited.Rating = info.GetRating()
+	}
+	if info.ContentText != nil {
+		edited.ContentText = strings.TrimSpace(info.GetContentText())
+	}
+	if !validReview(edited) {
+		return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_INVALID_REVIEW}, nil
+	}
+	// Saving an unchanged review would only mark it as edited
+	if edited.Rating == review.Rating && edited.ContentText == review.ContentText {
+		return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_OK}, nil
+	}
+
+	now := time.Now()
+	err := a.db.Model(&review).Updates(map[string]interface{}{
+		"rating":       edited.Rating,
+		"content_text": edited.ContentText,
+		"edited_at":    now,
+	}).Error
+	if err != nil {
+		a.logger.Error("Error editing place review", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.EditPlaceReviewResponse{Status: pb_aap.EditPlaceReviewResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) DeletePlaceReview(ctx context.Context, info *pb_aap.DeletePlaceReviewRequest) (*pb_aap.DeletePlaceReviewResponse, error) {
+	a.logger.Debug("start deleting place review", zap.Int64("review_id", info.GetReviewId()))
+	defer a.logger.Debug("end deleting place review")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeletePlaceReviewResponse{Status: pb_aap.DeletePlaceReviewResponse_USER_NOT_FOUND}, nil
+	}
+	exist, review := a.findReview(info.GetReviewId())
+	if !exist {
+		return &pb_aap.DeletePlaceReviewResponse{Status: pb_aap.DeletePlaceReviewResponse_REVIEW_NOT_FOUND}, nil
+	}
+	// Moderators can take down any review
+	if review.UserID != user.ID && !user.IsModerator {
+		return &pb_aap.DeletePlaceReviewResponse{Status: pb_aap.DeletePlaceReviewResponse_NOT_ALLOWED}, nil
+	}
+
+	if err := a.db.Delete(&review).Error; err != nil {
+		a.logger.Error("Error deleting place review", zap.Error(err))
+		return nil, err
+	}
+
+	a.logger.Info("Place review deleted",
+		zap.Int64("review_id", review.ID),
+		zap.Int64("user_id", user.ID),
+		zap.Bool("moderated", review.UserID != user.ID))
+
+	return &pb_aap.DeletePlaceReviewResponse{Status: pb_aap.DeletePlaceReviewResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetPlaceReview(ctx context.Context, info *pb_aap.GetPlaceReviewRequest) (*pb_aap.GetPlaceReviewResponse, error) {
+	a.logger.Debug("start getting place review", zap.Int64("review_id", info.GetReviewId()))
+	defer a.logger.Debug("end getting place review")
+
+	exist, review := a.findReview(info.GetReviewId())
+	if !exist {
+		return &pb_aap.GetPlaceReviewResponse{Status: pb_aap.GetPlaceReviewResponse_REVIEW_NOT_FOUND}, nil
+	}
+
+	voted, err := a.getHelpfulVotes(info.GetViewerId(), []int64{review.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.GetPlaceReviewResponse{
+		Status: pb_aap.GetPlaceReviewResponse_OK,
+		Review: reviewToProto(review, voted[review.ID]),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetPlaceReviews(ctx context.Context, info *pb_aap.GetPlaceReviewsRequest) (*pb_aap.GetPlaceReviewsResponse, error) {
+	a.logger.Debug("start getting place reviews", zap.Int64("place_id", info.GetPlaceId()))
+	defer a.logger.Debug("end getting place reviews")
+
+	exist, place := a.findPlace(info.GetPlaceId())
+	if !exist {
+		return &pb_aap.GetPlaceReviewsResponse{Status: pb_aap.GetPlaceReviewsResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	var after *reviewPosition
+	if info.GetCursor() != "" {
+		position, ok := decodeReviewCursor(info.GetCursor())
+		if !ok {
+			return &pb_aap.GetPlaceReviewsResponse{Status: pb_aap.GetPlaceReviewsResponse_INVALID_CURSOR}, nil
+		}
+		after = &position
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultPlaceReviewsLimit
+	} else if limit > maxPlaceReviewsLimit {
+		limit = maxPlaceReviewsLimit
+	}
+
+	// Every order ends with the newest review first, so the cursor is the (sort column, id) of the last review
+	query := a.db.Where("place_reviews.place_id = ?", place.ID)
+	column, descending := "place_reviews.id", true
+	switch info.GetSort() {
+	case pb_aap.GetPlaceReviewsRequest_MOST_HELPFUL:
+		column = "place_reviews.helpful_count"
+	case pb_aap.GetPlaceReviewsRequest_HIGHEST_RATED:
+		column = "place_reviews.rating"
+	case pb_aap.GetPlaceReviewsRequest_LOWEST_RATED:
+		column, descending = "place_reviews.rating", false
+	}
+	direction, comparison := "DESC", "<"
+	if !descending {
+		direction, comparison = "ASC", ">"
+	}
+	if after != nil {
+		query = query.Where(column+" "+comparison+" ? OR ("+column+" = ? AND place_reviews.id < ?)",
+			after.Key, after.Key, after.ID)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	var reviews []types.PlaceReview
+	err := query.Order(column + " " + direction + ", place_reviews.id DESC").Limit(limit + 1).Find(&reviews).Error
+	if err != nil {
+		a.logger.Error("Error getting place reviews", zap.Error(err))
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		last := reviews[limit-1]
+		position := reviewPosition{Key: last.ID, ID: last.ID}
+		switch info.GetSort() {
+		case pb_aap.GetPlaceReviewsRequest_MOST_HELPFUL:
+			position.Key = last.HelpfulCount
+		case pb_aap.GetPlaceReviewsRequest_HIGHEST_RATED, pb_aap.GetPlaceReviewsRequest_LOWEST_RATED:
+			position.Key = int64(last.Rating)
+		}
+		nextCursor = encodeReviewCursor(position)
+	}
+
+	reviewIds := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		reviewIds = append(reviewIds, review.ID)
+	}
+	voted, err := a.getHelpfulVotes(info.GetViewerId(), reviewIds)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := a.getRatingSummary(place.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.PlaceReview, 0, len(reviews))
+	for _, review := range reviews {
+		result = append(result, reviewToProto(review, voted[review.ID]))
+	}
+	return &pb_aap.GetPlaceReviewsResponse{
+		Status:     pb_aap.GetPlaceReviewsResponse_OK,
+		Reviews:    result,
+		NextCursor: nextCursor,
+		Summary:    summary,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) VoteReviewHelpful(ctx context.Context, info *pb_aap.VoteReviewHelpfulRequest) (*pb_aap.VoteReviewHelpfulResponse, error) {
+	a.logger.Debug("start voting on review", zap.Int64("review_id", info.GetReviewId()), zap.Bool("helpful", info.GetHelpful()))
+	defer a.logger.Debug("end voting on review")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.VoteReviewHelpfulResponse{Status: pb_aap.VoteReviewHelpfulResponse_USER_NOT_FOUND}, nil
+	}
+	exist, review := a.findReview(info.GetReviewId())
+	if !exist {
+		return &pb_aap.VoteReviewHelpfulResponse{Status: pb_aap.VoteReviewHelpfulResponse_REVIEW_NOT_FOUND}, nil
+	}
+	if review.UserID == user.ID {
+		return &pb_aap.VoteReviewHelpfulResponse{Status: pb_aap.VoteReviewHelpfulResponse_NOT_ALLOWED}, nil
+	}
+
+	// helpful_count only moves when a vote row is actually added or removed, so repeated votes are no-ops
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		var result *gorm.DB
+		delta := 1
+		if info.GetHelpful() {
+			result = tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&types.ReviewVote{ReviewID: review.ID, UserID: user.ID})
+		} else {
+			result = tx.Where("review_id = ? AND user_id = ?", review.ID, user.ID).Delete(&types.ReviewVote{})
+			delta = -1
+		}
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&types.PlaceReview{}).
+			Where("id = ?", review.ID).
+			UpdateColumn("helpful_count", gorm.Expr("helpful_count + ?", delta)).Error
+	})
+	if err != nil {
+		a.logger.Error("Error voting on review", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.VoteReviewHelpfulResponse{Status: pb_aap.VoteReviewHelpfulResponse_OK}, nil
+}
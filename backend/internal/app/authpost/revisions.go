@@ -0,0 +1,205 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (a *AuthenticateAndPostService) GetPostRevisions(ctx context.Context, info *pb_aap.GetPostRevisionsRequest) (*pb_aap.GetPostRevisionsResponse, error) {
+	a.logger.Debug("start getting post revisions")
+	defer a.logger.Debug("end getting post revisions")
+
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || !a.canViewPost(info.GetViewerId(), post) {
+		return &pb_aap.GetPostRevisionsResponse{Status: pb_aap.GetPostRevisionsResponse_POST_NOT_FOUND}, nil
+	}
+	// Earlier revisions may hold content the author chose to remove
+	if info.GetViewerId() != post.UserID {
+		return &pb_aap.GetPostRevisionsResponse{Status: pb_aap.GetPostRevisionsResponse_NOT_ALLOWED}, nil
+	}
+
+	var revisions []types.PostRevision
+	err := a.db.Preload("Media", orderedMedia).Where("post_id = ?", post.ID).Order("revision").Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.PostRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, &pb_aap.PostRevision{
+			Revision:    revision.Revision,
+			ContentText: revision.ContentText,
+			Visibility:  visibilityToProto(revision.Visibility),
+			CreatedAt:   timestamppb.New(revision.CreatedAt),
+			Media:       mediaToProto(revisionMedia(revision)),
+		})
+	}
+
+	return &pb_aap.GetPostRevisionsResponse{
+		Status:    pb_aap.GetPostRevisionsResponse_OK,
+		Revisions: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) RevertPost(ctx context.Context, info *pb_aap.RevertPostRequest) (*pb_aap.RevertPostResponse, error) {
+	a.logger.Debug("start reverting post")
+	defer a.logger.Debug("end reverting post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_POST_NOT_FOUND}, nil
+	}
+	if user.ID != post.UserID {
+		return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_NOT_ALLOWED}, nil
+	}
+
+	var revision types.PostRevision
+	result := a.db.Preload("Media", orderedMedia).Where("post_id = ? AND revision = ?", post.ID, info.GetRevision()).First(&revision)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_REVISION_NOT_FOUND}, nil
+	} else if result.Error != nil {
+		return nil, result.Error
+	}
+
+	post.ContentText = revision.ContentText
+	setPostMedia(&post, revisionMedia(revision))
+	post.Visibility = revision.Visibility
+	mentioned, err := a.savePostRevision(ctx, &post)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_POST_NOT_FOUND}, nil
+	} else if err != nil {
+		a.logger.Error("error saving reverted post", zap.Error(err), zap.Int64("post_id", post.ID))
+		return nil, err
+	}
+	a.publishMentions(ctx, post, 0, post.UserID, mentioned)
+
+	a.logger.Info("post reverted",
+		zap.Int64("post_id", post.ID),
+		zap.Int32("revision", revision.Revision))
+	return &pb_aap.RevertPostResponse{Status: pb_aap.RevertPostResponse_OK}, nil
+}
+
+// savePostRevision saves an edited post with its media, marks it as edited, refreshes its hashtags, links
+// and mentions and records its content as the next revision. A post made private is unpinned, a private
+// post made readable by followers is sent to their newsfeeds.
+// It returns the users newly mentioned by the edit, and gorm.ErrRecordNotFound when the post was
+// trashed in the meantime.
+func (a *AuthenticateAndPostService) savePostRevision(ctx context.Context, post *types.Post) ([]int64, error) {
+	var mentioned []int64
+	var wasPrivate bool
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the post so concurrent edits get consecutive revision numbers. The scheduler and the
+		// pin requests may have changed the post since it was read, their columns are taken from the
+		// locked row and only the columns of an edit are written.
+		var current types.Post
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "visibility", "publish_at", "pinned_at").First(&current, post.ID).Error
+		if err != nil {
+			return err
+		}
+		wasPrivate = current.Visibility == types.PostVisibilityPrivate
+		post.PublishAt = current.PublishAt
+		post.PinnedAt = current.PinnedAt
+
+		now := time.Now()
+		post.EditedAt = &now
+		err = tx.Model(&types.Post{}).Where("id = ?", post.ID).Updates(map[string]interface{}{
+			"content_text": post.ContentText,
+			"visibility":   post.Visibility,
+			"latitude":     post.Latitude,
+			"longitude":    post.Longitude,
+			"place_name":   post.PlaceName,
+			"geohash":      post.Geohash,
+			"place_id":     post.PlaceID,
+			"trip_id":      post.TripID,
+			"edited_at":    post.EditedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := syncPostMedia(tx, post); err != nil {
+			return err
+		}
+		// A post made private leaves the profile
+		if post.Visibility == types.PostVisibilityPrivate {
+			post.PinnedAt = nil
+			if err := unpinPost(tx, post.ID); err != nil {
+				return err
+			}
+		}
+		if err := syncPostHashtags(tx, post); err != nil {
+			return err
+		}
+		if err := syncPostLinks(tx, post); err != nil {
+			return err
+		}
+		if err := syncTravelVisit(tx, post); err != nil {
+			return err
+		}
+		mentioned, err = syncMentions(tx, post.ID, nil, post.UserID, post.ContentText)
+		if err != nil {
+			return err
+		}
+		return createPostRevision(tx, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.queueLinkPreviews(*post)
+
+	// Private posts were never fanned out, scheduled posts are announced by the post scheduler
+	if wasPrivate && post.Visibility != types.PostVisibilityPrivate && post.PublishAt == nil && a.nfPubClient != nil {
+		_, err := a.nfPubClient.PublishPost(ctx, &pb_nfp.PublishPostRequest{
+			UserId: post.UserID,
+			PostId: post.ID,
+		})
+		if err != nil {
+			a.logger.Error("Error publishing post made public to newsfeed", zap.Error(err), zap.Int64("post_id", post.ID))
+		}
+	}
+	return mentioned, nil
+}
+
+// createPostRevision records the post's current content as its next revision
+func createPostRevision(tx *gorm.DB, post *types.Post) error {
+	var lastRevision int32
+	err := tx.Model(&types.PostRevision{}).
+		Where("post_id = ?", post.ID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&lastRevision).Error
+	if err != nil {
+		return err
+	}
+
+	revision := types.PostRevision{
+		PostID:      post.ID,
+		Revision:    lastRevision + 1,
+		ContentText: post.ContentText,
+		Visibility:  post.Visibility,
+	}
+	for i, item := range postMedia(*post) {
+		revision.Media = append(revision.Media, types.PostRevisionMedia{Position: i, Media: item})
+	}
+	return tx.Create(&revision).Error
+}
+
+// revisionMedia returns the media of a revision in display order
+func revisionMedia(revision types.PostRevision) []types.Media {
+	media := make([]types.Media, 0, len(revision.Media))
+	for _, item := range revision.Media {
+		media = append(media, item.Media)
+	}
+	return media
+}
@@ -0,0 +1,238 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// maxScheduleAhead is how far in the future a post can be scheduled
+	maxScheduleAhead = 365 * 24 * time.Hour
+	// postSchedulerInterval is how often the scheduler looks for posts to publish
+	postSchedulerInterval = 30 * time.Second
+	// postSchedulerBatchSize bounds the number of posts published in one transaction
+	postSchedulerBatchSize = 100
+)
+
+// validPublishAt checks that a post is scheduled in the future, at most maxScheduleAhead from now
+func validPublishAt(publishAt time.Time) bool {
+	now := time.Now()
+	return publishAt.After(now) && publishAt.Before(now.Add(maxScheduleAhead))
+}
+
+func (a *AuthenticateAndPostService) GetScheduledPosts(ctx context.Context, info *pb_aap.GetScheduledPostsRequest) (*pb_aap.GetScheduledPostsResponse, error) {
+	a.logger.Debug("start getting scheduled posts")
+	defer a.logger.Debug("end getting scheduled posts")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetScheduledPostsResponse{Status: pb_aap.GetScheduledPostsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var posts []types.Post
+	err := a.db.
+		Preload("Media", orderedMedia).
+		Where("user_id = ? AND publish_at IS NOT NULL", user.ID).
+		Order("publish_at, id").
+		Find(&posts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled := make([]*pb_aap.ScheduledPost, 0, len(posts))
+	for _, post := range posts {
+		scheduled = append(scheduled, &pb_aap.ScheduledPost{
+			PostId:      post.ID,
+			ContentText: post.ContentText,
+			Visibility:  visibilityToProto(post.Visibility),
+			CreatedAt:   timestamppb.New(post.CreatedAt),
+			PublishAt:   timestamppb.New(*post.PublishAt),
+			Media:       mediaToProto(postMedia(post)),
+		})
+	}
+
+	return &pb_aap.GetScheduledPostsResponse{
+		Status: pb_aap.GetScheduledPostsResponse_OK,
+		Posts:  scheduled,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) ReschedulePost(ctx context.Context, info *pb_aap.ReschedulePostRequest) (*pb_aap.ReschedulePostResponse, error) {
+	a.logger.Debug("start rescheduling post", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end rescheduling post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_POST_NOT_FOUND}, nil
+	}
+	if user.ID != post.UserID {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_NOT_ALLOWED}, nil
+	}
+	if post.PublishAt == nil {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_NOT_SCHEDULED}, nil
+	}
+	publishAt := info.GetPublishAt().AsTime()
+	if info.PublishAt == nil || !validPublishAt(publishAt) {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_INVALID_PUBLISH_AT}, nil
+	}
+
+	// The poll of the post moves along so it stays open as long once the post is published
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// The scheduler may have published the post in the meantime, it then no longer matches
+		var scheduled types.Post
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "publish_at").
+			Where("id = ? AND publish_at IS NOT NULL", post.ID).
+			First(&scheduled).Error
+		if err != nil {
+			return err
+		}
+		shift := publishAt.Sub(*scheduled.PublishAt)
+		if err := tx.Model(&scheduled).Update("publish_at", publishAt).Error; err != nil {
+			return err
+		}
+		return tx.Model(&types.Poll{}).
+			Where("post_id = ?", post.ID).
+			Update("expires_at", gorm.Expr("expires_at + make_interval(secs => ?)", shift.Seconds())).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_NOT_SCHEDULED}, nil
+	} else if err != nil {
+		a.logger.Error("Error rescheduling post", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.ReschedulePostResponse{Status: pb_aap.ReschedulePostResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) CancelScheduledPost(ctx context.Context, info *pb_aap.CancelScheduledPostRequest) (*pb_aap.CancelScheduledPostResponse, error) {
+	a.logger.Debug("start cancelling scheduled post", zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end cancelling scheduled post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_POST_NOT_FOUND}, nil
+	}
+	if user.ID != post.UserID {
+		return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_NOT_ALLOWED}, nil
+	}
+	if post.PublishAt == nil {
+		return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_NOT_SCHEDULED}, nil
+	}
+
+	// Scheduled posts have no travel visit, moving them to the trash is all there is to do
+	result := a.db.Where("publish_at IS NOT NULL").Delete(&post)
+	if result.Error != nil {
+		a.logger.Error("Error cancelling scheduled post", zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_NOT_SCHEDULED}, nil
+	}
+
+	return &pb_aap.CancelScheduledPostResponse{Status: pb_aap.CancelScheduledPostResponse_OK}, nil
+}
+
+// RunPostScheduler publishes due scheduled posts every postSchedulerInterval until ctx is cancelled
+func (a *AuthenticateAndPostService) RunPostScheduler(ctx context.Context) {
+	ticker := time.NewTicker(postSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		published, err := a.PublishScheduledPosts(ctx)
+		if err != nil {
+			a.logger.Error("Error publishing scheduled posts", zap.Error(err))
+		} else if published > 0 {
+			a.logger.Info("Published scheduled posts", zap.Int("count", published))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishScheduledPosts publishes the scheduled posts whose publish_at has passed: the post
+// becomes visible, dated at its publish_at, and is announced to the newsfeeds of the followers
+// and to the mentioned users. It returns the number of published posts.
+func (a *AuthenticateAndPostService) PublishScheduledPosts(ctx context.Context) (int, error) {
+	total := 0
+
+	for {
+		var posts []types.Post
+		err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// SKIP LOCKED lets several authpost instances run the scheduler at the same time
+			// without publishing a post twice
+			err := tx.
+				Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("publish_at IS NOT NULL AND publish_at <= ?", time.Now()).
+				Order("publish_at, id").
+				Limit(postSchedulerBatchSize).
+				Find(&posts).Error
+			if err != nil || len(posts) == 0 {
+				return err
+			}
+
+			for i := range posts {
+				post := &posts[i]
+				err := tx.Model(post).Updates(map[string]interface{}{
+					"publish_at": nil,
+					"created_at": *post.PublishAt,
+				}).Error
+				if err != nil {
+					return err
+				}
+				post.CreatedAt = *post.PublishAt
+				post.PublishAt = nil
+				if err := syncTravelVisit(tx, post); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		// The posts are published once the rows are updated, a failure only leaves them out of the newsfeeds
+		for _, post := range posts {
+			a.announceScheduledPost(ctx, post)
+		}
+		total += len(posts)
+
+		if len(posts) < postSchedulerBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// announceScheduledPost announces a scheduled post once it is published, with the mentions of its current content
+func (a *AuthenticateAndPostService) announceScheduledPost(ctx context.Context, post types.Post) {
+	var mentioned []int64
+	err := a.db.Model(&types.Mention{}).
+		Where("post_id = ? AND comment_id IS NULL", post.ID).
+		Pluck("user_id", &mentioned).Error
+	if err != nil {
+		a.logger.Error("Error getting mentions of scheduled post", zap.Error(err), zap.Int64("post_id", post.ID))
+		return
+	}
+	a.announcePost(ctx, post, mentioned)
+}
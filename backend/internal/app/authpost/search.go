@@ -0,0 +1,213 @@
+package authpost
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxSearchQueryLength bounds the number of characters of a search query
+	maxSearchQueryLength = 200
+	// defaultSearchLimit and maxSearchLimit bound the page size of Search
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+	// searchTextConfig is the text search configuration of posts.search_vector,
+	// keep it in sync with migrations/0007_search.up.sql
+	searchTextConfig = "simple"
+	// userFullName is the expression indexed by idx_users_full_name_trgm
+	userFullName = "(users.first_name || ' ' || users.last_name)"
+)
+
+// searchHit is a ranked search result
+type searchHit struct {
+	ID   int64
+	Rank float32
+}
+
+// encodeSearchCursor encodes the position after a hit as an opaque cursor
+func encodeSearchCursor(hit searchHit) string {
+	value := strconv.FormatFloat(float64(hit.Rank), 'g', -1, 32) + ":" + strconv.FormatInt(hit.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// decodeSearchCursor decodes a cursor made by encodeSearchCursor
+func decodeSearchCursor(cursor string) (searchHit, bool) {
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return searchHit{}, false
+	}
+	rank, id, found := strings.Cut(string(value), ":")
+	if !found {
+		return searchHit{}, false
+	}
+	parsedRank, err := strconv.ParseFloat(rank, 32)
+	if err != nil {
+		return searchHit{}, false
+	}
+	parsedId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return searchHit{}, false
+	}
+	return searchHit{ID: parsedId, Rank: float32(parsedRank)}, true
+}
+
+// escapeLikePattern escapes the LIKE wildcards of s
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (a *AuthenticateAndPostService) Search(ctx context.Context, info *pb_aap.SearchRequest) (*pb_aap.SearchResponse, error) {
+	a.logger.Debug("start searching", zap.String("query", info.GetQuery()), zap.String("type", info.GetType().String()))
+	defer a.logger.Debug("end searching")
+
+	query := strings.TrimSpace(info.GetQuery())
+	if query == "" || utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return &pb_aap.SearchResponse{Status: pb_aap.SearchResponse_INVALID_QUERY}, nil
+	}
+
+	var after *searchHit
+	if info.GetCursor() != "" {
+		hit, ok := decodeSearchCursor(info.GetCursor())
+		if !ok {
+			return &pb_aap.SearchResponse{Status: pb_aap.SearchResponse_INVALID_CURSOR}, nil
+		}
+		after = &hit
+	}
+
+	limit := int(info.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	var ranked *gorm.DB
+	if info.GetType() == pb_aap.SearchRequest_USERS {
+		pattern := "%" + escapeLikePattern(query) + "%"
+		ranked = a.db.Model(&types.User{}).
+			Select("users.id, GREATEST(similarity(users.user_name, ?), similarity("+userFullName+", ?)) AS rank", query, query).
+			Where("users.user_name ILIKE ? OR "+userFullName+" ILIKE ? OR users.user_name % ? OR "+userFullName+" % ?",
+				pattern, pattern, query, query)
+	} else {
+		tsQuery := "websearch_to_tsquery('" + searchTextConfig + "', ?)"
+		ranked = a.db.Model(&types.Post{}).
+			Scopes(visiblePostsTo(info.GetViewerId())).
+			Select("posts.id, ts_rank_cd(posts.search_vector, "+tsQuery+") AS rank", query).
+			Where("posts.search_vector @@ "+tsQuery, query)
+	}
+
+	// Results are ordered by rank then id, so the cursor is the (rank, id) of the last hit
+	results := a.db.Table("(?) AS results", ranked)
+	if after != nil {
+		results = results.Where("results.rank < ? OR (results.rank = ? AND results.id < ?)",
+			float64(after.Rank), float64(after.Rank), after.ID)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	var hits []searchHit
+	err := results.Select("results.id, results.rank").
+		Order("results.rank DESC, results.id DESC").
+		Limit(limit + 1).
+		Scan(&hits).Error
+	if err != nil {
+		a.logger.Error("Error searching", zap.Error(err))
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(hits) > limit {
+		hits = hits[:limit]
+		nextCursor = encodeSearchCursor(hits[limit-1])
+	}
+
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+
+	if info.GetType() != pb_aap.SearchRequest_USERS {
+		return &pb_aap.SearchResponse{
+			Status:     pb_aap.SearchResponse_OK,
+			PostsIds:   ids,
+			NextCursor: nextCursor,
+		}, nil
+	}
+
+	users, err := a.getSearchUsers(ids, info.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb_aap.SearchResponse{
+		Status:     pb_aap.SearchResponse_OK,
+		Users:      users,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// getSearchUsers loads the public profile of the users in the given order,
+// with the follow flags of the viewer when one is given
+func (a *AuthenticateAndPostService) getSearchUsers(ids []int64, viewerId int64) ([]*pb_aap.UserDetailInfo, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var users []types.User
+	if err := a.db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	byId := make(map[int64]types.User, len(users))
+	for _, user := range users {
+		byId[user.ID] = user
+	}
+
+	following := make(map[int64]bool)
+	followedBy := make(map[int64]bool)
+	if viewerId > 0 {
+		var followingIds, followedByIds []int64
+		err := a.db.Model(&types.Following{}).
+			Where("follower_id = ? AND user_id IN ?", viewerId, ids).
+			Pluck("user_id", &followingIds).Error
+		if err != nil {
+			return nil, err
+		}
+		err = a.db.Model(&types.Following{}).
+			Where("user_id = ? AND follower_id IN ?", viewerId, ids).
+			Pluck("follower_id", &followedByIds).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range followingIds {
+			following[id] = true
+		}
+		for _, id := range followedByIds {
+			followedBy[id] = true
+		}
+	}
+
+	result := make([]*pb_aap.UserDetailInfo, 0, len(ids))
+	for _, id := range ids {
+		user, ok := byId[id]
+		if !ok {
+			continue
+		}
+		result = append(result, &pb_aap.UserDetailInfo{
+			UserId:         user.ID,
+			UserName:       user.UserName,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			ProfilePicture: user.ProfilePicture,
+			CoverPicture:   user.CoverPicture,
+			Following:      following[id],
+			FollowedBy:     followedBy[id],
+		})
+	}
+	return result, nil
+}
@@ -1,15 +1,21 @@
 package authpost
 
 import (
+	"context"
 	"errors"
 	"path/filepath"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/configs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/autocomplete"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/linkpreview"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/storage"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/utils"
 	client_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/client/newsfeed_publishing"
 	pb "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -21,6 +27,13 @@ type AuthenticateAndPostService struct {
 	db               *gorm.DB
 	migrationManager *utils.MigrationManager
 	nfPubClient      client_nfp.Client
+	mediaStorage     storage.BinaryStorage
+	mediaBucket      string
+	mediaHosts       []string
+	redisClient      *redis.Client
+	userIndex        *autocomplete.UserIndex
+	linkFetcher      *linkpreview.Fetcher
+	linkQueue        chan string
 	logger           *zap.Logger
 }
 
@@ -94,13 +107,65 @@ func NewAuthenticateAndPostService(cfg *configs.AuthenticateAndPostConfig) (*Aut
 		}
 	}
 
+	// Connect to S3 if configured, it is used to remove the media of purged posts
+	var mediaStorage storage.BinaryStorage
+	if cfg.S3.Bucket != "" {
+		mediaStorage, err = storage.NewS3BinaryStorage(utils.S3Config{
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			Endpoint:        cfg.S3.Endpoint,
+			DisableSSL:      cfg.S3.DisableSSL,
+			ForcePathStyle:  cfg.S3.ForcePathStyle,
+		}, logger)
+		if err != nil {
+			logger.Error("Failed to create media storage", zap.Error(err))
+			// Continue without media storage, purged posts will leave their media behind
+		}
+	}
+
+	// Connect to Redis if configured, it holds the user autocomplete index
+	var redisClient *redis.Client
+	var userIndex *autocomplete.UserIndex
+	if cfg.Redis.Addr != "" {
+		redisClient, err = utils.NewRedisClient(&cfg.Redis)
+		if err != nil {
+			logger.Error("Failed to connect to Redis", zap.Error(err))
+			// Continue without Redis, autocomplete falls back to the database
+			redisClient = nil
+		} else {
+			userIndex = autocomplete.NewUserIndex(redisClient)
+		}
+	}
+
+	// Link previews are fetched in background by RunLinkPreviewer
+	var linkFetcher *linkpreview.Fetcher
+	var linkQueue chan string
+	if !cfg.LinkPreview.Disabled {
+		linkFetcher = linkpreview.NewFetcher(linkpreview.Config{
+			Timeout:      time.Duration(cfg.LinkPreview.TimeoutSeconds) * time.Second,
+			MaxBodyBytes: int64(cfg.LinkPreview.MaxBodyKB) * 1024,
+		})
+		linkQueue = make(chan string, linkPreviewQueueSize)
+	}
+
 	logger.Info("AuthenticateAndPostService initialized successfully")
-	return &AuthenticateAndPostService{
+	service := &AuthenticateAndPostService{
 		db:               db,
 		migrationManager: migrationManager,
 		nfPubClient:      nfPubClient,
+		mediaStorage:     mediaStorage,
+		mediaBucket:      cfg.S3.Bucket,
+		mediaHosts:       mediaHosts(cfg.S3),
+		redisClient:      redisClient,
+		userIndex:        userIndex,
+		linkFetcher:      linkFetcher,
+		linkQueue:        linkQueue,
 		logger:           logger,
-	}, nil
+	}
+	go service.ensureUserIndex(context.Background())
+	return service, nil
 }
 
 // Getter methods for health checks
@@ -116,9 +181,9 @@ func (a *AuthenticateAndPostService) GetMigrationManager() *utils.MigrationManag
 	return a.migrationManager
 }
 
-func (a *AuthenticateAndPostService) GetRedis() interface{} {
-	// AuthPost service doesn't directly use Redis, return nil
-	return nil
+// GetRedis returns the Redis client of the user autocomplete index, nil when Redis is not configured
+func (a *AuthenticateAndPostService) GetRedis() *redis.Client {
+	return a.redisClient
 }
 
 // GetMigrationStatus returns the current status of database migrations
@@ -128,6 +193,9 @@ func (a *AuthenticateAndPostService) GetMigrationStatus() (*utils.MigrationStatu
 
 // Close gracefully closes the AuthPost service resources
 func (a *AuthenticateAndPostService) Close() error {
+	if a.redisClient != nil {
+		a.redisClient.Close()
+	}
 	if sqlDB, err := a.db.DB(); err == nil {
 		return sqlDB.Close()
 	}
@@ -160,3 +228,13 @@ func (a *AuthenticateAndPostService) findPostById(postId int64) (exist bool, pos
 	}
 	return true, post
 }
+
+// uniqueViolation is the SQLSTATE of an insert or update conflicting with a unique index
+const uniqueViolation = "23505"
+
+// isUniqueViolation checks if err comes from a unique index, the request that loses a race to insert
+// the same row gets it
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
@@ -0,0 +1,481 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// storyLifetime is how long a story is shown after it was created
+	storyLifetime = 24 * time.Hour
+	// storyExpireInterval is how often the expirer looks for expired stories
+	storyExpireInterval = time.Minute
+	// storyExpireBatchSize bounds the number of stories deleted in one transaction
+	storyExpireBatchSize = 100
+
+	// storyTrayKeyPrefix prefixes the story tray of a user, filled by the newsfeed publishing
+	// service, keep it in sync with it
+	storyTrayKeyPrefix = "story_tray:"
+	// storyViewersKeyPrefix prefixes the viewers of a story, a sorted set of user ids scored by
+	// the time they first saw the story. It expires with the story.
+	storyViewersKeyPrefix = "story_viewers:"
+)
+
+func storyViewersKey(storyId int64) string {
+	return storyViewersKeyPrefix + strconv.FormatInt(storyId, 10)
+}
+
+// activeStories only keeps the stories that did not expire yet
+func activeStories(db *gorm.DB) *gorm.DB {
+	return db.Where("stories.expires_at > ?", time.Now())
+}
+
+// findStoryById returns a story that did not expire yet
+func (a *AuthenticateAndPostService) findStoryById(storyId int64) (exist bool, story types.Story) {
+	result := a.db.Scopes(activeStories).First(&story, storyId)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.Story{}
+	}
+	return result.Error == nil, story
+}
+
+// canViewStory checks if the viewer is allowed to see the story, only the author and their followers are
+func (a *AuthenticateAndPostService) canViewStory(viewerId int64, story types.Story) bool {
+	return story.UserID == viewerId || a.isFollowing(story.UserID, viewerId)
+}
+
+func storyToProto(story types.Story) *pb_aap.Story {
+	return &pb_aap.Story{
+		StoryId:   story.ID,
+		UserId:    story.UserID,
+		Media:     mediaToProto([]types.Media{story.Media})[0],
+		CreatedAt: timestamppb.New(story.CreatedAt),
+		ExpiresAt: timestamppb.New(story.ExpiresAt),
+	}
+}
+
+// publishStory announces a story to the followers of its author
+func (a *AuthenticateAndPostService) publishStory(ctx context.Context, story types.Story) {
+	if a.nfPubClient == nil {
+		return
+	}
+
+	_, err := a.nfPubClient.PublishStory(ctx, &pb_nfp.PublishStoryRequest{
+		UserId:    story.UserID,
+		StoryId:   story.ID,
+		CreatedAt: story.CreatedAt.Unix(),
+	})
+	if err != nil {
+		a.logger.Error("Error publishing story to newsfeed", zap.Error(err))
+	}
+}
+
+func (a *AuthenticateAndPostService) CreateStory(ctx context.Context, info *pb_aap.CreateStoryRequest) (*pb_aap.CreateStoryResponse, error) {
+	a.logger.Debug("start creating story", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end creating story")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateStoryResponse{Status: pb_aap.CreateStoryResponse_USER_NOT_FOUND}, nil
+	}
+	if info.GetMedia() == nil {
+		return &pb_aap.CreateStoryResponse{Status: pb_aap.CreateStoryResponse_INVALID_MEDIA}, nil
+	}
+	media, ok := a.newMedia([]*pb_aap.PostMedia{info.GetMedia()})
+	if !ok || !strings.HasPrefix(media[0].MimeType, "image/") {
+		return &pb_aap.CreateStoryResponse{Status: pb_aap.CreateStoryResponse_INVALID_MEDIA}, nil
+	}
+
+	now := time.Now()
+	story := types.Story{
+		UserID:    user.ID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(storyLifetime),
+		Media:     media[0],
+	}
+	if err := a.db.Create(&story).Error; err != nil {
+		a.logger.Error("Error creating story", zap.Error(err))
+		return nil, err
+	}
+
+	a.publishStory(ctx, story)
+
+	return &pb_aap.CreateStoryResponse{
+		Status:    pb_aap.CreateStoryResponse_OK,
+		StoryId:   story.ID,
+		ExpiresAt: timestamppb.New(story.ExpiresAt),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteStory(ctx context.Context, info *pb_aap.DeleteStoryRequest) (*pb_aap.DeleteStoryResponse, error) {
+	a.logger.Debug("start deleting story", zap.Int64("story_id", info.GetStoryId()))
+	defer a.logger.Debug("end deleting story")
+
+	exist, story := a.findStoryById(info.GetStoryId())
+	if !exist {
+		return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_STORY_NOT_FOUND}, nil
+	}
+	if story.UserID != info.GetUserId() {
+		return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_NOT_ALLOWED}, nil
+	}
+
+	if err := a.db.Delete(&types.Story{}, story.ID).Error; err != nil {
+		a.logger.Error("Error deleting story", zap.Error(err))
+		return nil, err
+	}
+	// The author's entry in the follower trays goes away once their last story expires
+	if a.redisClient != nil {
+		if err := a.redisClient.Del(ctx, storyViewersKey(story.ID)).Err(); err != nil {
+			a.logger.Warn("Failed to delete story viewers", zap.Error(err))
+		}
+	}
+	a.deleteUnusedMedia([]types.Media{story.Media})
+
+	return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_OK}, nil
+}
+
+// storyTrayAuthors returns the followed users of the viewer that may have live stories. They are
+// read from the story tray of the viewer, or from the database without Redis.
+func (a *AuthenticateAndPostService) storyTrayAuthors(ctx context.Context, viewerId int64) ([]int64, error) {
+	if a.redisClient == nil {
+		var authorIds []int64
+		err := a.db.Model(&types.Story{}).
+			Scopes(activeStories).
+			Where("stories.user_id IN (?)", a.db.Model(&types.Following{}).
+				Select("user_id").
+				Where("follower_id = ?", viewerId)).
+			Distinct().
+			Pluck("stories.user_id", &authorIds).Error
+		return authorIds, err
+	}
+
+	trayKey := storyTrayKeyPrefix + strconv.FormatInt(viewerId, 10)
+	cutoff := strconv.FormatInt(time.Now().Add(-storyLifetime).Unix(), 10)
+	pipe := a.redisClient.Pipeline()
+	pipe.ZRemRangeByScore(ctx, trayKey, "-inf", "("+cutoff)
+	members := pipe.ZRange(ctx, trayKey, 0, -1)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	authorIds := make([]int64, 0, len(members.Val()))
+	for _, member := range members.Val() {
+		if id, err := strconv.ParseInt(member, 10, 64); err == nil {
+			authorIds = append(authorIds, id)
+		}
+	}
+	return authorIds, nil
+}
+
+// storiesSeenBy returns the stories the viewer saw among stories, by story id
+func (a *AuthenticateAndPostService) storiesSeenBy(ctx context.Context, viewerId int64, stories []types.Story) (map[int64]bool, error) {
+	seen := make(map[int64]bool)
+	if a.redisClient == nil || len(stories) == 0 {
+		return seen, nil
+	}
+
+	member := strconv.FormatInt(viewerId, 10)
+	pipe := a.redisClient.Pipeline()
+	scores := make([]*redis.FloatCmd, len(stories))
+	for i, story := range stories {
+		scores[i] = pipe.ZScore(ctx, storyViewersKey(story.ID), member)
+	}
+	// ZSCORE reports a viewer missing from a story as redis.Nil, which Exec returns as well
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	for i, story := range stories {
+		if scores[i].Err() == nil {
+			seen[story.ID] = true
+		}
+	}
+	return seen, nil
+}
+
+// storyViewCounts returns the number of viewers of each of stories, by story id
+func (a *AuthenticateAndPostService) storyViewCounts(ctx context.Context, stories []types.Story) (map[int64]int64, error) {
+	counts := make(map[int64]int64)
+	if a.redisClient == nil || len(stories) == 0 {
+		return counts, nil
+	}
+
+	pipe := a.redisClient.Pipeline()
+	cards := make([]*redis.IntCmd, len(stories))
+	for i, story := range stories {
+		cards[i] = pipe.ZCard(ctx, storyViewersKey(story.ID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	for i, story := range stories {
+		counts[story.ID] = cards[i].Val()
+	}
+	return counts, nil
+}
+
+func (a *AuthenticateAndPostService) GetStoryTray(ctx context.Context, info *pb_aap.GetStoryTrayRequest) (*pb_aap.GetStoryTrayResponse, error) {
+	a.logger.Debug("start getting story tray", zap.Int64("viewer_id", info.GetViewerId()))
+	defer a.logger.Debug("end getting story tray")
+
+	exist, viewer := a.findUserById(info.GetViewerId())
+	if !exist {
+		return &pb_aap.GetStoryTrayResponse{Status: pb_aap.GetStoryTrayResponse_USER_NOT_FOUND}, nil
+	}
+
+	authorIds, err := a.storyTrayAuthors(ctx, viewer.ID)
+	if err != nil {
+		a.logger.Error("Error getting story tray authors", zap.Error(err))
+		return nil, err
+	}
+
+	// The tray may still list users the viewer stopped following
+	var stories []types.Story
+	query := a.db.Model(&types.Story{}).Scopes(activeStories)
+	if len(authorIds) > 0 {
+		query = query.Where("stories.user_id = ? OR stories.user_id IN (?)", viewer.ID,
+			a.db.Model(&types.Following{}).
+				Select("user_id").
+				Where("follower_id = ? AND user_id IN ?", viewer.ID, authorIds))
+	} else {
+		query = query.Where("stories.user_id = ?", viewer.ID)
+	}
+	if err := query.Order("stories.created_at, stories.id").Find(&stories).Error; err != nil {
+		a.logger.Error("Error getting stories", zap.Error(err))
+		return nil, err
+	}
+
+	var ownStories []types.Story
+	for _, story := range stories {
+		if story.UserID == viewer.ID {
+			ownStories = append(ownStories, story)
+		}
+	}
+	seen, err := a.storiesSeenBy(ctx, viewer.ID, stories)
+	if err != nil {
+		a.logger.Error("Error getting seen stories", zap.Error(err))
+		return nil, err
+	}
+	viewCounts, err := a.storyViewCounts(ctx, ownStories)
+	if err != nil {
+		a.logger.Error("Error getting story view counts", zap.Error(err))
+		return nil, err
+	}
+
+	entries := make(map[int64]*pb_aap.StoryTrayEntry)
+	latest := make(map[int64]time.Time)
+	userIds := make([]int64, 0)
+	for _, story := range stories {
+		entry, ok := entries[story.UserID]
+		if !ok {
+			entry = &pb_aap.StoryTrayEntry{}
+			entries[story.UserID] = entry
+			userIds = append(userIds, story.UserID)
+		}
+		pbStory := storyToProto(story)
+		pbStory.Seen = story.UserID == viewer.ID || seen[story.ID]
+		pbStory.ViewCount = viewCounts[story.ID]
+		entry.Stories = append(entry.Stories, pbStory)
+		entry.HasUnseen = entry.HasUnseen || !pbStory.Seen
+		latest[story.UserID] = story.CreatedAt
+	}
+
+	var users []types.User
+	if len(userIds) > 0 {
+		if err := a.db.Where("id IN ?", userIds).Find(&users).Error; err != nil {
+			a.logger.Error("Error getting story authors", zap.Error(err))
+			return nil, err
+		}
+	}
+	result := make([]*pb_aap.StoryTrayEntry, 0, len(users))
+	for _, user := range users {
+		entry := entries[user.ID]
+		entry.Author = userToPostAuthor(user)
+		result = append(result, entry)
+	}
+
+	// The viewer comes first, then the users with unseen stories, the most recent story first
+	sort.SliceStable(result, func(i, j int) bool {
+		x, y := result[i], result[j]
+		if (x.Author.UserId == viewer.ID) != (y.Author.UserId == viewer.ID) {
+			return x.Author.UserId == viewer.ID
+		}
+		if x.HasUnseen != y.HasUnseen {
+			return x.HasUnseen
+		}
+		return latest[x.Author.UserId].After(latest[y.Author.UserId])
+	})
+
+	return &pb_aap.GetStoryTrayResponse{
+		Status:  pb_aap.GetStoryTrayResponse_OK,
+		Entries: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) ViewStory(ctx context.Context, info *pb_aap.ViewStoryRequest) (*pb_aap.ViewStoryResponse, error) {
+	a.logger.Debug("start viewing story", zap.Int64("story_id", info.GetStoryId()))
+	defer a.logger.Debug("end viewing story")
+
+	exist, story := a.findStoryById(info.GetStoryId())
+	if !exist || !a.canViewStory(info.GetViewerId(), story) {
+		return &pb_aap.ViewStoryResponse{Status: pb_aap.ViewStoryResponse_STORY_NOT_FOUND}, nil
+	}
+	// Authors do not count as viewers of their own stories, and views are not kept without Redis
+	if story.UserID == info.GetViewerId() || a.redisClient == nil {
+		return &pb_aap.ViewStoryResponse{Status: pb_aap.ViewStoryResponse_OK}, nil
+	}
+
+	key := storyViewersKey(story.ID)
+	pipe := a.redisClient.TxPipeline()
+	// NX keeps the time of the first view
+	pipe.ZAddNX(ctx, key, &redis.Z{
+		Score:  float64(time.Now().Unix()),
+		Member: strconv.FormatInt(info.GetViewerId(), 10),
+	})
+	pipe.ExpireAt(ctx, key, story.ExpiresAt)
+	if _, err := pipe.Exec(ctx); err != nil {
+		a.logger.Error("Error recording story view", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.ViewStoryResponse{Status: pb_aap.ViewStoryResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetStoryViewers(ctx context.Context, info *pb_aap.GetStoryViewersRequest) (*pb_aap.GetStoryViewersResponse, error) {
+	a.logger.Debug("start getting story viewers", zap.Int64("story_id", info.GetStoryId()))
+	defer a.logger.Debug("end getting story viewers")
+
+	exist, story := a.findStoryById(info.GetStoryId())
+	if !exist {
+		return &pb_aap.GetStoryViewersResponse{Status: pb_aap.GetStoryViewersResponse_STORY_NOT_FOUND}, nil
+	}
+	if story.UserID != info.GetUserId() {
+		return &pb_aap.GetStoryViewersResponse{Status: pb_aap.GetStoryViewersResponse_NOT_ALLOWED}, nil
+	}
+	if a.redisClient == nil {
+		return &pb_aap.GetStoryViewersResponse{
+			Status:  pb_aap.GetStoryViewersResponse_OK,
+			Viewers: []*pb_aap.StoryViewer{},
+		}, nil
+	}
+
+	views, err := a.redisClient.ZRevRangeWithScores(ctx, storyViewersKey(story.ID), 0, -1).Result()
+	if err != nil {
+		a.logger.Error("Error getting story viewers", zap.Error(err))
+		return nil, err
+	}
+	viewerIds := make([]int64, 0, len(views))
+	for _, view := range views {
+		if id, err := strconv.ParseInt(view.Member.(string), 10, 64); err == nil {
+			viewerIds = append(viewerIds, id)
+		}
+	}
+
+	users := make(map[int64]types.User, len(viewerIds))
+	if len(viewerIds) > 0 {
+		var rows []types.User
+		if err := a.db.Where("id IN ?", viewerIds).Find(&rows).Error; err != nil {
+			a.logger.Error("Error getting story viewer users", zap.Error(err))
+			return nil, err
+		}
+		for _, user := range rows {
+			users[user.ID] = user
+		}
+	}
+
+	viewers := make([]*pb_aap.StoryViewer, 0, len(views))
+	for _, view := range views {
+		id, err := strconv.ParseInt(view.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		if user, ok := users[id]; ok {
+			viewers = append(viewers, &pb_aap.StoryViewer{
+				User:     userToPostAuthor(user),
+				ViewedAt: timestamppb.New(time.Unix(int64(view.Score), 0)),
+			})
+		}
+	}
+
+	return &pb_aap.GetStoryViewersResponse{
+		Status:  pb_aap.GetStoryViewersResponse_OK,
+		Viewers: viewers,
+	}, nil
+}
+
+// RunStoryExpirer deletes expired stories every storyExpireInterval until ctx is cancelled
+func (a *AuthenticateAndPostService) RunStoryExpirer(ctx context.Context) {
+	ticker := time.NewTicker(storyExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := a.DeleteExpiredStories(ctx)
+		if err != nil {
+			a.logger.Error("Error deleting expired stories", zap.Error(err))
+		} else if expired > 0 {
+			a.logger.Info("Deleted expired stories", zap.Int("count", expired))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteExpiredStories removes the expired stories and their media. Their viewers expire from Redis
+// on their own. It returns the number of deleted stories.
+func (a *AuthenticateAndPostService) DeleteExpiredStories(ctx context.Context) (int, error) {
+	total := 0
+
+	for {
+		var stories []types.Story
+		err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// SKIP LOCKED lets several authpost instances expire stories at the same time
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("expires_at <= ?", time.Now()).
+				Order("id").
+				Limit(storyExpireBatchSize).
+				Find(&stories).Error
+			if err != nil || len(stories) == 0 {
+				return err
+			}
+
+			storyIds := make([]int64, 0, len(stories))
+			for _, story := range stories {
+				storyIds = append(storyIds, story.ID)
+			}
+			return tx.Where("id IN ?", storyIds).Delete(&types.Story{}).Error
+		})
+		if err != nil {
+			return total, err
+		}
+		if len(stories) == 0 {
+			return total, nil
+		}
+
+		media := make([]types.Media, 0, len(stories))
+		for _, story := range stories {
+			media = append(media, story.Media)
+		}
+		a.deleteUnusedMedia(media)
+
+		total += len(stories)
+		if len(stories) < storyExpireBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package authpost
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// trashRetention is how long a deleted post can be restored before it is purged
+	trashRetention = 30 * 24 * time.Hour
+	// trashPurgeInterval is how often the purger looks for expired posts
+	trashPurgeInterval = time.Hour
+	// trashPurgeBatchSize bounds the number of posts purged in one transaction
+	trashPurgeBatchSize = 100
+)
+
+func (a *AuthenticateAndPostService) GetTrashedPosts(ctx context.Context, info *pb_aap.GetTrashedPostsRequest) (*pb_aap.GetTrashedPostsResponse, error) {
+	a.logger.Debug("start getting trashed posts")
+	defer a.logger.Debug("end getting trashed posts")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetTrashedPostsResponse{Status: pb_aap.GetTrashedPostsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var posts []types.Post
+	err := a.db.Unscoped().
+		Preload("Media", orderedMedia).
+		Where("user_id = ? AND deleted_at > ?", info.GetUserId(), time.Now().Add(-trashRetention)).
+		Order("deleted_at DESC").
+		Find(&posts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	trashed := make([]*pb_aap.TrashedPost, 0, len(posts))
+	for _, post := range posts {
+		trashed = append(trashed, &pb_aap.TrashedPost{
+			PostId:      post.ID,
+			ContentText: post.ContentText,
+			CreatedAt:   timestamppb.New(post.CreatedAt),
+			DeletedAt:   timestamppb.New(post.DeletedAt.Time),
+			PurgeAt:     timestamppb.New(post.DeletedAt.Time.Add(trashRetention)),
+			Media:       mediaToProto(postMedia(post)),
+		})
+	}
+
+	return &pb_aap.GetTrashedPostsResponse{
+		Status: pb_aap.GetTrashedPostsResponse_OK,
+		Posts:  trashed,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) RestorePost(ctx context.Context, info *pb_aap.RestorePostRequest) (*pb_aap.RestorePostResponse, error) {
+	a.logger.Debug("start restoring post")
+	defer a.logger.Debug("end restoring post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_USER_NOT_FOUND}, nil
+	}
+
+	var post types.Post
+	result := a.db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", info.GetPostId()).First(&post)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_POST_NOT_FOUND}, nil
+	} else if result.Error != nil {
+		return nil, result.Error
+	}
+	if user.ID != post.UserID {
+		return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_NOT_ALLOWED}, nil
+	}
+	// Expired posts are waiting for the purger and can no longer be restored
+	if post.DeletedAt.Time.Before(time.Now().Add(-trashRetention)) {
+		return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_POST_NOT_FOUND}, nil
+	}
+	// A repost cannot come back once the user reposted the same post again
+	if post.RepostOfID != nil && a.hasReposted(post.UserID, *post.RepostOfID) {
+		return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_NOT_ALLOWED}, nil
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&post).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		post.DeletedAt = gorm.DeletedAt{}
+		return syncTravelVisit(tx, &post)
+	})
+	if err != nil {
+		a.logger.Error("Error restoring post", zap.Error(err))
+		return nil, err
+	}
+
+	a.logger.Info("Post restored from trash",
+		zap.Int64("post_id", post.ID),
+		zap.Int64("user_id", post.UserID))
+
+	// Publish the post again so it shows up in the followers' newsfeeds,
+	// a scheduled post is left to the post scheduler
+	if post.RepostOfID != nil {
+		a.publishRepost(ctx, post)
+	} else if a.nfPubClient != nil && post.Visibility != types.PostVisibilityPrivate && post.PublishAt == nil {
+		_, err := a.nfPubClient.PublishPost(ctx, &pb_nfp.PublishPostRequest{
+			UserId: post.UserID,
+			PostId: post.ID,
+		})
+		if err != nil {
+			a.logger.Error("Error publishing restored post to newsfeed", zap.Error(err))
+		}
+	}
+
+	return &pb_aap.RestorePostResponse{Status: pb_aap.RestorePostResponse_OK}, nil
+}
+
+// RunTrashPurger purges expired posts every trashPurgeInterval until ctx is cancelled
+func (a *AuthenticateAndPostService) RunTrashPurger(ctx context.Context) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := a.PurgeExpiredPosts(ctx)
+		if err != nil {
+			a.logger.Error("Error purging trashed posts", zap.Error(err))
+		} else if purged > 0 {
+			a.logger.Info("Purged trashed posts", zap.Int("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpiredPosts permanently removes the posts that have been in the trash for longer
+// than trashRetention, together with their comments, likes, mentions, revisions, hashtags, media
+// reposts and bookmarks. Quotes of a purged post are kept without it. It returns the number of purged posts.
+func (a *AuthenticateAndPostService) PurgeExpiredPosts(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-trashRetention)
+	total := 0
+
+	for {
+		var posts []types.Post
+		var media []types.Media
+		err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// SKIP LOCKED lets several authpost instances purge at the same time without
+			// waiting on, or double-deleting, each other's rows
+			err := tx.Unscoped().
+				Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("deleted_at IS NOT NULL AND deleted_at <= ?", cutoff).
+				Order("id").
+				Limit(trashPurgeBatchSize).
+				Find(&posts).Error
+			if err != nil || len(posts) == 0 {
+				return err
+			}
+
+			postIds := make([]int64, 0, len(posts))
+			for _, post := range posts {
+				postIds = append(postIds, post.ID)
+			}
+			// Reposts, live or trashed, go away with the post they share
+			var repostIds []int64
+			err = tx.Unscoped().Model(&types.Post{}).Where("repost_of_id IN ?", postIds).Pluck("id", &repostIds).Error
+			if err != nil {
+				return err
+			}
+			postIds = append(postIds, repostIds...)
+
+			// The media of the posts and of their revisions are deleted from S3 once the rows are gone
+			err = tx.Model(&types.PostMedia{}).Where("post_id IN ?", postIds).Find(&media).Error
+			if err != nil {
+				return err
+			}
+			var revisionMedia []types.Media
+			err = tx.Model(&types.PostRevisionMedia{}).
+				Joins("JOIN post_revisions ON post_revisions.id = post_revision_media.revision_id").
+				Where("post_revisions.post_id IN ?", postIds).
+				Find(&revisionMedia).Error
+			if err != nil {
+				return err
+			}
+			media = append(media, revisionMedia...)
+
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.Mention{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.Like{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Where("post_id IN ?", postIds).Delete(&types.Comment{}).Error; err != nil {
+				return err
+			}
+			// The media of the revisions go with them
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.PostRevision{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.PostMedia{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.PostHashtag{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.PostLink{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.TravelVisit{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.Bookmark{}).Error; err != nil {
+				return err
+			}
+			// The options and votes of the polls go with them
+			if err := tx.Where("post_id IN ?", postIds).Delete(&types.Poll{}).Error; err != nil {
+				return err
+			}
+			return tx.Unscoped().Where("id IN ?", postIds).Delete(&types.Post{}).Error
+		})
+		if err != nil {
+			return total, err
+		}
+
+		// Media is removed once the rows are gone, a failure only leaves an orphaned object behind
+		a.deleteUnusedMedia(media)
+		total += len(posts)
+
+		if len(posts) < trashPurgeBatchSize {
+			return total, nil
+		}
+	}
+}
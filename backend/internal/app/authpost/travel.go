@@ -0,0 +1,299 @@
+package authpost
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// visibleVisitsTo restricts a travel_visits query to the rows the viewer is allowed to see.
+// It mirrors visiblePostsTo, visits of posts carry the visibility of their post.
+func visibleVisitsTo(viewerId int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerId <= 0 {
+			return db.Where("travel_visits.visibility = ?", types.PostVisibilityPublic)
+		}
+		return db.Where(
+			"travel_visits.user_id = ? OR travel_visits.visibility = ? OR (travel_visits.visibility = ? AND EXISTS (SELECT 1 FROM following f WHERE f.user_id = travel_visits.user_id AND f.follower_id = ?))",
+			viewerId, types.PostVisibilityPublic, types.PostVisibilityFollowers, viewerId,
+		)
+	}
+}
+
+// removeTravelVisit removes the visit following a post, if any
+func removeTravelVisit(tx *gorm.DB, postId int64) error {
+	return tx.Where("post_id = ?", postId).Delete(&types.TravelVisit{}).Error
+}
+
+// syncTravelVisit keeps the visit following a post in sync with its location, place and visibility.
+// Posts without a location, deleted posts and scheduled posts have no visit.
+func syncTravelVisit(tx *gorm.DB, post *types.Post) error {
+	if post.DeletedAt.Valid || post.PublishAt != nil || (post.Latitude == nil && post.PlaceID == nil) {
+		return removeTravelVisit(tx, post.ID)
+	}
+
+	visit := types.TravelVisit{
+		UserID:     post.UserID,
+		PostID:     &post.ID,
+		PlaceID:    post.PlaceID,
+		Latitude:   post.Latitude,
+		Longitude:  post.Longitude,
+		Visibility: post.Visibility,
+		VisitedAt:  post.CreatedAt,
+	}
+	if post.PlaceID != nil {
+		var place types.Place
+		if err := tx.Unscoped().First(&place, *post.PlaceID).Error; err != nil {
+			return err
+		}
+		visit.Country = place.Country
+		visit.City = place.City
+		if visit.Latitude == nil || visit.Longitude == nil {
+			visit.Latitude = &place.Latitude
+			visit.Longitude = &place.Longitude
+		}
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "post_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"updated_at", "place_id", "country", "city", "latitude", "longitude", "visibility", "visited_at",
+		}),
+	}).Create(&visit).Error
+}
+
+func (a *AuthenticateAndPostService) GetTravelMap(ctx context.Context, info *pb_aap.GetTravelMapRequest) (*pb_aap.GetTravelMapResponse, error) {
+	a.logger.Debug("start getting travel map")
+	defer a.logger.Debug("end getting travel map")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetTravelMapResponse{Status: pb_aap.GetTravelMapResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Visits outside of a city are grouped under an empty city and only count towards their country
+	var rows []struct {
+		Country        string
+		City           string
+		FirstVisitedAt time.Time
+		PostsCount     int64
+	}
+	err := a.db.Model(&types.TravelVisit{}).
+		Scopes(visibleVisitsTo(info.GetViewerId())).
+		Select("travel_visits.country, travel_visits.city, MIN(travel_visits.visited_at) AS first_visited_at, COUNT(travel_visits.post_id) AS posts_count").
+		Where("travel_visits.user_id = ? AND travel_visits.country <> ''", info.GetUserId()).
+		Group("travel_visits.country, travel_visits.city").
+		Scan(&rows).Error
+	if err != nil {
+		a.logger.Error("Error getting travel map", zap.Int64("user_id", info.GetUserId()), zap.Error(err))
+		return nil, err
+	}
+
+	countries := make([]*pb_aap.VisitedCountry, 0)
+	byCountry := make(map[string]*pb_aap.VisitedCountry)
+	for _, row := range rows {
+		country, ok := byCountry[row.Country]
+		if !ok {
+			country = &pb_aap.VisitedCountry{
+				Country:        row.Country,
+				FirstVisitedAt: timestamppb.New(row.FirstVisitedAt),
+			}
+			byCountry[row.Country] = country
+			countries = append(countries, country)
+		} else if row.FirstVisitedAt.Before(country.FirstVisitedAt.AsTime()) {
+			country.FirstVisitedAt = timestamppb.New(row.FirstVisitedAt)
+		}
+		country.PostsCount += row.PostsCount
+		if row.City != "" {
+			country.Cities = append(country.Cities, &pb_aap.VisitedCity{
+				City:           row.City,
+				FirstVisitedAt: timestamppb.New(row.FirstVisitedAt),
+				PostsCount:     row.PostsCount,
+			})
+		}
+	}
+
+	// Countries and cities are listed in the order they were first visited
+	sort.Slice(countries, func(i, j int) bool {
+		return visitedBefore(countries[i].FirstVisitedAt, countries[i].Country, countries[j].FirstVisitedAt, countries[j].Country)
+	})
+	for _, country := range countries {
+		cities := country.Cities
+		sort.Slice(cities, func(i, j int) bool {
+			return visitedBefore(cities[i].FirstVisitedAt, cities[i].City, cities[j].FirstVisitedAt, cities[j].City)
+		})
+	}
+
+	var manual []types.TravelVisit
+	err = a.db.Scopes(visibleVisitsTo(info.GetViewerId())).
+		Where("travel_visits.user_id = ? AND travel_visits.post_id IS NULL", info.GetUserId()).
+		Order("travel_visits.visited_at DESC, travel_visits.id DESC").
+		Find(&manual).Error
+	if err != nil {
+		a.logger.Error("Error getting travel visits", zap.Int64("user_id", info.GetUserId()), zap.Error(err))
+		return nil, err
+	}
+
+	visits := make([]*pb_aap.TravelVisit, 0, len(manual))
+	for _, visit := range manual {
+		visits = append(visits, &pb_aap.TravelVisit{
+			VisitId:    visit.ID,
+			Country:    visit.Country,
+			City:       visit.City,
+			VisitedAt:  timestamppb.New(visit.VisitedAt),
+			Visibility: visibilityToProto(visit.Visibility),
+		})
+	}
+
+	return &pb_aap.GetTravelMapResponse{
+		Status:    pb_aap.GetTravelMapResponse_OK,
+		Countries: countries,
+		Visits:    visits,
+	}, nil
+}
+
+// visitedBefore orders visited locations by first visit, then by name
+func visitedBefore(firstA *timestamppb.Timestamp, nameA string, firstB *timestamppb.Timestamp, nameB string) bool {
+	a, b := firstA.AsTime(), firstB.AsTime()
+	if !a.Equal(b) {
+		return a.Before(b)
+	}
+	return nameA < nameB
+}
+
+func (a *AuthenticateAndPostService) GetTravelStats(ctx context.Context, info *pb_aap.GetTravelStatsRequest) (*pb_aap.GetTravelStatsResponse, error) {
+	a.logger.Debug("start getting travel stats")
+	defer a.logger.Debug("end getting travel stats")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetTravelStatsResponse{Status: pb_aap.GetTravelStatsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var totals struct {
+		CountriesCount int64
+		CitiesCount    int64
+		PlacesCount    int64
+		PostsCount     int64
+		FirstVisitedAt *time.Time
+		LastVisitedAt  *time.Time
+	}
+	err := a.db.Model(&types.TravelVisit{}).
+		Scopes(visibleVisitsTo(info.GetViewerId())).
+		Select("COUNT(DISTINCT NULLIF(travel_visits.country, '')) AS countries_count, "+
+			"COUNT(DISTINCT CASE WHEN travel_visits.country <> '' AND travel_visits.city <> '' THEN travel_visits.country || '/' || travel_visits.city END) AS cities_count, "+
+			"COUNT(DISTINCT travel_visits.place_id) AS places_count, "+
+			"COUNT(travel_visits.post_id) AS posts_count, "+
+			"MIN(travel_visits.visited_at) AS first_visited_at, "+
+			"MAX(travel_visits.visited_at) AS last_visited_at").
+		Where("travel_visits.user_id = ?", info.GetUserId()).
+		Scan(&totals).Error
+	if err != nil {
+		a.logger.Error("Error getting travel stats", zap.Int64("user_id", info.GetUserId()), zap.Error(err))
+		return nil, err
+	}
+
+	// The distance travelled goes through the located visits in the order they happened
+	var points []struct {
+		Latitude  float64
+		Longitude float64
+	}
+	err = a.db.Model(&types.TravelVisit{}).
+		Scopes(visibleVisitsTo(info.GetViewerId())).
+		Select("travel_visits.latitude, travel_visits.longitude").
+		Where("travel_visits.user_id = ? AND travel_visits.latitude IS NOT NULL AND travel_visits.longitude IS NOT NULL", info.GetUserId()).
+		Order("travel_visits.visited_at, travel_visits.id").
+		Scan(&points).Error
+	if err != nil {
+		a.logger.Error("Error getting travel distance", zap.Int64("user_id", info.GetUserId()), zap.Error(err))
+		return nil, err
+	}
+
+	var distance float64
+	for i := 1; i < len(points); i++ {
+		distance += distanceKm(points[i-1].Latitude, points[i-1].Longitude, points[i].Latitude, points[i].Longitude)
+	}
+
+	stats := &pb_aap.GetTravelStatsResponse{
+		Status:         pb_aap.GetTravelStatsResponse_OK,
+		CountriesCount: totals.CountriesCount,
+		CitiesCount:    totals.CitiesCount,
+		PlacesCount:    totals.PlacesCount,
+		PostsCount:     totals.PostsCount,
+		DistanceKm:     distance,
+	}
+	if totals.FirstVisitedAt != nil {
+		stats.FirstVisitedAt = timestamppb.New(*totals.FirstVisitedAt)
+	}
+	if totals.LastVisitedAt != nil {
+		stats.LastVisitedAt = timestamppb.New(*totals.LastVisitedAt)
+	}
+	return stats, nil
+}
+
+func (a *AuthenticateAndPostService) AddTravelVisit(ctx context.Context, info *pb_aap.AddTravelVisitRequest) (*pb_aap.AddTravelVisitResponse, error) {
+	a.logger.Debug("start adding travel visit")
+	defer a.logger.Debug("end adding travel visit")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.AddTravelVisitResponse{Status: pb_aap.AddTravelVisitResponse_USER_NOT_FOUND}, nil
+	}
+
+	visit := types.TravelVisit{
+		UserID:     user.ID,
+		Country:    strings.TrimSpace(info.GetCountry()),
+		City:       strings.TrimSpace(info.GetCity()),
+		Visibility: visibilityToModel(info.GetVisibility()),
+		VisitedAt:  time.Now(),
+	}
+	if info.VisitedAt != nil {
+		visit.VisitedAt = info.GetVisitedAt().AsTime()
+	}
+	if visit.Country == "" || utf8.RuneCountInString(visit.Country) > maxPlaceCountryLength ||
+		utf8.RuneCountInString(visit.City) > maxPlaceCityLength || visit.VisitedAt.After(time.Now()) {
+		return &pb_aap.AddTravelVisitResponse{Status: pb_aap.AddTravelVisitResponse_INVALID_VISIT}, nil
+	}
+
+	if err := a.db.Create(&visit).Error; err != nil {
+		a.logger.Error("Error adding travel visit", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.AddTravelVisitResponse{
+		Status:  pb_aap.AddTravelVisitResponse_OK,
+		VisitId: visit.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteTravelVisit(ctx context.Context, info *pb_aap.DeleteTravelVisitRequest) (*pb_aap.DeleteTravelVisitResponse, error) {
+	a.logger.Debug("start deleting travel visit")
+	defer a.logger.Debug("end deleting travel visit")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteTravelVisitResponse{Status: pb_aap.DeleteTravelVisitResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Visits of posts are removed together with the location of their post
+	result := a.db.Where("id = ? AND user_id = ? AND post_id IS NULL", info.GetVisitId(), info.GetUserId()).
+		Delete(&types.TravelVisit{})
+	if result.Error != nil {
+		a.logger.Error("Error deleting travel visit", zap.Error(result.Error))
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.DeleteTravelVisitResponse{Status: pb_aap.DeleteTravelVisitResponse_VISIT_NOT_FOUND}, nil
+	}
+
+	return &pb_aap.DeleteTravelVisitResponse{Status: pb_aap.DeleteTravelVisitResponse_OK}, nil
+}
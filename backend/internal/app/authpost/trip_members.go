@@ -0,0 +1,327 @@
+package authpost
+
+import (
+	"context"
+	"time"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// tripRoleToModel converts the role of an invitation into the value stored in trip_members.role.
+// Only viewers and editors can be invited, a trip has a single owner.
+func tripRoleToModel(role pb_aap.TripRole) (string, bool) {
+	switch role {
+	case pb_aap.TripRole_VIEWER:
+		return types.TripRoleViewer, true
+	case pb_aap.TripRole_EDITOR:
+		return types.TripRoleEditor, true
+	default:
+		return "", false
+	}
+}
+
+// tripRoleToProto converts a trip role into its protobuf representation
+func tripRoleToProto(role string) pb_aap.TripRole {
+	switch role {
+	case types.TripRoleOwner:
+		return pb_aap.TripRole_OWNER
+	case types.TripRoleEditor:
+		return pb_aap.TripRole_EDITOR
+	default:
+		return pb_aap.TripRole_VIEWER
+	}
+}
+
+// findTripMember checks if a user was invited to a trip, whether the invitation was accepted or not
+func (a *AuthenticateAndPostService) findTripMember(tripId int64, userId int64) (exist bool, member types.TripMember) {
+	result := a.db.Where("trip_id = ? AND user_id = ?", tripId, userId).First(&member)
+	if result.Error != nil {
+		return false, types.TripMember{}
+	}
+	return true, member
+}
+
+// getTripMembers returns the accepted members of a trip, with its pending invitations when withPending is set
+func (a *AuthenticateAndPostService) getTripMembers(trip types.Trip, withPending bool) ([]*pb_aap.TripMember, error) {
+	query := a.db.Where("trip_id = ?", trip.ID)
+	if !withPending {
+		query = query.Where("status = ?", types.TripMemberAccepted)
+	}
+
+	var members []types.TripMember
+	if err := query.Order("created_at, user_id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb_aap.TripMember, 0, len(members))
+	for _, member := range members {
+		result = append(result, &pb_aap.TripMember{
+			UserId:  member.UserID,
+			Role:    tripRoleToProto(member.Role),
+			Pending: member.Status == types.TripMemberPending,
+		})
+	}
+	return result, nil
+}
+
+// publishTripInvitation notifies a user of an invitation event, failures are logged only
+func (a *AuthenticateAndPostService) publishTripInvitation(ctx context.Context, event pb_nfp.PublishTripInvitationRequest_TripInvitationEvent, userId int64, recipientId int64, tripId int64) {
+	if a.nfPubClient == nil {
+		return
+	}
+
+	_, err := a.nfPubClient.PublishTripInvitation(ctx, &pb_nfp.PublishTripInvitationRequest{
+		Event:       event,
+		UserId:      userId,
+		RecipientId: recipientId,
+		TripId:      tripId,
+	})
+	if err != nil {
+		a.logger.Error("Error publishing trip invitation", zap.Error(err), zap.Int64("trip_id", tripId))
+	}
+}
+
+func (a *AuthenticateAndPostService) InviteTripMember(ctx context.Context, info *pb_aap.InviteTripMemberRequest) (*pb_aap.InviteTripMemberResponse, error) {
+	a.logger.Debug("start inviting trip member",
+		zap.Int64("trip_id", info.GetTripId()),
+		zap.Int64("invitee_id", info.GetInviteeId()))
+	defer a.logger.Debug("end inviting trip member")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_TRIP_NOT_FOUND}, nil
+	}
+	if trip.UserID != user.ID {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_NOT_ALLOWED}, nil
+	}
+	role, ok := tripRoleToModel(info.GetRole())
+	if !ok {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_INVALID_ROLE}, nil
+	}
+	exist, _ = a.findUserById(info.GetInviteeId())
+	if !exist {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_INVITEE_NOT_FOUND}, nil
+	}
+	if info.GetInviteeId() == trip.UserID {
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_ALREADY_MEMBER}, nil
+	}
+
+	// Inviting a user again only updates the role of the pending invitation
+	exist, member := a.findTripMember(trip.ID, info.GetInviteeId())
+	if exist {
+		if member.Status == types.TripMemberAccepted {
+			return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_ALREADY_MEMBER}, nil
+		}
+		if err := a.db.Model(&member).Update("role", role).Error; err != nil {
+			a.logger.Error("Error updating trip invitation", zap.Error(err))
+			return nil, err
+		}
+		return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_OK}, nil
+	}
+
+	member = types.TripMember{
+		TripID:    trip.ID,
+		UserID:    info.GetInviteeId(),
+		Role:      role,
+		Status:    types.TripMemberPending,
+		InvitedBy: user.ID,
+	}
+	if err := a.db.Create(&member).Error; err != nil {
+		a.logger.Error("Error creating trip invitation", zap.Error(err))
+		return nil, err
+	}
+	a.publishTripInvitation(ctx, pb_nfp.PublishTripInvitationRequest_INVITED, user.ID, member.UserID, trip.ID)
+
+	return &pb_aap.InviteTripMemberResponse{Status: pb_aap.InviteTripMemberResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RespondTripInvitation(ctx context.Context, info *pb_aap.RespondTripInvitationRequest) (*pb_aap.RespondTripInvitationResponse, error) {
+	a.logger.Debug("start responding to trip invitation",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("trip_id", info.GetTripId()),
+		zap.Bool("accept", info.GetAccept()))
+	defer a.logger.Debug("end responding to trip invitation")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RespondTripInvitationResponse{Status: pb_aap.RespondTripInvitationResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.RespondTripInvitationResponse{Status: pb_aap.RespondTripInvitationResponse_INVITATION_NOT_FOUND}, nil
+	}
+	exist, member := a.findTripMember(trip.ID, user.ID)
+	if !exist || member.Status != types.TripMemberPending {
+		return &pb_aap.RespondTripInvitationResponse{Status: pb_aap.RespondTripInvitationResponse_INVITATION_NOT_FOUND}, nil
+	}
+
+	// Declined invitations are deleted so the owner can invite the user again
+	if !info.GetAccept() {
+		if err := a.db.Delete(&member).Error; err != nil {
+			a.logger.Error("Error declining trip invitation", zap.Error(err))
+			return nil, err
+		}
+		return &pb_aap.RespondTripInvitationResponse{Status: pb_aap.RespondTripInvitationResponse_OK}, nil
+	}
+
+	if err := a.db.Model(&member).Update("status", types.TripMemberAccepted).Error; err != nil {
+		a.logger.Error("Error accepting trip invitation", zap.Error(err))
+		return nil, err
+	}
+	a.publishTripInvitation(ctx, pb_nfp.PublishTripInvitationRequest_ACCEPTED, user.ID, trip.UserID, trip.ID)
+
+	return &pb_aap.RespondTripInvitationResponse{Status: pb_aap.RespondTripInvitationResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetTripInvitations(ctx context.Context, info *pb_aap.GetTripInvitationsRequest) (*pb_aap.GetTripInvitationsResponse, error) {
+	a.logger.Debug("start getting trip invitations", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end getting trip invitations")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetTripInvitationsResponse{Status: pb_aap.GetTripInvitationsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var rows []struct {
+		TripID    int64
+		Title     string
+		InvitedBy int64
+		Role      string
+		CreatedAt time.Time
+	}
+	err := a.db.Model(&types.TripMember{}).
+		Select("trip_members.trip_id, trips.title, trip_members.invited_by, trip_members.role, trip_members.created_at").
+		Joins("JOIN trips ON trips.id = trip_members.trip_id AND trips.deleted_at IS NULL").
+		Where("trip_members.user_id = ? AND trip_members.status = ?", info.GetUserId(), types.TripMemberPending).
+		Order("trip_members.created_at DESC, trip_members.trip_id DESC").
+		Scan(&rows).Error
+	if err != nil {
+		a.logger.Error("Error getting trip invitations", zap.Error(err))
+		return nil, err
+	}
+
+	invitations := make([]*pb_aap.TripInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, &pb_aap.TripInvitation{
+			TripId:    row.TripID,
+			Title:     row.Title,
+			InvitedBy: row.InvitedBy,
+			Role:      tripRoleToProto(row.Role),
+			CreatedAt: timestamppb.New(row.CreatedAt),
+		})
+	}
+	return &pb_aap.GetTripInvitationsResponse{
+		Status:      pb_aap.GetTripInvitationsResponse_OK,
+		Invitations: invitations,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) UpdateTripMember(ctx context.Context, info *pb_aap.UpdateTripMemberRequest) (*pb_aap.UpdateTripMemberResponse, error) {
+	a.logger.Debug("start updating trip member",
+		zap.Int64("trip_id", info.GetTripId()),
+		zap.Int64("member_id", info.GetMemberId()))
+	defer a.logger.Debug("end updating trip member")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_TRIP_NOT_FOUND}, nil
+	}
+	if trip.UserID != user.ID {
+		return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_NOT_ALLOWED}, nil
+	}
+	role, ok := tripRoleToModel(info.GetRole())
+	if !ok {
+		return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_INVALID_ROLE}, nil
+	}
+	exist, member := a.findTripMember(trip.ID, info.GetMemberId())
+	if !exist {
+		return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_MEMBER_NOT_FOUND}, nil
+	}
+
+	if err := a.db.Model(&member).Update("role", role).Error; err != nil {
+		a.logger.Error("Error updating trip member", zap.Error(err))
+		return nil, err
+	}
+	return &pb_aap.UpdateTripMemberResponse{Status: pb_aap.UpdateTripMemberResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RemoveTripMember(ctx context.Context, info *pb_aap.RemoveTripMemberRequest) (*pb_aap.RemoveTripMemberResponse, error) {
+	a.logger.Debug("start removing trip member",
+		zap.Int64("trip_id", info.GetTripId()),
+		zap.Int64("member_id", info.GetMemberId()))
+	defer a.logger.Debug("end removing trip member")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RemoveTripMemberResponse{Status: pb_aap.RemoveTripMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.RemoveTripMemberResponse{Status: pb_aap.RemoveTripMemberResponse_TRIP_NOT_FOUND}, nil
+	}
+	if trip.UserID != user.ID && info.GetMemberId() != user.ID {
+		return &pb_aap.RemoveTripMemberResponse{Status: pb_aap.RemoveTripMemberResponse_NOT_ALLOWED}, nil
+	}
+	exist, member := a.findTripMember(trip.ID, info.GetMemberId())
+	if !exist {
+		return &pb_aap.RemoveTripMemberResponse{Status: pb_aap.RemoveTripMemberResponse_MEMBER_NOT_FOUND}, nil
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&member).Error; err != nil {
+			return err
+		}
+		// Posts in the trash leave the trip too so restoring them does not add them back
+		return tx.Unscoped().Model(&types.Post{}).
+			Where("trip_id = ? AND user_id = ?", trip.ID, member.UserID).
+			Update("trip_id", nil).Error
+	})
+	if err != nil {
+		a.logger.Error("Error removing trip member", zap.Error(err))
+		return nil, err
+	}
+	return &pb_aap.RemoveTripMemberResponse{Status: pb_aap.RemoveTripMemberResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RemoveTripPost(ctx context.Context, info *pb_aap.RemoveTripPostRequest) (*pb_aap.RemoveTripPostResponse, error) {
+	a.logger.Debug("start removing trip post",
+		zap.Int64("trip_id", info.GetTripId()),
+		zap.Int64("post_id", info.GetPostId()))
+	defer a.logger.Debug("end removing trip post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RemoveTripPostResponse{Status: pb_aap.RemoveTripPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.RemoveTripPostResponse{Status: pb_aap.RemoveTripPostResponse_TRIP_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist || post.TripID == nil || *post.TripID != trip.ID {
+		return &pb_aap.RemoveTripPostResponse{Status: pb_aap.RemoveTripPostResponse_POST_NOT_FOUND}, nil
+	}
+	if role := a.tripRole(user.ID, trip); post.UserID != user.ID && role != types.TripRoleOwner && role != types.TripRoleEditor {
+		return &pb_aap.RemoveTripPostResponse{Status: pb_aap.RemoveTripPostResponse_NOT_ALLOWED}, nil
+	}
+
+	// Leaving a trip does not change the content of the post, so no revision is recorded
+	if err := a.db.Model(&post).Update("trip_id", nil).Error; err != nil {
+		a.logger.Error("Error removing trip post", zap.Error(err))
+		return nil, err
+	}
+	return &pb_aap.RemoveTripPostResponse{Status: pb_aap.RemoveTripPostResponse_OK}, nil
+}
@@ -0,0 +1,354 @@
+package authpost
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxTripTitleLength and maxTripCoverImageLength match the sizes of trips.title and trips.cover_image
+	maxTripTitleLength      = 200
+	maxTripCoverImageLength = 1000
+	// maxTripPlaces bounds the number of places of a trip
+	maxTripPlaces = 100
+)
+
+// tripDate truncates a timestamp to its UTC day, the precision of trips.start_date and trips.end_date
+func tripDate(ts *timestamppb.Timestamp) time.Time {
+	t := ts.AsTime().UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// validTrip checks the title, dates and cover image of a trip
+func validTrip(trip types.Trip) bool {
+	return trip.Title != "" && utf8.RuneCountInString(trip.Title) <= maxTripTitleLength &&
+		(trip.EndDate == nil || !trip.EndDate.Before(trip.StartDate)) &&
+		utf8.RuneCountInString(trip.CoverImage) <= maxTripCoverImageLength
+}
+
+// findTrip checks if a trip exists in database
+func (a *AuthenticateAndPostService) findTrip(tripId int64) (exist bool, trip types.Trip) {
+	result := a.db.First(&trip, tripId)
+	if result.Error != nil {
+		return false, types.Trip{}
+	}
+	return true, trip
+}
+
+// tripRole returns the role of a user in a trip, empty when the user is neither the owner nor an accepted member
+func (a *AuthenticateAndPostService) tripRole(userId int64, trip types.Trip) string {
+	if userId <= 0 {
+		return ""
+	}
+	if userId == trip.UserID {
+		return types.TripRoleOwner
+	}
+
+	var member types.TripMember
+	err := a.db.Where("trip_id = ? AND user_id = ? AND status = ?", trip.ID, userId, types.TripMemberAccepted).
+		First(&member).Error
+	if err != nil {
+		return ""
+	}
+	return member.Role
+}
+
+// canViewTrip checks if the viewer is allowed to read the trip. Drafts are only visible to their owner
+// and members, published trips follow the same rules as posts.
+func (a *AuthenticateAndPostService) canViewTrip(viewerId int64, trip types.Trip) bool {
+	if a.tripRole(viewerId, trip) != "" {
+		return true
+	}
+	if trip.PublishedAt == nil {
+		return false
+	}
+	return a.canViewPost(viewerId, types.Post{UserID: trip.UserID, Visibility: trip.Visibility})
+}
+
+// sameTrip checks whether two posts are part of the same trip
+func sameTrip(a types.Post, b types.Post) bool {
+	if a.TripID == nil || b.TripID == nil {
+		return a.TripID == nil && b.TripID == nil
+	}
+	return *a.TripID == *b.TripID
+}
+
+// resolveTripPlaces resolves the requested places of a trip in order. Merged places resolve to the place
+// they were merged into and repeated places keep their first position. It returns false when a place does not exist.
+func (a *AuthenticateAndPostService) resolveTripPlaces(placeIds []int64) ([]int64, bool) {
+	resolved := make([]int64, 0, len(placeIds))
+	seen := make(map[int64]bool, len(placeIds))
+	for _, placeId := range placeIds {
+		exist, place := a.findPlace(placeId)
+		if !exist {
+			return nil, false
+		}
+		if !seen[place.ID] {
+			seen[place.ID] = true
+			resolved = append(resolved, place.ID)
+		}
+	}
+	return resolved, true
+}
+
+// replaceTripPlaces replaces the places of a trip by placeIds, in order
+func replaceTripPlaces(tx *gorm.DB, tripId int64, placeIds []int64) error {
+	if err := tx.Where("trip_id = ?", tripId).Delete(&types.TripPlace{}).Error; err != nil {
+		return err
+	}
+	if len(placeIds) == 0 {
+		return nil
+	}
+
+	tripPlaces := make([]types.TripPlace, 0, len(placeIds))
+	for i, placeId := range placeIds {
+		tripPlaces = append(tripPlaces, types.TripPlace{
+			TripID:   tripId,
+			PlaceID:  placeId,
+			Position: int32(i),
+		})
+	}
+	return tx.Create(&tripPlaces).Error
+}
+
+func (a *AuthenticateAndPostService) CreateTrip(ctx context.Context, info *pb_aap.CreateTripRequest) (*pb_aap.CreateTripResponse, error) {
+	a.logger.Debug("start creating trip", zap.Int64("user_id", info.GetUserId()))
+	defer a.logger.Debug("end creating trip")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateTripResponse{Status: pb_aap.CreateTripResponse_USER_NOT_FOUND}, nil
+	}
+
+	if info.GetStartDate() == nil || len(info.GetPlaceIds()) > maxTripPlaces {
+		return &pb_aap.CreateTripResponse{Status: pb_aap.CreateTripResponse_INVALID_TRIP}, nil
+	}
+	trip := types.Trip{
+		UserID:     info.GetUserId(),
+		Title:      strings.TrimSpace(info.GetTitle()),
+		StartDate:  tripDate(info.GetStartDate()),
+		CoverImage: strings.TrimSpace(info.GetCoverImage()),
+		Visibility: visibilityToModel(info.GetVisibility()),
+	}
+	if info.GetEndDate() != nil {
+		endDate := tripDate(info.GetEndDate())
+		trip.EndDate = &endDate
+	}
+	if !validTrip(trip) {
+		return &pb_aap.CreateTripResponse{Status: pb_aap.CreateTripResponse_INVALID_TRIP}, nil
+	}
+
+	placeIds, ok := a.resolveTripPlaces(info.GetPlaceIds())
+	if !ok {
+		return &pb_aap.CreateTripResponse{Status: pb_aap.CreateTripResponse_PLACE_NOT_FOUND}, nil
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&trip).Error; err != nil {
+			return err
+		}
+		return replaceTripPlaces(tx, trip.ID, placeIds)
+	})
+	if err != nil {
+		a.logger.Error("Error creating trip", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb_aap.CreateTripResponse{
+		Status: pb_aap.CreateTripResponse_OK,
+		TripId: trip.ID,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) EditTrip(ctx context.Context, info *pb_aap.EditTripRequest) (*pb_aap.EditTripResponse, error) {
+	a.logger.Debug("start editing trip", zap.Int64("user_id", info.GetUserId()), zap.Int64("trip_id", info.GetTripId()))
+	defer a.logger.Debug("end editing trip")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_TRIP_NOT_FOUND}, nil
+	}
+	if role := a.tripRole(user.ID, trip); role != types.TripRoleOwner && role != types.TripRoleEditor {
+		return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_NOT_ALLOWED}, nil
+	}
+
+	if info.Title != nil {
+		trip.Title = strings.TrimSpace(info.GetTitle())
+	}
+	if info.GetStartDate() != nil {
+		trip.StartDate = tripDate(info.GetStartDate())
+	}
+	if info.GetRemoveEndDate() {
+		trip.EndDate = nil
+	} else if info.GetEndDate() != nil {
+		endDate := tripDate(info.GetEndDate())
+		trip.EndDate = &endDate
+	}
+	if info.CoverImage != nil {
+		trip.CoverImage = strings.TrimSpace(info.GetCoverImage())
+	}
+	if info.Visibility != nil {
+		trip.Visibility = visibilityToModel(info.GetVisibility())
+	}
+	if !validTrip(trip) || len(info.GetPlaceIds()) > maxTripPlaces {
+		return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_INVALID_TRIP}, nil
+	}
+
+	var placeIds []int64
+	if info.GetUpdatePlaces() {
+		var ok bool
+		placeIds, ok = a.resolveTripPlaces(info.GetPlaceIds())
+		if !ok {
+			return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_PLACE_NOT_FOUND}, nil
+		}
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&trip).Error; err != nil {
+			return err
+		}
+		if !info.GetUpdatePlaces() {
+			return nil
+		}
+		return replaceTripPlaces(tx, trip.ID, placeIds)
+	})
+	if err != nil {
+		a.logger.Error("Error editing trip", zap.Error(err), zap.Int64("trip_id", trip.ID))
+		return nil, err
+	}
+
+	return &pb_aap.EditTripResponse{Status: pb_aap.EditTripResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) PublishTrip(ctx context.Context, info *pb_aap.PublishTripRequest) (*pb_aap.PublishTripResponse, error) {
+	a.logger.Debug("start publishing trip", zap.Int64("user_id", info.GetUserId()), zap.Int64("trip_id", info.GetTripId()))
+	defer a.logger.Debug("end publishing trip")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.PublishTripResponse{Status: pb_aap.PublishTripResponse_USER_NOT_FOUND}, nil
+	}
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist {
+		return &pb_aap.PublishTripResponse{Status: pb_aap.PublishTripResponse_TRIP_NOT_FOUND}, nil
+	}
+	if trip.UserID != user.ID {
+		return &pb_aap.PublishTripResponse{Status: pb_aap.PublishTripResponse_NOT_ALLOWED}, nil
+	}
+	if trip.PublishedAt != nil {
+		return &pb_aap.PublishTripResponse{Status: pb_aap.PublishTripResponse_OK}, nil
+	}
+
+	// Only the first publication sets published_at, so followers are announced a trip once
+	now := time.Now()
+	result := a.db.Model(&types.Trip{}).
+		Where("id = ? AND published_at IS NULL", trip.ID).
+		Update("published_at", now)
+	if result.Error != nil {
+		a.logger.Error("Error publishing trip", zap.Error(result.Error), zap.Int64("trip_id", trip.ID))
+		return nil, result.Error
+	}
+
+	// Private trips are never fanned out since no follower is allowed to read them
+	if result.RowsAffected > 0 && a.nfPubClient != nil && trip.Visibility != types.PostVisibilityPrivate {
+		_, err := a.nfPubClient.PublishTrip(ctx, &pb_nfp.PublishTripRequest{
+			UserId: trip.UserID,
+			TripId: trip.ID,
+		})
+		if err != nil {
+			a.logger.Error("Error publishing trip to newsfeed", zap.Error(err))
+			// Continue anyway, as the trip is published - async event can be retried
+		}
+	}
+
+	return &pb_aap.PublishTripResponse{Status: pb_aap.PublishTripResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetTrip(ctx context.Context, info *pb_aap.GetTripRequest) (*pb_aap.GetTripResponse, error) {
+	a.logger.Debug("start getting trip", zap.Int64("trip_id", info.GetTripId()))
+	defer a.logger.Debug("end getting trip")
+
+	// Trips the viewer cannot read are reported as not found so their existence is not disclosed
+	exist, trip := a.findTrip(info.GetTripId())
+	if !exist || !a.canViewTrip(info.GetViewerId(), trip) {
+		return &pb_aap.GetTripResponse{Status: pb_aap.GetTripResponse_TRIP_NOT_FOUND}, nil
+	}
+
+	var places []types.Place
+	err := a.db.Joins("JOIN trip_places ON trip_places.place_id = places.id").
+		Where("trip_places.trip_id = ?", trip.ID).
+		Order("trip_places.position").
+		Find(&places).Error
+	if err != nil {
+		a.logger.Error("Error getting trip places", zap.Error(err))
+		return nil, err
+	}
+
+	var posts []types.Post
+	err = a.db.Model(&types.Post{}).
+		Scopes(visiblePostsTo(info.GetViewerId())).
+		Select("posts.id, posts.user_id, posts.created_at, posts.place_id").
+		Where("posts.trip_id = ?", trip.ID).
+		Order("posts.created_at, posts.id").
+		Find(&posts).Error
+	if err != nil {
+		a.logger.Error("Error getting trip timeline", zap.Error(err))
+		return nil, err
+	}
+
+	result := &pb_aap.Trip{
+		TripId:     trip.ID,
+		UserId:     trip.UserID,
+		Title:      trip.Title,
+		StartDate:  timestamppb.New(trip.StartDate),
+		CoverImage: trip.CoverImage,
+		Visibility: visibilityToProto(trip.Visibility),
+		CreatedAt:  timestamppb.New(trip.CreatedAt),
+		Places:     make([]*pb_aap.Place, 0, len(places)),
+		Timeline:   make([]*pb_aap.TripPost, 0, len(posts)),
+	}
+	members, err := a.getTripMembers(trip, info.GetViewerId() > 0 && info.GetViewerId() == trip.UserID)
+	if err != nil {
+		a.logger.Error("Error getting trip members", zap.Error(err))
+		return nil, err
+	}
+	result.Members = members
+	if trip.EndDate != nil {
+		result.EndDate = timestamppb.New(*trip.EndDate)
+	}
+	if trip.PublishedAt != nil {
+		result.PublishedAt = timestamppb.New(*trip.PublishedAt)
+	}
+	for _, place := range places {
+		result.Places = append(result.Places, placeToProto(place))
+	}
+	for _, post := range posts {
+		tripPost := &pb_aap.TripPost{
+			PostId:    post.ID,
+			CreatedAt: timestamppb.New(post.CreatedAt),
+			UserId:    post.UserID,
+		}
+		if post.PlaceID != nil {
+			tripPost.PlaceId = *post.PlaceID
+		}
+		result.Timeline = append(result.Timeline, tripPost)
+	}
+
+	return &pb_aap.GetTripResponse{
+		Status: pb_aap.GetTripResponse_OK,
+		Trip:   result,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -24,6 +25,12 @@ const (
 	// Default pagination values
 	DefaultPageSize = 10
 	MaxPageSize     = 50
+
+	// TripFeedItemPrefix, ReviewFeedItemPrefix and RepostFeedItemPrefix prefix the trip, review and
+	// repost items of a newsfeed list, keep them in sync with the newsfeed publishing service
+	TripFeedItemPrefix   = "trip:"
+	ReviewFeedItemPrefix = "review:"
+	RepostFeedItemPrefix = "repost:"
 )
 
 type NewsfeedService struct {
@@ -104,8 +111,12 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, req *pb_nf.GetNewsf
 		pageSize = MaxPageSize
 	}
 
-	// Calculate offset for pagination
+	// Calculate offset for pagination, an explicit offset reads from any item
 	offset := int64((page - 1) * pageSize)
+	if req.GetOffset() > 0 {
+		offset = int64(req.GetOffset())
+		page = req.GetOffset()/pageSize + 1
+	}
 	limit := int64(pageSize)
 
 	// Create Redis key for the user's newsfeed
@@ -170,14 +181,31 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, req *pb_nf.GetNewsf
 		}, nil
 	}
 
-	// Convert string IDs to int64
+	// Convert string IDs to int64, trips are stored as "trip:<id>", reviews as "review:<id>"
+	// and reposts as "repost:<id>" where id is the post created for the repost
 	var postIdsInt64 []int64
+	var items []*pb_nf.NewsfeedItem
 	for _, idStr := range postIds {
-		if id, err := strconv.ParseInt(idStr, 10, 64); err == nil {
-			postIdsInt64 = append(postIdsInt64, id)
+		itemType := pb_nf.NewsfeedItem_POST
+		itemId := idStr
+		if strings.HasPrefix(idStr, TripFeedItemPrefix) {
+			itemType = pb_nf.NewsfeedItem_TRIP
+			itemId = strings.TrimPrefix(idStr, TripFeedItemPrefix)
+		} else if strings.HasPrefix(idStr, ReviewFeedItemPrefix) {
+			itemType = pb_nf.NewsfeedItem_REVIEW
+			itemId = strings.TrimPrefix(idStr, ReviewFeedItemPrefix)
+		} else if strings.HasPrefix(idStr, RepostFeedItemPrefix) {
+			itemType = pb_nf.NewsfeedItem_REPOST
+			itemId = strings.TrimPrefix(idStr, RepostFeedItemPrefix)
+		}
+		if id, err := strconv.ParseInt(itemId, 10, 64); err == nil {
+			if itemType == pb_nf.NewsfeedItem_POST {
+				postIdsInt64 = append(postIdsInt64, id)
+			}
+			items = append(items, &pb_nf.NewsfeedItem{Type: itemType, Id: id})
 		} else {
-			svc.logger.Warn("Invalid post ID in newsfeed",
-				zap.String("post_id", idStr),
+			svc.logger.Warn("Invalid item in newsfeed",
+				zap.String("item", idStr),
 				zap.Error(err))
 		}
 	}
@@ -185,6 +213,7 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, req *pb_nf.GetNewsf
 	svc.logger.Info("Retrieved newsfeed",
 		zap.Int64("user_id", userID),
 		zap.Int("post_count", len(postIdsInt64)),
+		zap.Int("item_count", len(items)),
 		zap.Int32("page", page),
 		zap.Int32("total_pages", totalPages))
 
@@ -194,6 +223,7 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, req *pb_nf.GetNewsf
 		TotalPages:  totalPages,
 		CurrentPage: page,
 		TotalItems:  int32(totalItems),
+		Items:       items,
 	}, nil
 }
 
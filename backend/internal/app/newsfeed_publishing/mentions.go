@@ -0,0 +1,136 @@
+package newsfeed_publishing_svc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+)
+
+// MaxNotificationsPerUser caps the notification list kept for each user
+const MaxNotificationsPerUser = 200
+
+// mentionMessage is the Kafka payload of a "mention" message
+type mentionMessage struct {
+	UserID           int64   `json:"user_id"`
+	MentionedUserIDs []int64 `json:"mentioned_user_ids"`
+	PostID           int64   `json:"post_id"`
+	CommentID        int64   `json:"comment_id,omitempty"`
+}
+
+// notification is an entry of the notifications:<user_id> list, newest first
+type notification struct {
+	Type      string `json:"type"`
+	UserID    int64  `json:"user_id"`
+	PostID    int64  `json:"post_id,omitempty"`
+	CommentID int64  `json:"comment_id,omitempty"`
+	TripID    int64  `json:"trip_id,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func (svc *NewsfeedPublishingService) PublishMention(ctx context.Context, info *pb_nfp.PublishMentionRequest) (*pb_nfp.PublishMentionResponse, error) {
+	svc.logger.Info("Publishing mention",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64s("mentioned_user_ids", info.GetMentionedUserIds()),
+		zap.Int64("post_id", info.GetPostId()),
+		zap.Int64("comment_id", info.GetCommentId()))
+
+	message := mentionMessage{
+		UserID:           info.GetUserId(),
+		MentionedUserIDs: info.GetMentionedUserIds(),
+		PostID:           info.GetPostId(),
+		CommentID:        info.GetCommentId(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing mention directly")
+		if err := svc.addMentionNotifications(message); err != nil {
+			svc.logger.Error("Failed to process mention directly", zap.Error(err))
+			return &pb_nfp.PublishMentionResponse{Status: pb_nfp.PublishMentionResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishMentionResponse{Status: pb_nfp.PublishMentionResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal mention data", zap.Error(err))
+		return &pb_nfp.PublishMentionResponse{Status: pb_nfp.PublishMentionResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "mention", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish mention to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addMentionNotifications(message); err != nil {
+			svc.logger.Error("Failed to process mention directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishMentionResponse{Status: pb_nfp.PublishMentionResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishMentionResponse{Status: pb_nfp.PublishMentionResponse_OK}, nil
+}
+
+// processMention handles mention events
+func (svc *NewsfeedPublishingService) processMention(value []byte) error {
+	var message mentionMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal mention message", zap.Error(err))
+		return err
+	}
+
+	return svc.addMentionNotifications(message)
+}
+
+// addMentionNotifications adds a mention notification to each mentioned user's notification list
+func (svc *NewsfeedPublishingService) addMentionNotifications(message mentionMessage) error {
+	if len(message.MentionedUserIDs) == 0 {
+		return nil
+	}
+
+	value, err := json.Marshal(notification{
+		Type:      "mention",
+		UserID:    message.UserID,
+		PostID:    message.PostID,
+		CommentID: message.CommentID,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return svc.addNotification(message.MentionedUserIDs, string(value))
+}
+
+// addNotification prepends a notification to the notification list of each user
+func (svc *NewsfeedPublishingService) addNotification(userIds []int64, value string) error {
+	if svc.redisPool != nil {
+		ctx := context.Background()
+		pipe := svc.redisPool.Client.Pipeline()
+		for _, id := range userIds {
+			key := "notifications:" + strconv.FormatInt(id, 10)
+			pipe.LPush(ctx, key, value)
+			pipe.LTrim(ctx, key, 0, MaxNotificationsPerUser-1)
+		}
+
+		if _, err := pipe.Exec(ctx); err == nil {
+			return nil
+		} else {
+			svc.logger.Error("Failed to add notifications in Redis, falling back to memory store", zap.Error(err))
+		}
+	}
+
+	svc.memoryStore.mu.Lock()
+	defer svc.memoryStore.mu.Unlock()
+	for _, id := range userIds {
+		key := "notifications:" + strconv.FormatInt(id, 10)
+		list := append([]string{value}, svc.memoryStore.notifications[key]...)
+		if len(list) > MaxNotificationsPerUser {
+			list = list[:MaxNotificationsPerUser]
+		}
+		svc.memoryStore.notifications[key] = list
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package newsfeed_publishing_svc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+)
+
+// RepostFeedItemPrefix prefixes the repost items of a newsfeed list, keep it in sync with the newsfeed service
+const RepostFeedItemPrefix = "repost:"
+
+// RepostedPostsKeyPrefix prefixes the posts reposted into the newsfeed of a user. It is a hash
+// from the id of each reposted post to the id of the repost which brought it to the newsfeed.
+const RepostedPostsKeyPrefix = "newsfeed_reposted:"
+
+// RepostedPostsExpirationTime is how long the reposted posts of a newsfeed are remembered after
+// the last repost, a post reposted again later may show up twice in the newsfeed
+const RepostedPostsExpirationTime = 30 * 24 * time.Hour
+
+// repostMessage is the Kafka payload of a "repost" message
+type repostMessage struct {
+	UserID         int64 `json:"user_id"`
+	RepostID       int64 `json:"repost_id"`
+	OriginalPostID int64 `json:"original_post_id"`
+}
+
+func (svc *NewsfeedPublishingService) PublishRepost(ctx context.Context, info *pb_nfp.PublishRepostRequest) (*pb_nfp.PublishRepostResponse, error) {
+	svc.logger.Info("Publishing repost",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("repost_id", info.GetRepostId()),
+		zap.Int64("original_post_id", info.GetOriginalPostId()))
+
+	message := repostMessage{
+		UserID:         info.GetUserId(),
+		RepostID:       info.GetRepostId(),
+		OriginalPostID: info.GetOriginalPostId(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing repost directly")
+		if err := svc.addRepostToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process repost directly", zap.Error(err))
+			return &pb_nfp.PublishRepostResponse{Status: pb_nfp.PublishRepostResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishRepostResponse{Status: pb_nfp.PublishRepostResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal repost data", zap.Error(err))
+		return &pb_nfp.PublishRepostResponse{Status: pb_nfp.PublishRepostResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "repost", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish repost to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addRepostToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process repost directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishRepostResponse{Status: pb_nfp.PublishRepostResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishRepostResponse{Status: pb_nfp.PublishRepostResponse_OK}, nil
+}
+
+// processRepost handles repost publication events
+func (svc *NewsfeedPublishingService) processRepost(value []byte) error {
+	var message repostMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal repost message", zap.Error(err))
+		return err
+	}
+
+	return svc.addRepostToFollowerFeeds(message)
+}
+
+// addRepostToFollowerFeeds adds the repost to the newsfeed of each of the reposter's followers
+// that does not show the original post yet
+func (svc *NewsfeedPublishingService) addRepostToFollowerFeeds(message repostMessage) error {
+	followers, err := svc.getFollowers(message.UserID)
+	if err != nil {
+		svc.logger.Error("Failed to get followers",
+			zap.Int64("user_id", message.UserID),
+			zap.Error(err))
+		return err
+	}
+
+	followers, err = svc.followersWithoutPost(followers, message.OriginalPostID, message.RepostID)
+	if err != nil {
+		return err
+	}
+
+	return svc.addItemToFollowerFeeds(followers, RepostFeedItemPrefix+strconv.FormatInt(message.RepostID, 10))
+}
+
+func (svc *NewsfeedPublishingService) UnpublishRepost(ctx context.Context, info *pb_nfp.UnpublishRepostRequest) (*pb_nfp.UnpublishRepostResponse, error) {
+	svc.logger.Info("Unpublishing repost",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("repost_id", info.GetRepostId()),
+		zap.Int64("original_post_id", info.GetOriginalPostId()))
+
+	message := repostMessage{
+		UserID:         info.GetUserId(),
+		RepostID:       info.GetRepostId(),
+		OriginalPostID: info.GetOriginalPostId(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing repost removal directly")
+		if err := svc.removeRepostFromFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process repost removal directly", zap.Error(err))
+			return &pb_nfp.UnpublishRepostResponse{Status: pb_nfp.UnpublishRepostResponse_FAILED}, err
+		}
+		return &pb_nfp.UnpublishRepostResponse{Status: pb_nfp.UnpublishRepostResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal repost data", zap.Error(err))
+		return &pb_nfp.UnpublishRepostResponse{Status: pb_nfp.UnpublishRepostResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "unrepost", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish repost removal to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.removeRepostFromFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process repost removal directly in fallback", zap.Error(err))
+			return &pb_nfp.UnpublishRepostResponse{Status: pb_nfp.UnpublishRepostResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.UnpublishRepostResponse{Status: pb_nfp.UnpublishRepostResponse_OK}, nil
+}
+
+// processUnrepost handles repost removal events
+func (svc *NewsfeedPublishingService) processUnrepost(value []byte) error {
+	var message repostMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal repost message", zap.Error(err))
+		return err
+	}
+
+	return svc.removeRepostFromFollowerFeeds(message)
+}
+
+// followersWithoutPost drops the followers whose newsfeed already has the post or a repost of it,
+// and remembers that repostID brings the post to the newsfeed of the others
+func (svc *NewsfeedPublishingService) followersWithoutPost(followerIds []string, postID int64, repostID int64) ([]string, error) {
+	if len(followerIds) == 0 {
+		return followerIds, nil
+	}
+	postIDStr := strconv.FormatInt(postID, 10)
+	repostIDStr := strconv.FormatInt(repostID, 10)
+
+	if svc.redisPool == nil {
+		svc.memoryStore.mu.Lock()
+		defer svc.memoryStore.mu.Unlock()
+
+		remaining := make([]string, 0, len(followerIds))
+		for _, id := range followerIds {
+			if svc.memoryStore.hasPost("newsfeed:"+id, postIDStr) {
+				continue
+			}
+			reposted := svc.memoryStore.repostedPosts(RepostedPostsKeyPrefix + id)
+			if _, found := reposted[postIDStr]; found {
+				continue
+			}
+			reposted[postIDStr] = repostIDStr
+			svc.memoryStore.expirations[RepostedPostsKeyPrefix+id] = time.Now().Add(RepostedPostsExpirationTime)
+			remaining = append(remaining, id)
+		}
+		return remaining, nil
+	}
+
+	ctx := context.Background()
+	pipe := svc.redisPool.Client.Pipeline()
+	positions := make([]*redis.IntCmd, len(followerIds))
+	added := make([]*redis.BoolCmd, len(followerIds))
+	for i, id := range followerIds {
+		key := RepostedPostsKeyPrefix + id
+		positions[i] = pipe.LPos(ctx, "newsfeed:"+id, postIDStr, redis.LPosArgs{})
+		added[i] = pipe.HSetNX(ctx, key, postIDStr, repostIDStr)
+		pipe.Expire(ctx, key, RepostedPostsExpirationTime)
+	}
+	// LPOS reports a post missing from a feed as redis.Nil, which Exec returns as well
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		svc.logger.Error("Failed to check follower feeds for the reposted post", zap.Error(err))
+		return nil, err
+	}
+
+	remaining := make([]string, 0, len(followerIds))
+	for i, id := range followerIds {
+		if errors.Is(positions[i].Err(), redis.Nil) && added[i].Val() {
+			remaining = append(remaining, id)
+		}
+	}
+
+	svc.logger.Debug("Filtered followers already showing the reposted post",
+		zap.Int64("post_id", postID),
+		zap.Int("follower_count", len(followerIds)),
+		zap.Int("remaining", len(remaining)))
+	return remaining, nil
+}
+
+// removeRepostFromFollowerFeeds removes the repost from the newsfeed of each of the reposter's followers
+// and forgets the original post where the repost brought it, so another repost can bring it again
+func (svc *NewsfeedPublishingService) removeRepostFromFollowerFeeds(message repostMessage) error {
+	followers, err := svc.getFollowers(message.UserID)
+	if err != nil {
+		svc.logger.Error("Failed to get followers",
+			zap.Int64("user_id", message.UserID),
+			zap.Error(err))
+		return err
+	}
+	if len(followers) == 0 {
+		return nil
+	}
+
+	item := RepostFeedItemPrefix + strconv.FormatInt(message.RepostID, 10)
+	postIDStr := strconv.FormatInt(message.OriginalPostID, 10)
+	repostIDStr := strconv.FormatInt(message.RepostID, 10)
+
+	if svc.redisPool == nil {
+		svc.memoryStore.mu.Lock()
+		defer svc.memoryStore.mu.Unlock()
+
+		for _, id := range followers {
+			svc.memoryStore.removePost("newsfeed:"+id, item)
+			reposted := svc.memoryStore.repostedPosts(RepostedPostsKeyPrefix + id)
+			if reposted[postIDStr] == repostIDStr {
+				delete(reposted, postIDStr)
+			}
+		}
+		return nil
+	}
+
+	ctx := context.Background()
+	pipe := svc.redisPool.Client.Pipeline()
+	bringers := make([]*redis.StringCmd, len(followers))
+	for i, id := range followers {
+		pipe.LRem(ctx, "newsfeed:"+id, 0, item)
+		bringers[i] = pipe.HGet(ctx, RepostedPostsKeyPrefix+id, postIDStr)
+	}
+	// HGET reports a post that was not reposted to a feed as redis.Nil, which Exec returns as well
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		svc.logger.Error("Failed to remove the repost from follower feeds", zap.Error(err))
+		return err
+	}
+
+	// Another repost may have brought the post to some of the feeds, those keep it
+	pipe = svc.redisPool.Client.Pipeline()
+	for i, id := range followers {
+		if bringers[i].Val() == repostIDStr {
+			pipe.HDel(ctx, RepostedPostsKeyPrefix+id, postIDStr)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		svc.logger.Error("Failed to forget the reposted post in follower feeds", zap.Error(err))
+		return err
+	}
+	return nil
+}
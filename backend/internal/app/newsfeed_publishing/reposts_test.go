@@ -0,0 +1,59 @@
+package newsfeed_publishing_svc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestRepostsInMemory(t *testing.T) {
+	svc := &NewsfeedPublishingService{memoryStore: NewMemoryStore(), logger: zap.NewNop()}
+	store := svc.memoryStore
+	// alice (1) is followed by bob (2) and carol (3), dave (4) by bob; carol follows the author of post 100
+	for key, followers := range map[string][]string{"followers:1": {"2", "3"}, "followers:4": {"2"}} {
+		store.followers[key] = followers
+		store.expirations[key] = time.Now().Add(FollowerCacheExpirationTime)
+	}
+	store.newsfeeds["newsfeed:3"] = []string{"100"}
+
+	repost := func(userID int64, repostID int64) {
+		t.Helper()
+		if err := svc.addRepostToFollowerFeeds(repostMessage{UserID: userID, RepostID: repostID, OriginalPostID: 100}); err != nil {
+			t.Fatalf("addRepostToFollowerFeeds failed: %v", err)
+		}
+	}
+	unrepost := func(userID int64, repostID int64) {
+		t.Helper()
+		if err := svc.removeRepostFromFollowerFeeds(repostMessage{UserID: userID, RepostID: repostID, OriginalPostID: 100}); err != nil {
+			t.Fatalf("removeRepostFromFollowerFeeds failed: %v", err)
+		}
+	}
+	expectFeed := func(followerID string, want ...string) {
+		t.Helper()
+		if got := store.newsfeeds["newsfeed:"+followerID]; !reflect.DeepEqual(got, want) && len(got)+len(want) > 0 {
+			t.Errorf("Expected the newsfeed of %s to be %v, got %v", followerID, want, got)
+		}
+	}
+
+	// Only the followers without the post get the repost, once
+	repost(1, 500)
+	repost(4, 501)
+	expectFeed("2", "repost:500")
+	expectFeed("3", "100")
+
+	// Undoing the repost takes it back and lets another repost bring the post
+	unrepost(1, 500)
+	expectFeed("2")
+	repost(4, 501)
+	expectFeed("2", "repost:501")
+
+	// A repost restored from the trash is skipped where another repost brought the post,
+	// and undoing it again leaves the other repost in place
+	repost(1, 500)
+	unrepost(1, 500)
+	repost(1, 502)
+	expectFeed("2", "repost:501")
+	expectFeed("3", "100")
+}
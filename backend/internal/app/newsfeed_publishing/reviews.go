@@ -0,0 +1,81 @@
+package newsfeed_publishing_svc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+)
+
+// ReviewFeedItemPrefix prefixes the review items of a newsfeed list, keep it in sync with the newsfeed service
+const ReviewFeedItemPrefix = "review:"
+
+// reviewMessage is the Kafka payload of a "review" message
+type reviewMessage struct {
+	UserID   int64 `json:"user_id"`
+	ReviewID int64 `json:"review_id"`
+}
+
+func (svc *NewsfeedPublishingService) PublishReview(ctx context.Context, info *pb_nfp.PublishReviewRequest) (*pb_nfp.PublishReviewResponse, error) {
+	svc.logger.Info("Publishing review",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("review_id", info.GetReviewId()))
+
+	message := reviewMessage{
+		UserID:   info.GetUserId(),
+		ReviewID: info.GetReviewId(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing review directly")
+		if err := svc.addReviewToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process review directly", zap.Error(err))
+			return &pb_nfp.PublishReviewResponse{Status: pb_nfp.PublishReviewResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishReviewResponse{Status: pb_nfp.PublishReviewResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal review data", zap.Error(err))
+		return &pb_nfp.PublishReviewResponse{Status: pb_nfp.PublishReviewResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "review", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish review to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addReviewToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process review directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishReviewResponse{Status: pb_nfp.PublishReviewResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishReviewResponse{Status: pb_nfp.PublishReviewResponse_OK}, nil
+}
+
+// processReview handles review publication events
+func (svc *NewsfeedPublishingService) processReview(value []byte) error {
+	var message reviewMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal review message", zap.Error(err))
+		return err
+	}
+
+	return svc.addReviewToFollowerFeeds(message)
+}
+
+// addReviewToFollowerFeeds adds the review to the newsfeed of each of the reviewer's followers
+func (svc *NewsfeedPublishingService) addReviewToFollowerFeeds(message reviewMessage) error {
+	followers, err := svc.getFollowers(message.UserID)
+	if err != nil {
+		svc.logger.Error("Failed to get followers",
+			zap.Int64("user_id", message.UserID),
+			zap.Error(err))
+		return err
+	}
+
+	return svc.addItemToFollowerFeeds(followers, ReviewFeedItemPrefix+strconv.FormatInt(message.ReviewID, 10))
+}
@@ -40,20 +40,58 @@ const (
 
 // MemoryStore is a simple in-memory fallback for Redis
 type MemoryStore struct {
-	mu          sync.RWMutex
-	followers   map[string][]string
-	newsfeeds   map[string][]string
-	expirations map[string]time.Time
+	mu            sync.RWMutex
+	followers     map[string][]string
+	newsfeeds     map[string][]string
+	notifications map[string][]string
+	reposts       map[string]map[string]string
+	expirations   map[string]time.Time
 }
 
 func NewMemoryStore() *MemoryStore {
 	return &MemoryStore{
-		followers:   make(map[string][]string),
-		newsfeeds:   make(map[string][]string),
-		expirations: make(map[string]time.Time),
+		followers:     make(map[string][]string),
+		newsfeeds:     make(map[string][]string),
+		notifications: make(map[string][]string),
+		reposts:       make(map[string]map[string]string),
+		expirations:   make(map[string]time.Time),
 	}
 }
 
+// hasPost reports whether the newsfeed has the item, the caller holds the lock
+func (m *MemoryStore) hasPost(newsfeedKey string, item string) bool {
+	for _, existing := range m.newsfeeds[newsfeedKey] {
+		if existing == item {
+			return true
+		}
+	}
+	return false
+}
+
+// removePost removes the item from the newsfeed, the caller holds the lock
+func (m *MemoryStore) removePost(newsfeedKey string, item string) {
+	feed := m.newsfeeds[newsfeedKey][:0]
+	for _, existing := range m.newsfeeds[newsfeedKey] {
+		if existing != item {
+			feed = append(feed, existing)
+		}
+	}
+	m.newsfeeds[newsfeedKey] = feed
+}
+
+// repostedPosts returns the reposted posts of a newsfeed, like the Redis hash it is emptied
+// once expired. The caller holds the write lock.
+func (m *MemoryStore) repostedPosts(key string) map[string]string {
+	if expiration, ok := m.expirations[key]; ok && time.Now().After(expiration) {
+		delete(m.reposts, key)
+		delete(m.expirations, key)
+	}
+	if _, ok := m.reposts[key]; !ok {
+		m.reposts[key] = make(map[string]string)
+	}
+	return m.reposts[key]
+}
+
 type NewsfeedPublishingService struct {
 	pb_nfp.UnimplementedNewsfeedPublishingServer
 	kafkaWriter               *kafka.Writer
@@ -257,12 +295,32 @@ func (svc *NewsfeedPublishingService) PublishPost(ctx context.Context, info *pb_
 		}, err
 	}
 
-	// Implement retry logic for writing to Kafka
+	writeErr := svc.writeMessage(ctx, "post", jsonValue)
+	if writeErr != nil {
+		svc.logger.Error("Failed to publish post to Kafka after retries", zap.Error(writeErr))
+		// Fall back to direct processing if Kafka fails
+		svc.logger.Info("Falling back to direct processing after Kafka failure")
+		err = svc.processPostDirect(info.GetUserId(), info.GetPostId())
+		if err != nil {
+			svc.logger.Error("Failed to process post directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishPostResponse{
+				Status: pb_nfp.PublishPostResponse_FAILED,
+			}, err
+		}
+	}
+
+	return &pb_nfp.PublishPostResponse{
+		Status: pb_nfp.PublishPostResponse_OK,
+	}, nil
+}
+
+// writeMessage writes a message to Kafka, retrying with exponential backoff
+func (svc *NewsfeedPublishingService) writeMessage(ctx context.Context, key string, value []byte) error {
 	var writeErr error
 	for attempt := 1; attempt <= MaxRetryAttempts; attempt++ {
 		writeErr = svc.kafkaWriter.WriteMessages(ctx, kafka.Message{
-			Key:   []byte("post"),
-			Value: jsonValue,
+			Key:   []byte(key),
+			Value: value,
 		})
 
 		if writeErr == nil {
@@ -272,6 +330,7 @@ func (svc *NewsfeedPublishingService) PublishPost(ctx context.Context, info *pb_
 
 		// Log the error and retry
 		svc.logger.Warn("Kafka write attempt failed",
+			zap.String("key", key),
 			zap.Int("attempt", attempt),
 			zap.Error(writeErr))
 
@@ -285,23 +344,7 @@ func (svc *NewsfeedPublishingService) PublishPost(ctx context.Context, info *pb_
 		backoffMs := BaseRetryDelayMs * (1 << (attempt - 1))
 		time.Sleep(time.Duration(backoffMs) * time.Millisecond)
 	}
-
-	if writeErr != nil {
-		svc.logger.Error("Failed to publish post to Kafka after retries", zap.Error(writeErr))
-		// Fall back to direct processing if Kafka fails
-		svc.logger.Info("Falling back to direct processing after Kafka failure")
-		err = svc.processPostDirect(info.GetUserId(), info.GetPostId())
-		if err != nil {
-			svc.logger.Error("Failed to process post directly in fallback", zap.Error(err))
-			return &pb_nfp.PublishPostResponse{
-				Status: pb_nfp.PublishPostResponse_FAILED,
-			}, err
-		}
-	}
-
-	return &pb_nfp.PublishPostResponse{
-		Status: pb_nfp.PublishPostResponse_OK,
-	}, nil
+	return writeErr
 }
 
 // processPostDirect is a fallback method that processes posts directly without Kafka
@@ -393,6 +436,20 @@ func (svc *NewsfeedPublishingService) processMessage(message kafka.Message) erro
 	// Process message based on its key
 	if msgType == "post" {
 		return svc.processPost(message.Value)
+	} else if msgType == "mention" {
+		return svc.processMention(message.Value)
+	} else if msgType == "trip" {
+		return svc.processTrip(message.Value)
+	} else if msgType == "trip_invitation" {
+		return svc.processTripInvitation(message.Value)
+	} else if msgType == "review" {
+		return svc.processReview(message.Value)
+	} else if msgType == "repost" {
+		return svc.processRepost(message.Value)
+	} else if msgType == "unrepost" {
+		return svc.processUnrepost(message.Value)
+	} else if msgType == "story" {
+		return svc.processStory(message.Value)
 	}
 
 	svc.logger.Warn("Unknown message type", zap.String("type", msgType))
@@ -644,13 +701,17 @@ func (svc *NewsfeedPublishingService) fetchFollowersFromAPI(ctx context.Context,
 
 // addPostToFollowerFeeds adds the post to each follower's newsfeed
 func (svc *NewsfeedPublishingService) addPostToFollowerFeeds(followerIds []string, postID int64) error {
+	return svc.addItemToFollowerFeeds(followerIds, strconv.FormatInt(postID, 10))
+}
+
+// addItemToFollowerFeeds adds a newsfeed item to each follower's newsfeed.
+// Posts are stored as their id, other items as "<type>:<id>".
+func (svc *NewsfeedPublishingService) addItemToFollowerFeeds(followerIds []string, item string) error {
 	if len(followerIds) == 0 {
 		svc.logger.Info("No followers to add post to")
 		return nil
 	}
 
-	postIDStr := strconv.FormatInt(postID, 10)
-
 	// Always try Redis first, regardless of redisAvailable flag
 	// This ensures compatibility with the newsfeed service which only reads from Redis
 	if svc.redisPool != nil {
@@ -663,9 +724,12 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeeds(followerIds []strin
 		// Use pipelining for better performance with large follower counts
 		pipe := svc.redisPool.Client.Pipeline()
 
+		// The post is removed first so publishing it again, e.g. after a restore
+		// from the trash, does not duplicate it in the feed
 		for _, id := range followerIds {
 			newsfeedKey := "newsfeed:" + id
-			pipe.RPush(ctx, newsfeedKey, postIDStr)
+			pipe.LRem(ctx, newsfeedKey, 0, item)
+			pipe.RPush(ctx, newsfeedKey, item)
 		}
 
 		// Execute the pipeline
@@ -673,10 +737,10 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeeds(followerIds []strin
 		if err != nil {
 			svc.logger.Error("Pipeline execution failed, trying individual updates", zap.Error(err))
 			// Fall back to individual updates
-			if err := svc.addPostToFollowerFeedsIndividually(ctx, followerIds, postIDStr); err != nil {
+			if err := svc.addPostToFollowerFeedsIndividually(ctx, followerIds, item); err != nil {
 				svc.logger.Error("Individual Redis updates also failed, falling back to memory store", zap.Error(err))
 				// Only use memory store if Redis is completely unreachable
-				return svc.addPostToFollowerFeedsInMemory(followerIds, postIDStr)
+				return svc.addPostToFollowerFeedsInMemory(followerIds, item)
 			}
 			return nil
 		}
@@ -685,7 +749,7 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeeds(followerIds []strin
 		for i, cmd := range cmds {
 			if cmd.Err() != nil {
 				svc.logger.Error("Failed to add post to follower feed",
-					zap.String("follower_id", followerIds[i]),
+					zap.String("follower_id", followerIds[i/2]),
 					zap.Error(cmd.Err()))
 				errCount++
 			}
@@ -705,7 +769,7 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeeds(followerIds []strin
 
 	// If no Redis pool is available, use memory store as last resort
 	svc.logger.Warn("No Redis pool available, using memory store (posts may not be visible in newsfeed service)")
-	return svc.addPostToFollowerFeedsInMemory(followerIds, postIDStr)
+	return svc.addPostToFollowerFeedsInMemory(followerIds, item)
 }
 
 // addPostToFollowerFeedsInMemory stores posts in memory as a fallback
@@ -722,7 +786,8 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeedsInMemory(followerIds
 			svc.memoryStore.newsfeeds[newsfeedKey] = make([]string, 0)
 		}
 
-		// Add post to newsfeed
+		// Add post to newsfeed, dropping an earlier copy of it
+		svc.memoryStore.removePost(newsfeedKey, postIDStr)
 		svc.memoryStore.newsfeeds[newsfeedKey] = append(svc.memoryStore.newsfeeds[newsfeedKey], postIDStr)
 	}
 	svc.memoryStore.mu.Unlock()
@@ -743,7 +808,10 @@ func (svc *NewsfeedPublishingService) addPostToFollowerFeedsIndividually(ctx con
 		// Try with retry
 		var redisErr error
 		for attempt := 1; attempt <= MaxRetryAttempts; attempt++ {
-			_, redisErr = svc.redisPool.Client.RPush(ctx, newsfeedKey, postIDStr).Result()
+			redisErr = svc.redisPool.Client.LRem(ctx, newsfeedKey, 0, postIDStr).Err()
+			if redisErr == nil {
+				_, redisErr = svc.redisPool.Client.RPush(ctx, newsfeedKey, postIDStr).Result()
+			}
 
 			if redisErr == nil {
 				break
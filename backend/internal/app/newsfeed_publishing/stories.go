@@ -0,0 +1,121 @@
+package newsfeed_publishing_svc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+)
+
+// StoryTrayKeyPrefix prefixes the story tray of a user, keep it in sync with the authpost service.
+// A tray is a sorted set of the followed users with stories, scored by the creation time of
+// their latest story.
+const StoryTrayKeyPrefix = "story_tray:"
+
+// StoryTrayExpirationTime is how long a tray lives after its last story was added, the
+// lifetime of a story
+const StoryTrayExpirationTime = 24 * time.Hour
+
+// storyMessage is the Kafka payload of a "story" message
+type storyMessage struct {
+	UserID    int64 `json:"user_id"`
+	StoryID   int64 `json:"story_id"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+func (svc *NewsfeedPublishingService) PublishStory(ctx context.Context, info *pb_nfp.PublishStoryRequest) (*pb_nfp.PublishStoryResponse, error) {
+	svc.logger.Info("Publishing story",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("story_id", info.GetStoryId()))
+
+	message := storyMessage{
+		UserID:    info.GetUserId(),
+		StoryID:   info.GetStoryId(),
+		CreatedAt: info.GetCreatedAt(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing story directly")
+		if err := svc.addStoryToFollowerTrays(message); err != nil {
+			svc.logger.Error("Failed to process story directly", zap.Error(err))
+			return &pb_nfp.PublishStoryResponse{Status: pb_nfp.PublishStoryResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishStoryResponse{Status: pb_nfp.PublishStoryResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal story data", zap.Error(err))
+		return &pb_nfp.PublishStoryResponse{Status: pb_nfp.PublishStoryResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "story", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish story to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addStoryToFollowerTrays(message); err != nil {
+			svc.logger.Error("Failed to process story directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishStoryResponse{Status: pb_nfp.PublishStoryResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishStoryResponse{Status: pb_nfp.PublishStoryResponse_OK}, nil
+}
+
+// processStory handles story publication events
+func (svc *NewsfeedPublishingService) processStory(value []byte) error {
+	var message storyMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal story message", zap.Error(err))
+		return err
+	}
+
+	return svc.addStoryToFollowerTrays(message)
+}
+
+// addStoryToFollowerTrays moves the author of the story to the front of the story tray of each of
+// their followers. Trays only live in Redis, without it the authpost service reads the followed
+// users from the database instead.
+func (svc *NewsfeedPublishingService) addStoryToFollowerTrays(message storyMessage) error {
+	if svc.redisPool == nil {
+		svc.logger.Warn("No Redis pool available, skipping story trays",
+			zap.Int64("story_id", message.StoryID))
+		return nil
+	}
+
+	followers, err := svc.getFollowers(message.UserID)
+	if err != nil {
+		svc.logger.Error("Failed to get followers",
+			zap.Int64("user_id", message.UserID),
+			zap.Error(err))
+		return err
+	}
+	if len(followers) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	member := &redis.Z{
+		Score:  float64(message.CreatedAt),
+		Member: strconv.FormatInt(message.UserID, 10),
+	}
+	pipe := svc.redisPool.Client.Pipeline()
+	for _, id := range followers {
+		trayKey := StoryTrayKeyPrefix + id
+		pipe.ZAdd(ctx, trayKey, member)
+		pipe.Expire(ctx, trayKey, StoryTrayExpirationTime)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		svc.logger.Error("Failed to add story to follower trays", zap.Error(err))
+		return err
+	}
+
+	svc.logger.Debug("Added story to follower trays",
+		zap.Int64("story_id", message.StoryID),
+		zap.Int("follower_count", len(followers)))
+	return nil
+}
@@ -0,0 +1,167 @@
+package newsfeed_publishing_svc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	pb_nfp "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed_publishing"
+	"go.uber.org/zap"
+)
+
+// TripFeedItemPrefix prefixes the trip items of a newsfeed list, keep it in sync with the newsfeed service
+const TripFeedItemPrefix = "trip:"
+
+// Notification types of trip invitation events
+const (
+	notificationTripInvitation         = "trip_invitation"
+	notificationTripInvitationAccepted = "trip_invitation_accepted"
+)
+
+// tripInvitationMessage is the Kafka payload of a "trip_invitation" message
+type tripInvitationMessage struct {
+	Type        string `json:"type"`
+	UserID      int64  `json:"user_id"`
+	RecipientID int64  `json:"recipient_id"`
+	TripID      int64  `json:"trip_id"`
+}
+
+// tripMessage is the Kafka payload of a "trip" message
+type tripMessage struct {
+	UserID int64 `json:"user_id"`
+	TripID int64 `json:"trip_id"`
+}
+
+func (svc *NewsfeedPublishingService) PublishTrip(ctx context.Context, info *pb_nfp.PublishTripRequest) (*pb_nfp.PublishTripResponse, error) {
+	svc.logger.Info("Publishing trip",
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("trip_id", info.GetTripId()))
+
+	message := tripMessage{
+		UserID: info.GetUserId(),
+		TripID: info.GetTripId(),
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing trip directly")
+		if err := svc.addTripToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process trip directly", zap.Error(err))
+			return &pb_nfp.PublishTripResponse{Status: pb_nfp.PublishTripResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishTripResponse{Status: pb_nfp.PublishTripResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal trip data", zap.Error(err))
+		return &pb_nfp.PublishTripResponse{Status: pb_nfp.PublishTripResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "trip", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish trip to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addTripToFollowerFeeds(message); err != nil {
+			svc.logger.Error("Failed to process trip directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishTripResponse{Status: pb_nfp.PublishTripResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishTripResponse{Status: pb_nfp.PublishTripResponse_OK}, nil
+}
+
+// processTrip handles trip publication events
+func (svc *NewsfeedPublishingService) processTrip(value []byte) error {
+	var message tripMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal trip message", zap.Error(err))
+		return err
+	}
+
+	return svc.addTripToFollowerFeeds(message)
+}
+
+// addTripToFollowerFeeds adds the trip to the newsfeed of each of the owner's followers
+func (svc *NewsfeedPublishingService) addTripToFollowerFeeds(message tripMessage) error {
+	followers, err := svc.getFollowers(message.UserID)
+	if err != nil {
+		svc.logger.Error("Failed to get followers",
+			zap.Int64("user_id", message.UserID),
+			zap.Error(err))
+		return err
+	}
+
+	return svc.addItemToFollowerFeeds(followers, TripFeedItemPrefix+strconv.FormatInt(message.TripID, 10))
+}
+
+func (svc *NewsfeedPublishingService) PublishTripInvitation(ctx context.Context, info *pb_nfp.PublishTripInvitationRequest) (*pb_nfp.PublishTripInvitationResponse, error) {
+	svc.logger.Info("Publishing trip invitation",
+		zap.String("event", info.GetEvent().String()),
+		zap.Int64("user_id", info.GetUserId()),
+		zap.Int64("recipient_id", info.GetRecipientId()),
+		zap.Int64("trip_id", info.GetTripId()))
+
+	message := tripInvitationMessage{
+		Type:        notificationTripInvitation,
+		UserID:      info.GetUserId(),
+		RecipientID: info.GetRecipientId(),
+		TripID:      info.GetTripId(),
+	}
+	if info.GetEvent() == pb_nfp.PublishTripInvitationRequest_ACCEPTED {
+		message.Type = notificationTripInvitationAccepted
+	}
+
+	// If Kafka isn't available, skip it and process directly
+	if !svc.kafkaAvailable {
+		svc.logger.Info("Kafka unavailable, processing trip invitation directly")
+		if err := svc.addTripInvitationNotification(message); err != nil {
+			svc.logger.Error("Failed to process trip invitation directly", zap.Error(err))
+			return &pb_nfp.PublishTripInvitationResponse{Status: pb_nfp.PublishTripInvitationResponse_FAILED}, err
+		}
+		return &pb_nfp.PublishTripInvitationResponse{Status: pb_nfp.PublishTripInvitationResponse_OK}, nil
+	}
+
+	jsonValue, err := json.Marshal(message)
+	if err != nil {
+		svc.logger.Error("Failed to marshal trip invitation data", zap.Error(err))
+		return &pb_nfp.PublishTripInvitationResponse{Status: pb_nfp.PublishTripInvitationResponse_FAILED}, err
+	}
+
+	if err := svc.writeMessage(ctx, "trip_invitation", jsonValue); err != nil {
+		svc.logger.Error("Failed to publish trip invitation to Kafka after retries", zap.Error(err))
+		// Fall back to direct processing if Kafka fails
+		if err := svc.addTripInvitationNotification(message); err != nil {
+			svc.logger.Error("Failed to process trip invitation directly in fallback", zap.Error(err))
+			return &pb_nfp.PublishTripInvitationResponse{Status: pb_nfp.PublishTripInvitationResponse_FAILED}, err
+		}
+	}
+
+	return &pb_nfp.PublishTripInvitationResponse{Status: pb_nfp.PublishTripInvitationResponse_OK}, nil
+}
+
+// processTripInvitation handles trip invitation events
+func (svc *NewsfeedPublishingService) processTripInvitation(value []byte) error {
+	var message tripInvitationMessage
+	if err := json.Unmarshal(value, &message); err != nil {
+		svc.logger.Error("Failed to unmarshal trip invitation message", zap.Error(err))
+		return err
+	}
+
+	return svc.addTripInvitationNotification(message)
+}
+
+// addTripInvitationNotification adds a trip invitation notification to the recipient's notification list
+func (svc *NewsfeedPublishingService) addTripInvitationNotification(message tripInvitationMessage) error {
+	value, err := json.Marshal(notification{
+		Type:      message.Type,
+		UserID:    message.UserID,
+		TripID:    message.TripID,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return svc.addNotification([]int64{message.RecipientID}, string(value))
+}
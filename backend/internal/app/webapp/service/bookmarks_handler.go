@@ -0,0 +1,458 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// AddBookmark godoc
+// @Summary Save a post
+// @Description Save a post to the bookmarks of the current user, optionally into one of their collections. Saving a saved post again moves it to the requested collection. Bookmarks are private.
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param request body types.AddBookmarkRequest false "Collection to save the post into"
+// @Success 200 {object} types.MessageResponse "Post saved successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Post or collection not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/bookmark [post]
+// @Security ApiKeyAuth
+func (svc *WebService) AddBookmark(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Validate request, the body is optional
+	var jsonRequest types.AddBookmarkRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call AddBookmark service
+	resp, err := svc.AuthenticateAndPostClient.AddBookmark(ctx, &pb_aap.AddBookmarkRequest{
+		UserId:       int64(userId),
+		PostId:       postId,
+		CollectionId: jsonRequest.CollectionId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.AddBookmarkResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddBookmarkResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddBookmarkResponse_COLLECTION_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "collection not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddBookmarkResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RemoveBookmark godoc
+// @Summary Unsave a post
+// @Description Remove a post from the bookmarks of the current user, and from its collection
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Post removed from the bookmarks"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Post not bookmarked"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/bookmark [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) RemoveBookmark(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Call RemoveBookmark service
+	resp, err := svc.AuthenticateAndPostClient.RemoveBookmark(ctx, &pb_aap.RemoveBookmarkRequest{
+		UserId: int64(userId),
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RemoveBookmarkResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveBookmarkResponse_NOT_BOOKMARKED {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not bookmarked"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveBookmarkResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetBookmarks godoc
+// @Summary Get saved posts
+// @Description Get the posts saved by the current user, newest first. Posts that were deleted or that the user can no longer see are left out.
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param collection_id query int false "Only the posts of this collection"
+// @Param cursor query int false "next_cursor of the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} types.BookmarksResponse "Saved posts"
+// @Failure 400 {object} types.MessageResponse "Invalid collection ID, cursor or limit"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Collection not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/me/bookmarks [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetBookmarks(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check query params
+	collectionId, err := strconv.ParseInt(ctx.DefaultQuery("collection_id", "0"), 10, 64)
+	if err != nil || collectionId < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid collection_id"})
+		return
+	}
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil || cursor < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetBookmarks service
+	resp, err := svc.AuthenticateAndPostClient.GetBookmarks(ctx, &pb_aap.GetBookmarksRequest{
+		UserId:       int64(userId),
+		CollectionId: collectionId,
+		Cursor:       cursor,
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetBookmarksResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetBookmarksResponse_COLLECTION_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "collection not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetBookmarksResponse_OK {
+		bookmarks := make([]types.BookmarkResponse, 0, len(resp.GetBookmarks()))
+		for _, bookmark := range resp.GetBookmarks() {
+			bookmarks = append(bookmarks, types.BookmarkResponse{
+				PostId:       bookmark.GetPostId(),
+				CollectionId: bookmark.GetCollectionId(),
+				CreatedAt:    bookmark.GetCreatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.BookmarksResponse{
+			Bookmarks:  bookmarks,
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetBookmarkCollections godoc
+// @Summary Get bookmark collections
+// @Description Get the bookmark collections of the current user by name
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.BookmarkCollectionsResponse "Bookmark collections"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/me/collections [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetBookmarkCollections(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetBookmarkCollections service
+	resp, err := svc.AuthenticateAndPostClient.GetBookmarkCollections(ctx, &pb_aap.GetBookmarkCollectionsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetBookmarkCollectionsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetBookmarkCollectionsResponse_OK {
+		collections := make([]types.BookmarkCollectionResponse, 0, len(resp.GetCollections()))
+		for _, collection := range resp.GetCollections() {
+			collections = append(collections, types.BookmarkCollectionResponse{
+				CollectionId:   collection.GetCollectionId(),
+				Name:           collection.GetName(),
+				BookmarksCount: collection.GetBookmarksCount(),
+				CreatedAt:      collection.GetCreatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.BookmarkCollectionsResponse{Collections: collections})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// CreateBookmarkCollection godoc
+// @Summary Create a bookmark collection
+// @Description Create a private collection to organize saved posts. Names are unique per user, regardless of case.
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param request body types.BookmarkCollectionRequest true "Collection name"
+// @Success 200 {object} types.CreateBookmarkCollectionResponse "Collection created successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 409 {object} types.MessageResponse "A collection with this name already exists"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/me/collections [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreateBookmarkCollection(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.BookmarkCollectionRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CreateBookmarkCollection service
+	resp, err := svc.AuthenticateAndPostClient.CreateBookmarkCollection(ctx, &pb_aap.CreateBookmarkCollectionRequest{
+		UserId: int64(userId),
+		Name:   jsonRequest.Name,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateBookmarkCollectionResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateBookmarkCollectionResponse_INVALID_NAME {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid collection name"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateBookmarkCollectionResponse_ALREADY_EXISTS {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "collection already exists"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateBookmarkCollectionResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreateBookmarkCollectionResponse{
+			Message:      "OK",
+			CollectionId: resp.GetCollectionId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EditBookmarkCollection godoc
+// @Summary Rename a bookmark collection
+// @Description Rename a bookmark collection of the current user
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param collection_id path int true "Collection ID"
+// @Param request body types.BookmarkCollectionRequest true "New collection name"
+// @Success 200 {object} types.MessageResponse "Collection renamed successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Collection not found"
+// @Failure 409 {object} types.MessageResponse "A collection with this name already exists"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/me/collections/{collection_id} [put]
+// @Security ApiKeyAuth
+func (svc *WebService) EditBookmarkCollection(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	collectionId, err := strconv.ParseInt(ctx.Param("collection_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid collection_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.BookmarkCollectionRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call EditBookmarkCollection service
+	resp, err := svc.AuthenticateAndPostClient.EditBookmarkCollection(ctx, &pb_aap.EditBookmarkCollectionRequest{
+		UserId:       int64(userId),
+		CollectionId: collectionId,
+		Name:         jsonRequest.Name,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EditBookmarkCollectionResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditBookmarkCollectionResponse_COLLECTION_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "collection not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditBookmarkCollectionResponse_INVALID_NAME {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid collection name"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditBookmarkCollectionResponse_ALREADY_EXISTS {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "collection already exists"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditBookmarkCollectionResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteBookmarkCollection godoc
+// @Summary Delete a bookmark collection
+// @Description Delete a bookmark collection of the current user. Its posts stay saved, out of any collection.
+// @Tags bookmarks
+// @Accept json
+// @Produce json
+// @Param collection_id path int true "Collection ID"
+// @Success 200 {object} types.MessageResponse "Collection deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Collection not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/me/collections/{collection_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) DeleteBookmarkCollection(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	collectionId, err := strconv.ParseInt(ctx.Param("collection_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid collection_id"})
+		return
+	}
+
+	// Call DeleteBookmarkCollection service
+	resp, err := svc.AuthenticateAndPostClient.DeleteBookmarkCollection(ctx, &pb_aap.DeleteBookmarkCollectionRequest{
+		UserId:       int64(userId),
+		CollectionId: collectionId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteBookmarkCollectionResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteBookmarkCollectionResponse_COLLECTION_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "collection not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteBookmarkCollectionResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// CreateDraft godoc
+// @Summary Save a draft
+// @Description Save an unpublished post for the current user. Drafts are never shown to anyone else nor sent to newsfeeds, and the images they reference are kept until the draft is deleted or published.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Param request body types.CreateDraftRequest true "Draft content"
+// @Success 200 {object} types.CreateDraftResponse "Draft saved successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 409 {object} types.MessageResponse "Too many drafts"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/drafts [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreateDraft(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request, an empty draft can be saved
+	var jsonRequest types.CreateDraftRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CreateDraft service
+	resp, err := svc.AuthenticateAndPostClient.CreateDraft(ctx, &pb_aap.CreateDraftRequest{
+		UserId:        int64(userId),
+		ContentText:   jsonRequest.ContentText,
+		Media:         toPbMedia(jsonRequest.Media, jsonRequest.ContentImagePath),
+		Visibility:    toPbPostVisibility(jsonRequest.Visibility),
+		Location:      toPbLocation(jsonRequest.Location),
+		PlaceId:       jsonRequest.PlaceId,
+		TripId:        jsonRequest.TripId,
+		QuoteOfPostId: jsonRequest.QuoteOfPostId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateDraftResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_QUOTED_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "quoted post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_TOO_MANY_DRAFTS {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "too many drafts"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid media"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateDraftResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreateDraftResponse{
+			Message: "OK",
+			DraftId: resp.GetDraftId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetDrafts godoc
+// @Summary Get drafts
+// @Description List the current user's drafts, the last edited first
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.DraftsResponse "Drafts"
+// @Failure 400 {object} types.MessageResponse "User not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/drafts [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetDrafts(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetDrafts service
+	resp, err := svc.AuthenticateAndPostClient.GetDrafts(ctx, &pb_aap.GetDraftsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetDraftsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetDraftsResponse_OK {
+		drafts := make([]types.DraftResponse, 0, len(resp.GetDrafts()))
+		for _, draft := range resp.GetDrafts() {
+			drafts = append(drafts, types.DraftResponse{
+				DraftID:          draft.GetDraftId(),
+				ContentText:      draft.GetContentText(),
+				ContentImagePath: mediaURLs(draft.GetMedia()),
+				Media:            fromPbMedia(draft.GetMedia()),
+				Visibility:       fromPbPostVisibility(draft.GetVisibility()),
+				Location:         fromPbLocation(draft.GetLocation()),
+				PlaceId:          draft.GetPlaceId(),
+				TripId:           draft.GetTripId(),
+				QuoteOfPostId:    draft.GetQuoteOfPostId(),
+				CreatedAt:        draft.GetCreatedAt().AsTime().Format(time.RFC3339),
+				UpdatedAt:        draft.GetUpdatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.DraftsResponse{Drafts: drafts})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EditDraft godoc
+// @Summary Edit a draft
+// @Description Update the fields of a draft of the current user that are set in the request, an empty media list removes its media
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Param draft_id path int true "Draft ID"
+// @Param request body types.EditDraftRequest true "Draft edit parameters"
+// @Success 200 {object} types.MessageResponse "Draft updated successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Draft not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/drafts/{draft_id} [put]
+// @Security ApiKeyAuth
+func (svc *WebService) EditDraft(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	draftId, err := strconv.ParseInt(ctx.Param("draft_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid draft_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.EditDraftRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call EditDraft service
+	grpcReq := &pb_aap.EditDraftRequest{
+		UserId:         int64(userId),
+		DraftId:        draftId,
+		ContentText:    jsonRequest.ContentText,
+		Location:       toPbLocation(jsonRequest.Location),
+		RemoveLocation: jsonRequest.RemoveLocation,
+		PlaceId:        jsonRequest.PlaceId,
+		RemovePlace:    jsonRequest.RemovePlace,
+		TripId:         jsonRequest.TripId,
+		RemoveTrip:     jsonRequest.RemoveTrip,
+		QuoteOfPostId:  jsonRequest.QuoteOfPostId,
+		RemoveQuote:    jsonRequest.RemoveQuote,
+	}
+	grpcReq.Media = toPbMediaList(jsonRequest.Media, jsonRequest.ContentImagePath)
+	if jsonRequest.Visibility != nil {
+		grpcReq.Visibility = toPbPostVisibility(*jsonRequest.Visibility)
+	}
+
+	resp, err := svc.AuthenticateAndPostClient.EditDraft(ctx, grpcReq)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EditDraftResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_DRAFT_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "draft not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_QUOTED_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "quoted post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid media"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditDraftResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteDraft godoc
+// @Summary Delete a draft
+// @Description Delete a draft of the current user, its images are no longer kept for it
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Param draft_id path int true "Draft ID"
+// @Success 200 {object} types.MessageResponse "Draft deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Draft not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/drafts/{draft_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) DeleteDraft(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	draftId, err := strconv.ParseInt(ctx.Param("draft_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid draft_id"})
+		return
+	}
+
+	// Call DeleteDraft service
+	resp, err := svc.AuthenticateAndPostClient.DeleteDraft(ctx, &pb_aap.DeleteDraftRequest{
+		UserId:  int64(userId),
+		DraftId: draftId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteDraftResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteDraftResponse_DRAFT_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "draft not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteDraftResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// PublishDraft godoc
+// @Summary Publish a draft
+// @Description Turn a draft of the current user into a post, which is then sent to newsfeeds like any new post. Set publish_at, an RFC3339 time within a year, to schedule the post instead. The draft is removed once published.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Param draft_id path int true "Draft ID"
+// @Param request body types.PublishDraftRequest false "Publication time"
+// @Success 200 {object} types.CreatePostResponse "Draft published successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or empty draft"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Draft not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/drafts/{draft_id}/publish [post]
+// @Security ApiKeyAuth
+func (svc *WebService) PublishDraft(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	draftId, err := strconv.ParseInt(ctx.Param("draft_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid draft_id"})
+		return
+	}
+
+	// Validate request, the body is optional
+	var jsonRequest types.PublishDraftRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	var publishAt *timestamppb.Timestamp
+	if jsonRequest.PublishAt != "" {
+		parsed, err := time.Parse(time.RFC3339, jsonRequest.PublishAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid publish_at"})
+			return
+		}
+		publishAt = timestamppb.New(parsed)
+	}
+
+	// Call PublishDraft service
+	resp, err := svc.AuthenticateAndPostClient.PublishDraft(ctx, &pb_aap.PublishDraftRequest{
+		UserId:    int64(userId),
+		DraftId:   draftId,
+		PublishAt: publishAt,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.PublishDraftResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_DRAFT_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "draft not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_EMPTY_DRAFT {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "draft has no content"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_QUOTED_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "quoted post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_INVALID_PUBLISH_AT {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "publish_at must be in the future and within a year"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid media"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishDraftResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreatePostResponse{
+			Message: "OK",
+			PostId:  resp.GetPostId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
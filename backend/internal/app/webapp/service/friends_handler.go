@@ -202,7 +202,7 @@ func (svc *WebService) GetUserFollowings(ctx *gin.Context) {
 
 // GetUserPosts godoc
 // @Summary Get user posts
-// @Description Get all posts of a user
+// @Description Get the posts of a user that the current viewer is allowed to see
 // @Tags friends
 // @Accept json
 // @Produce json
@@ -221,7 +221,8 @@ func (svc *WebService) GetUserPosts(ctx *gin.Context) {
 
 	// Call GetUserPosts service
 	resp, err := svc.AuthenticateAndPostClient.GetUserPosts(ctx, &pb_aap.GetUserPostsRequest{
-		UserId: int64(userId),
+		UserId:   int64(userId),
+		ViewerId: svc.getViewerId(ctx),
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// GetHashtagInfo godoc
+// @Summary Get hashtag details
+// @Description Get the usage statistics of a hashtag, computed over public posts
+// @Tags hashtags
+// @Accept json
+// @Produce json
+// @Param tag path string true "Hashtag, with or without the leading #"
+// @Success 200 {object} types.HashtagInfoResponse "Hashtag details"
+// @Failure 404 {object} types.MessageResponse "Hashtag not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /hashtags/{tag} [get]
+func (svc *WebService) GetHashtagInfo(ctx *gin.Context) {
+	// Call GetHashtagInfo service
+	resp, err := svc.AuthenticateAndPostClient.GetHashtagInfo(ctx, &pb_aap.GetHashtagInfoRequest{
+		Tag: ctx.Param("tag"),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetHashtagInfoResponse_HASHTAG_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "hashtag not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetHashtagInfoResponse_OK {
+		ctx.JSON(http.StatusOK, types.HashtagInfoResponse{
+			Tag:             resp.GetHashtag().GetTag(),
+			PostCount:       resp.GetHashtag().GetPostCount(),
+			AuthorCount:     resp.GetHashtag().GetAuthorCount(),
+			RecentPostCount: resp.GetHashtag().GetRecentPostCount(),
+			FirstUsedAt:     formatOptionalTime(resp.GetHashtag().GetFirstUsedAt()),
+			LastUsedAt:      formatOptionalTime(resp.GetHashtag().GetLastUsedAt()),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetHashtagPosts godoc
+// @Summary Get hashtag posts
+// @Description Get the posts using a hashtag that the current viewer is allowed to see, newest first
+// @Tags hashtags
+// @Accept json
+// @Produce json
+// @Param tag path string true "Hashtag, with or without the leading #"
+// @Param cursor query int false "next_cursor of the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} types.HashtagPostsResponse "Hashtag posts"
+// @Failure 400 {object} types.MessageResponse "Invalid cursor or limit"
+// @Failure 404 {object} types.MessageResponse "Hashtag not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /hashtags/{tag}/posts [get]
+func (svc *WebService) GetHashtagPosts(ctx *gin.Context) {
+	// Check query params
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil || cursor < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetHashtagPosts service
+	resp, err := svc.AuthenticateAndPostClient.GetHashtagPosts(ctx, &pb_aap.GetHashtagPostsRequest{
+		Tag:      ctx.Param("tag"),
+		ViewerId: svc.getViewerId(ctx),
+		Cursor:   cursor,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetHashtagPostsResponse_HASHTAG_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "hashtag not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetHashtagPostsResponse_OK {
+		postsIds := resp.GetPostsIds()
+		if postsIds == nil {
+			postsIds = []int64{}
+		}
+		ctx.JSON(http.StatusOK, types.HashtagPostsResponse{
+			PostsIds:   postsIds,
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -1,21 +1,39 @@
 package service
 
 import (
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
 	pb_newsfeed "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed"
 	"go.uber.org/zap"
 )
 
+const (
+	// defaultNewsfeedPageSize and maxNewsfeedPageSize are the page sizes of the newsfeed service, keep them in sync with it
+	defaultNewsfeedPageSize = 10
+	maxNewsfeedPageSize     = 50
+	// maxNewsfeedHydrationRounds bounds how often a hydrated newsfeed page reads on to replace the items
+	// the user cannot see, each round reads and loads a page of items
+	maxNewsfeedHydrationRounds = 3
+)
+
 // GetNewsfeed godoc
 // @Summary Get user's newsfeed
-// @Description Get the current user's newsfeed
+// @Description Get a page of the current user's newsfeed. posts_ids lists the posts, items lists the posts, published trips, reviews and reposts in feed order. With hydrate, the posts and reposts of items come with their content, author, media, counts and viewer flags, reposts also come with the shared post, and the newsfeed is read with cursor instead of page: posts in the trash or that the user cannot read right now are left out and the page is filled with the following items, next_cursor is where the next page starts and is 0 at the end of the newsfeed. Hidden posts are kept in the newsfeed and show up again once visible. A page of a newsfeed with many hidden posts may be short, keep reading until next_cursor is 0. Items published while reading a newsfeed with cursors push the others back and may show up again on the next page.
 // @Tags newsfeed
 // @Accept json
 // @Produce json
+// @Param page query int false "Page number, starting at 1, without hydrate" default(1)
+// @Param cursor query int false "next_cursor of the previous page, with hydrate"
+// @Param page_size query int false "Number of items per page, 10 by default and at most 50"
+// @Param hydrate query bool false "Return the posts of the items" default(false)
 // @Success 200 {object} types.NewsfeedResponse "User's newsfeed"
 // @Failure 400 {object} types.MessageResponse "Validation error"
 // @Failure 401 {object} types.MessageResponse "Unauthorized"
@@ -30,22 +48,86 @@ func (svc *WebService) GetNewsfeed(ctx *gin.Context) {
 		return
 	}
 
+	// Check query params
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid page"})
+		return
+	}
+	pageSize, err := strconv.Atoi(ctx.DefaultQuery("page_size", "0"))
+	if err != nil || pageSize < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid page_size"})
+		return
+	}
+	hydrate, err := strconv.ParseBool(ctx.DefaultQuery("hydrate", "false"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid hydrate"})
+		return
+	}
+	cursor, err := strconv.Atoi(ctx.DefaultQuery("cursor", "0"))
+	if err != nil || cursor < 0 || cursor > math.MaxInt32 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	// A hydrated newsfeed skips hidden items, its pages can only be read in order
+	if hydrate && page > 1 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "page is not supported with hydrate, use cursor"})
+		return
+	}
+
 	// Call GetNewsfeed service
-	resp, err := svc.NewsfeedClient.GetNewsfeed(ctx, &pb_newsfeed.GetNewsfeedRequest{
-		UserId: int64(userId),
-	})
+	var resp *pb_newsfeed.GetNewsfeedResponse
+	var posts map[int64]*pb_aap.PostSummary
+	var nextCursor int
+	if hydrate {
+		resp, posts, nextCursor, err = svc.getHydratedNewsfeed(ctx, int64(userId), cursor, pageSize)
+	} else {
+		resp, err = svc.NewsfeedClient.GetNewsfeed(ctx, &pb_newsfeed.GetNewsfeedRequest{
+			UserId:   int64(userId),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
+	}
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
 		return
 	}
+
 	if resp.GetStatus() == pb_newsfeed.GetNewsfeedResponse_NEWSFEED_EMPTY {
 		ctx.JSON(http.StatusOK, types.NewsfeedResponse{
-			PostsIds: []int64{}, // Return empty array
+			PostsIds:    []int64{}, // Return empty array
+			Items:       []types.NewsfeedItemResponse{},
+			TotalPages:  resp.GetTotalPages(),
+			CurrentPage: int32(page),
 		})
 		return
 	} else if resp.GetStatus() == pb_newsfeed.GetNewsfeedResponse_OK {
+		items := make([]types.NewsfeedItemResponse, 0, len(resp.GetItems()))
+		for _, item := range resp.GetItems() {
+			itemResp := types.NewsfeedItemResponse{
+				Type: strings.ToLower(item.GetType().String()),
+				Id:   item.GetId(),
+			}
+			if post, ok := posts[item.GetId()]; ok && isNewsfeedPost(item) {
+				summary := fromPbPostSummary(post)
+				itemResp.Post = &summary
+				if original, ok := posts[post.GetRepostOfPostId()]; ok && item.GetType() == pb_newsfeed.NewsfeedItem_REPOST {
+					originalSummary := fromPbPostSummary(original)
+					itemResp.RepostOf = &originalSummary
+				}
+			}
+			items = append(items, itemResp)
+		}
+		postsIds := resp.GetPostsIds()
+		if postsIds == nil {
+			postsIds = []int64{}
+		}
 		ctx.JSON(http.StatusOK, types.NewsfeedResponse{
-			PostsIds: resp.GetPostsIds(),
+			PostsIds:    postsIds,
+			Items:       items,
+			TotalPages:  resp.GetTotalPages(),
+			CurrentPage: resp.GetCurrentPage(),
+			NextCursor:  int64(nextCursor),
 		})
 		return
 	} else {
@@ -54,6 +136,143 @@ func (svc *WebService) GetNewsfeed(ctx *gin.Context) {
 	}
 }
 
+// getHydratedNewsfeed returns the newsfeed items the user can see from the feed position cursor on, with the
+// posts of the page and the posts shared by its reposts, by post id, and the position of the item that follows
+// the page, 0 at the end of the newsfeed. Items the user cannot see right now, like trashed posts or
+// followers-only posts of users they no longer follow, are left out of the page but kept in the newsfeed.
+// The items left out are replaced by reading on, at most maxNewsfeedHydrationRounds times, so a page of
+// a newsfeed with many hidden items may be short.
+func (svc *WebService) getHydratedNewsfeed(ctx *gin.Context, userId int64, cursor int, pageSize int) (*pb_newsfeed.GetNewsfeedResponse, map[int64]*pb_aap.PostSummary, int, error) {
+	if pageSize == 0 {
+		pageSize = defaultNewsfeedPageSize
+	}
+	if pageSize > maxNewsfeedPageSize {
+		pageSize = maxNewsfeedPageSize
+	}
+
+	posts := make(map[int64]*pb_aap.PostSummary)
+	items := make([]*pb_newsfeed.NewsfeedItem, 0, pageSize)
+	// Publishing moves items around the newsfeed while it is read, an item is only returned once
+	seen := make(map[string]bool)
+	hidden := 0
+	var totalItems int32
+	next := cursor
+	for round := 0; round < maxNewsfeedHydrationRounds && len(items) < pageSize; round++ {
+		resp, err := svc.NewsfeedClient.GetNewsfeed(ctx, &pb_newsfeed.GetNewsfeedRequest{
+			UserId:   userId,
+			Offset:   int32(next),
+			PageSize: int32(pageSize),
+		})
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if resp.GetStatus() != pb_newsfeed.GetNewsfeedResponse_OK {
+			if round == 0 {
+				return resp, posts, 0, nil
+			}
+			next = 0
+			break
+		}
+		totalItems = resp.GetTotalItems()
+
+		var postIds []int64
+		for _, item := range resp.GetItems() {
+			if isNewsfeedPost(item) {
+				postIds = append(postIds, item.GetId())
+			}
+		}
+		chunkPosts := make(map[int64]*pb_aap.PostSummary)
+		if err := svc.loadNewsfeedPosts(ctx, userId, postIds, chunkPosts); err != nil {
+			return nil, nil, 0, err
+		}
+		// The next page starts after the last item read into this one
+		read := 0
+		for _, item := range resp.GetItems() {
+			if len(items) == pageSize {
+				break
+			}
+			read++
+			key := fmt.Sprintf("%s:%d", item.GetType(), item.GetId())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if isNewsfeedPost(item) {
+				post, ok := chunkPosts[item.GetId()]
+				if !ok {
+					hidden++
+					continue
+				}
+				posts[item.GetId()] = post
+			}
+			items = append(items, item)
+		}
+		next += read
+		if len(resp.GetItems()) < pageSize && read == len(resp.GetItems()) || next >= int(totalItems) {
+			next = 0
+			break
+		}
+	}
+
+	// A visible repost implies its shared post is visible too
+	var originalIds []int64
+	for _, item := range items {
+		if post, ok := posts[item.GetId()]; ok && item.GetType() == pb_newsfeed.NewsfeedItem_REPOST {
+			if _, loaded := posts[post.GetRepostOfPostId()]; !loaded && post.GetRepostOfPostId() != 0 {
+				originalIds = append(originalIds, post.GetRepostOfPostId())
+			}
+		}
+	}
+	if err := svc.loadNewsfeedPosts(ctx, userId, originalIds, posts); err != nil {
+		return nil, nil, 0, err
+	}
+
+	postsIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		if item.GetType() == pb_newsfeed.NewsfeedItem_POST {
+			postsIds = append(postsIds, item.GetId())
+		}
+	}
+	if hidden > 0 {
+		svc.Logger.Debug("Left hidden posts out of newsfeed page",
+			zap.Int64("user_id", userId),
+			zap.Int("post_count", hidden))
+	}
+	return &pb_newsfeed.GetNewsfeedResponse{
+		Status:     pb_newsfeed.GetNewsfeedResponse_OK,
+		PostsIds:   postsIds,
+		TotalPages: int32((int(totalItems) + pageSize - 1) / pageSize),
+		TotalItems: totalItems,
+		Items:      items,
+	}, posts, next, nil
+}
+
+// loadNewsfeedPosts adds the posts the user can read among postIds to posts
+func (svc *WebService) loadNewsfeedPosts(ctx *gin.Context, userId int64, postIds []int64, posts map[int64]*pb_aap.PostSummary) error {
+	if len(postIds) == 0 {
+		return nil
+	}
+	resp, err := svc.AuthenticateAndPostClient.GetPostsByIds(ctx, &pb_aap.GetPostsByIdsRequest{
+		PostIds:  postIds,
+		ViewerId: userId,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != pb_aap.GetPostsByIdsResponse_OK {
+		return fmt.Errorf("failed to get posts: %s", resp.GetStatus())
+	}
+	for _, post := range resp.GetPosts() {
+		posts[post.GetPostId()] = post
+	}
+	return nil
+}
+
+// isNewsfeedPost checks if a newsfeed item is a post, reposts are posts too
+func isNewsfeedPost(item *pb_newsfeed.NewsfeedItem) bool {
+	return item.GetType() == pb_newsfeed.NewsfeedItem_POST || item.GetType() == pb_newsfeed.NewsfeedItem_REPOST
+}
+
 // RemovePostFromNewsfeed calls the newsfeed service to remove a post from all newsfeeds
 // This should be called when a post is deleted
 func (svc *WebService) RemovePostFromNewsfeed(postID int64) {
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+	pb_newsfeed "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/newsfeed"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// fakeNewsfeedClient pages through a fixed newsfeed like the newsfeed service and counts the items read
+type fakeNewsfeedClient struct {
+	pb_newsfeed.NewsfeedClient
+	items []*pb_newsfeed.NewsfeedItem
+	read  int
+}
+
+func (c *fakeNewsfeedClient) GetNewsfeed(ctx context.Context, in *pb_newsfeed.GetNewsfeedRequest, opts ...grpc.CallOption) (*pb_newsfeed.GetNewsfeedResponse, error) {
+	if len(c.items) == 0 {
+		return &pb_newsfeed.GetNewsfeedResponse{Status: pb_newsfeed.GetNewsfeedResponse_NEWSFEED_EMPTY}, nil
+	}
+	size := int(in.GetPageSize())
+	start := 0
+	if in.GetPage() > 1 {
+		start = int(in.GetPage()-1) * size
+	}
+	if in.GetOffset() > 0 {
+		start = int(in.GetOffset())
+	}
+	end := start + size
+	if start > len(c.items) {
+		start = len(c.items)
+	}
+	if end > len(c.items) {
+		end = len(c.items)
+	}
+	return &pb_newsfeed.GetNewsfeedResponse{
+		Status:      pb_newsfeed.GetNewsfeedResponse_OK,
+		TotalPages:  int32((len(c.items) + size - 1) / size),
+		CurrentPage: in.GetPage(),
+		TotalItems:  int32(len(c.items)),
+		Items:       c.items[start:end],
+	}, c.countRead(end - start)
+}
+
+func (c *fakeNewsfeedClient) countRead(n int) error {
+	c.read += n
+	return nil
+}
+
+// fakePostsClient returns the posts that are not hidden
+type fakePostsClient struct {
+	pb_aap.AuthenticateAndPostClient
+	hidden    map[int64]bool
+	repostsOf map[int64]int64
+}
+
+func (c *fakePostsClient) GetPostsByIds(ctx context.Context, in *pb_aap.GetPostsByIdsRequest, opts ...grpc.CallOption) (*pb_aap.GetPostsByIdsResponse, error) {
+	var posts []*pb_aap.PostSummary
+	for _, id := range in.GetPostIds() {
+		if !c.hidden[id] {
+			posts = append(posts, &pb_aap.PostSummary{PostId: id, RepostOfPostId: c.repostsOf[id]})
+		}
+	}
+	return &pb_aap.GetPostsByIdsResponse{Status: pb_aap.GetPostsByIdsResponse_OK, Posts: posts}, nil
+}
+
+func TestGetHydratedNewsfeed(t *testing.T) {
+	// 120 posts, every third one hidden, and a trip which is always visible
+	feed := &fakeNewsfeedClient{}
+	posts := &fakePostsClient{hidden: make(map[int64]bool), repostsOf: map[int64]int64{500: 900}}
+	var visible []int64
+	for id := int64(1); id <= 120; id++ {
+		feed.items = append(feed.items, &pb_newsfeed.NewsfeedItem{Type: pb_newsfeed.NewsfeedItem_POST, Id: id})
+		if id%3 == 0 {
+			posts.hidden[id] = true
+		} else {
+			visible = append(visible, id)
+		}
+	}
+	feed.items = append(feed.items,
+		&pb_newsfeed.NewsfeedItem{Type: pb_newsfeed.NewsfeedItem_TRIP, Id: 7},
+		&pb_newsfeed.NewsfeedItem{Type: pb_newsfeed.NewsfeedItem_REPOST, Id: 500},
+	)
+	visible = append(visible, 7, 500)
+
+	svc := &WebService{NewsfeedClient: feed, AuthenticateAndPostClient: posts, Logger: zap.NewNop()}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	// readAll reads the newsfeed with next_cursor until its end
+	readAll := func(pageSize int) ([]*pb_newsfeed.GetNewsfeedResponse, map[int64]*pb_aap.PostSummary) {
+		var pages []*pb_newsfeed.GetNewsfeedResponse
+		loaded := make(map[int64]*pb_aap.PostSummary)
+		cursor := 0
+		for {
+			resp, posts, next, err := svc.getHydratedNewsfeed(ctx, 1, cursor, pageSize)
+			if err != nil {
+				t.Fatalf("getHydratedNewsfeed failed: %v", err)
+			}
+			pages = append(pages, resp)
+			for id, post := range posts {
+				loaded[id] = post
+			}
+			if next == 0 {
+				return pages, loaded
+			}
+			if next <= cursor || len(pages) > len(feed.items) {
+				t.Fatalf("Expected the cursor to move on from %d, got %d", cursor, next)
+			}
+			cursor = next
+		}
+	}
+
+	t.Run("Cursor Reads Visible Items Once", func(t *testing.T) {
+		pages, _ := readAll(30)
+		var ids []int64
+		for i, page := range pages {
+			if i < len(pages)-1 && len(page.GetItems()) != 30 {
+				t.Errorf("Expected page %d to be full, got %d items", i+1, len(page.GetItems()))
+			}
+			for _, item := range page.GetItems() {
+				ids = append(ids, item.GetId())
+			}
+		}
+		if len(ids) != len(visible) {
+			t.Fatalf("Expected the %d visible items once each, got %d", len(visible), len(ids))
+		}
+		for i := range ids {
+			if ids[i] != visible[i] {
+				t.Fatalf("Expected item %d to be %d, got %d", i, visible[i], ids[i])
+			}
+		}
+	})
+
+	t.Run("Pages Only Read Their Items", func(t *testing.T) {
+		feed.read = 0
+		_, _, next, err := svc.getHydratedNewsfeed(ctx, 1, 90, 10)
+		if err != nil {
+			t.Fatalf("getHydratedNewsfeed failed: %v", err)
+		}
+		// Items 91 to 100 hold 7 visible ones, the 3 missing ones are 101, 103 and 104
+		if next != 104 || feed.read != 20 {
+			t.Errorf("Expected 2 reads of 10 items and the next page at 104, read %d up to %d", feed.read, next)
+		}
+	})
+
+	t.Run("Reposts Come With The Shared Post", func(t *testing.T) {
+		pages, loaded := readAll(30)
+		last := pages[len(pages)-1].GetItems()[len(pages[len(pages)-1].GetItems())-1]
+		if last.GetType() != pb_newsfeed.NewsfeedItem_REPOST || loaded[last.GetId()] == nil {
+			t.Fatalf("Expected the repost to be loaded, got %+v", last)
+		}
+		if loaded[900] == nil {
+			t.Errorf("Expected the shared post to be loaded")
+		}
+		for _, page := range pages {
+			for _, id := range page.GetPostsIds() {
+				if posts.hidden[id] {
+					t.Errorf("Expected hidden post %d to be left out", id)
+				}
+			}
+		}
+	})
+
+	t.Run("Hidden Items Bound The Reads", func(t *testing.T) {
+		hiddenFeed := &fakeNewsfeedClient{}
+		hiddenPosts := &fakePostsClient{hidden: make(map[int64]bool)}
+		for id := int64(1); id <= 200; id++ {
+			hiddenFeed.items = append(hiddenFeed.items, &pb_newsfeed.NewsfeedItem{Type: pb_newsfeed.NewsfeedItem_POST, Id: id})
+			hiddenPosts.hidden[id] = id < 200
+		}
+		svc := &WebService{NewsfeedClient: hiddenFeed, AuthenticateAndPostClient: hiddenPosts, Logger: zap.NewNop()}
+		resp, _, next, err := svc.getHydratedNewsfeed(ctx, 1, 0, 10)
+		if err != nil {
+			t.Fatalf("getHydratedNewsfeed failed: %v", err)
+		}
+		if len(resp.GetItems()) != 0 || next != 30 || hiddenFeed.read != 30 {
+			t.Errorf("Expected a short page read up to 30, got %d items read up to %d after reading %d", len(resp.GetItems()), next, hiddenFeed.read)
+		}
+	})
+}
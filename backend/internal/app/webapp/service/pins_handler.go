@@ -0,0 +1,136 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// PinPost godoc
+// @Summary Pin a post
+// @Description Pin one of the current user's posts to the top of their profile. Up to three posts can be pinned. Private and scheduled posts and reposts cannot be pinned, a pin is removed when its post is deleted or made private.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Post pinned successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or post not pinnable"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the post"
+// @Failure 404 {object} types.MessageResponse "Post not found"
+// @Failure 409 {object} types.MessageResponse "Too many pinned posts"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/pin [post]
+// @Security ApiKeyAuth
+func (svc *WebService) PinPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Call PinPost service
+	resp, err := svc.AuthenticateAndPostClient.PinPost(ctx, &pb_aap.PinPostRequest{
+		UserId: int64(userId),
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.PinPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_NOT_PINNABLE {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "private and scheduled posts cannot be pinned"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_TOO_MANY_PINS {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "too many pinned posts"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UnpinPost godoc
+// @Summary Unpin a post
+// @Description Remove the pin of one of the current user's posts
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Post unpinned successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the post"
+// @Failure 404 {object} types.MessageResponse "Post not found or not pinned"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/pin [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) UnpinPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Call UnpinPost service
+	resp, err := svc.AuthenticateAndPostClient.UnpinPost(ctx, &pb_aap.UnpinPostRequest{
+		UserId: int64(userId),
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UnpinPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_NOT_PINNED {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not pinned"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
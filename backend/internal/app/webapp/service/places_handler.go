@@ -0,0 +1,351 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// CreatePlace godoc
+// @Summary Create a place
+// @Description Add a place to the catalog so posts can check in at it
+// @Tags places
+// @Accept json
+// @Produce json
+// @Param request body types.CreatePlaceRequest true "Place creation parameters"
+// @Success 200 {object} types.CreatePlaceResponse "Place created successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreatePlace(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreatePlaceRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CreatePlace service
+	resp, err := svc.AuthenticateAndPostClient.CreatePlace(ctx, &pb_aap.CreatePlaceRequest{
+		UserId:    int64(userId),
+		Name:      jsonRequest.Name,
+		Category:  jsonRequest.Category,
+		Country:   jsonRequest.Country,
+		City:      jsonRequest.City,
+		Latitude:  *jsonRequest.Latitude,
+		Longitude: *jsonRequest.Longitude,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreatePlaceResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceResponse_INVALID_PLACE {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreatePlaceResponse{
+			Message: "OK",
+			PlaceId: resp.GetPlaceId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// SearchPlaces godoc
+// @Summary Search places
+// @Description Find places by name, best matches first, or near a point, closest first. At least one of q and lat/lng is required.
+// @Tags places
+// @Accept json
+// @Produce json
+// @Param q query string false "Place name, at most 200 characters"
+// @Param category query string false "Place category" Enums(restaurant, cafe, bar, hotel, attraction, museum, park, beach, mountain, airport, station, shop, city, other)
+// @Param lat query number false "Latitude in degrees, requires lng"
+// @Param lng query number false "Longitude in degrees, requires lat"
+// @Param radius_km query number false "Search radius in km around lat/lng, at most 500" default(50)
+// @Param limit query int false "Maximum number of places, 20 by default and at most 50"
+// @Success 200 {object} types.PlacesResponse "Matching places"
+// @Failure 400 {object} types.MessageResponse "Invalid query, location or limit"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places [get]
+func (svc *WebService) SearchPlaces(ctx *gin.Context) {
+	// Check query params
+	var near *pb_aap.Location
+	if ctx.Query("lat") != "" || ctx.Query("lng") != "" {
+		latitude, err := strconv.ParseFloat(ctx.Query("lat"), 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid lat"})
+			return
+		}
+		longitude, err := strconv.ParseFloat(ctx.Query("lng"), 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid lng"})
+			return
+		}
+		near = &pb_aap.Location{Latitude: latitude, Longitude: longitude}
+	}
+	radiusKm, err := strconv.ParseFloat(ctx.DefaultQuery("radius_km", "0"), 64)
+	if err != nil || radiusKm < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid radius_km"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call SearchPlaces service
+	resp, err := svc.AuthenticateAndPostClient.SearchPlaces(ctx, &pb_aap.SearchPlacesRequest{
+		Query:    ctx.Query("q"),
+		Category: ctx.Query("category"),
+		Near:     near,
+		RadiusKm: radiusKm,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.SearchPlacesResponse_INVALID_QUERY {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid query"})
+		return
+	} else if resp.GetStatus() == pb_aap.SearchPlacesResponse_OK {
+		places := make([]types.PlaceResponse, 0, len(resp.GetPlaces()))
+		for _, place := range resp.GetPlaces() {
+			places = append(places, *fromPbPlace(place))
+		}
+		ctx.JSON(http.StatusOK, types.PlacesResponse{Places: places})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPlace godoc
+// @Summary Get place details
+// @Description Get a place with its check-in statistics, computed over public posts. A merged place returns the place it was merged into.
+// @Tags places
+// @Accept json
+// @Produce json
+// @Param place_id path int true "Place ID"
+// @Success 200 {object} types.PlaceResponse "Place details"
+// @Failure 400 {object} types.MessageResponse "Invalid place ID"
+// @Failure 404 {object} types.MessageResponse "Place not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places/{place_id} [get]
+func (svc *WebService) GetPlace(ctx *gin.Context) {
+	// Check URL params
+	placeId, err := strconv.ParseInt(ctx.Param("place_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place_id"})
+		return
+	}
+
+	// Call GetPlace service
+	resp, err := svc.AuthenticateAndPostClient.GetPlace(ctx, &pb_aap.GetPlaceRequest{PlaceId: placeId})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPlaceResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPlaceResponse_OK {
+		ctx.JSON(http.StatusOK, fromPbPlace(resp.GetPlace()))
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPlacePosts godoc
+// @Summary Get place check-ins
+// @Description Get the posts checked in at a place that the current viewer is allowed to see, newest first
+// @Tags places
+// @Accept json
+// @Produce json
+// @Param place_id path int true "Place ID"
+// @Param cursor query int false "next_cursor of the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 100"
+// @Success 200 {object} types.PlacePostsResponse "Place check-ins"
+// @Failure 400 {object} types.MessageResponse "Invalid place ID, cursor or limit"
+// @Failure 404 {object} types.MessageResponse "Place not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places/{place_id}/posts [get]
+func (svc *WebService) GetPlacePosts(ctx *gin.Context) {
+	// Check URL and query params
+	placeId, err := strconv.ParseInt(ctx.Param("place_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place_id"})
+		return
+	}
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil || cursor < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetPlacePosts service
+	resp, err := svc.AuthenticateAndPostClient.GetPlacePosts(ctx, &pb_aap.GetPlacePostsRequest{
+		PlaceId:  placeId,
+		ViewerId: svc.getViewerId(ctx),
+		Cursor:   cursor,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPlacePostsResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPlacePostsResponse_OK {
+		postsIds := resp.GetPostsIds()
+		if postsIds == nil {
+			postsIds = []int64{}
+		}
+		ctx.JSON(http.StatusOK, types.PlacePostsResponse{
+			PostsIds:   postsIds,
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// MergePlace godoc
+// @Summary Merge a duplicate place
+// @Description Move the check-ins of a duplicate place to the target place and remove the duplicate. Only moderators may merge places.
+// @Tags places
+// @Accept json
+// @Produce json
+// @Param place_id path int true "ID of the duplicate place"
+// @Param request body types.MergePlaceRequest true "Place the duplicate is merged into"
+// @Success 200 {object} types.MergePlacesResponse "Places merged successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or same place"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not a moderator"
+// @Failure 404 {object} types.MessageResponse "Place not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places/{place_id}/merge [post]
+// @Security ApiKeyAuth
+func (svc *WebService) MergePlace(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	placeId, err := strconv.ParseInt(ctx.Param("place_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.MergePlaceRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call MergePlaces service
+	resp, err := svc.AuthenticateAndPostClient.MergePlaces(ctx, &pb_aap.MergePlacesRequest{
+		UserId:        int64(userId),
+		SourcePlaceId: placeId,
+		TargetPlaceId: jsonRequest.TargetPlaceId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.MergePlacesResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.MergePlacesResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "only moderators can merge places"})
+		return
+	} else if resp.GetStatus() == pb_aap.MergePlacesResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.MergePlacesResponse_SAME_PLACE {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "cannot merge a place into itself"})
+		return
+	} else if resp.GetStatus() == pb_aap.MergePlacesResponse_OK {
+		ctx.JSON(http.StatusOK, types.MergePlacesResponse{
+			Message:         "OK",
+			MovedPostsCount: resp.GetMovedPostsCount(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// fromPbPlace converts a place, nil when it is not set
+func fromPbPlace(place *pb_aap.Place) *types.PlaceResponse {
+	if place == nil {
+		return nil
+	}
+	return &types.PlaceResponse{
+		PlaceId:       place.GetPlaceId(),
+		Name:          place.GetName(),
+		Category:      place.GetCategory(),
+		Country:       place.GetCountry(),
+		City:          place.GetCity(),
+		Latitude:      place.GetLatitude(),
+		Longitude:     place.GetLongitude(),
+		CreatedBy:     place.GetCreatedBy(),
+		CreatedAt:     place.GetCreatedAt().AsTime().Format(time.RFC3339),
+		CheckInCount:  place.GetCheckInCount(),
+		VisitorCount:  place.GetVisitorCount(),
+		RatingCount:   place.GetRatingCount(),
+		RatingAverage: place.GetRatingAverage(),
+		DistanceKm:    place.GetDistanceKm(),
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// VotePoll godoc
+// @Summary Vote on a poll
+// @Description Vote for one option of the poll of a post, or several for multiple choice polls. Voting again replaces the previous vote until the poll closes. The results are visible once the user voted.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param request body types.VotePollRequest true "Chosen options"
+// @Success 200 {object} types.PollResponse "Poll with the vote counted"
+// @Failure 400 {object} types.MessageResponse "Validation error or invalid options"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Post or poll not found"
+// @Failure 409 {object} types.MessageResponse "Poll closed"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/poll/vote [post]
+// @Security ApiKeyAuth
+func (svc *WebService) VotePoll(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.VotePollRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call VotePoll service
+	resp, err := svc.AuthenticateAndPostClient.VotePoll(ctx, &pb_aap.VotePollRequest{
+		UserId:    int64(userId),
+		PostId:    postId,
+		OptionIds: jsonRequest.OptionIds,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.VotePollResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_POLL_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "poll not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_POLL_CLOSED {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "poll closed"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_INVALID_OPTIONS {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid options"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_OK {
+		ctx.JSON(http.StatusOK, fromPbPoll(resp.GetPoll()))
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// fromPbPoll converts the poll of a post, nil when the post has no poll
+func fromPbPoll(poll *pb_aap.Poll) *types.PollResponse {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]types.PollOptionResponse, 0, len(poll.GetOptions()))
+	for _, option := range poll.GetOptions() {
+		options = append(options, types.PollOptionResponse{
+			OptionId:   option.GetOptionId(),
+			Text:       option.GetText(),
+			VotesCount: option.GetVotesCount(),
+			VoterIds:   option.GetVoterIds(),
+		})
+	}
+	myOptionIds := poll.GetMyOptionIds()
+	if myOptionIds == nil {
+		myOptionIds = make([]int64, 0)
+	}
+
+	return &types.PollResponse{
+		Options:        options,
+		ExpiresAt:      poll.GetExpiresAt().AsTime().Format(time.RFC3339),
+		MultipleChoice: poll.GetMultipleChoice(),
+		Anonymous:      poll.GetAnonymous(),
+		Closed:         poll.GetClosed(),
+		VotersCount:    poll.GetVotersCount(),
+		ResultsVisible: poll.GetResultsVisible(),
+		MyOptionIds:    myOptionIds,
+	}
+}
@@ -11,13 +11,14 @@ import (
 	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
 	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
 )
 
 // CreatePost godoc
 // @Summary Create a new post
-// @Description Create a new post with text and optional images
+// @Description Create a new post with text and optional images. Set quote_of_post_id to quote a public post. Set publish_at, an RFC3339 time within a year, to schedule the post: it stays hidden from everyone but its author until then. Set poll to attach a poll of 2 to 6 options, closing between 5 minutes and 30 days after the post is published.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -54,13 +55,43 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 	if jsonRequest.Visible != nil {
 		visible = *jsonRequest.Visible
 	}
+	var publishAt *timestamppb.Timestamp
+	if jsonRequest.PublishAt != "" {
+		parsed, err := time.Parse(time.RFC3339, jsonRequest.PublishAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid publish_at"})
+			return
+		}
+		publishAt = timestamppb.New(parsed)
+	}
+	var poll *pb_aap.PollInput
+	if jsonRequest.Poll != nil {
+		expiresAt, err := time.Parse(time.RFC3339, jsonRequest.Poll.ExpiresAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid poll expires_at"})
+			return
+		}
+		poll = &pb_aap.PollInput{
+			Options:        jsonRequest.Poll.Options,
+			ExpiresAt:      timestamppb.New(expiresAt),
+			MultipleChoice: jsonRequest.Poll.MultipleChoice,
+			Anonymous:      jsonRequest.Poll.Anonymous,
+		}
+	}
 
 	// Call CreatePost service
 	resp, err := svc.AuthenticateAndPostClient.CreatePost(ctx, &pb_aap.CreatePostRequest{
-		UserId:           int64(userId),
-		ContentText:      jsonRequest.ContentText,
-		ContentImagePath: jsonRequest.ContentImagePath,
-		Visible:          visible,
+		UserId:        int64(userId),
+		ContentText:   jsonRequest.ContentText,
+		Media:         toPbMedia(jsonRequest.Media, jsonRequest.ContentImagePath),
+		Visible:       visible,
+		Visibility:    toPbPostVisibility(jsonRequest.Visibility),
+		Location:      toPbLocation(jsonRequest.Location),
+		PlaceId:       jsonRequest.PlaceId,
+		TripId:        jsonRequest.TripId,
+		QuoteOfPostId: jsonRequest.QuoteOfPostId,
+		PublishAt:     publishAt,
+		Poll:          poll,
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -69,6 +100,27 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 	if resp.GetStatus() == pb_aap.CreatePostResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_QUOTED_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "quoted post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_INVALID_PUBLISH_AT {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "publish_at must be in the future and within a year"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_INVALID_POLL {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "poll options must be distinct and close between 5 minutes and 30 days after publication"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid media"})
+		return
 	} else if resp.GetStatus() == pb_aap.CreatePostResponse_OK {
 		postId := resp.GetPostId()
 		response := types.CreatePostResponse{
@@ -85,7 +137,7 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 
 // GetPostDetail godoc
 // @Summary Get post details
-// @Description Get detailed information about a post
+// @Description Get detailed information about a post the current viewer is allowed to see. The previews of the links of the post appear once their pages were fetched in background.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -104,7 +156,8 @@ func (svc *WebService) GetPostDetail(ctx *gin.Context) {
 
 	// Call grpc service
 	resp, err := svc.AuthenticateAndPostClient.GetPostDetailInfo(ctx, &pb_aap.GetPostDetailInfoRequest{
-		PostId: int64(postId),
+		PostId:   int64(postId),
+		ViewerId: svc.getViewerId(ctx),
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -122,6 +175,7 @@ func (svc *WebService) GetPostDetail(ctx *gin.Context) {
 				UserId:      comment.GetUserId(),
 				PostId:      comment.GetPostId(),
 				ContentText: comment.GetContentText(),
+				Mentions:    fromPbMentionSpans(comment.GetMentions()),
 			})
 		}
 
@@ -135,10 +189,27 @@ func (svc *WebService) GetPostDetail(ctx *gin.Context) {
 			PostID:           resp.GetPost().GetPostId(),
 			UserID:           resp.GetPost().GetUserId(),
 			ContentText:      resp.GetPost().GetContentText(),
-			ContentImagePath: resp.GetPost().GetContentImagePath(),
+			ContentImagePath: mediaURLs(resp.GetPost().GetMedia()),
+			Media:            fromPbMedia(resp.GetPost().GetMedia()),
+			Visibility:       fromPbPostVisibility(resp.GetPost().GetVisibility()),
 			CreatedAt:        resp.GetPost().GetCreatedAt().AsTime().Format(time.RFC3339),
 			Comments:         comments,
 			UsersLiked:       usersLiked,
+			LikedByMe:        resp.GetPost().GetLikedByMe(),
+			EditedAt:         formatOptionalTime(resp.GetPost().GetEditedAt()),
+			Mentions:         fromPbMentionSpans(resp.GetPost().GetMentions()),
+			Location:         fromPbLocation(resp.GetPost().GetLocation()),
+			Place:            fromPbPlace(resp.GetPost().GetPlace()),
+			TripId:           resp.GetPost().GetTripId(),
+			RepostOfPostId:   resp.GetPost().GetRepostOfPostId(),
+			QuoteOfPostId:    resp.GetPost().GetQuoteOfPostId(),
+			RepostCount:      resp.GetPost().GetRepostCount(),
+			QuoteCount:       resp.GetPost().GetQuoteCount(),
+			RepostedByMe:     resp.GetPost().GetRepostedByMe(),
+			BookmarkedByMe:   resp.GetPost().GetBookmarkedByMe(),
+			PublishAt:        formatOptionalTime(resp.GetPost().GetPublishAt()),
+			Poll:             fromPbPoll(resp.GetPost().GetPoll()),
+			LinkPreviews:     fromPbLinkPreviews(resp.GetPost().GetLinkPreviews()),
 		})
 		return
 	} else {
@@ -147,9 +218,125 @@ func (svc *WebService) GetPostDetail(ctx *gin.Context) {
 	}
 }
 
+// GetPostsByIds godoc
+// @Summary Get several posts
+// @Description Get the posts of a list, such as a page of the newsfeed, in one request. Posts that do not exist or that the current viewer is not allowed to see are left out, the others keep the order of ids. Comments, liked users, polls and link previews are only returned by the post details.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param ids query string true "Comma separated post IDs, at most 100"
+// @Success 200 {object} types.PostsResponse "Posts"
+// @Failure 400 {object} types.MessageResponse "Invalid or too many ids"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts [get]
+func (svc *WebService) GetPostsByIds(ctx *gin.Context) {
+	// Check query params
+	var postIds []int64
+	for _, id := range strings.Split(ctx.Query("ids"), ",") {
+		postId, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+		if err != nil || postId <= 0 {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid ids"})
+			return
+		}
+		postIds = append(postIds, postId)
+	}
+
+	// Call GetPostsByIds service
+	resp, err := svc.AuthenticateAndPostClient.GetPostsByIds(ctx, &pb_aap.GetPostsByIdsRequest{
+		PostIds:  postIds,
+		ViewerId: svc.getViewerId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPostsByIdsResponse_TOO_MANY_POSTS {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "too many ids"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPostsByIdsResponse_OK {
+		posts := make([]types.PostSummaryResponse, 0, len(resp.GetPosts()))
+		for _, post := range resp.GetPosts() {
+			posts = append(posts, fromPbPostSummary(post))
+		}
+		ctx.JSON(http.StatusOK, types.PostsResponse{Posts: posts})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetNearbyPosts godoc
+// @Summary Get nearby posts
+// @Description List the geotagged posts the current viewer is allowed to see within a radius of a point, closest first
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude in degrees"
+// @Param lng query number true "Longitude in degrees"
+// @Param radius_km query number false "Search radius in km, at most 500" default(10)
+// @Param limit query int false "Maximum number of posts, 20 by default and at most 100"
+// @Success 200 {object} types.NearbyPostsResponse "Nearby posts"
+// @Failure 400 {object} types.MessageResponse "Invalid location, radius or limit"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/nearby [get]
+func (svc *WebService) GetNearbyPosts(ctx *gin.Context) {
+	// Check query params
+	latitude, err := strconv.ParseFloat(ctx.Query("lat"), 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid lat"})
+		return
+	}
+	longitude, err := strconv.ParseFloat(ctx.Query("lng"), 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid lng"})
+		return
+	}
+	radiusKm, err := strconv.ParseFloat(ctx.DefaultQuery("radius_km", "10"), 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid radius_km"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetNearbyPosts service
+	resp, err := svc.AuthenticateAndPostClient.GetNearbyPosts(ctx, &pb_aap.GetNearbyPostsRequest{
+		Latitude:  latitude,
+		Longitude: longitude,
+		RadiusKm:  radiusKm,
+		ViewerId:  svc.getViewerId(ctx),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetNearbyPostsResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location or radius"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetNearbyPostsResponse_OK {
+		posts := make([]types.NearbyPostResponse, 0, len(resp.GetPosts()))
+		for _, post := range resp.GetPosts() {
+			posts = append(posts, types.NearbyPostResponse{
+				PostId:     post.GetPostId(),
+				DistanceKm: post.GetDistanceKm(),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.NearbyPostsResponse{Posts: posts})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
 // EditPost godoc
 // @Summary Edit post
-// @Description Edit an existing post
+// @Description Edit an existing post. media replaces the media of the post, an empty list removes them
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -199,16 +386,19 @@ func (svc *WebService) EditPost(ctx *gin.Context) {
 	if jsonRequest.ContentText != nil {
 		grpcReq.ContentText = jsonRequest.ContentText
 	}
-	if jsonRequest.ContentImagePath != nil {
-		// Convert []string to single string (as per existing implementation)
-		if len(*jsonRequest.ContentImagePath) > 0 {
-			imagePath := strings.Join(*jsonRequest.ContentImagePath, " ")
-			grpcReq.ContentImagePath = &imagePath
-		}
-	}
+	grpcReq.Media = toPbMediaList(jsonRequest.Media, jsonRequest.ContentImagePath)
 	if jsonRequest.Visible != nil {
 		grpcReq.Visible = jsonRequest.Visible
 	}
+	if jsonRequest.Visibility != nil {
+		grpcReq.Visibility = toPbPostVisibility(*jsonRequest.Visibility)
+	}
+	grpcReq.Location = toPbLocation(jsonRequest.Location)
+	grpcReq.RemoveLocation = jsonRequest.RemoveLocation
+	grpcReq.PlaceId = jsonRequest.PlaceId
+	grpcReq.RemovePlace = jsonRequest.RemovePlace
+	grpcReq.TripId = jsonRequest.TripId
+	grpcReq.RemoveTrip = jsonRequest.RemoveTrip
 
 	resp, err := svc.AuthenticateAndPostClient.EditPost(ctx, grpcReq)
 	if err != nil {
@@ -224,6 +414,18 @@ func (svc *WebService) EditPost(ctx *gin.Context) {
 	} else if resp.GetStatus() == pb_aap.EditPostResponse_NOT_ALLOWED {
 		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: "not allowed to edit this post"})
 		return
+	} else if resp.GetStatus() == pb_aap.EditPostResponse_INVALID_LOCATION {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid location"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPostResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPostResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPostResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid media"})
+		return
 	} else if resp.GetStatus() == pb_aap.EditPostResponse_OK {
 		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
@@ -235,7 +437,7 @@ func (svc *WebService) EditPost(ctx *gin.Context) {
 
 // DeletePost godoc
 // @Summary Delete post
-// @Description Delete an existing post
+// @Description Move an existing post to the trash. It can be restored for 30 days before it is permanently removed
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -288,6 +490,439 @@ func (svc *WebService) DeletePost(ctx *gin.Context) {
 	}
 }
 
+// GetTrashedPosts godoc
+// @Summary Get trashed posts
+// @Description List the current user's deleted posts that can still be restored
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.TrashedPostsResponse "Trashed posts"
+// @Failure 400 {object} types.MessageResponse "User not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/trash [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetTrashedPosts(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetTrashedPosts service
+	resp, err := svc.AuthenticateAndPostClient.GetTrashedPosts(ctx, &pb_aap.GetTrashedPostsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetTrashedPostsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetTrashedPostsResponse_OK {
+		posts := make([]types.TrashedPostResponse, 0, len(resp.GetPosts()))
+		for _, post := range resp.GetPosts() {
+			posts = append(posts, types.TrashedPostResponse{
+				PostID:           post.GetPostId(),
+				ContentText:      post.GetContentText(),
+				ContentImagePath: mediaURLs(post.GetMedia()),
+				Media:            fromPbMedia(post.GetMedia()),
+				CreatedAt:        post.GetCreatedAt().AsTime().Format(time.RFC3339),
+				DeletedAt:        post.GetDeletedAt().AsTime().Format(time.RFC3339),
+				PurgeAt:          post.GetPurgeAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.TrashedPostsResponse{Posts: posts})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetScheduledPosts godoc
+// @Summary Get scheduled posts
+// @Description List the current user's posts waiting to be published, the next to be published first
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.ScheduledPostsResponse "Scheduled posts"
+// @Failure 400 {object} types.MessageResponse "User not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/scheduled [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetScheduledPosts(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetScheduledPosts service
+	resp, err := svc.AuthenticateAndPostClient.GetScheduledPosts(ctx, &pb_aap.GetScheduledPostsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetScheduledPostsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetScheduledPostsResponse_OK {
+		posts := make([]types.ScheduledPostResponse, 0, len(resp.GetPosts()))
+		for _, post := range resp.GetPosts() {
+			posts = append(posts, types.ScheduledPostResponse{
+				PostID:           post.GetPostId(),
+				ContentText:      post.GetContentText(),
+				ContentImagePath: mediaURLs(post.GetMedia()),
+				Media:            fromPbMedia(post.GetMedia()),
+				Visibility:       fromPbPostVisibility(post.GetVisibility()),
+				CreatedAt:        post.GetCreatedAt().AsTime().Format(time.RFC3339),
+				PublishAt:        post.GetPublishAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.ScheduledPostsResponse{Posts: posts})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// ReschedulePost godoc
+// @Summary Reschedule a post
+// @Description Move the publication of a scheduled post of the current user to another time, within a year. Its poll closes as much later.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param request body types.ReschedulePostRequest true "New publication time"
+// @Success 200 {object} types.MessageResponse "Post rescheduled successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the post"
+// @Failure 404 {object} types.MessageResponse "Post not found"
+// @Failure 409 {object} types.MessageResponse "Post already published"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/schedule [put]
+// @Security ApiKeyAuth
+func (svc *WebService) ReschedulePost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.ReschedulePostRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	publishAt, err := time.Parse(time.RFC3339, jsonRequest.PublishAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid publish_at"})
+		return
+	}
+
+	// Call ReschedulePost service
+	resp, err := svc.AuthenticateAndPostClient.ReschedulePost(ctx, &pb_aap.ReschedulePostRequest{
+		UserId:    int64(userId),
+		PostId:    postId,
+		PublishAt: timestamppb.New(publishAt),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.ReschedulePostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ReschedulePostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ReschedulePostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.ReschedulePostResponse_NOT_SCHEDULED {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "post already published"})
+		return
+	} else if resp.GetStatus() == pb_aap.ReschedulePostResponse_INVALID_PUBLISH_AT {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "publish_at must be in the future and within a year"})
+		return
+	} else if resp.GetStatus() == pb_aap.ReschedulePostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// CancelScheduledPost godoc
+// @Summary Cancel a scheduled post
+// @Description Move a scheduled post of the current user to the trash before it is published. Restoring it from the trash schedules it again.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Scheduled post cancelled successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the post"
+// @Failure 404 {object} types.MessageResponse "Post not found"
+// @Failure 409 {object} types.MessageResponse "Post already published"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/schedule [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) CancelScheduledPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Call CancelScheduledPost service
+	resp, err := svc.AuthenticateAndPostClient.CancelScheduledPost(ctx, &pb_aap.CancelScheduledPostRequest{
+		UserId: int64(userId),
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CancelScheduledPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelScheduledPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelScheduledPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelScheduledPostResponse_NOT_SCHEDULED {
+		ctx.JSON(http.StatusConflict, types.MessageResponse{Message: "post already published"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelScheduledPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RestorePost godoc
+// @Summary Restore post
+// @Description Restore a post from the trash and publish it again to the followers' newsfeeds. A cancelled scheduled post is scheduled again.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Post restored successfully"
+// @Failure 400 {object} types.MessageResponse "Post not found in trash"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/restore [post]
+// @Security ApiKeyAuth
+func (svc *WebService) RestorePost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call RestorePost service
+	resp, err := svc.AuthenticateAndPostClient.RestorePost(ctx, &pb_aap.RestorePostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RestorePostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found in trash"})
+		return
+	} else if resp.GetStatus() == pb_aap.RestorePostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RestorePostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: "not allowed to restore this post"})
+		return
+	} else if resp.GetStatus() == pb_aap.RestorePostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPostRevisions godoc
+// @Summary Get post revisions
+// @Description List every version of a post, oldest first. Only the author can see the revisions of a post
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.PostRevisionsResponse "Post revisions"
+// @Failure 400 {object} types.MessageResponse "Post not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not allowed to see the revisions of this post"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/revisions [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetPostRevisions(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call GetPostRevisions service
+	resp, err := svc.AuthenticateAndPostClient.GetPostRevisions(ctx, &pb_aap.GetPostRevisionsRequest{
+		PostId:   int64(postId),
+		ViewerId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPostRevisionsResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPostRevisionsResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to see the revisions of this post"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPostRevisionsResponse_OK {
+		revisions := make([]types.PostRevisionResponse, 0, len(resp.GetRevisions()))
+		for _, revision := range resp.GetRevisions() {
+			revisions = append(revisions, types.PostRevisionResponse{
+				Revision:         revision.GetRevision(),
+				ContentText:      revision.GetContentText(),
+				ContentImagePath: mediaURLs(revision.GetMedia()),
+				Media:            fromPbMedia(revision.GetMedia()),
+				Visibility:       fromPbPostVisibility(revision.GetVisibility()),
+				CreatedAt:        revision.GetCreatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.PostRevisionsResponse{Revisions: revisions})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RevertPost godoc
+// @Summary Revert post
+// @Description Restore the content of an earlier revision of a post. The reverted content is recorded as a new revision
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} types.MessageResponse "Post reverted successfully"
+// @Failure 400 {object} types.MessageResponse "Post or revision not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/revisions/{revision}/revert [post]
+// @Security ApiKeyAuth
+func (svc *WebService) RevertPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+	revision, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "revision not found"})
+		return
+	}
+
+	// Call RevertPost service
+	resp, err := svc.AuthenticateAndPostClient.RevertPost(ctx, &pb_aap.RevertPostRequest{
+		UserId:   int64(userId),
+		PostId:   int64(postId),
+		Revision: int32(revision),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RevertPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RevertPostResponse_REVISION_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "revision not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RevertPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RevertPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: "not allowed to revert this post"})
+		return
+	} else if resp.GetStatus() == pb_aap.RevertPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
 // CommentPost godoc
 // @Summary Comment on a post
 // @Description Add a comment to an existing post
@@ -349,7 +984,8 @@ func (svc *WebService) CommentPost(ctx *gin.Context) {
 	} else if resp.GetStatus() == pb_aap.CommentPostResponse_OK {
 		// Get updated post details
 		postResp, err := svc.AuthenticateAndPostClient.GetPostDetailInfo(ctx, &pb_aap.GetPostDetailInfoRequest{
-			PostId: int64(postId),
+			PostId:   int64(postId),
+			ViewerId: int64(userId),
 		})
 		if err != nil {
 			ctx.JSON(http.StatusOK, types.MessageResponse{Message: "Comment added successfully"})
@@ -364,6 +1000,7 @@ func (svc *WebService) CommentPost(ctx *gin.Context) {
 				UserId:      comment.GetUserId(),
 				PostId:      comment.GetPostId(),
 				ContentText: comment.GetContentText(),
+				Mentions:    fromPbMentionSpans(comment.GetMentions()),
 			})
 		}
 
@@ -377,10 +1014,18 @@ func (svc *WebService) CommentPost(ctx *gin.Context) {
 			PostID:           postResp.GetPost().GetPostId(),
 			UserID:           postResp.GetPost().GetUserId(),
 			ContentText:      postResp.GetPost().GetContentText(),
-			ContentImagePath: postResp.GetPost().GetContentImagePath(),
+			ContentImagePath: mediaURLs(postResp.GetPost().GetMedia()),
+			Media:            fromPbMedia(postResp.GetPost().GetMedia()),
+			Visibility:       fromPbPostVisibility(postResp.GetPost().GetVisibility()),
 			CreatedAt:        postResp.GetPost().GetCreatedAt().AsTime().Format(time.RFC3339),
 			Comments:         comments,
 			UsersLiked:       usersLiked,
+			LikedByMe:        postResp.GetPost().GetLikedByMe(),
+			EditedAt:         formatOptionalTime(postResp.GetPost().GetEditedAt()),
+			Mentions:         fromPbMentionSpans(postResp.GetPost().GetMentions()),
+			Location:         fromPbLocation(postResp.GetPost().GetLocation()),
+			Place:            fromPbPlace(postResp.GetPost().GetPlace()),
+			TripId:           postResp.GetPost().GetTripId(),
 		})
 		return
 	} else {
@@ -441,6 +1086,124 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 	}
 }
 
+// RepostPost godoc
+// @Summary Repost a post
+// @Description Share a public post as is with the followers of the current user. Reposting a repost shares its original post. Followers whose newsfeed already has the post do not get it twice.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.RepostPostResponse "Post reposted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or post not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "The post is not public"
+// @Failure 409 {object} types.RepostPostResponse "Already reposted, repost_id is the existing repost"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/repost [post]
+// @Security ApiKeyAuth
+func (svc *WebService) RepostPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call RepostPost service
+	resp, err := svc.AuthenticateAndPostClient.RepostPost(ctx, &pb_aap.RepostPostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RepostPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RepostPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RepostPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "only public posts can be reposted"})
+		return
+	} else if resp.GetStatus() == pb_aap.RepostPostResponse_ALREADY_REPOSTED {
+		ctx.JSON(http.StatusConflict, types.RepostPostResponse{
+			Message:  "post already reposted",
+			RepostId: resp.GetRepostId(),
+		})
+		return
+	} else if resp.GetStatus() == pb_aap.RepostPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.RepostPostResponse{
+			Message:  "OK",
+			RepostId: resp.GetRepostId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UndoRepost godoc
+// @Summary Undo a repost
+// @Description Delete the repost of a post by the current user, post_id is the reposted post or the repost itself
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Repost deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or post not reposted"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /posts/{post_id}/repost [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) UndoRepost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call UndoRepost service
+	resp, err := svc.AuthenticateAndPostClient.UndoRepost(ctx, &pb_aap.UndoRepostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UndoRepostResponse_NOT_REPOSTED {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "post not reposted"})
+		return
+	} else if resp.GetStatus() == pb_aap.UndoRepostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UndoRepostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
 // GetS3PresignedUrl godoc
 // @Summary Get a presigned S3 URL for file upload
 // @Description Get a presigned URL to directly upload a file to S3
@@ -496,6 +1259,191 @@ func (svc *WebService) GetS3PresignedUrl(ctx *gin.Context) {
 	})
 }
 
+// toPbPostVisibility converts a visibility name from the API into its protobuf value.
+// It returns nil for an empty name so the service falls back to the visible flag.
+func toPbPostVisibility(visibility string) *pb_aap.PostVisibility {
+	var v pb_aap.PostVisibility
+	switch visibility {
+	case "public":
+		v = pb_aap.PostVisibility_PUBLIC
+	case "followers":
+		v = pb_aap.PostVisibility_FOLLOWERS
+	case "private":
+		v = pb_aap.PostVisibility_PRIVATE
+	default:
+		return nil
+	}
+	return &v
+}
+
+// fromPbPostVisibility converts a protobuf visibility into the name used by the API
+func fromPbPostVisibility(visibility pb_aap.PostVisibility) string {
+	return strings.ToLower(visibility.String())
+}
+
+// toPbLocation converts the location of a create or edit request, nil when it is not set
+func toPbLocation(location *types.LocationRequest) *pb_aap.Location {
+	if location == nil {
+		return nil
+	}
+	return &pb_aap.Location{
+		Latitude:  *location.Latitude,
+		Longitude: *location.Longitude,
+		PlaceName: location.PlaceName,
+	}
+}
+
+// fromPbLocation converts the location of a post, nil when the post is not geotagged
+func fromPbLocation(location *pb_aap.Location) *types.LocationResponse {
+	if location == nil {
+		return nil
+	}
+	return &types.LocationResponse{
+		Latitude:  location.GetLatitude(),
+		Longitude: location.GetLongitude(),
+		PlaceName: location.GetPlaceName(),
+	}
+}
+
+// fromPbMentionSpans converts the mention spans of a post or comment text
+func fromPbMentionSpans(spans []*pb_aap.MentionSpan) []types.MentionSpanResponse {
+	mentions := make([]types.MentionSpanResponse, 0, len(spans))
+	for _, span := range spans {
+		mentions = append(mentions, types.MentionSpanResponse{
+			UserID:   span.GetUserId(),
+			UserName: span.GetUserName(),
+			Start:    span.GetStart(),
+			End:      span.GetEnd(),
+		})
+	}
+	return mentions
+}
+
+// toPbMedia converts the media of a request, falling back to the deprecated image paths
+func toPbMedia(media []types.MediaRequest, imagePaths []string) []*pb_aap.PostMedia {
+	result := make([]*pb_aap.PostMedia, 0, len(media))
+	if media == nil {
+		for _, path := range imagePaths {
+			result = append(result, &pb_aap.PostMedia{Url: path})
+		}
+		return result
+	}
+	for _, item := range media {
+		result = append(result, &pb_aap.PostMedia{
+			Url:      item.URL,
+			MimeType: item.MimeType,
+			Width:    item.Width,
+			Height:   item.Height,
+			AltText:  item.AltText,
+			Blurhash: item.Blurhash,
+		})
+	}
+	return result
+}
+
+// toPbMediaList converts the media of an edit request, nil leaves the media unchanged
+func toPbMediaList(media *[]types.MediaRequest, imagePaths *[]string) *pb_aap.PostMediaList {
+	if media != nil {
+		return &pb_aap.PostMediaList{Media: toPbMedia(*media, nil)}
+	}
+	if imagePaths != nil {
+		return &pb_aap.PostMediaList{Media: toPbMedia(nil, *imagePaths)}
+	}
+	return nil
+}
+
+// fromPbMedia converts media, always returning an array
+func fromPbMedia(media []*pb_aap.PostMedia) []types.MediaResponse {
+	result := make([]types.MediaResponse, 0, len(media))
+	for _, item := range media {
+		result = append(result, types.MediaResponse{
+			URL:      item.GetUrl(),
+			MimeType: item.GetMimeType(),
+			Width:    item.GetWidth(),
+			Height:   item.GetHeight(),
+			AltText:  item.GetAltText(),
+			Blurhash: item.GetBlurhash(),
+		})
+	}
+	return result
+}
+
+// mediaURLs returns the urls of media, for the deprecated content_image_path of responses
+func mediaURLs(media []*pb_aap.PostMedia) []string {
+	urls := make([]string, 0, len(media))
+	for _, item := range media {
+		urls = append(urls, item.GetUrl())
+	}
+	return urls
+}
+
+// fromPbPostAuthor converts the public profile of the author of a post or story
+func fromPbPostAuthor(author *pb_aap.PostAuthor) types.PostAuthorResponse {
+	return types.PostAuthorResponse{
+		UserID:         author.GetUserId(),
+		UserName:       author.GetUserName(),
+		FirstName:      author.GetFirstName(),
+		LastName:       author.GetLastName(),
+		ProfilePicture: author.GetProfilePicture(),
+	}
+}
+
+// fromPbPostSummary converts a post of a list
+func fromPbPostSummary(post *pb_aap.PostSummary) types.PostSummaryResponse {
+	var author *types.PostAuthorResponse
+	if post.GetAuthor() != nil {
+		postAuthor := fromPbPostAuthor(post.GetAuthor())
+		author = &postAuthor
+	}
+	return types.PostSummaryResponse{
+		PostID:           post.GetPostId(),
+		UserID:           post.GetUserId(),
+		Author:           author,
+		ContentText:      post.GetContentText(),
+		ContentImagePath: mediaURLs(post.GetMedia()),
+		Media:            fromPbMedia(post.GetMedia()),
+		Visibility:       fromPbPostVisibility(post.GetVisibility()),
+		CreatedAt:        post.GetCreatedAt().AsTime().Format(time.RFC3339),
+		EditedAt:         formatOptionalTime(post.GetEditedAt()),
+		Mentions:         fromPbMentionSpans(post.GetMentions()),
+		Location:         fromPbLocation(post.GetLocation()),
+		Place:            fromPbPlace(post.GetPlace()),
+		TripId:           post.GetTripId(),
+		RepostOfPostId:   post.GetRepostOfPostId(),
+		QuoteOfPostId:    post.GetQuoteOfPostId(),
+		LikeCount:        post.GetLikeCount(),
+		CommentCount:     post.GetCommentCount(),
+		RepostCount:      post.GetRepostCount(),
+		QuoteCount:       post.GetQuoteCount(),
+		LikedByMe:        post.GetLikedByMe(),
+		RepostedByMe:     post.GetRepostedByMe(),
+		BookmarkedByMe:   post.GetBookmarkedByMe(),
+	}
+}
+
+// fromPbLinkPreviews converts the link previews of a post
+func fromPbLinkPreviews(previews []*pb_aap.LinkPreview) []types.LinkPreviewResponse {
+	result := make([]types.LinkPreviewResponse, 0, len(previews))
+	for _, preview := range previews {
+		result = append(result, types.LinkPreviewResponse{
+			URL:         preview.GetUrl(),
+			Title:       preview.GetTitle(),
+			Description: preview.GetDescription(),
+			ImageURL:    preview.GetImageUrl(),
+			SiteName:    preview.GetSiteName(),
+		})
+	}
+	return result
+}
+
+// formatOptionalTime formats an optional timestamp as RFC3339, returning an empty string when it is unset
+func formatOptionalTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
+
 // generatePresignedPutURL generates a presigned URL for PUT operations (uploads)
 func (svc *WebService) generatePresignedPutURL(key string, contentType string, expiration time.Duration) (string, error) {
 	return svc.BinaryStorage.GenerateUploadURL(key, contentType, expiration)
@@ -0,0 +1,451 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// CreatePlaceReview godoc
+// @Summary Review a place
+// @Description Rate a place from 1 to 5 stars with an optional text. A user has at most one review per place, edit it to change it. The review is added to the newsfeeds of the reviewer's followers.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param place_id path int true "Place ID"
+// @Param request body types.CreatePlaceReviewRequest true "Rating and review text"
+// @Success 200 {object} types.CreatePlaceReviewResponse "Review created successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Place not found"
+// @Failure 409 {object} types.CreatePlaceReviewResponse "The user already reviewed this place, review_id is their review"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places/{place_id}/reviews [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreatePlaceReview(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	placeId, err := strconv.ParseInt(ctx.Param("place_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreatePlaceReviewRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CreatePlaceReview service
+	resp, err := svc.AuthenticateAndPostClient.CreatePlaceReview(ctx, &pb_aap.CreatePlaceReviewRequest{
+		UserId:      int64(userId),
+		PlaceId:     placeId,
+		Rating:      jsonRequest.Rating,
+		ContentText: jsonRequest.ContentText,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreatePlaceReviewResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceReviewResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceReviewResponse_INVALID_REVIEW {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceReviewResponse_ALREADY_REVIEWED {
+		ctx.JSON(http.StatusConflict, types.CreatePlaceReviewResponse{
+			Message:  "place already reviewed",
+			ReviewId: resp.GetReviewId(),
+		})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePlaceReviewResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreatePlaceReviewResponse{
+			Message:  "OK",
+			ReviewId: resp.GetReviewId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPlaceReviews godoc
+// @Summary Get place reviews
+// @Description Get the reviews of a place in the requested order, with the rating summary of the place
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param place_id path int true "Place ID"
+// @Param sort query string false "Order of the reviews" Enums(newest, helpful, highest, lowest) default(newest)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 50"
+// @Success 200 {object} types.PlaceReviewsResponse "Place reviews"
+// @Failure 400 {object} types.MessageResponse "Invalid place ID, sort, cursor or limit"
+// @Failure 404 {object} types.MessageResponse "Place not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /places/{place_id}/reviews [get]
+func (svc *WebService) GetPlaceReviews(ctx *gin.Context) {
+	// Check URL and query params
+	placeId, err := strconv.ParseInt(ctx.Param("place_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid place_id"})
+		return
+	}
+	var sort pb_aap.GetPlaceReviewsRequest_ReviewSort
+	switch ctx.DefaultQuery("sort", "newest") {
+	case "newest":
+		sort = pb_aap.GetPlaceReviewsRequest_NEWEST
+	case "helpful":
+		sort = pb_aap.GetPlaceReviewsRequest_MOST_HELPFUL
+	case "highest":
+		sort = pb_aap.GetPlaceReviewsRequest_HIGHEST_RATED
+	case "lowest":
+		sort = pb_aap.GetPlaceReviewsRequest_LOWEST_RATED
+	default:
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid sort, must be newest, helpful, highest or lowest"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetPlaceReviews service
+	resp, err := svc.AuthenticateAndPostClient.GetPlaceReviews(ctx, &pb_aap.GetPlaceReviewsRequest{
+		PlaceId:  placeId,
+		ViewerId: svc.getViewerId(ctx),
+		Sort:     sort,
+		Cursor:   ctx.Query("cursor"),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPlaceReviewsResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPlaceReviewsResponse_INVALID_CURSOR {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPlaceReviewsResponse_OK {
+		reviews := make([]types.PlaceReviewResponse, 0, len(resp.GetReviews()))
+		for _, review := range resp.GetReviews() {
+			reviews = append(reviews, fromPbPlaceReview(review))
+		}
+		ctx.JSON(http.StatusOK, types.PlaceReviewsResponse{
+			Reviews: reviews,
+			Summary: types.RatingSummaryResponse{
+				Count:   resp.GetSummary().GetCount(),
+				Average: resp.GetSummary().GetAverage(),
+				Counts:  resp.GetSummary().GetCounts(),
+			},
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPlaceReview godoc
+// @Summary Get a place review
+// @Description Get a review of a place, for example a review item of the newsfeed
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param review_id path int true "Review ID"
+// @Success 200 {object} types.PlaceReviewResponse "Review details"
+// @Failure 400 {object} types.MessageResponse "Invalid review ID"
+// @Failure 404 {object} types.MessageResponse "Review not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /reviews/{review_id} [get]
+func (svc *WebService) GetPlaceReview(ctx *gin.Context) {
+	// Check URL params
+	reviewId, err := strconv.ParseInt(ctx.Param("review_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review_id"})
+		return
+	}
+
+	// Call GetPlaceReview service
+	resp, err := svc.AuthenticateAndPostClient.GetPlaceReview(ctx, &pb_aap.GetPlaceReviewRequest{
+		ReviewId: reviewId,
+		ViewerId: svc.getViewerId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPlaceReviewResponse_REVIEW_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "review not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPlaceReviewResponse_OK {
+		ctx.JSON(http.StatusOK, fromPbPlaceReview(resp.GetReview()))
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EditPlaceReview godoc
+// @Summary Edit a place review
+// @Description Update the rating or the text of a review. Only the author may edit it.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param review_id path int true "Review ID"
+// @Param request body types.EditPlaceReviewRequest true "Fields to update"
+// @Success 200 {object} types.MessageResponse "Review edited successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the review"
+// @Failure 404 {object} types.MessageResponse "Review not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /reviews/{review_id} [put]
+// @Security ApiKeyAuth
+func (svc *WebService) EditPlaceReview(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	reviewId, err := strconv.ParseInt(ctx.Param("review_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.EditPlaceReviewRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call EditPlaceReview service
+	resp, err := svc.AuthenticateAndPostClient.EditPlaceReview(ctx, &pb_aap.EditPlaceReviewRequest{
+		UserId:      int64(userId),
+		ReviewId:    reviewId,
+		Rating:      jsonRequest.Rating,
+		ContentText: jsonRequest.ContentText,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EditPlaceReviewResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPlaceReviewResponse_REVIEW_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "review not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPlaceReviewResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "only the author can edit this review"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPlaceReviewResponse_INVALID_REVIEW {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditPlaceReviewResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeletePlaceReview godoc
+// @Summary Delete a place review
+// @Description Delete a review. The author and moderators may delete it.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param review_id path int true "Review ID"
+// @Success 200 {object} types.MessageResponse "Review deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Invalid review ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Neither the author nor a moderator"
+// @Failure 404 {object} types.MessageResponse "Review not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /reviews/{review_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) DeletePlaceReview(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	reviewId, err := strconv.ParseInt(ctx.Param("review_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review_id"})
+		return
+	}
+
+	// Call DeletePlaceReview service
+	resp, err := svc.AuthenticateAndPostClient.DeletePlaceReview(ctx, &pb_aap.DeletePlaceReviewRequest{
+		UserId:   int64(userId),
+		ReviewId: reviewId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeletePlaceReviewResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeletePlaceReviewResponse_REVIEW_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "review not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeletePlaceReviewResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to delete this review"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeletePlaceReviewResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// VoteReviewHelpful godoc
+// @Summary Vote a review helpful
+// @Description Mark a review as helpful. Voting twice has no effect and authors cannot vote on their own reviews.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param review_id path int true "Review ID"
+// @Success 200 {object} types.MessageResponse "Vote recorded"
+// @Failure 400 {object} types.MessageResponse "Invalid review ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Own review"
+// @Failure 404 {object} types.MessageResponse "Review not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /reviews/{review_id}/helpful [post]
+// @Security ApiKeyAuth
+func (svc *WebService) VoteReviewHelpful(ctx *gin.Context) {
+	svc.voteReviewHelpful(ctx, true)
+}
+
+// UnvoteReviewHelpful godoc
+// @Summary Remove a helpful vote
+// @Description Remove the helpful vote of the current user from a review
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param review_id path int true "Review ID"
+// @Success 200 {object} types.MessageResponse "Vote removed"
+// @Failure 400 {object} types.MessageResponse "Invalid review ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Own review"
+// @Failure 404 {object} types.MessageResponse "Review not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /reviews/{review_id}/helpful [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) UnvoteReviewHelpful(ctx *gin.Context) {
+	svc.voteReviewHelpful(ctx, false)
+}
+
+// voteReviewHelpful adds or removes the helpful vote of the current user
+func (svc *WebService) voteReviewHelpful(ctx *gin.Context, helpful bool) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	reviewId, err := strconv.ParseInt(ctx.Param("review_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid review_id"})
+		return
+	}
+
+	// Call VoteReviewHelpful service
+	resp, err := svc.AuthenticateAndPostClient.VoteReviewHelpful(ctx, &pb_aap.VoteReviewHelpfulRequest{
+		UserId:   int64(userId),
+		ReviewId: reviewId,
+		Helpful:  helpful,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.VoteReviewHelpfulResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VoteReviewHelpfulResponse_REVIEW_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "review not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VoteReviewHelpfulResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "cannot vote on your own review"})
+		return
+	} else if resp.GetStatus() == pb_aap.VoteReviewHelpfulResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// fromPbPlaceReview converts a review
+func fromPbPlaceReview(review *pb_aap.PlaceReview) types.PlaceReviewResponse {
+	var editedAt string
+	if review.GetEditedAt() != nil {
+		editedAt = review.GetEditedAt().AsTime().Format(time.RFC3339)
+	}
+	return types.PlaceReviewResponse{
+		ReviewId:     review.GetReviewId(),
+		PlaceId:      review.GetPlaceId(),
+		UserId:       review.GetUserId(),
+		Rating:       review.GetRating(),
+		ContentText:  review.GetContentText(),
+		HelpfulCount: review.GetHelpfulCount(),
+		VotedHelpful: review.GetVotedHelpful(),
+		CreatedAt:    review.GetCreatedAt().AsTime().Format(time.RFC3339),
+		EditedAt:     editedAt,
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// Search godoc
+// @Summary Search posts or users
+// @Description Full-text search over the posts the current viewer is allowed to see, or fuzzy search over user names and full names. Best matches come first.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query, at most 200 characters"
+// @Param type query string false "What to search for" Enums(posts, users) default(posts)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, 20 by default and at most 50"
+// @Success 200 {object} types.SearchResponse "Search results"
+// @Failure 400 {object} types.MessageResponse "Invalid query, type, cursor or limit"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /search [get]
+func (svc *WebService) Search(ctx *gin.Context) {
+	// Check query params
+	searchType := ctx.DefaultQuery("type", "posts")
+	var pbSearchType pb_aap.SearchRequest_SearchType
+	switch searchType {
+	case "posts":
+		pbSearchType = pb_aap.SearchRequest_POSTS
+	case "users":
+		pbSearchType = pb_aap.SearchRequest_USERS
+	default:
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid type, must be posts or users"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call Search service
+	resp, err := svc.AuthenticateAndPostClient.Search(ctx, &pb_aap.SearchRequest{
+		Query:    ctx.Query("q"),
+		Type:     pbSearchType,
+		ViewerId: svc.getViewerId(ctx),
+		Cursor:   ctx.Query("cursor"),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.SearchResponse_INVALID_QUERY {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid query"})
+		return
+	} else if resp.GetStatus() == pb_aap.SearchResponse_INVALID_CURSOR {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	} else if resp.GetStatus() == pb_aap.SearchResponse_OK {
+		result := types.SearchResponse{
+			Type:       searchType,
+			NextCursor: resp.GetNextCursor(),
+		}
+		if pbSearchType == pb_aap.SearchRequest_USERS {
+			result.Users = make([]types.UserDetailInfoResponse, 0, len(resp.GetUsers()))
+			for _, user := range resp.GetUsers() {
+				result.Users = append(result.Users, types.UserDetailInfoResponse{
+					UserID:         user.GetUserId(),
+					UserName:       user.GetUserName(),
+					FirstName:      user.GetFirstName(),
+					LastName:       user.GetLastName(),
+					ProfilePicture: user.GetProfilePicture(),
+					CoverPicture:   user.GetCoverPicture(),
+					Following:      user.GetFollowing(),
+					FollowedBy:     user.GetFollowedBy(),
+				})
+			}
+		} else {
+			result.PostsIds = resp.GetPostsIds()
+			if result.PostsIds == nil {
+				result.PostsIds = []int64{}
+			}
+		}
+		ctx.JSON(http.StatusOK, result)
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// CreateStory godoc
+// @Summary Post a story
+// @Description Post an image as a story of the current user. Stories are shown to the user's followers for 24 hours, then deleted with their image.
+// @Tags stories
+// @Accept json
+// @Produce json
+// @Param request body types.CreateStoryRequest true "Story image"
+// @Success 200 {object} types.CreateStoryResponse "Story posted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or media is not an image"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /stories [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreateStory(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreateStoryRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CreateStory service
+	resp, err := svc.AuthenticateAndPostClient.CreateStory(ctx, &pb_aap.CreateStoryRequest{
+		UserId: int64(userId),
+		Media:  toPbMedia([]types.MediaRequest{jsonRequest.Media}, nil)[0],
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateStoryResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateStoryResponse_INVALID_MEDIA {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "a story must be an image"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateStoryResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreateStoryResponse{
+			StoryID:   resp.GetStoryId(),
+			ExpiresAt: resp.GetExpiresAt().AsTime().Format(time.RFC3339),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetStoryTray godoc
+// @Summary Get the story tray
+// @Description Get the live stories of the current user and of the users they follow, grouped by user. The current user comes first, then the users with stories the current user has not seen, the most recent story first.
+// @Tags stories
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.StoryTrayResponse "Story tray retrieved successfully"
+// @Failure 400 {object} types.MessageResponse "User not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /stories [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetStoryTray(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetStoryTray service
+	resp, err := svc.AuthenticateAndPostClient.GetStoryTray(ctx, &pb_aap.GetStoryTrayRequest{
+		ViewerId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetStoryTrayResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryTrayResponse_OK {
+		entries := make([]types.StoryTrayEntryResponse, 0, len(resp.GetEntries()))
+		for _, entry := range resp.GetEntries() {
+			stories := make([]types.StoryResponse, 0, len(entry.GetStories()))
+			for _, story := range entry.GetStories() {
+				stories = append(stories, fromPbStory(story))
+			}
+			entries = append(entries, types.StoryTrayEntryResponse{
+				Author:    fromPbPostAuthor(entry.GetAuthor()),
+				Stories:   stories,
+				HasUnseen: entry.GetHasUnseen(),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.StoryTrayResponse{Entries: entries})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// ViewStory godoc
+// @Summary Mark a story as seen
+// @Description Mark a story as seen by the current user, who is added to its viewers. Viewing a story again keeps the time of the first view.
+// @Tags stories
+// @Accept json
+// @Produce json
+// @Param story_id path int true "Story ID"
+// @Success 200 {object} types.MessageResponse "Story marked as seen"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Story not found, expired or not visible"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /stories/{story_id}/view [post]
+// @Security ApiKeyAuth
+func (svc *WebService) ViewStory(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.ParseInt(ctx.Param("story_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid story_id"})
+		return
+	}
+
+	// Call ViewStory service
+	resp, err := svc.AuthenticateAndPostClient.ViewStory(ctx, &pb_aap.ViewStoryRequest{
+		ViewerId: int64(userId),
+		StoryId:  storyId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.ViewStoryResponse_STORY_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ViewStoryResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetStoryViewers godoc
+// @Summary Get the viewers of a story
+// @Description Get the users who saw one of the current user's stories, most recent view first
+// @Tags stories
+// @Accept json
+// @Produce json
+// @Param story_id path int true "Story ID"
+// @Success 200 {object} types.StoryViewersResponse "Viewers retrieved successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the story"
+// @Failure 404 {object} types.MessageResponse "Story not found or expired"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /stories/{story_id}/viewers [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetStoryViewers(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.ParseInt(ctx.Param("story_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid story_id"})
+		return
+	}
+
+	// Call GetStoryViewers service
+	resp, err := svc.AuthenticateAndPostClient.GetStoryViewers(ctx, &pb_aap.GetStoryViewersRequest{
+		UserId:  int64(userId),
+		StoryId: storyId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetStoryViewersResponse_STORY_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryViewersResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryViewersResponse_OK {
+		viewers := make([]types.StoryViewerResponse, 0, len(resp.GetViewers()))
+		for _, viewer := range resp.GetViewers() {
+			viewers = append(viewers, types.StoryViewerResponse{
+				User:     fromPbPostAuthor(viewer.GetUser()),
+				ViewedAt: viewer.GetViewedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.StoryViewersResponse{Viewers: viewers})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteStory godoc
+// @Summary Delete a story
+// @Description Delete one of the current user's stories before it expires
+// @Tags stories
+// @Accept json
+// @Produce json
+// @Param story_id path int true "Story ID"
+// @Success 200 {object} types.MessageResponse "Story deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the author of the story"
+// @Failure 404 {object} types.MessageResponse "Story not found or expired"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /stories/{story_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) DeleteStory(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.ParseInt(ctx.Param("story_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid story_id"})
+		return
+	}
+
+	// Call DeleteStory service
+	resp, err := svc.AuthenticateAndPostClient.DeleteStory(ctx, &pb_aap.DeleteStoryRequest{
+		UserId:  int64(userId),
+		StoryId: storyId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteStoryResponse_STORY_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteStoryResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteStoryResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// fromPbStory converts a story of the story tray
+func fromPbStory(story *pb_aap.Story) types.StoryResponse {
+	return types.StoryResponse{
+		StoryID:   story.GetStoryId(),
+		UserID:    story.GetUserId(),
+		Media:     fromPbMedia([]*pb_aap.PostMedia{story.GetMedia()})[0],
+		CreatedAt: story.GetCreatedAt().AsTime().Format(time.RFC3339),
+		ExpiresAt: story.GetExpiresAt().AsTime().Format(time.RFC3339),
+		Seen:      story.GetSeen(),
+		ViewCount: story.GetViewCount(),
+	}
+}
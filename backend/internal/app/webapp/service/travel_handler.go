@@ -0,0 +1,269 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// GetTravelMap godoc
+// @Summary Get the travel map of a user
+// @Description Get the countries and cities a user visited, with their first visit and the number of located posts, built from the user's check-ins and the visits they added by hand. Only the visits the current viewer is allowed to see are counted.
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} types.TravelMapResponse "Travel map"
+// @Failure 400 {object} types.MessageResponse "Invalid user ID or user not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/{user_id}/travel-map [get]
+func (svc *WebService) GetTravelMap(ctx *gin.Context) {
+	// Check URL params
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid user_id"})
+		return
+	}
+
+	// Call GetTravelMap service
+	resp, err := svc.AuthenticateAndPostClient.GetTravelMap(ctx, &pb_aap.GetTravelMapRequest{
+		UserId:   userId,
+		ViewerId: svc.getViewerId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetTravelMapResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetTravelMapResponse_OK {
+		countries := make([]types.VisitedCountryResponse, 0, len(resp.GetCountries()))
+		for _, country := range resp.GetCountries() {
+			cities := make([]types.VisitedCityResponse, 0, len(country.GetCities()))
+			for _, city := range country.GetCities() {
+				cities = append(cities, types.VisitedCityResponse{
+					City:           city.GetCity(),
+					FirstVisitedAt: city.GetFirstVisitedAt().AsTime().Format(time.RFC3339),
+					PostsCount:     city.GetPostsCount(),
+				})
+			}
+			countries = append(countries, types.VisitedCountryResponse{
+				Country:        country.GetCountry(),
+				FirstVisitedAt: country.GetFirstVisitedAt().AsTime().Format(time.RFC3339),
+				PostsCount:     country.GetPostsCount(),
+				Cities:         cities,
+			})
+		}
+
+		visits := make([]types.TravelVisitResponse, 0, len(resp.GetVisits()))
+		for _, visit := range resp.GetVisits() {
+			visits = append(visits, types.TravelVisitResponse{
+				VisitId:    visit.GetVisitId(),
+				Country:    visit.GetCountry(),
+				City:       visit.GetCity(),
+				VisitedAt:  visit.GetVisitedAt().AsTime().Format(time.DateOnly),
+				Visibility: fromPbPostVisibility(visit.GetVisibility()),
+			})
+		}
+
+		ctx.JSON(http.StatusOK, types.TravelMapResponse{
+			Countries: countries,
+			Visits:    visits,
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetTravelStats godoc
+// @Summary Get the travel stats of a user
+// @Description Get the number of countries, cities and places a user visited, their located posts and the distance travelled between them in order. Only the visits the current viewer is allowed to see are counted.
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} types.TravelStatsResponse "Travel stats"
+// @Failure 400 {object} types.MessageResponse "Invalid user ID or user not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/{user_id}/travel-stats [get]
+func (svc *WebService) GetTravelStats(ctx *gin.Context) {
+	// Check URL params
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid user_id"})
+		return
+	}
+
+	// Call GetTravelStats service
+	resp, err := svc.AuthenticateAndPostClient.GetTravelStats(ctx, &pb_aap.GetTravelStatsRequest{
+		UserId:   userId,
+		ViewerId: svc.getViewerId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetTravelStatsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetTravelStatsResponse_OK {
+		stats := types.TravelStatsResponse{
+			CountriesCount: resp.GetCountriesCount(),
+			CitiesCount:    resp.GetCitiesCount(),
+			PlacesCount:    resp.GetPlacesCount(),
+			PostsCount:     resp.GetPostsCount(),
+			DistanceKm:     resp.GetDistanceKm(),
+		}
+		if resp.GetFirstVisitedAt() != nil {
+			stats.FirstVisitedAt = resp.GetFirstVisitedAt().AsTime().Format(time.RFC3339)
+		}
+		if resp.GetLastVisitedAt() != nil {
+			stats.LastVisitedAt = resp.GetLastVisitedAt().AsTime().Format(time.RFC3339)
+		}
+		ctx.JSON(http.StatusOK, stats)
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// AddTravelVisit godoc
+// @Summary Add a visit to the travel map
+// @Description Add a visit to a country, or to a city of a country, to the travel map of the current user without posting about it
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Param request body types.AddTravelVisitRequest true "Visited country and city"
+// @Success 200 {object} types.AddTravelVisitResponse "Visit added successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or visit date in the future"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/travel-visits [post]
+// @Security ApiKeyAuth
+func (svc *WebService) AddTravelVisit(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.AddTravelVisitRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	var visitedAt *timestamppb.Timestamp
+	if jsonRequest.VisitedAt != "" {
+		parsed, err := time.Parse(time.DateOnly, jsonRequest.VisitedAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid visited_at"})
+			return
+		}
+		visitedAt = timestamppb.New(parsed)
+	}
+	visibility := pb_aap.PostVisibility_PUBLIC
+	if v := toPbPostVisibility(jsonRequest.Visibility); v != nil {
+		visibility = *v
+	}
+
+	// Call AddTravelVisit service
+	resp, err := svc.AuthenticateAndPostClient.AddTravelVisit(ctx, &pb_aap.AddTravelVisitRequest{
+		UserId:     int64(userId),
+		Country:    jsonRequest.Country,
+		City:       jsonRequest.City,
+		VisitedAt:  visitedAt,
+		Visibility: visibility,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.AddTravelVisitResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddTravelVisitResponse_INVALID_VISIT {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid visit"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddTravelVisitResponse_OK {
+		ctx.JSON(http.StatusOK, types.AddTravelVisitResponse{
+			Message: "OK",
+			VisitId: resp.GetVisitId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteTravelVisit godoc
+// @Summary Delete a visit from the travel map
+// @Description Delete a visit the current user added by hand. Visits coming from posts are removed with the location of their post.
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Param visit_id path int true "Visit ID"
+// @Success 200 {object} types.MessageResponse "Visit deleted successfully"
+// @Failure 400 {object} types.MessageResponse "Invalid visit ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Visit not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/travel-visits/{visit_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) DeleteTravelVisit(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	visitId, err := strconv.ParseInt(ctx.Param("visit_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid visit_id"})
+		return
+	}
+
+	// Call DeleteTravelVisit service
+	resp, err := svc.AuthenticateAndPostClient.DeleteTravelVisit(ctx, &pb_aap.DeleteTravelVisitRequest{
+		UserId:  int64(userId),
+		VisitId: visitId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteTravelVisitResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteTravelVisitResponse_VISIT_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "visit not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteTravelVisitResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -0,0 +1,790 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/hoangNguyenDev3/WanderSphere/backend/docs"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_aap "github.com/hoangNguyenDev3/WanderSphere/backend/pkg/types/proto/pb/authpost"
+)
+
+// CreateTrip godoc
+// @Summary Create a trip
+// @Description Create a draft trip. Drafts are only visible to their owner and members until they are published.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param request body types.CreateTripRequest true "Trip creation parameters"
+// @Success 200 {object} types.CreateTripResponse "Trip created successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or place not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips [post]
+// @Security ApiKeyAuth
+func (svc *WebService) CreateTrip(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreateTripRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	startDate, err := time.Parse(time.DateOnly, jsonRequest.StartDate)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid start_date"})
+		return
+	}
+	var endDate *timestamppb.Timestamp
+	if jsonRequest.EndDate != "" {
+		parsed, err := time.Parse(time.DateOnly, jsonRequest.EndDate)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid end_date"})
+			return
+		}
+		endDate = timestamppb.New(parsed)
+	}
+	visibility := pb_aap.PostVisibility_PUBLIC
+	if v := toPbPostVisibility(jsonRequest.Visibility); v != nil {
+		visibility = *v
+	}
+
+	// Call CreateTrip service
+	resp, err := svc.AuthenticateAndPostClient.CreateTrip(ctx, &pb_aap.CreateTripRequest{
+		UserId:     int64(userId),
+		Title:      jsonRequest.Title,
+		StartDate:  timestamppb.New(startDate),
+		EndDate:    endDate,
+		CoverImage: jsonRequest.CoverImage,
+		Visibility: visibility,
+		PlaceIds:   jsonRequest.PlaceIds,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateTripResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateTripResponse_INVALID_TRIP {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateTripResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateTripResponse_OK {
+		ctx.JSON(http.StatusOK, types.CreateTripResponse{
+			Message: "OK",
+			TripId:  resp.GetTripId(),
+		})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EditTrip godoc
+// @Summary Edit a trip
+// @Description Update the fields of a trip that are set. Only the owner and editors may edit a trip.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Param request body types.EditTripRequest true "Trip fields to update"
+// @Success 200 {object} types.MessageResponse "Trip edited successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error or place not found"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the owner or an editor of the trip"
+// @Failure 404 {object} types.MessageResponse "Trip not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id} [put]
+// @Security ApiKeyAuth
+func (svc *WebService) EditTrip(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.EditTripRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	grpcReq := &pb_aap.EditTripRequest{
+		UserId:        int64(userId),
+		TripId:        tripId,
+		Title:         jsonRequest.Title,
+		RemoveEndDate: jsonRequest.RemoveEndDate,
+		CoverImage:    jsonRequest.CoverImage,
+	}
+	if jsonRequest.StartDate != nil {
+		startDate, err := time.Parse(time.DateOnly, *jsonRequest.StartDate)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid start_date"})
+			return
+		}
+		grpcReq.StartDate = timestamppb.New(startDate)
+	}
+	if jsonRequest.EndDate != nil {
+		endDate, err := time.Parse(time.DateOnly, *jsonRequest.EndDate)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid end_date"})
+			return
+		}
+		grpcReq.EndDate = timestamppb.New(endDate)
+	}
+	if jsonRequest.Visibility != nil {
+		grpcReq.Visibility = toPbPostVisibility(*jsonRequest.Visibility)
+	}
+	if jsonRequest.PlaceIds != nil {
+		grpcReq.PlaceIds = *jsonRequest.PlaceIds
+		grpcReq.UpdatePlaces = true
+	}
+
+	// Call EditTrip service
+	resp, err := svc.AuthenticateAndPostClient.EditTrip(ctx, grpcReq)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EditTripResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditTripResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditTripResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to edit this trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditTripResponse_INVALID_TRIP {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditTripResponse_PLACE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "place not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditTripResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// PublishTrip godoc
+// @Summary Publish a trip
+// @Description Make a draft trip visible according to its visibility. Followers allowed to see it get it in their newsfeed. Publishing a published trip does nothing.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Success 200 {object} types.MessageResponse "Trip published successfully"
+// @Failure 400 {object} types.MessageResponse "Invalid trip ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the owner of the trip"
+// @Failure 404 {object} types.MessageResponse "Trip not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/publish [post]
+// @Security ApiKeyAuth
+func (svc *WebService) PublishTrip(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+
+	// Call PublishTrip service
+	resp, err := svc.AuthenticateAndPostClient.PublishTrip(ctx, &pb_aap.PublishTripRequest{
+		UserId: int64(userId),
+		TripId: tripId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.PublishTripResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishTripResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishTripResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to publish this trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.PublishTripResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetTrip godoc
+// @Summary Get a trip
+// @Description Get a trip with its visited places, its members and the timeline of the posts the current viewer is allowed to see, oldest first
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Success 200 {object} types.TripResponse "Trip details"
+// @Failure 400 {object} types.MessageResponse "Invalid trip ID"
+// @Failure 404 {object} types.MessageResponse "Trip not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id} [get]
+func (svc *WebService) GetTrip(ctx *gin.Context) {
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+
+	// Call GetTrip service
+	resp, err := svc.AuthenticateAndPostClient.GetTrip(ctx, &pb_aap.GetTripRequest{
+		TripId:   tripId,
+		ViewerId: svc.getViewerId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetTripResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetTripResponse_OK {
+		ctx.JSON(http.StatusOK, fromPbTrip(resp.GetTrip()))
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// fromPbTrip converts a trip with its places and timeline
+func fromPbTrip(trip *pb_aap.Trip) types.TripResponse {
+	places := make([]types.PlaceResponse, 0, len(trip.GetPlaces()))
+	for _, place := range trip.GetPlaces() {
+		places = append(places, *fromPbPlace(place))
+	}
+	timeline := make([]types.TripPostResponse, 0, len(trip.GetTimeline()))
+	for _, post := range trip.GetTimeline() {
+		timeline = append(timeline, types.TripPostResponse{
+			PostId:    post.GetPostId(),
+			UserId:    post.GetUserId(),
+			CreatedAt: post.GetCreatedAt().AsTime().Format(time.RFC3339),
+			PlaceId:   post.GetPlaceId(),
+		})
+	}
+
+	members := make([]types.TripMemberResponse, 0, len(trip.GetMembers()))
+	for _, member := range trip.GetMembers() {
+		members = append(members, types.TripMemberResponse{
+			UserId:  member.GetUserId(),
+			Role:    fromPbTripRole(member.GetRole()),
+			Pending: member.GetPending(),
+		})
+	}
+
+	var endDate string
+	if trip.GetEndDate() != nil {
+		endDate = trip.GetEndDate().AsTime().Format(time.DateOnly)
+	}
+	return types.TripResponse{
+		TripId:      trip.GetTripId(),
+		UserId:      trip.GetUserId(),
+		Title:       trip.GetTitle(),
+		StartDate:   trip.GetStartDate().AsTime().Format(time.DateOnly),
+		EndDate:     endDate,
+		CoverImage:  trip.GetCoverImage(),
+		Visibility:  fromPbPostVisibility(trip.GetVisibility()),
+		PublishedAt: formatOptionalTime(trip.GetPublishedAt()),
+		CreatedAt:   trip.GetCreatedAt().AsTime().Format(time.RFC3339),
+		Places:      places,
+		Timeline:    timeline,
+		Members:     members,
+	}
+}
+
+// InviteTripMember godoc
+// @Summary Invite a user to a trip
+// @Description Invite a user to a trip as a viewer or an editor. The invitee is notified and becomes a member after accepting. Only the owner may invite.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Param request body types.InviteTripMemberRequest true "User to invite and their role"
+// @Success 200 {object} types.MessageResponse "User invited successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error, invitee not found or already a member"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the owner of the trip"
+// @Failure 404 {object} types.MessageResponse "Trip not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/members [post]
+// @Security ApiKeyAuth
+func (svc *WebService) InviteTripMember(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.InviteTripMemberRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call InviteTripMember service
+	resp, err := svc.AuthenticateAndPostClient.InviteTripMember(ctx, &pb_aap.InviteTripMemberRequest{
+		UserId:    int64(userId),
+		TripId:    tripId,
+		InviteeId: jsonRequest.UserId,
+		Role:      toPbTripRole(jsonRequest.Role),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.InviteTripMemberResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "only the owner can invite to this trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_INVITEE_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invitee not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_ALREADY_MEMBER {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user is already a member of this trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_INVALID_ROLE {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid role"})
+		return
+	} else if resp.GetStatus() == pb_aap.InviteTripMemberResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetTripInvitations godoc
+// @Summary Get trip invitations
+// @Description Get the pending trip invitations of the current user, newest first
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.TripInvitationsResponse "Pending invitations"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/invitations [get]
+// @Security ApiKeyAuth
+func (svc *WebService) GetTripInvitations(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetTripInvitations service
+	resp, err := svc.AuthenticateAndPostClient.GetTripInvitations(ctx, &pb_aap.GetTripInvitationsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetTripInvitationsResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetTripInvitationsResponse_OK {
+		invitations := make([]types.TripInvitationResponse, 0, len(resp.GetInvitations()))
+		for _, invitation := range resp.GetInvitations() {
+			invitations = append(invitations, types.TripInvitationResponse{
+				TripId:    invitation.GetTripId(),
+				Title:     invitation.GetTitle(),
+				InvitedBy: invitation.GetInvitedBy(),
+				Role:      fromPbTripRole(invitation.GetRole()),
+				CreatedAt: invitation.GetCreatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		ctx.JSON(http.StatusOK, types.TripInvitationsResponse{Invitations: invitations})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// AcceptTripInvitation godoc
+// @Summary Accept a trip invitation
+// @Description Accept an invitation to a trip. The owner is notified and the current user can add their posts to the trip.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Success 200 {object} types.MessageResponse "Invitation accepted"
+// @Failure 400 {object} types.MessageResponse "Invalid trip ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Invitation not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/invitation/accept [post]
+// @Security ApiKeyAuth
+func (svc *WebService) AcceptTripInvitation(ctx *gin.Context) {
+	svc.respondTripInvitation(ctx, true)
+}
+
+// DeclineTripInvitation godoc
+// @Summary Decline a trip invitation
+// @Description Decline an invitation to a trip, the owner may invite the current user again
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Success 200 {object} types.MessageResponse "Invitation declined"
+// @Failure 400 {object} types.MessageResponse "Invalid trip ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 404 {object} types.MessageResponse "Invitation not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/invitation/decline [post]
+// @Security ApiKeyAuth
+func (svc *WebService) DeclineTripInvitation(ctx *gin.Context) {
+	svc.respondTripInvitation(ctx, false)
+}
+
+// respondTripInvitation accepts or declines the invitation of the current user to the trip of the URL
+func (svc *WebService) respondTripInvitation(ctx *gin.Context, accept bool) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+
+	// Call RespondTripInvitation service
+	resp, err := svc.AuthenticateAndPostClient.RespondTripInvitation(ctx, &pb_aap.RespondTripInvitationRequest{
+		UserId: int64(userId),
+		TripId: tripId,
+		Accept: accept,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RespondTripInvitationResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RespondTripInvitationResponse_INVITATION_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "invitation not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RespondTripInvitationResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UpdateTripMember godoc
+// @Summary Change the role of a trip member
+// @Description Change the role of a member or of a pending invitation. Only the owner may change roles.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Param user_id path int true "Member user ID"
+// @Param request body types.UpdateTripMemberRequest true "New role"
+// @Success 200 {object} types.MessageResponse "Role changed successfully"
+// @Failure 400 {object} types.MessageResponse "Validation error"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not the owner of the trip"
+// @Failure 404 {object} types.MessageResponse "Trip or member not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/members/{user_id} [put]
+// @Security ApiKeyAuth
+func (svc *WebService) UpdateTripMember(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+	memberId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid user_id"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.UpdateTripMemberRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call UpdateTripMember service
+	resp, err := svc.AuthenticateAndPostClient.UpdateTripMember(ctx, &pb_aap.UpdateTripMemberRequest{
+		UserId:   int64(userId),
+		TripId:   tripId,
+		MemberId: memberId,
+		Role:     toPbTripRole(jsonRequest.Role),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "only the owner can change roles"})
+		return
+	} else if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_MEMBER_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "member not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_INVALID_ROLE {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid role"})
+		return
+	} else if resp.GetStatus() == pb_aap.UpdateTripMemberResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RemoveTripMember godoc
+// @Summary Remove a trip member
+// @Description Remove a member or cancel an invitation. The owner may remove anyone, members may remove themselves to leave the trip. The posts of the removed member leave the trip.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Param user_id path int true "Member user ID"
+// @Success 200 {object} types.MessageResponse "Member removed successfully"
+// @Failure 400 {object} types.MessageResponse "Invalid trip or user ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not allowed to remove this member"
+// @Failure 404 {object} types.MessageResponse "Trip or member not found"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/members/{user_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) RemoveTripMember(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+	memberId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid user_id"})
+		return
+	}
+
+	// Call RemoveTripMember service
+	resp, err := svc.AuthenticateAndPostClient.RemoveTripMember(ctx, &pb_aap.RemoveTripMemberRequest{
+		UserId:   int64(userId),
+		TripId:   tripId,
+		MemberId: memberId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RemoveTripMemberResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripMemberResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripMemberResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to remove this member"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripMemberResponse_MEMBER_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "member not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripMemberResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RemoveTripPost godoc
+// @Summary Remove a post from a trip
+// @Description Take a post out of a trip, the post itself is kept. The owner and editors may remove any post, authors may remove their own posts.
+// @Tags trips
+// @Accept json
+// @Produce json
+// @Param trip_id path int true "Trip ID"
+// @Param post_id path int true "Post ID"
+// @Success 200 {object} types.MessageResponse "Post removed from the trip"
+// @Failure 400 {object} types.MessageResponse "Invalid trip or post ID"
+// @Failure 401 {object} types.MessageResponse "Unauthorized"
+// @Failure 403 {object} types.MessageResponse "Not allowed to remove this post"
+// @Failure 404 {object} types.MessageResponse "Trip not found or post not in the trip"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /trips/{trip_id}/posts/{post_id} [delete]
+// @Security ApiKeyAuth
+func (svc *WebService) RemoveTripPost(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	tripId, err := strconv.ParseInt(ctx.Param("trip_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid trip_id"})
+		return
+	}
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid post_id"})
+		return
+	}
+
+	// Call RemoveTripPost service
+	resp, err := svc.AuthenticateAndPostClient.RemoveTripPost(ctx, &pb_aap.RemoveTripPostRequest{
+		UserId: int64(userId),
+		TripId: tripId,
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RemoveTripPostResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripPostResponse_TRIP_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "trip not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripPostResponse_NOT_ALLOWED {
+		ctx.JSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to remove this post"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, types.MessageResponse{Message: "post not found in this trip"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveTripPostResponse_OK {
+		ctx.JSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.JSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// toPbTripRole converts a role name from the API into its protobuf value
+func toPbTripRole(role string) pb_aap.TripRole {
+	if role == "editor" {
+		return pb_aap.TripRole_EDITOR
+	}
+	return pb_aap.TripRole_VIEWER
+}
+
+// fromPbTripRole converts a protobuf trip role into the name used by the API
+func fromPbTripRole(role pb_aap.TripRole) string {
+	return strings.ToLower(role.String())
+}
@@ -134,7 +134,8 @@ func (svc *WebService) CheckUserAuthentication(ctx *gin.Context) {
 
 		// Get user details to include in response
 		userInfo, err := svc.AuthenticateAndPostClient.GetUserDetailInfo(ctx, &pb_aap.GetUserDetailInfoRequest{
-			UserId: authentication.GetUserId(),
+			UserId:   authentication.GetUserId(),
+			ViewerId: authentication.GetUserId(),
 		})
 
 		if err != nil {
@@ -320,7 +321,7 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 
 // GetUserDetailInfo godoc
 // @Summary Get user details
-// @Description Get detailed information about a user
+// @Description Get detailed information about a user. Email and date of birth are only returned to the user themselves; following/followed_by describe the relationship with the signed-in viewer. pinned_post_ids lists the posts pinned to the profile that the viewer can see, most recently pinned first
 // @Tags users
 // @Accept json
 // @Produce json
@@ -339,7 +340,8 @@ func (svc *WebService) GetUserDetailInfo(ctx *gin.Context) {
 
 	// Call gprc service
 	resp, err := svc.AuthenticateAndPostClient.GetUserDetailInfo(ctx, &pb_aap.GetUserDetailInfoRequest{
-		UserId: int64(userId),
+		UserId:   int64(userId),
+		ViewerId: svc.getViewerId(ctx),
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -349,15 +351,23 @@ func (svc *WebService) GetUserDetailInfo(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
 	} else if resp.GetStatus() == pb_aap.GetUserDetailInfoResponse_OK {
+		// Date of birth is only returned when the viewer is the user themselves
+		var dateOfBirth string
+		if resp.GetUser().GetDateOfBirth() != nil {
+			dateOfBirth = resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly)
+		}
 		ctx.IndentedJSON(http.StatusOK, types.UserDetailInfoResponse{
 			UserID:         resp.GetUser().GetUserId(),
 			UserName:       resp.GetUser().GetUserName(),
 			FirstName:      resp.GetUser().GetFirstName(),
 			LastName:       resp.GetUser().GetLastName(),
-			DateOfBirth:    resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly),
+			DateOfBirth:    dateOfBirth,
 			Email:          resp.GetUser().GetEmail(),
 			ProfilePicture: resp.GetUser().GetProfilePicture(),
 			CoverPicture:   resp.GetUser().GetCoverPicture(),
+			Following:      resp.GetUser().GetFollowing(),
+			FollowedBy:     resp.GetUser().GetFollowedBy(),
+			PinnedPostIds:  resp.GetUser().GetPinnedPostIds(),
 		})
 		return
 	} else {
@@ -403,3 +413,68 @@ func (svc *WebService) checkSessionAuthentication(ctx *gin.Context) (sessionId s
 
 	return sessionId, userId, nil
 }
+
+// AutocompleteUsers godoc
+// @Summary Autocomplete users
+// @Description Get the users whose user name, first name, last name or full name starts with the prefix, for mention pickers and the search box. Users the signed-in viewer follows come first.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param prefix query string true "Prefix to complete, case-insensitive, a leading @ is ignored"
+// @Param limit query int false "Number of users, 10 by default and at most 20"
+// @Success 200 {object} types.AutocompleteUsersResponse "Matching users"
+// @Failure 400 {object} types.MessageResponse "Invalid prefix or limit"
+// @Failure 500 {object} types.MessageResponse "Internal server error"
+// @Router /users/autocomplete [get]
+func (svc *WebService) AutocompleteUsers(ctx *gin.Context) {
+	// Check query params
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call AutocompleteUsers service
+	resp, err := svc.AuthenticateAndPostClient.AutocompleteUsers(ctx, &pb_aap.AutocompleteUsersRequest{
+		Prefix:   ctx.Query("prefix"),
+		ViewerId: svc.getViewerId(ctx),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.AutocompleteUsersResponse_INVALID_PREFIX {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid prefix"})
+		return
+	} else if resp.GetStatus() == pb_aap.AutocompleteUsersResponse_OK {
+		users := make([]types.UserDetailInfoResponse, 0, len(resp.GetUsers()))
+		for _, user := range resp.GetUsers() {
+			users = append(users, types.UserDetailInfoResponse{
+				UserID:         user.GetUserId(),
+				UserName:       user.GetUserName(),
+				FirstName:      user.GetFirstName(),
+				LastName:       user.GetLastName(),
+				ProfilePicture: user.GetProfilePicture(),
+				CoverPicture:   user.GetCoverPicture(),
+				Following:      user.GetFollowing(),
+				FollowedBy:     user.GetFollowedBy(),
+			})
+		}
+		ctx.IndentedJSON(http.StatusOK, types.AutocompleteUsersResponse{Users: users})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// getViewerId returns the ID of the logged in user for routes that also serve anonymous visitors.
+// It returns 0 when there is no valid session.
+func (svc *WebService) getViewerId(ctx *gin.Context) int64 {
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		return 0
+	}
+	return int64(userId)
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddHashtagRouter adds hashtag-related routes to input router
+func AddHashtagRouter(r *gin.RouterGroup, svc *service.WebService) {
+	hashtagRouter := r.Group("hashtags")
+
+	// Public routes
+	hashtagRouter.GET(":tag", svc.GetHashtagInfo)
+	hashtagRouter.GET(":tag/posts", svc.GetHashtagPosts)
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddPlaceRouter adds place-related routes to input router
+func AddPlaceRouter(r *gin.RouterGroup, svc *service.WebService) {
+	placeRouter := r.Group("places")
+
+	// Public routes
+	placeRouter.GET("", svc.SearchPlaces)
+	placeRouter.GET(":place_id", svc.GetPlace)
+	placeRouter.GET(":place_id/posts", svc.GetPlacePosts)
+	placeRouter.GET(":place_id/reviews", svc.GetPlaceReviews)
+
+	// Protected routes that require authentication
+	authRouter := placeRouter.Group("")
+	authRouter.Use(svc.AuthRequired())
+	authRouter.POST("", svc.CreatePlace)
+	authRouter.POST(":place_id/merge", svc.MergePlace)
+	authRouter.POST(":place_id/reviews", svc.CreatePlaceReview)
+}
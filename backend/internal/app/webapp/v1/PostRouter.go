@@ -10,6 +10,8 @@ func AddPostRouter(r *gin.RouterGroup, svc *service.WebService) {
 	postRouter := r.Group("posts")
 
 	// Public routes
+	postRouter.GET("", svc.GetPostsByIds)
+	postRouter.GET("nearby", svc.GetNearbyPosts)
 	postRouter.GET(":post_id", svc.GetPostDetail)
 
 	// Protected routes that require authentication
@@ -18,7 +20,26 @@ func AddPostRouter(r *gin.RouterGroup, svc *service.WebService) {
 	authRouter.POST("", svc.CreatePost)
 	authRouter.PUT(":post_id", svc.EditPost)
 	authRouter.DELETE(":post_id", svc.DeletePost)
+	authRouter.GET("trash", svc.GetTrashedPosts)
+	authRouter.GET("scheduled", svc.GetScheduledPosts)
+	authRouter.PUT(":post_id/schedule", svc.ReschedulePost)
+	authRouter.DELETE(":post_id/schedule", svc.CancelScheduledPost)
+	authRouter.POST("drafts", svc.CreateDraft)
+	authRouter.GET("drafts", svc.GetDrafts)
+	authRouter.PUT("drafts/:draft_id", svc.EditDraft)
+	authRouter.DELETE("drafts/:draft_id", svc.DeleteDraft)
+	authRouter.POST("drafts/:draft_id/publish", svc.PublishDraft)
+	authRouter.POST(":post_id/restore", svc.RestorePost)
+	authRouter.GET(":post_id/revisions", svc.GetPostRevisions)
+	authRouter.POST(":post_id/revisions/:revision/revert", svc.RevertPost)
 	authRouter.POST(":post_id", svc.CommentPost)
 	authRouter.POST(":post_id/likes", svc.LikePost)
+	authRouter.POST(":post_id/repost", svc.RepostPost)
+	authRouter.DELETE(":post_id/repost", svc.UndoRepost)
+	authRouter.POST(":post_id/bookmark", svc.AddBookmark)
+	authRouter.DELETE(":post_id/bookmark", svc.RemoveBookmark)
+	authRouter.POST(":post_id/poll/vote", svc.VotePoll)
+	authRouter.POST(":post_id/pin", svc.PinPost)
+	authRouter.DELETE(":post_id/pin", svc.UnpinPost)
 	authRouter.GET("url", svc.GetS3PresignedUrl)
 }
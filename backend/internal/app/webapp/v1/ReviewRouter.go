@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddReviewRouter adds place review routes to input router, reviews are created under their place
+func AddReviewRouter(r *gin.RouterGroup, svc *service.WebService) {
+	reviewRouter := r.Group("reviews")
+
+	// Public routes
+	reviewRouter.GET(":review_id", svc.GetPlaceReview)
+
+	// Protected routes that require authentication
+	authRouter := reviewRouter.Group("")
+	authRouter.Use(svc.AuthRequired())
+	authRouter.PUT(":review_id", svc.EditPlaceReview)
+	authRouter.DELETE(":review_id", svc.DeletePlaceReview)
+	authRouter.POST(":review_id/helpful", svc.VoteReviewHelpful)
+	authRouter.DELETE(":review_id/helpful", svc.UnvoteReviewHelpful)
+}
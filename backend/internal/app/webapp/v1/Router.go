@@ -14,5 +14,11 @@ func AddAllRouter(r *gin.RouterGroup, webService *service.WebService) {
 	AddFriendRouter(r, webService)
 	AddPostRouter(r, webService)
 	AddNewsfeedRouter(r, webService)
+	AddStoryRouter(r, webService)
+	AddHashtagRouter(r, webService)
+	AddSearchRouter(r, webService)
+	AddPlaceRouter(r, webService)
+	AddReviewRouter(r, webService)
+	AddTripRouter(r, webService)
 	AddBinaryRouter(r, webService)
 }
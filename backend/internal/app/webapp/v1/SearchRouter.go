@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddSearchRouter adds search-related routes to input router
+func AddSearchRouter(r *gin.RouterGroup, svc *service.WebService) {
+	searchRouter := r.Group("search")
+
+	// Public routes
+	searchRouter.GET("", svc.Search)
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddStoryRouter adds story routes to input router
+func AddStoryRouter(r *gin.RouterGroup, svc *service.WebService) {
+	storyRouter := r.Group("stories")
+
+	// Protected routes that require authentication
+	authRouter := storyRouter.Group("")
+	authRouter.Use(svc.AuthRequired())
+	authRouter.POST("", svc.CreateStory)
+	authRouter.GET("", svc.GetStoryTray)
+	authRouter.DELETE(":story_id", svc.DeleteStory)
+	authRouter.POST(":story_id/view", svc.ViewStory)
+	authRouter.GET(":story_id/viewers", svc.GetStoryViewers)
+}
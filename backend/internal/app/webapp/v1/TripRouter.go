@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/app/webapp/service"
+)
+
+// AddTripRouter adds trip-related routes to input router
+func AddTripRouter(r *gin.RouterGroup, svc *service.WebService) {
+	tripRouter := r.Group("trips")
+
+	// Public routes
+	tripRouter.GET(":trip_id", svc.GetTrip)
+
+	// Protected routes that require authentication
+	authRouter := tripRouter.Group("")
+	authRouter.Use(svc.AuthRequired())
+	authRouter.POST("", svc.CreateTrip)
+	authRouter.PUT(":trip_id", svc.EditTrip)
+	authRouter.POST(":trip_id/publish", svc.PublishTrip)
+	authRouter.DELETE(":trip_id/posts/:post_id", svc.RemoveTripPost)
+
+	// Collaboration
+	authRouter.GET("invitations", svc.GetTripInvitations)
+	authRouter.POST(":trip_id/invitation/accept", svc.AcceptTripInvitation)
+	authRouter.POST(":trip_id/invitation/decline", svc.DeclineTripInvitation)
+	authRouter.POST(":trip_id/members", svc.InviteTripMember)
+	authRouter.PUT(":trip_id/members/:user_id", svc.UpdateTripMember)
+	authRouter.DELETE(":trip_id/members/:user_id", svc.RemoveTripMember)
+}
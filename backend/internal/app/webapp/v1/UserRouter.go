@@ -11,10 +11,20 @@ func AddUserRouter(r *gin.RouterGroup, svc *service.WebService) {
 	// Public routes
 	userRouter.POST("signup", svc.CreateUser)
 	userRouter.POST("login", svc.CheckUserAuthentication)
+	userRouter.GET("autocomplete", svc.AutocompleteUsers)
 	userRouter.GET(":user_id", svc.GetUserDetailInfo)
+	userRouter.GET(":user_id/travel-map", svc.GetTravelMap)
+	userRouter.GET(":user_id/travel-stats", svc.GetTravelStats)
 
 	// Protected routes that require authentication
 	authRouter := userRouter.Group("")
 	authRouter.Use(svc.AuthRequired())
 	authRouter.PUT("edit", svc.EditUser)
+	authRouter.POST("travel-visits", svc.AddTravelVisit)
+	authRouter.DELETE("travel-visits/:visit_id", svc.DeleteTravelVisit)
+	authRouter.GET("me/bookmarks", svc.GetBookmarks)
+	authRouter.GET("me/collections", svc.GetBookmarkCollections)
+	authRouter.POST("me/collections", svc.CreateBookmarkCollection)
+	authRouter.PUT("me/collections/:collection_id", svc.EditBookmarkCollection)
+	authRouter.DELETE("me/collections/:collection_id", svc.DeleteBookmarkCollection)
 }
@@ -0,0 +1,222 @@
+package autocomplete
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/hoangNguyenDev3/WanderSphere/backend/internal/pkg/types"
+	"gorm.io/gorm"
+)
+
+const (
+	// UserIndexKey is the sorted set of the user prefix index.
+	// Every member is "<term>\x00<user_id>" with a score of 0, so members are ordered
+	// lexicographically and a prefix lookup is a single ZRANGEBYLEX.
+	UserIndexKey = "autocomplete:users"
+	// rebuildKey is the index being built by Rebuild, swapped in once it is complete
+	rebuildKey = UserIndexKey + ":rebuild"
+	// rebuildingKey is set while Rebuild runs, it expires after rebuildTimeout if Rebuild does not finish
+	rebuildingKey  = UserIndexKey + ":rebuilding"
+	rebuildTimeout = time.Hour
+	// rebuildBatchSize is the number of users loaded from the database at a time by Rebuild
+	rebuildBatchSize = 1000
+)
+
+// UserIndex is a Redis prefix index over user names, first names, last names and full names
+type UserIndex struct {
+	client *redis.Client
+}
+
+func NewUserIndex(client *redis.Client) *UserIndex {
+	return &UserIndex{client: client}
+}
+
+// NormalizePrefix lower-cases a prefix and strips the leading '@' of a mention
+func NormalizePrefix(prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(prefix), "@"))
+}
+
+// userTerms returns the distinct normalized terms a user can be found by
+func userTerms(user types.User) []string {
+	candidates := []string{
+		user.UserName,
+		user.FirstName,
+		user.LastName,
+		user.FirstName + " " + user.LastName,
+	}
+
+	var terms []string
+	seen := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		term := strings.ToLower(strings.TrimSpace(candidate))
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// userMembers returns the index members of a user
+func userMembers(user types.User) []string {
+	terms := userTerms(user)
+	members := make([]string, 0, len(terms))
+	for _, term := range terms {
+		members = append(members, term+"\x00"+strconv.FormatInt(user.ID, 10))
+	}
+	return members
+}
+
+func zMembers(members []string) []*redis.Z {
+	result := make([]*redis.Z, 0, len(members))
+	for _, member := range members {
+		result = append(result, &redis.Z{Score: 0, Member: member})
+	}
+	return result
+}
+
+// updateScript removes the stale members of a user and adds its current ones to the index, and to the
+// index being rebuilt while a rebuild runs, so that users changed during a rebuild are not lost when it
+// is swapped in. KEYS are the index, the rebuild key and the rebuilding flag. ARGV[1] is the number of
+// stale members, followed by the stale members and the current ones.
+var updateScript = redis.NewScript(`
+local keys = {KEYS[1]}
+if redis.call('EXISTS', KEYS[3]) == 1 then
+	table.insert(keys, KEYS[2])
+end
+local stale = tonumber(ARGV[1])
+for _, key in ipairs(keys) do
+	for i = 2, stale + 1 do
+		redis.call('ZREM', key, ARGV[i])
+	end
+	for i = stale + 2, #ARGV do
+		redis.call('ZADD', key, 0, ARGV[i])
+	end
+end
+return 0
+`)
+
+// swapScript replaces the index with the rebuilt one, which does not exist when no user was indexed,
+// and ends the rebuild. KEYS are the same as updateScript's.
+var swapScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	redis.call('RENAME', KEYS[2], KEYS[1])
+else
+	redis.call('DEL', KEYS[1])
+end
+redis.call('DEL', KEYS[3])
+return 0
+`)
+
+// update runs updateScript
+func (i *UserIndex) update(ctx context.Context, stale []string, members []string) error {
+	if len(stale) == 0 && len(members) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, 1+len(stale)+len(members))
+	args = append(args, len(stale))
+	for _, member := range stale {
+		args = append(args, member)
+	}
+	for _, member := range members {
+		args = append(args, member)
+	}
+	return updateScript.Run(ctx, i.client, []string{UserIndexKey, rebuildKey, rebuildingKey}, args...).Err()
+}
+
+// Add indexes a new user
+func (i *UserIndex) Add(ctx context.Context, user types.User) error {
+	return i.update(ctx, nil, userMembers(user))
+}
+
+// Update replaces the indexed terms of a user whose names changed from old to user
+func (i *UserIndex) Update(ctx context.Context, old types.User, user types.User) error {
+	members := userMembers(user)
+	current := make(map[string]bool, len(members))
+	for _, member := range members {
+		current[member] = true
+	}
+	var stale []string
+	for _, member := range userMembers(old) {
+		if !current[member] {
+			stale = append(stale, member)
+		}
+	}
+	return i.update(ctx, stale, members)
+}
+
+// Search returns the ids of the users with a term starting with the normalized prefix,
+// in lexicographic order of their matching term. At most count index members are read.
+func (i *UserIndex) Search(ctx context.Context, prefix string, count int64) ([]int64, error) {
+	// 0xff never appears in UTF-8 text, so it sorts after every term starting with the prefix
+	members, err := i.client.ZRangeByLex(ctx, UserIndexKey, &redis.ZRangeBy{
+		Min:   "[" + prefix,
+		Max:   "[" + prefix + "\xff",
+		Count: count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	seen := make(map[int64]bool, len(members))
+	for _, member := range members {
+		separator := strings.LastIndexByte(member, 0)
+		if separator < 0 {
+			continue
+		}
+		id, err := strconv.ParseInt(member[separator+1:], 10, 64)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Exists reports whether the index has been built
+func (i *UserIndex) Exists(ctx context.Context) (bool, error) {
+	n, err := i.client.Exists(ctx, UserIndexKey).Result()
+	return n > 0, err
+}
+
+// Rebuild recreates the index from the users table and returns the number of indexed users.
+// The new index is built under a temporary key and swapped in at once, so lookups keep working meanwhile.
+// Add and Update write to both keys while it runs. A rename that commits while the batch of the user is
+// read may still leave the old names indexed until the next rebuild.
+func (i *UserIndex) Rebuild(ctx context.Context, db *gorm.DB) (int, error) {
+	pipe := i.client.TxPipeline()
+	pipe.Set(ctx, rebuildingKey, 1, rebuildTimeout)
+	pipe.Del(ctx, rebuildKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	total := 0
+	var users []types.User
+	result := db.WithContext(ctx).
+		Select("id", "user_name", "first_name", "last_name").
+		FindInBatches(&users, rebuildBatchSize, func(tx *gorm.DB, batch int) error {
+			var members []string
+			for _, user := range users {
+				members = append(members, userMembers(user)...)
+			}
+			total += len(users)
+			if len(members) == 0 {
+				return nil
+			}
+			return i.client.ZAdd(ctx, rebuildKey, zMembers(members)...).Err()
+		})
+	if result.Error != nil {
+		i.client.Del(ctx, rebuildingKey, rebuildKey)
+		return 0, result.Error
+	}
+
+	err := swapScript.Run(ctx, i.client, []string{UserIndexKey, rebuildKey, rebuildingKey}).Err()
+	return total, err
+}
@@ -0,0 +1,212 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	defaultTimeout      = 5 * time.Second
+	defaultMaxBodyBytes = 512 * 1024
+	maxRedirects        = 3
+	maxTitleLength      = 300
+	maxDescriptionLen   = 1000
+	maxURLLength        = 2048
+	userAgent           = "WanderSphereBot/1.0 (+link preview)"
+)
+
+// ErrForbiddenAddress is returned when a link resolves to an address the fetcher must not reach
+var ErrForbiddenAddress = errors.New("link resolves to a private or reserved address")
+
+// Preview is the OpenGraph or Twitter card metadata of a page
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// Config configures a Fetcher, zero values use the defaults
+type Config struct {
+	// Timeout bounds a whole fetch, redirects and body included
+	Timeout time.Duration
+	// MaxBodyBytes bounds the part of a page that is read, metadata further down is ignored
+	MaxBodyBytes int64
+	// Transport replaces the default transport, which refuses to connect to private addresses.
+	// A custom transport is responsible for its own address checks.
+	Transport http.RoundTripper
+}
+
+// Fetcher downloads pages and extracts their link preview
+type Fetcher struct {
+	client       *http.Client
+	maxBodyBytes int64
+}
+
+// NewFetcher creates a Fetcher
+func NewFetcher(cfg Config) *Fetcher {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	maxBodyBytes := cfg.MaxBodyBytes
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = defaultMaxBodyBytes
+	}
+	transport := cfg.Transport
+	if transport == nil {
+		transport = NewSafeTransport()
+	}
+
+	return &Fetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				// via holds the requests made so far, the first one was not a redirect
+				if len(via) > maxRedirects {
+					return errors.New("too many redirects")
+				}
+				return checkURL(req.URL)
+			},
+		},
+		maxBodyBytes: maxBodyBytes,
+	}
+}
+
+// NewSafeTransport returns a transport that only connects to public addresses. The check is
+// done on the address actually dialed, after DNS resolution, so hostnames resolving to private
+// addresses are refused as well. Proxies from the environment are ignored since they would
+// bypass the check.
+func NewSafeTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout: defaultTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+	return &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   defaultTimeout,
+		ResponseHeaderTimeout: defaultTimeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+}
+
+// isPublicIP reports whether ip is a globally routable unicast address
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		// 0.0.0.0/8, 100.64.0.0/10 (carrier-grade NAT) and 198.18.0.0/15 (benchmarking)
+		if ip4[0] == 0 || (ip4[0] == 100 && ip4[1]&0xc0 == 64) || (ip4[0] == 198 && ip4[1]&0xfe == 18) {
+			return false
+		}
+		return ip4[0] < 240
+	}
+	return true
+}
+
+// checkURL only lets absolute http and https URLs through
+func checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	if u.Hostname() == "" || u.User != nil {
+		return errors.New("invalid link")
+	}
+	return nil
+}
+
+// Fetch downloads the page at rawURL and extracts its link preview
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	if len(rawURL) > maxURLLength {
+		return Preview{}, errors.New("link too long")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Preview{}, err
+	}
+	if err := checkURL(u); err != nil {
+		return Preview{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Preview{}, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return Preview{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Preview{}, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return Preview{}, fmt.Errorf("unsupported content type %q", resp.Header.Get("Content-Type"))
+	}
+
+	meta := extractMeta(io.LimitReader(resp.Body, f.maxBodyBytes))
+	preview := Preview{
+		URL:         rawURL,
+		Title:       truncate(firstNonEmpty(meta["og:title"], meta["twitter:title"], meta["title"]), maxTitleLength),
+		Description: truncate(firstNonEmpty(meta["og:description"], meta["twitter:description"], meta["description"]), maxDescriptionLen),
+		SiteName:    truncate(meta["og:site_name"], maxTitleLength),
+	}
+	// Images are resolved against the final URL, after redirects
+	if image := firstNonEmpty(meta["og:image"], meta["og:image:url"], meta["twitter:image"], meta["twitter:image:src"]); image != "" {
+		if ref, err := resp.Request.URL.Parse(image); err == nil && checkURL(ref) == nil && len(ref.String()) <= maxURLLength {
+			preview.ImageURL = ref.String()
+		}
+	}
+	if preview.Title == "" && preview.Description == "" && preview.ImageURL == "" {
+		return Preview{}, errors.New("no preview metadata")
+	}
+	return preview, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// truncate cuts s to at most max runes
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return strings.TrimSpace(string([]rune(s)[:max]))
+}
@@ -0,0 +1,89 @@
+package linkpreview
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// urlPattern matches the http and https links of a text
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// ExtractURLs returns the distinct links of a text in order of appearance, at most max of them
+func ExtractURLs(text string, max int) []string {
+	urls := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, match := range urlPattern.FindAllString(text, -1) {
+		// Punctuation ending a sentence is not part of the link
+		link := strings.TrimRight(match, ".,;:!?)]}")
+		if len(link) > maxURLLength || seen[link] {
+			continue
+		}
+		seen[link] = true
+		urls = append(urls, link)
+		if len(urls) == max {
+			break
+		}
+	}
+	return urls
+}
+
+// extractMeta reads the head of an HTML document and returns its title and the content of its
+// meta tags keyed by their lowercased property or name. The first value of a key wins.
+func extractMeta(r io.Reader) map[string]string {
+	meta := make(map[string]string)
+	set := func(key string, value string) {
+		value = strings.Join(strings.Fields(value), " ")
+		if _, exist := meta[key]; !exist && value != "" {
+			meta[key] = value
+		}
+	}
+
+	tokenizer := html.NewTokenizer(r)
+	inTitle := false
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// io.EOF, or the body limit was reached
+			return meta
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.DataAtom {
+			case atom.Body:
+				return meta
+			case atom.Title:
+				inTitle = true
+			case atom.Meta:
+				var key, content string
+				for _, attr := range token.Attr {
+					switch strings.ToLower(attr.Key) {
+					case "property", "name":
+						if key == "" {
+							key = strings.ToLower(strings.TrimSpace(attr.Val))
+						}
+					case "content":
+						content = attr.Val
+					}
+				}
+				if key != "" {
+					set(key, content)
+				}
+			}
+		case html.TextToken:
+			if inTitle {
+				set("title", string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			token := tokenizer.Token()
+			switch token.DataAtom {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				return meta
+			}
+		}
+	}
+}
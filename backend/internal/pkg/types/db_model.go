@@ -2,14 +2,18 @@ package types
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
-// Base contains common fields for all models
+// Base contains common fields for all models.
+// DeletedAt is a gorm.DeletedAt so soft-deleted rows are filtered out of every query
+// unless Unscoped() is used.
 type Base struct {
-	ID        int64      `json:"id" gorm:"primaryKey;autoIncrement"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at" gorm:"index"`
+	ID        int64          `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
 
 // User represents a user in the system
@@ -24,6 +28,7 @@ type User struct {
 	UserName       string    `json:"user_name" gorm:"column:user_name;size:50;unique;not null"`
 	ProfilePicture string    `json:"profile_picture" gorm:"column:profile_picture;size:1000"`
 	CoverPicture   string    `json:"cover_picture" gorm:"column:cover_picture;size:1000"`
+	IsModerator    bool      `json:"-" gorm:"column:is_moderator;not null;default:false"`
 	Posts          []*Post   `json:"-" gorm:"foreignKey:UserID"`
 	// Followers: Users who follow this user (this user's ID is user_id, followers' IDs are follower_id)
 	Followers []*User `json:"-" gorm:"many2many:following;joinForeignKey:user_id;joinReferences:follower_id"`
@@ -47,15 +52,34 @@ func (Following) TableName() string {
 	return "following"
 }
 
+// Post visibility levels stored in posts.visibility
+const (
+	PostVisibilityPublic    = "public"
+	PostVisibilityFollowers = "followers"
+	PostVisibilityPrivate   = "private"
+)
+
 // Post represents a post in the system
 type Post struct {
 	Base
-	UserID           int64      `json:"user_id" gorm:"column:user_id;not null"`
-	ContentText      string     `json:"content_text" gorm:"column:content_text;type:text;not null"`
-	ContentImagePath string     `json:"content_image_path" gorm:"column:content_image_path;size:1000"`
-	User             *User      `json:"-" gorm:"foreignKey:UserID"`
-	Comments         []*Comment `json:"-" gorm:"foreignKey:PostID"`
-	LikedUsers       []*User    `json:"-" gorm:"many2many:likes;joinForeignKey:post_id;joinReferences:user_id"`
+	UserID      int64       `json:"user_id" gorm:"column:user_id;not null"`
+	ContentText string      `json:"content_text" gorm:"column:content_text;type:text;not null"`
+	Visibility  string      `json:"visibility" gorm:"column:visibility;size:20;not null;default:public"`
+	EditedAt    *time.Time  `json:"edited_at" gorm:"column:edited_at"`
+	Latitude    *float64    `json:"latitude" gorm:"column:latitude"`
+	Longitude   *float64    `json:"longitude" gorm:"column:longitude"`
+	PlaceName   string      `json:"place_name" gorm:"column:place_name;size:200"`
+	Geohash     *string     `json:"-" gorm:"column:geohash;size:12"`
+	PlaceID     *int64      `json:"place_id" gorm:"column:place_id"`
+	TripID      *int64      `json:"trip_id" gorm:"column:trip_id"`
+	RepostOfID  *int64      `json:"repost_of_id" gorm:"column:repost_of_id"`
+	QuoteOfID   *int64      `json:"quote_of_id" gorm:"column:quote_of_id"`
+	PublishAt   *time.Time  `json:"publish_at" gorm:"column:publish_at"`
+	PinnedAt    *time.Time  `json:"pinned_at" gorm:"column:pinned_at"`
+	User        *User       `json:"-" gorm:"foreignKey:UserID"`
+	Comments    []*Comment  `json:"-" gorm:"foreignKey:PostID"`
+	LikedUsers  []*User     `json:"-" gorm:"many2many:likes;joinForeignKey:post_id;joinReferences:user_id"`
+	Media       []PostMedia `json:"-" gorm:"foreignKey:PostID"`
 }
 
 // TableName returns the table name for Post
@@ -63,6 +87,397 @@ func (Post) TableName() string {
 	return "posts"
 }
 
+// Place categories stored in places.category
+var PlaceCategories = []string{
+	"restaurant", "cafe", "bar", "hotel", "attraction", "museum", "park",
+	"beach", "mountain", "airport", "station", "shop", "city", "other",
+}
+
+// Place is a point of interest posts can check in at.
+// A duplicate merged by a moderator is soft-deleted and MergedIntoID points to the place that replaced it.
+type Place struct {
+	Base
+	Name         string  `json:"name" gorm:"column:name;size:200;not null"`
+	Category     string  `json:"category" gorm:"column:category;size:50;not null"`
+	Country      string  `json:"country" gorm:"column:country;size:100"`
+	City         string  `json:"city" gorm:"column:city;size:100"`
+	Latitude     float64 `json:"latitude" gorm:"column:latitude;not null"`
+	Longitude    float64 `json:"longitude" gorm:"column:longitude;not null"`
+	Geohash      string  `json:"-" gorm:"column:geohash;size:12;not null"`
+	CreatedBy    int64   `json:"created_by" gorm:"column:created_by;not null"`
+	MergedIntoID *int64  `json:"merged_into_id" gorm:"column:merged_into_id"`
+}
+
+// TableName returns the table name for Place
+func (Place) TableName() string {
+	return "places"
+}
+
+// PlaceReview is a rating from 1 to 5 stars with an optional text. A user has at most one review per place.
+// HelpfulCount is the number of ReviewVote rows of the review.
+type PlaceReview struct {
+	Base
+	PlaceID      int64      `json:"place_id" gorm:"column:place_id;not null"`
+	UserID       int64      `json:"user_id" gorm:"column:user_id;not null"`
+	Rating       int32      `json:"rating" gorm:"column:rating;not null"`
+	ContentText  string     `json:"content_text" gorm:"column:content_text;type:text;not null"`
+	EditedAt     *time.Time `json:"edited_at" gorm:"column:edited_at"`
+	HelpfulCount int64      `json:"helpful_count" gorm:"column:helpful_count;not null;default:0"`
+}
+
+// TableName returns the table name for PlaceReview
+func (PlaceReview) TableName() string {
+	return "place_reviews"
+}
+
+// ReviewVote is a "helpful" vote of a user on a review
+type ReviewVote struct {
+	ReviewID  int64     `json:"review_id" gorm:"column:review_id;primaryKey"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;primaryKey"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName returns the table name for ReviewVote
+func (ReviewVote) TableName() string {
+	return "review_votes"
+}
+
+// Trip groups the posts of a journey. It is a draft only its owner and members can see until PublishedAt is set,
+// then it follows the same visibility rules as posts.
+type Trip struct {
+	Base
+	UserID      int64      `json:"user_id" gorm:"column:user_id;not null;index"`
+	Title       string     `json:"title" gorm:"column:title;size:200;not null"`
+	StartDate   time.Time  `json:"start_date" gorm:"column:start_date;type:date;not null"`
+	EndDate     *time.Time `json:"end_date" gorm:"column:end_date;type:date"`
+	CoverImage  string     `json:"cover_image" gorm:"column:cover_image;size:1000"`
+	Visibility  string     `json:"visibility" gorm:"column:visibility;size:20;not null;default:public"`
+	PublishedAt *time.Time `json:"published_at" gorm:"column:published_at"`
+}
+
+// TableName returns the table name for Trip
+func (Trip) TableName() string {
+	return "trips"
+}
+
+// TripPlace is a place visited during a trip, Position orders the places of a trip
+type TripPlace struct {
+	TripID   int64 `json:"trip_id" gorm:"column:trip_id;primaryKey"`
+	PlaceID  int64 `json:"place_id" gorm:"column:place_id;primaryKey"`
+	Position int32 `json:"position" gorm:"column:position;not null"`
+}
+
+// TableName returns the table name for TripPlace
+func (TripPlace) TableName() string {
+	return "trip_places"
+}
+
+// Trip member roles stored in trip_members.role. The owner of a trip is trips.user_id.
+const (
+	TripRoleOwner  = "owner"
+	TripRoleEditor = "editor"
+	TripRoleViewer = "viewer"
+)
+
+// Trip member statuses stored in trip_members.status
+const (
+	TripMemberPending  = "pending"
+	TripMemberAccepted = "accepted"
+)
+
+// TripMember is a user invited to a trip. Accepted members can add their own posts to the trip,
+// editors can also edit the trip and remove posts from it.
+type TripMember struct {
+	TripID    int64     `json:"trip_id" gorm:"column:trip_id;primaryKey"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;primaryKey"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Role      string    `json:"role" gorm:"column:role;size:20;not null;default:viewer"`
+	Status    string    `json:"status" gorm:"column:status;size:20;not null;default:pending"`
+	InvitedBy int64     `json:"invited_by" gorm:"column:invited_by;not null"`
+}
+
+// TableName returns the table name for TripMember
+func (TripMember) TableName() string {
+	return "trip_members"
+}
+
+// TravelVisit is a visit on the travel map of a user. Visits with a PostID follow a located post,
+// the others were added by hand. Country and City are empty when the post is not a check-in.
+type TravelVisit struct {
+	ID         int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UserID     int64     `json:"user_id" gorm:"column:user_id;not null"`
+	PostID     *int64    `json:"post_id" gorm:"column:post_id;uniqueIndex"`
+	PlaceID    *int64    `json:"place_id" gorm:"column:place_id"`
+	Country    string    `json:"country" gorm:"column:country;size:100;not null"`
+	City       string    `json:"city" gorm:"column:city;size:100;not null"`
+	Latitude   *float64  `json:"latitude" gorm:"column:latitude"`
+	Longitude  *float64  `json:"longitude" gorm:"column:longitude"`
+	Visibility string    `json:"visibility" gorm:"column:visibility;size:20;not null;default:public"`
+	VisitedAt  time.Time `json:"visited_at" gorm:"column:visited_at;not null"`
+}
+
+// TableName returns the table name for TravelVisit
+func (TravelVisit) TableName() string {
+	return "travel_visits"
+}
+
+// BookmarkCollection is a named private collection of saved posts
+type BookmarkCollection struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;not null"`
+	Name      string    `json:"name" gorm:"column:name;size:100;not null"`
+}
+
+// TableName returns the table name for BookmarkCollection
+func (BookmarkCollection) TableName() string {
+	return "bookmark_collections"
+}
+
+// Bookmark is a post saved by a user, CollectionID is nil for bookmarks outside any collection
+type Bookmark struct {
+	ID           int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UserID       int64     `json:"user_id" gorm:"column:user_id;not null"`
+	PostID       int64     `json:"post_id" gorm:"column:post_id;not null"`
+	CollectionID *int64    `json:"collection_id" gorm:"column:collection_id"`
+}
+
+// TableName returns the table name for Bookmark
+func (Bookmark) TableName() string {
+	return "bookmarks"
+}
+
+// PostDraft is a post being written, it is never published or fanned out until it is turned into a post
+type PostDraft struct {
+	ID          int64            `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+	UserID      int64            `json:"user_id" gorm:"column:user_id;not null"`
+	ContentText string           `json:"content_text" gorm:"column:content_text;type:text;not null"`
+	Visibility  string           `json:"visibility" gorm:"column:visibility;size:20;not null;default:public"`
+	Latitude    *float64         `json:"latitude" gorm:"column:latitude"`
+	Longitude   *float64         `json:"longitude" gorm:"column:longitude"`
+	PlaceName   string           `json:"place_name" gorm:"column:place_name;size:200;not null"`
+	PlaceID     *int64           `json:"place_id" gorm:"column:place_id"`
+	TripID      *int64           `json:"trip_id" gorm:"column:trip_id"`
+	QuoteOfID   *int64           `json:"quote_of_id" gorm:"column:quote_of_id"`
+	Media       []PostDraftMedia `json:"-" gorm:"foreignKey:DraftID"`
+}
+
+// TableName returns the table name for PostDraft
+func (PostDraft) TableName() string {
+	return "post_drafts"
+}
+
+// PostDraftMedia is a media item of a draft, Position orders the media of a draft from 0.
+// Media cleanup keeps the uploads a draft uses.
+type PostDraftMedia struct {
+	DraftID  int64 `json:"draft_id" gorm:"column:draft_id;primaryKey;autoIncrement:false"`
+	Position int   `json:"position" gorm:"column:position;primaryKey;autoIncrement:false"`
+	Media    `gorm:"embedded"`
+}
+
+// TableName returns the table name for PostDraftMedia
+func (PostDraftMedia) TableName() string {
+	return "post_draft_media"
+}
+
+// Story is an image shown to the followers of its author until ExpiresAt
+type Story struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;not null;index"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"column:expires_at;not null;index"`
+	Media     `gorm:"embedded"`
+}
+
+// TableName returns the table name for Story
+func (Story) TableName() string {
+	return "stories"
+}
+
+// Poll is the poll attached to a post, it takes votes until ExpiresAt
+type Poll struct {
+	PostID         int64        `json:"post_id" gorm:"column:post_id;primaryKey;autoIncrement:false"`
+	CreatedAt      time.Time    `json:"created_at"`
+	ExpiresAt      time.Time    `json:"expires_at" gorm:"column:expires_at;not null"`
+	MultipleChoice bool         `json:"multiple_choice" gorm:"column:multiple_choice;not null"`
+	Anonymous      bool         `json:"anonymous" gorm:"column:anonymous;not null"`
+	Options        []PollOption `json:"options" gorm:"foreignKey:PostID;references:PostID"`
+}
+
+// TableName returns the table name for Poll
+func (Poll) TableName() string {
+	return "polls"
+}
+
+// PollOption is one of the answers of a poll, Position orders them from 0
+type PollOption struct {
+	ID       int64  `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID   int64  `json:"post_id" gorm:"column:post_id;not null"`
+	Position int    `json:"position" gorm:"column:position;not null"`
+	Text     string `json:"text" gorm:"column:text;size:100;not null"`
+}
+
+// TableName returns the table name for PollOption
+func (PollOption) TableName() string {
+	return "poll_options"
+}
+
+// PollBallot records that a user voted on a poll, the chosen options are its PollVotes
+type PollBallot struct {
+	PostID    int64     `json:"post_id" gorm:"column:post_id;primaryKey;autoIncrement:false"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for PollBallot
+func (PollBallot) TableName() string {
+	return "poll_ballots"
+}
+
+// PollVote is an option chosen by a user in a poll
+type PollVote struct {
+	PostID   int64 `json:"post_id" gorm:"column:post_id;primaryKey;autoIncrement:false"`
+	UserID   int64 `json:"user_id" gorm:"column:user_id;primaryKey;autoIncrement:false"`
+	OptionID int64 `json:"option_id" gorm:"column:option_id;primaryKey;autoIncrement:false"`
+}
+
+// TableName returns the table name for PollVote
+func (PollVote) TableName() string {
+	return "poll_votes"
+}
+
+// LinkPreview is the cached preview of a link, failed fetches are cached without metadata
+type LinkPreview struct {
+	URL         string    `json:"url" gorm:"column:url;primaryKey;size:2048"`
+	FetchedAt   time.Time `json:"fetched_at" gorm:"column:fetched_at;not null"`
+	Failed      bool      `json:"failed" gorm:"column:failed;not null"`
+	Title       string    `json:"title" gorm:"column:title;size:300;not null"`
+	Description string    `json:"description" gorm:"column:description;type:text;not null"`
+	ImageURL    string    `json:"image_url" gorm:"column:image_url;size:2048;not null"`
+	SiteName    string    `json:"site_name" gorm:"column:site_name;size:300;not null"`
+}
+
+// TableName returns the table name for LinkPreview
+func (LinkPreview) TableName() string {
+	return "link_previews"
+}
+
+// PostLink is a link found in the text of a post, Position orders the links of a post from 0
+type PostLink struct {
+	PostID   int64  `json:"post_id" gorm:"column:post_id;primaryKey;autoIncrement:false"`
+	Position int    `json:"position" gorm:"column:position;primaryKey;autoIncrement:false"`
+	URL      string `json:"url" gorm:"column:url;size:2048;not null"`
+}
+
+// TableName returns the table name for PostLink
+func (PostLink) TableName() string {
+	return "post_links"
+}
+
+// PostRevision is a snapshot of a post's content.
+// Revision 1 is recorded when the post is created and every edit adds the next one.
+type PostRevision struct {
+	ID          int64               `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt   time.Time           `json:"created_at"`
+	PostID      int64               `json:"post_id" gorm:"column:post_id;not null;uniqueIndex:idx_post_revisions_post_revision"`
+	Revision    int32               `json:"revision" gorm:"column:revision;not null;uniqueIndex:idx_post_revisions_post_revision"`
+	ContentText string              `json:"content_text" gorm:"column:content_text;type:text;not null"`
+	Visibility  string              `json:"visibility" gorm:"column:visibility;size:20;not null;default:public"`
+	Media       []PostRevisionMedia `json:"-" gorm:"foreignKey:RevisionID"`
+}
+
+// TableName returns the table name for PostRevision
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
+
+// Media is an uploaded image or video. StorageKey is the object key of the upload, it is empty
+// for media migrated from image paths.
+type Media struct {
+	URL        string `json:"url" gorm:"column:url;size:2048;not null"`
+	StorageKey string `json:"storage_key" gorm:"column:storage_key;size:1000;not null"`
+	MimeType   string `json:"mime_type" gorm:"column:mime_type;size:100;not null"`
+	Width      int32  `json:"width" gorm:"column:width;not null"`
+	Height     int32  `json:"height" gorm:"column:height;not null"`
+	AltText    string `json:"alt_text" gorm:"column:alt_text;size:1000;not null"`
+	Blurhash   string `json:"blurhash" gorm:"column:blurhash;size:100;not null"`
+}
+
+// PostMedia is a media item of a post, Position orders the media of a post from 0
+type PostMedia struct {
+	PostID   int64 `json:"post_id" gorm:"column:post_id;primaryKey;autoIncrement:false"`
+	Position int   `json:"position" gorm:"column:position;primaryKey;autoIncrement:false"`
+	Media    `gorm:"embedded"`
+}
+
+// TableName returns the table name for PostMedia
+func (PostMedia) TableName() string {
+	return "post_media"
+}
+
+// PostRevisionMedia is a media item of a revision, Position orders the media of a revision from 0
+type PostRevisionMedia struct {
+	RevisionID int64 `json:"revision_id" gorm:"column:revision_id;primaryKey;autoIncrement:false"`
+	Position   int   `json:"position" gorm:"column:position;primaryKey;autoIncrement:false"`
+	Media      `gorm:"embedded"`
+}
+
+// TableName returns the table name for PostRevisionMedia
+func (PostRevisionMedia) TableName() string {
+	return "post_revision_media"
+}
+
+// Hashtag is a normalized tag, lower case and without the leading '#'
+type Hashtag struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `json:"created_at"`
+	Tag       string    `json:"tag" gorm:"column:tag;size:100;unique;not null"`
+}
+
+// TableName returns the table name for Hashtag
+func (Hashtag) TableName() string {
+	return "hashtags"
+}
+
+// PostHashtag links a post to a hashtag used in its text
+type PostHashtag struct {
+	CreatedAt time.Time `json:"created_at"`
+	PostID    int64     `json:"post_id" gorm:"column:post_id;primaryKey"`
+	HashtagID int64     `json:"hashtag_id" gorm:"column:hashtag_id;primaryKey"`
+}
+
+// TableName returns the table name for PostHashtag
+func (PostHashtag) TableName() string {
+	return "post_hashtags"
+}
+
+// Mention is a user mentioned with @username in a post or, when CommentID is set, in one of its comments.
+// Start and End are code point offsets of the "@username" span in the text, End exclusive.
+type Mention struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `json:"created_at"`
+	PostID    int64     `json:"post_id" gorm:"column:post_id;not null;index"`
+	CommentID *int64    `json:"comment_id" gorm:"column:comment_id"`
+	UserID    int64     `json:"user_id" gorm:"column:user_id;not null;index"`
+	AuthorID  int64     `json:"author_id" gorm:"column:author_id;not null"`
+	Start     int32     `json:"start" gorm:"column:mention_start;not null"`
+	End       int32     `json:"end" gorm:"column:mention_end;not null"`
+}
+
+// TableName returns the table name for Mention
+func (Mention) TableName() string {
+	return "mentions"
+}
+
 // Comment represents a comment on a post
 type Comment struct {
 	Base
@@ -36,16 +36,189 @@ type EditUserRequest struct {
 	CoverPicture   string `json:"cover_picture" validate:"omitempty,url"`
 }
 
+// CreatePostRequest creates a post. ContentImagePath is deprecated, it is only used when Media is not set.
 type CreatePostRequest struct {
-	ContentText      string   `json:"content_text" validate:"required"`
-	ContentImagePath []string `json:"content_image_path" validate:"omitempty,dive,url"`
-	Visible          *bool    `json:"visible"`
+	ContentText      string           `json:"content_text" validate:"required"`
+	ContentImagePath []string         `json:"content_image_path" validate:"omitempty,max=10,dive,url"`
+	Media            []MediaRequest   `json:"media" validate:"omitempty,max=10,dive"`
+	Visible          *bool            `json:"visible"`
+	Visibility       string           `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	Location         *LocationRequest `json:"location"`
+	PlaceId          int64            `json:"place_id" validate:"omitempty,min=1"`
+	TripId           int64            `json:"trip_id" validate:"omitempty,min=1"`
+	QuoteOfPostId    int64            `json:"quote_of_post_id" validate:"omitempty,min=1"`
+	PublishAt        string           `json:"publish_at"`
+	Poll             *PollRequest     `json:"poll"`
 }
 
+// MediaRequest is an uploaded image or video, in display order. Width and Height are in pixels
+// and Blurhash is a placeholder shown while the media loads.
+type MediaRequest struct {
+	URL      string `json:"url" validate:"required,url,max=2048"`
+	MimeType string `json:"mime_type" validate:"omitempty,max=100"`
+	Width    int32  `json:"width" validate:"gte=0"`
+	Height   int32  `json:"height" validate:"gte=0"`
+	AltText  string `json:"alt_text" validate:"max=1000"`
+	Blurhash string `json:"blurhash" validate:"max=100"`
+}
+
+// PollRequest attaches a poll to a new post, ExpiresAt is an RFC3339 time
+type PollRequest struct {
+	Options        []string `json:"options" validate:"required,min=2,max=6,dive,required,max=100"`
+	ExpiresAt      string   `json:"expires_at" validate:"required"`
+	MultipleChoice bool     `json:"multiple_choice"`
+	Anonymous      bool     `json:"anonymous"`
+}
+
+// VotePollRequest votes for options of a poll, replacing the previous vote of the user
+type VotePollRequest struct {
+	OptionIds []int64 `json:"option_ids" validate:"required,min=1,dive,min=1"`
+}
+
+// ReschedulePostRequest moves a scheduled post to another RFC3339 time
+type ReschedulePostRequest struct {
+	PublishAt string `json:"publish_at" validate:"required"`
+}
+
+// CreateDraftRequest saves an unpublished post, every field is optional so a draft can be saved as it is typed.
+// ContentImagePath is deprecated, it is only used when Media is not set.
+type CreateDraftRequest struct {
+	ContentText      string           `json:"content_text"`
+	ContentImagePath []string         `json:"content_image_path" validate:"omitempty,max=10,dive,url"`
+	Media            []MediaRequest   `json:"media" validate:"omitempty,max=10,dive"`
+	Visibility       string           `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	Location         *LocationRequest `json:"location"`
+	PlaceId          int64            `json:"place_id" validate:"omitempty,min=1"`
+	TripId           int64            `json:"trip_id" validate:"omitempty,min=1"`
+	QuoteOfPostId    int64            `json:"quote_of_post_id" validate:"omitempty,min=1"`
+}
+
+// EditDraftRequest updates the fields of a draft that are set, an empty media list removes its media.
+// ContentImagePath is deprecated, it is only used when Media is not set.
+type EditDraftRequest struct {
+	ContentText      *string          `json:"content_text"`
+	ContentImagePath *[]string        `json:"content_image_path" validate:"omitempty,max=10,dive,url"`
+	Media            *[]MediaRequest  `json:"media" validate:"omitempty,max=10,dive"`
+	Visibility       *string          `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	Location         *LocationRequest `json:"location"`
+	RemoveLocation   bool             `json:"remove_location"`
+	PlaceId          *int64           `json:"place_id" validate:"omitempty,min=1"`
+	RemovePlace      bool             `json:"remove_place"`
+	TripId           *int64           `json:"trip_id" validate:"omitempty,min=1"`
+	RemoveTrip       bool             `json:"remove_trip"`
+	QuoteOfPostId    *int64           `json:"quote_of_post_id" validate:"omitempty,min=1"`
+	RemoveQuote      bool             `json:"remove_quote"`
+}
+
+// PublishDraftRequest publishes a draft, now or at the RFC3339 PublishAt
+type PublishDraftRequest struct {
+	PublishAt string `json:"publish_at"`
+}
+
+// EditPostRequest updates the fields of a post that are set, an empty media list removes its media.
+// ContentImagePath is deprecated, it is only used when Media is not set.
 type EditPostRequest struct {
-	ContentText      *string   `json:"content_text" validate:"omitempty"`
-	ContentImagePath *[]string `json:"content_image_path" validate:"omitempty,dive,url"`
-	Visible          *bool     `json:"visible"`
+	ContentText      *string          `json:"content_text" validate:"omitempty"`
+	ContentImagePath *[]string        `json:"content_image_path" validate:"omitempty,max=10,dive,url"`
+	Media            *[]MediaRequest  `json:"media" validate:"omitempty,max=10,dive"`
+	Visible          *bool            `json:"visible"`
+	Visibility       *string          `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	Location         *LocationRequest `json:"location"`
+	RemoveLocation   bool             `json:"remove_location"`
+	PlaceId          *int64           `json:"place_id" validate:"omitempty,min=1"`
+	RemovePlace      bool             `json:"remove_place"`
+	TripId           *int64           `json:"trip_id" validate:"omitempty,min=1"`
+	RemoveTrip       bool             `json:"remove_trip"`
+}
+
+// LocationRequest geotags a post, coordinates are WGS84 degrees
+type LocationRequest struct {
+	Latitude  *float64 `json:"latitude" validate:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" validate:"required,min=-180,max=180"`
+	PlaceName string   `json:"place_name" validate:"max=200"`
+}
+
+// CreatePlaceRequest adds a place to the catalog, coordinates are WGS84 degrees
+type CreatePlaceRequest struct {
+	Name      string   `json:"name" validate:"required,max=200"`
+	Category  string   `json:"category" validate:"required,oneof=restaurant cafe bar hotel attraction museum park beach mountain airport station shop city other"`
+	Country   string   `json:"country" validate:"max=100"`
+	City      string   `json:"city" validate:"max=100"`
+	Latitude  *float64 `json:"latitude" validate:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" validate:"required,min=-180,max=180"`
+}
+
+// MergePlaceRequest merges the place of the URL into the target place
+type MergePlaceRequest struct {
+	TargetPlaceId int64 `json:"target_place_id" validate:"required,min=1"`
+}
+
+// CreateTripRequest creates a draft trip, dates are YYYY-MM-DD and place_ids are the visited places in order
+type CreateTripRequest struct {
+	Title      string  `json:"title" validate:"required,max=200"`
+	StartDate  string  `json:"start_date" validate:"required"`
+	EndDate    string  `json:"end_date"`
+	CoverImage string  `json:"cover_image" validate:"max=1000"`
+	Visibility string  `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	PlaceIds   []int64 `json:"place_ids" validate:"max=100,dive,min=1"`
+}
+
+// EditTripRequest updates the fields that are set. place_ids replaces the visited places when it is set.
+type EditTripRequest struct {
+	Title         *string  `json:"title" validate:"omitempty,min=1,max=200"`
+	StartDate     *string  `json:"start_date"`
+	EndDate       *string  `json:"end_date"`
+	RemoveEndDate bool     `json:"remove_end_date"`
+	CoverImage    *string  `json:"cover_image" validate:"omitempty,max=1000"`
+	Visibility    *string  `json:"visibility" validate:"omitempty,oneof=public followers private"`
+	PlaceIds      *[]int64 `json:"place_ids" validate:"omitempty,max=100,dive,min=1"`
+}
+
+// InviteTripMemberRequest invites a user to a trip
+type InviteTripMemberRequest struct {
+	UserId int64  `json:"user_id" validate:"required,min=1"`
+	Role   string `json:"role" validate:"required,oneof=viewer editor"`
+}
+
+// UpdateTripMemberRequest changes the role of a trip member
+type UpdateTripMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=viewer editor"`
+}
+
+// AddTravelVisitRequest adds a visit to the travel map of the current user.
+// visited_at is YYYY-MM-DD and defaults to today.
+type AddTravelVisitRequest struct {
+	Country    string `json:"country" validate:"required,max=100"`
+	City       string `json:"city" validate:"max=100"`
+	VisitedAt  string `json:"visited_at"`
+	Visibility string `json:"visibility" validate:"omitempty,oneof=public followers private"`
+}
+
+// CreatePlaceReviewRequest rates a place from 1 to 5 stars
+type CreatePlaceReviewRequest struct {
+	Rating      int32  `json:"rating" validate:"required,min=1,max=5"`
+	ContentText string `json:"content_text" validate:"max=5000"`
+}
+
+// EditPlaceReviewRequest updates the fields that are set
+type EditPlaceReviewRequest struct {
+	Rating      *int32  `json:"rating" validate:"omitempty,min=1,max=5"`
+	ContentText *string `json:"content_text" validate:"omitempty,max=5000"`
+}
+
+// AddBookmarkRequest saves a post, CollectionId 0 keeps it out of any collection
+type AddBookmarkRequest struct {
+	CollectionId int64 `json:"collection_id" validate:"min=0"`
+}
+
+// BookmarkCollectionRequest names a bookmark collection, used to create and rename it
+type BookmarkCollectionRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+// CreateStoryRequest posts an image as a story for 24 hours
+type CreateStoryRequest struct {
+	Media MediaRequest `json:"media" validate:"required"`
 }
 
 type CreatePostCommentRequest struct {
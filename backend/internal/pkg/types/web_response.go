@@ -27,20 +27,262 @@ type ErrorResponse struct {
 
 // PostDetailInfoResponse return post detail in response.
 type PostDetailInfoResponse struct {
-	PostID           int64             `json:"post_id"`
-	UserID           int64             `json:"user_id"`
+	PostID           int64                 `json:"post_id"`
+	UserID           int64                 `json:"user_id"`
+	ContentText      string                `json:"content_text"`
+	ContentImagePath []string              `json:"content_image_path"`
+	Media            []MediaResponse       `json:"media"`
+	Visibility       string                `json:"visibility"`
+	CreatedAt        string                `json:"created_at"`
+	Comments         []CommentResponse     `json:"comments"`
+	UsersLiked       []int64               `json:"users_liked"`
+	LikedByMe        bool                  `json:"liked_by_me"`
+	EditedAt         string                `json:"edited_at,omitempty"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *LocationResponse     `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripId           int64                 `json:"trip_id,omitempty"`
+	RepostOfPostId   int64                 `json:"repost_of_post_id,omitempty"`
+	QuoteOfPostId    int64                 `json:"quote_of_post_id,omitempty"`
+	RepostCount      int64                 `json:"repost_count"`
+	QuoteCount       int64                 `json:"quote_count"`
+	RepostedByMe     bool                  `json:"reposted_by_me"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me"`
+	PublishAt        string                `json:"publish_at,omitempty"`
+	Poll             *PollResponse         `json:"poll,omitempty"`
+	LinkPreviews     []LinkPreviewResponse `json:"link_previews"`
+}
+
+// PostsResponse returns the posts of a list, see PostSummaryResponse
+type PostsResponse struct {
+	Posts []PostSummaryResponse `json:"posts"`
+}
+
+// PostSummaryResponse is a post as shown in a list. Its comments, liked users, poll and link previews
+// are left out, they come with the post details.
+type PostSummaryResponse struct {
+	PostID           int64                 `json:"post_id"`
+	UserID           int64                 `json:"user_id"`
+	Author           *PostAuthorResponse   `json:"author,omitempty"`
+	ContentText      string                `json:"content_text"`
+	ContentImagePath []string              `json:"content_image_path"`
+	Media            []MediaResponse       `json:"media"`
+	Visibility       string                `json:"visibility"`
+	CreatedAt        string                `json:"created_at"`
+	EditedAt         string                `json:"edited_at,omitempty"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *LocationResponse     `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripId           int64                 `json:"trip_id,omitempty"`
+	RepostOfPostId   int64                 `json:"repost_of_post_id,omitempty"`
+	QuoteOfPostId    int64                 `json:"quote_of_post_id,omitempty"`
+	LikeCount        int64                 `json:"like_count"`
+	CommentCount     int64                 `json:"comment_count"`
+	RepostCount      int64                 `json:"repost_count"`
+	QuoteCount       int64                 `json:"quote_count"`
+	LikedByMe        bool                  `json:"liked_by_me"`
+	RepostedByMe     bool                  `json:"reposted_by_me"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me"`
+}
+
+// PostAuthorResponse is the public profile of the author of a post
+type PostAuthorResponse struct {
+	UserID         int64  `json:"user_id"`
+	UserName       string `json:"user_name"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+// CreateStoryResponse is the id of a new story and when it expires
+type CreateStoryResponse struct {
+	StoryID   int64  `json:"story_id"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// StoryTrayResponse lists the users with live stories: the current user first, then the users with
+// unseen stories, the most recent story first
+type StoryTrayResponse struct {
+	Entries []StoryTrayEntryResponse `json:"entries"`
+}
+
+// StoryTrayEntryResponse is the live stories of a user, oldest first
+type StoryTrayEntryResponse struct {
+	Author    PostAuthorResponse `json:"author"`
+	Stories   []StoryResponse    `json:"stories"`
+	HasUnseen bool               `json:"has_unseen"`
+}
+
+// StoryResponse is an image shown until ExpiresAt. ViewCount is only set for the author of the story.
+type StoryResponse struct {
+	StoryID   int64         `json:"story_id"`
+	UserID    int64         `json:"user_id"`
+	Media     MediaResponse `json:"media"`
+	CreatedAt string        `json:"created_at"`
+	ExpiresAt string        `json:"expires_at"`
+	Seen      bool          `json:"seen"`
+	ViewCount int64         `json:"view_count,omitempty"`
+}
+
+// StoryViewersResponse lists who saw a story, most recent view first
+type StoryViewersResponse struct {
+	Viewers []StoryViewerResponse `json:"viewers"`
+}
+
+// StoryViewerResponse is a user who saw a story and when they first did
+type StoryViewerResponse struct {
+	User     PostAuthorResponse `json:"user"`
+	ViewedAt string             `json:"viewed_at"`
+}
+
+// MediaResponse is an image or video of a post, Width and Height are 0 when unknown.
+// The content_image_path of posts, revisions and drafts lists the urls of their media for older clients.
+type MediaResponse struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type,omitempty"`
+	Width    int32  `json:"width,omitempty"`
+	Height   int32  `json:"height,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
+	Blurhash string `json:"blurhash,omitempty"`
+}
+
+// LinkPreviewResponse is the preview of a link of a post, from the OpenGraph or Twitter card metadata of the page
+type LinkPreviewResponse struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+// PollResponse is the poll of a post, the tallies are only set when ResultsVisible
+type PollResponse struct {
+	Options        []PollOptionResponse `json:"options"`
+	ExpiresAt      string               `json:"expires_at"`
+	MultipleChoice bool                 `json:"multiple_choice"`
+	Anonymous      bool                 `json:"anonymous"`
+	Closed         bool                 `json:"closed"`
+	VotersCount    int64                `json:"voters_count"`
+	ResultsVisible bool                 `json:"results_visible"`
+	MyOptionIds    []int64              `json:"my_option_ids"`
+}
+
+// PollOptionResponse is an option of a poll, VoterIds is empty for anonymous polls
+type PollOptionResponse struct {
+	OptionId   int64   `json:"option_id"`
+	Text       string  `json:"text"`
+	VotesCount int64   `json:"votes_count"`
+	VoterIds   []int64 `json:"voter_ids,omitempty"`
+}
+
+// RepostPostResponse is returned when reposting a post, RepostId is the post created for the repost
+type RepostPostResponse struct {
+	Message  string `json:"message"`
+	RepostId int64  `json:"repost_id"`
+}
+
+// LocationResponse is where a post was written
+type LocationResponse struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	PlaceName string  `json:"place_name,omitempty"`
+}
+
+// NearbyPostResponse is a post close to the requested point
+type NearbyPostResponse struct {
+	PostId     int64   `json:"post_id"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// NearbyPostsResponse lists nearby posts, closest first
+type NearbyPostsResponse struct {
+	Posts []NearbyPostResponse `json:"posts"`
+}
+
+// PostRevisionResponse is one version of a post's content
+type PostRevisionResponse struct {
+	Revision         int32           `json:"revision"`
+	ContentText      string          `json:"content_text"`
+	ContentImagePath []string        `json:"content_image_path"`
+	Media            []MediaResponse `json:"media"`
+	Visibility       string          `json:"visibility"`
+	CreatedAt        string          `json:"created_at"`
+}
+
+type PostRevisionsResponse struct {
+	Revisions []PostRevisionResponse `json:"revisions"`
+}
+
+// TrashedPostResponse is a deleted post that can still be restored
+type TrashedPostResponse struct {
+	PostID           int64           `json:"post_id"`
+	ContentText      string          `json:"content_text"`
+	ContentImagePath []string        `json:"content_image_path"`
+	Media            []MediaResponse `json:"media"`
+	CreatedAt        string          `json:"created_at"`
+	DeletedAt        string          `json:"deleted_at"`
+	PurgeAt          string          `json:"purge_at"`
+}
+
+type TrashedPostsResponse struct {
+	Posts []TrashedPostResponse `json:"posts"`
+}
+
+// ScheduledPostResponse is a post waiting to be published at PublishAt
+type ScheduledPostResponse struct {
+	PostID           int64           `json:"post_id"`
+	ContentText      string          `json:"content_text"`
+	ContentImagePath []string        `json:"content_image_path"`
+	Media            []MediaResponse `json:"media"`
+	Visibility       string          `json:"visibility"`
+	CreatedAt        string          `json:"created_at"`
+	PublishAt        string          `json:"publish_at"`
+}
+
+type ScheduledPostsResponse struct {
+	Posts []ScheduledPostResponse `json:"posts"`
+}
+
+// DraftResponse is an unpublished post of the current user, ids are 0 when unset
+type DraftResponse struct {
+	DraftID          int64             `json:"draft_id"`
 	ContentText      string            `json:"content_text"`
 	ContentImagePath []string          `json:"content_image_path"`
+	Media            []MediaResponse   `json:"media"`
+	Visibility       string            `json:"visibility"`
+	Location         *LocationResponse `json:"location,omitempty"`
+	PlaceId          int64             `json:"place_id,omitempty"`
+	TripId           int64             `json:"trip_id,omitempty"`
+	QuoteOfPostId    int64             `json:"quote_of_post_id,omitempty"`
 	CreatedAt        string            `json:"created_at"`
-	Comments         []CommentResponse `json:"comments"`
-	UsersLiked       []int64           `json:"users_liked"`
+	UpdatedAt        string            `json:"updated_at"`
+}
+
+// DraftsResponse lists the drafts of the current user, the last edited first
+type DraftsResponse struct {
+	Drafts []DraftResponse `json:"drafts"`
+}
+
+// CreateDraftResponse represents a successful draft creation response
+type CreateDraftResponse struct {
+	Message string `json:"message"`
+	DraftId int64  `json:"draft_id"`
 }
 
 type CommentResponse struct {
-	CommentId   int64  `json:"comment_id"`
-	UserId      int64  `json:"user_id"`
-	PostId      int64  `json:"post_id"`
-	ContentText string `json:"content_text"`
+	CommentId   int64                 `json:"comment_id"`
+	UserId      int64                 `json:"user_id"`
+	PostId      int64                 `json:"post_id"`
+	ContentText string                `json:"content_text"`
+	Mentions    []MentionSpanResponse `json:"mentions"`
+}
+
+// MentionSpanResponse is a resolved @username, Start and End are code point offsets, End exclusive
+type MentionSpanResponse struct {
+	UserID   int64  `json:"user_id"`
+	UserName string `json:"user_name"`
+	Start    int32  `json:"start"`
+	End      int32  `json:"end"`
 }
 
 type UserFollowerResponse struct {
@@ -55,8 +297,259 @@ type UserPostsResponse struct {
 	PostsIds []int64 `json:"posts_ids"`
 }
 
+// HashtagInfoResponse holds the usage statistics of a hashtag
+type HashtagInfoResponse struct {
+	Tag             string `json:"tag"`
+	PostCount       int64  `json:"post_count"`
+	AuthorCount     int64  `json:"author_count"`
+	RecentPostCount int64  `json:"recent_post_count"`
+	FirstUsedAt     string `json:"first_used_at,omitempty"`
+	LastUsedAt      string `json:"last_used_at,omitempty"`
+}
+
+// HashtagPostsResponse is a page of posts using a hashtag.
+// NextCursor is 0 when there are no more posts.
+type HashtagPostsResponse struct {
+	PostsIds   []int64 `json:"posts_ids"`
+	NextCursor int64   `json:"next_cursor"`
+}
+
+// CreatePlaceResponse represents a successful place creation response
+type CreatePlaceResponse struct {
+	Message string `json:"message"`
+	PlaceId int64  `json:"place_id"`
+}
+
+// PlaceResponse is a place of the catalog. CheckInCount and VisitorCount count public check-ins,
+// DistanceKm is only set by searches near a point.
+type PlaceResponse struct {
+	PlaceId       int64   `json:"place_id"`
+	Name          string  `json:"name"`
+	Category      string  `json:"category"`
+	Country       string  `json:"country,omitempty"`
+	City          string  `json:"city,omitempty"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	CreatedBy     int64   `json:"created_by"`
+	CreatedAt     string  `json:"created_at"`
+	CheckInCount  int64   `json:"check_in_count"`
+	VisitorCount  int64   `json:"visitor_count"`
+	RatingCount   int64   `json:"rating_count"`
+	RatingAverage float64 `json:"rating_average"`
+	DistanceKm    float64 `json:"distance_km,omitempty"`
+}
+
+// PlacesResponse lists the places matching a search
+type PlacesResponse struct {
+	Places []PlaceResponse `json:"places"`
+}
+
+// PlacePostsResponse is a page of check-ins at a place.
+// NextCursor is 0 when there are no more posts.
+type PlacePostsResponse struct {
+	PostsIds   []int64 `json:"posts_ids"`
+	NextCursor int64   `json:"next_cursor"`
+}
+
+// CreateTripResponse represents a successful trip creation response
+type CreateTripResponse struct {
+	Message string `json:"message"`
+	TripId  int64  `json:"trip_id"`
+}
+
+// TripResponse is a trip with its visited places in order and the timeline of its posts, oldest first.
+// PublishedAt is empty for drafts. Members only lists pending invitations to the owner.
+type TripResponse struct {
+	TripId      int64                `json:"trip_id"`
+	UserId      int64                `json:"user_id"`
+	Title       string               `json:"title"`
+	StartDate   string               `json:"start_date"`
+	EndDate     string               `json:"end_date,omitempty"`
+	CoverImage  string               `json:"cover_image,omitempty"`
+	Visibility  string               `json:"visibility"`
+	PublishedAt string               `json:"published_at,omitempty"`
+	CreatedAt   string               `json:"created_at"`
+	Places      []PlaceResponse      `json:"places"`
+	Timeline    []TripPostResponse   `json:"timeline"`
+	Members     []TripMemberResponse `json:"members"`
+}
+
+// TripPostResponse is a post of a trip timeline, PlaceId is set for check-ins
+type TripPostResponse struct {
+	PostId    int64  `json:"post_id"`
+	UserId    int64  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+	PlaceId   int64  `json:"place_id,omitempty"`
+}
+
+// TripMemberResponse is a member of a trip, Role is "editor" or "viewer"
+type TripMemberResponse struct {
+	UserId  int64  `json:"user_id"`
+	Role    string `json:"role"`
+	Pending bool   `json:"pending"`
+}
+
+// TripInvitationResponse is a pending invitation to a trip
+type TripInvitationResponse struct {
+	TripId    int64  `json:"trip_id"`
+	Title     string `json:"title"`
+	InvitedBy int64  `json:"invited_by"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+// TripInvitationsResponse lists the pending trip invitations of the current user, newest first
+type TripInvitationsResponse struct {
+	Invitations []TripInvitationResponse `json:"invitations"`
+}
+
+// AddTravelVisitResponse represents a successful travel visit creation response
+type AddTravelVisitResponse struct {
+	Message string `json:"message"`
+	VisitId int64  `json:"visit_id"`
+}
+
+// TravelMapResponse lists the countries and cities a user visited in the order they were first visited,
+// and the visits the user added by hand, newest first
+type TravelMapResponse struct {
+	Countries []VisitedCountryResponse `json:"countries"`
+	Visits    []TravelVisitResponse    `json:"visits"`
+}
+
+// VisitedCountryResponse is a visited country, PostsCount counts the posts located in the country
+type VisitedCountryResponse struct {
+	Country        string                `json:"country"`
+	FirstVisitedAt string                `json:"first_visited_at"`
+	PostsCount     int64                 `json:"posts_count"`
+	Cities         []VisitedCityResponse `json:"cities"`
+}
+
+// VisitedCityResponse is a visited city of a country
+type VisitedCityResponse struct {
+	City           string `json:"city"`
+	FirstVisitedAt string `json:"first_visited_at"`
+	PostsCount     int64  `json:"posts_count"`
+}
+
+// TravelVisitResponse is a visit added by hand, VisitedAt is YYYY-MM-DD
+type TravelVisitResponse struct {
+	VisitId    int64  `json:"visit_id"`
+	Country    string `json:"country"`
+	City       string `json:"city,omitempty"`
+	VisitedAt  string `json:"visited_at"`
+	Visibility string `json:"visibility"`
+}
+
+// TravelStatsResponse sums up the travels of a user, the visit dates are empty when there is no visit
+type TravelStatsResponse struct {
+	CountriesCount int64   `json:"countries_count"`
+	CitiesCount    int64   `json:"cities_count"`
+	PlacesCount    int64   `json:"places_count"`
+	PostsCount     int64   `json:"posts_count"`
+	DistanceKm     float64 `json:"distance_km"`
+	FirstVisitedAt string  `json:"first_visited_at,omitempty"`
+	LastVisitedAt  string  `json:"last_visited_at,omitempty"`
+}
+
+// CreatePlaceReviewResponse represents a successful review creation response
+type CreatePlaceReviewResponse struct {
+	Message  string `json:"message"`
+	ReviewId int64  `json:"review_id"`
+}
+
+// PlaceReviewResponse is a review of a place. EditedAt is empty for reviews that were never edited,
+// VotedHelpful tells whether the current viewer voted the review helpful.
+type PlaceReviewResponse struct {
+	ReviewId     int64  `json:"review_id"`
+	PlaceId      int64  `json:"place_id"`
+	UserId       int64  `json:"user_id"`
+	Rating       int32  `json:"rating"`
+	ContentText  string `json:"content_text"`
+	HelpfulCount int64  `json:"helpful_count"`
+	VotedHelpful bool   `json:"voted_helpful"`
+	CreatedAt    string `json:"created_at"`
+	EditedAt     string `json:"edited_at,omitempty"`
+}
+
+// RatingSummaryResponse aggregates the ratings of a place, Counts has the number of reviews per star, 1 to 5
+type RatingSummaryResponse struct {
+	Count   int64   `json:"count"`
+	Average float64 `json:"average"`
+	Counts  []int64 `json:"counts"`
+}
+
+// PlaceReviewsResponse is a page of the reviews of a place with its rating summary
+type PlaceReviewsResponse struct {
+	Reviews    []PlaceReviewResponse `json:"reviews"`
+	Summary    RatingSummaryResponse `json:"summary"`
+	NextCursor string                `json:"next_cursor"`
+}
+
+// BookmarkResponse is a saved post, CollectionId is 0 for posts saved out of any collection
+type BookmarkResponse struct {
+	PostId       int64  `json:"post_id"`
+	CollectionId int64  `json:"collection_id"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// BookmarksResponse is a page of the saved posts of the current user, newest first
+type BookmarksResponse struct {
+	Bookmarks  []BookmarkResponse `json:"bookmarks"`
+	NextCursor int64              `json:"next_cursor"`
+}
+
+// BookmarkCollectionResponse is a collection of saved posts, BookmarksCount only counts the posts the owner can still see
+type BookmarkCollectionResponse struct {
+	CollectionId   int64  `json:"collection_id"`
+	Name           string `json:"name"`
+	BookmarksCount int64  `json:"bookmarks_count"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// BookmarkCollectionsResponse lists the bookmark collections of the current user
+type BookmarkCollectionsResponse struct {
+	Collections []BookmarkCollectionResponse `json:"collections"`
+}
+
+// CreateBookmarkCollectionResponse represents a successful collection creation response
+type CreateBookmarkCollectionResponse struct {
+	Message      string `json:"message"`
+	CollectionId int64  `json:"collection_id"`
+}
+
+// MergePlacesResponse represents a successful place merge response
+type MergePlacesResponse struct {
+	Message         string `json:"message"`
+	MovedPostsCount int64  `json:"moved_posts_count"`
+}
+
+// SearchResponse is a page of search results, posts_ids is set for post searches and users for user searches
+type SearchResponse struct {
+	Type       string                   `json:"type"`
+	PostsIds   []int64                  `json:"posts_ids,omitempty"`
+	Users      []UserDetailInfoResponse `json:"users,omitempty"`
+	NextCursor string                   `json:"next_cursor"`
+}
+
+// NewsfeedResponse lists a page of the newsfeed. PostsIds only has the posts, Items has every item in feed order.
+// A hydrated newsfeed is read with NextCursor, which is 0 at the end of the newsfeed, its CurrentPage is 0 and
+// its TotalPages counts the hidden items too.
 type NewsfeedResponse struct {
-	PostsIds []int64 `json:"posts_ids"`
+	PostsIds    []int64                `json:"posts_ids"`
+	Items       []NewsfeedItemResponse `json:"items"`
+	TotalPages  int32                  `json:"total_pages"`
+	CurrentPage int32                  `json:"current_page"`
+	NextCursor  int64                  `json:"next_cursor"`
+}
+
+// NewsfeedItemResponse is an entry of the newsfeed, Type is "post", "trip", "review" or "repost".
+// The id of a repost is the post created for it, its repost_of_post_id is the shared post.
+// Post is only set for posts and reposts of a hydrated newsfeed, RepostOf is the post shared by a repost.
+type NewsfeedItemResponse struct {
+	Type     string               `json:"type"`
+	Id       int64                `json:"id"`
+	Post     *PostSummaryResponse `json:"post,omitempty"`
+	RepostOf *PostSummaryResponse `json:"repost_of,omitempty"`
 }
 
 // UserDetailInfo represents a user's profile information
@@ -73,14 +566,22 @@ type UserDetailInfo struct {
 
 // UserDetailInfoResponse is being maintained for backward compatibility
 type UserDetailInfoResponse struct {
-	UserID         int64  `json:"user_id"`
-	UserName       string `json:"user_name"`
-	FirstName      string `json:"first_name"`
-	LastName       string `json:"last_name"`
-	DateOfBirth    string `json:"date_of_birth"`
-	Email          string `json:"email"`
-	ProfilePicture string `json:"profile_picture,omitempty"`
-	CoverPicture   string `json:"cover_picture,omitempty"`
+	UserID         int64   `json:"user_id"`
+	UserName       string  `json:"user_name"`
+	FirstName      string  `json:"first_name"`
+	LastName       string  `json:"last_name"`
+	DateOfBirth    string  `json:"date_of_birth,omitempty"`
+	Email          string  `json:"email,omitempty"`
+	ProfilePicture string  `json:"profile_picture,omitempty"`
+	CoverPicture   string  `json:"cover_picture,omitempty"`
+	Following      bool    `json:"following"`
+	FollowedBy     bool    `json:"followed_by"`
+	PinnedPostIds  []int64 `json:"pinned_post_ids,omitempty"`
+}
+
+// AutocompleteUsersResponse lists the users matching an autocomplete prefix
+type AutocompleteUsersResponse struct {
+	Users []UserDetailInfoResponse `json:"users"`
 }
 
 // GetS3PresignedUrlResponse represents the response for getting a presigned S3 URL
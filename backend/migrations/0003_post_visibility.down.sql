@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_users_deleted_at;
+DROP INDEX IF EXISTS idx_comments_deleted_at;
+DROP INDEX IF EXISTS idx_posts_user_id_visibility;
+DROP INDEX IF EXISTS idx_posts_deleted_at;
+
+-- Restore the old encoding where hidden posts carry a deleted_at timestamp
+UPDATE posts
+SET deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP)
+WHERE visibility <> 'public';
+
+ALTER TABLE posts
+DROP CONSTRAINT IF EXISTS chk_posts_visibility,
+DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,17 @@
+-- Add an explicit visibility column to posts
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public';
+
+-- Posts used to be hidden by setting deleted_at, since deletion was always a hard delete.
+-- Convert those rows to private posts so deleted_at only means "deleted" from now on.
+UPDATE posts
+SET visibility = 'private', deleted_at = NULL
+WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE posts
+ADD CONSTRAINT chk_posts_visibility CHECK (visibility IN ('public', 'followers', 'private'));
+
+CREATE INDEX IF NOT EXISTS idx_posts_deleted_at ON posts (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_posts_user_id_visibility ON posts (user_id, visibility);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
@@ -0,0 +1,22 @@
+-- Track when a post was last edited
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP NULL;
+
+-- Create the post revision table, one row per version of a post's content
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    post_id BIGINT NOT NULL,
+    revision INT NOT NULL,
+    content_text TEXT NOT NULL,
+    content_image_path VARCHAR(1000),
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    FOREIGN KEY (post_id) REFERENCES posts(id),
+    CONSTRAINT idx_post_revisions_post_revision UNIQUE (post_id, revision)
+);
+
+-- Existing posts start their history with their current content
+INSERT INTO post_revisions (created_at, post_id, revision, content_text, content_image_path, visibility)
+SELECT created_at, id, 1, content_text, content_image_path, visibility
+FROM posts
+ON CONFLICT (post_id, revision) DO NOTHING;
@@ -0,0 +1,35 @@
+-- Create the hashtag table, tags are stored normalized (lower case, without '#')
+CREATE TABLE IF NOT EXISTS hashtags (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tag VARCHAR(100) NOT NULL,
+    CONSTRAINT idx_hashtags_tag UNIQUE (tag)
+);
+
+-- Create the post hashtag table
+CREATE TABLE IF NOT EXISTS post_hashtags (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    post_id BIGINT NOT NULL,
+    hashtag_id BIGINT NOT NULL,
+    PRIMARY KEY (post_id, hashtag_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id),
+    FOREIGN KEY (hashtag_id) REFERENCES hashtags(id)
+);
+
+-- Hashtag pages list the newest posts of a tag first
+CREATE INDEX IF NOT EXISTS idx_post_hashtags_hashtag_id_post_id ON post_hashtags (hashtag_id, post_id DESC);
+
+-- Extract the hashtags of existing posts
+INSERT INTO hashtags (tag)
+SELECT DISTINCT lower(m[1])
+FROM posts
+CROSS JOIN LATERAL regexp_matches(content_text, '(?:^|[^[:alnum:]_&/])#([[:alnum:]_]*[[:alpha:]][[:alnum:]_]*)', 'g') AS m
+WHERE length(m[1]) <= 100
+ON CONFLICT (tag) DO NOTHING;
+
+INSERT INTO post_hashtags (created_at, post_id, hashtag_id)
+SELECT DISTINCT p.created_at, p.id, h.id
+FROM posts p
+CROSS JOIN LATERAL regexp_matches(p.content_text, '(?:^|[^[:alnum:]_&/])#([[:alnum:]_]*[[:alpha:]][[:alnum:]_]*)', 'g') AS m
+JOIN hashtags h ON h.tag = lower(m[1])
+ON CONFLICT (post_id, hashtag_id) DO NOTHING;
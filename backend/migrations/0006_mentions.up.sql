@@ -0,0 +1,20 @@
+-- Create the mention table, one row per @username resolved in a post or comment text.
+-- comment_id is NULL for mentions in the post text itself.
+-- mention_start and mention_end are code point offsets of the '@username' span, end exclusive.
+CREATE TABLE IF NOT EXISTS mentions (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    post_id BIGINT NOT NULL,
+    comment_id BIGINT,
+    user_id BIGINT NOT NULL,
+    author_id BIGINT NOT NULL,
+    mention_start INT NOT NULL,
+    mention_end INT NOT NULL,
+    FOREIGN KEY (post_id) REFERENCES posts(id),
+    FOREIGN KEY (comment_id) REFERENCES comments(id),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (author_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mentions_post_id ON mentions (post_id);
+CREATE INDEX IF NOT EXISTS idx_mentions_user_id ON mentions (user_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_full_name_trgm;
+DROP INDEX IF EXISTS idx_users_user_name_trgm;
+DROP INDEX IF EXISTS idx_posts_search_vector;
+DROP TRIGGER IF EXISTS posts_search_vector_update ON posts;
+DROP FUNCTION IF EXISTS posts_search_vector_update();
+ALTER TABLE posts DROP COLUMN IF EXISTS search_vector;
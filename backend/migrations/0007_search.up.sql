@@ -0,0 +1,26 @@
+-- Trigram matching is used for user search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Full-text search over post text. The 'simple' configuration does not stem or drop
+-- stop words, so posts in any language are indexed the same way.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+UPDATE posts SET search_vector = to_tsvector('simple', COALESCE(content_text, ''));
+
+CREATE OR REPLACE FUNCTION posts_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := to_tsvector('simple', COALESCE(NEW.content_text, ''));
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS posts_search_vector_update ON posts;
+CREATE TRIGGER posts_search_vector_update
+    BEFORE INSERT OR UPDATE OF content_text ON posts
+    FOR EACH ROW EXECUTE FUNCTION posts_search_vector_update();
+
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector);
+
+-- Trigram indexes for user search, the full name expression must match the one used by Search
+CREATE INDEX IF NOT EXISTS idx_users_user_name_trgm ON users USING GIN (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_full_name_trgm ON users USING GIN ((first_name || ' ' || last_name) gin_trgm_ops);
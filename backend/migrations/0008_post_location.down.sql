@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_posts_geohash;
+
+ALTER TABLE posts
+DROP COLUMN IF EXISTS geohash,
+DROP COLUMN IF EXISTS place_name,
+DROP COLUMN IF EXISTS longitude,
+DROP COLUMN IF EXISTS latitude;
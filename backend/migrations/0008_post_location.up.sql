@@ -0,0 +1,9 @@
+-- Optional location of a post, coordinates are WGS84 degrees
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS place_name VARCHAR(200),
+ADD COLUMN IF NOT EXISTS geohash VARCHAR(12);
+
+-- PostGIS is not available, nearby queries narrow the candidates with geohash prefixes
+CREATE INDEX IF NOT EXISTS idx_posts_geohash ON posts (geohash text_pattern_ops) WHERE geohash IS NOT NULL;
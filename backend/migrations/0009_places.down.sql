@@ -0,0 +1,6 @@
+ALTER TABLE users DROP COLUMN IF EXISTS is_moderator;
+
+DROP INDEX IF EXISTS idx_posts_place_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS place_id;
+
+DROP TABLE IF EXISTS places;
@@ -0,0 +1,29 @@
+-- Create the place table, coordinates are WGS84 degrees.
+-- A place merged into another one is soft-deleted and points to the place it was merged into.
+CREATE TABLE IF NOT EXISTS places (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    name VARCHAR(200) NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    country VARCHAR(100),
+    city VARCHAR(100),
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    geohash VARCHAR(12) NOT NULL,
+    created_by BIGINT NOT NULL,
+    merged_into_id BIGINT NULL,
+    FOREIGN KEY (created_by) REFERENCES users(id),
+    FOREIGN KEY (merged_into_id) REFERENCES places(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_places_geohash ON places (geohash text_pattern_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_places_name_trgm ON places USING GIN (name gin_trgm_ops);
+
+-- A post can be a check-in at a place
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS place_id BIGINT NULL REFERENCES places(id);
+CREATE INDEX IF NOT EXISTS idx_posts_place_id ON posts (place_id, id DESC) WHERE place_id IS NOT NULL;
+
+-- Moderators can merge duplicate places
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_moderator BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_posts_trip_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS trip_id;
+
+DROP TABLE IF EXISTS trip_places;
+DROP TABLE IF EXISTS trips;
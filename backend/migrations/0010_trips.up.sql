@@ -0,0 +1,33 @@
+-- Create the trip table. A trip is a draft only its owner can see until published_at is set.
+CREATE TABLE IF NOT EXISTS trips (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    user_id BIGINT NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NULL,
+    cover_image VARCHAR(1000),
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    published_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT chk_trips_visibility CHECK (visibility IN ('public', 'followers', 'private')),
+    CONSTRAINT chk_trips_dates CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_trips_user_id ON trips (user_id);
+
+-- Create the trip place table, the places visited during a trip in order
+CREATE TABLE IF NOT EXISTS trip_places (
+    trip_id BIGINT NOT NULL,
+    place_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (trip_id, place_id),
+    FOREIGN KEY (trip_id) REFERENCES trips(id),
+    FOREIGN KEY (place_id) REFERENCES places(id)
+);
+
+-- A post can be part of a trip, whose timeline lists its posts oldest first
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS trip_id BIGINT NULL REFERENCES trips(id);
+CREATE INDEX IF NOT EXISTS idx_posts_trip_id ON posts (trip_id, created_at, id) WHERE trip_id IS NOT NULL;
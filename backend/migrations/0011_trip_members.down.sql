@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_trip_members_user_id;
+DROP TABLE IF EXISTS trip_members;
@@ -0,0 +1,19 @@
+-- Create the trip member table. The owner of a trip is trips.user_id and is not a member row.
+-- An invitation is a pending row, accepting it makes the user a member, declining it deletes the row.
+CREATE TABLE IF NOT EXISTS trip_members (
+    trip_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    role VARCHAR(20) NOT NULL DEFAULT 'viewer',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    invited_by BIGINT NOT NULL,
+    PRIMARY KEY (trip_id, user_id),
+    FOREIGN KEY (trip_id) REFERENCES trips(id),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (invited_by) REFERENCES users(id),
+    CONSTRAINT chk_trip_members_role CHECK (role IN ('editor', 'viewer')),
+    CONSTRAINT chk_trip_members_status CHECK (status IN ('pending', 'accepted'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_members_user_id ON trip_members (user_id, status);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_travel_visits_user_id;
+DROP TABLE IF EXISTS travel_visits;
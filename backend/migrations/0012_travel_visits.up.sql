@@ -0,0 +1,32 @@
+-- Create the travel visit table behind the travel map of a user.
+-- A visit either follows a located post, post_id is set and the row is kept in sync with the post,
+-- or was added by hand, post_id is NULL. Country and city come from the place of a check-in.
+CREATE TABLE IF NOT EXISTS travel_visits (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    post_id BIGINT NULL UNIQUE,
+    place_id BIGINT NULL,
+    country VARCHAR(100) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION NULL,
+    longitude DOUBLE PRECISION NULL,
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    visited_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (post_id) REFERENCES posts(id),
+    FOREIGN KEY (place_id) REFERENCES places(id),
+    CONSTRAINT chk_travel_visits_visibility CHECK (visibility IN ('public', 'followers', 'private'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_travel_visits_user_id ON travel_visits (user_id, visited_at);
+
+-- Backfill the visits of the posts that are already located
+INSERT INTO travel_visits (user_id, post_id, place_id, country, city, latitude, longitude, visibility, visited_at)
+SELECT p.user_id, p.id, p.place_id, COALESCE(pl.country, ''), COALESCE(pl.city, ''),
+       COALESCE(p.latitude, pl.latitude), COALESCE(p.longitude, pl.longitude), p.visibility, p.created_at
+FROM posts p
+LEFT JOIN places pl ON pl.id = p.place_id
+WHERE p.deleted_at IS NULL AND (p.latitude IS NOT NULL OR p.place_id IS NOT NULL)
+ON CONFLICT (post_id) DO NOTHING;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS review_votes;
+DROP INDEX IF EXISTS idx_place_reviews_place_id;
+DROP INDEX IF EXISTS idx_place_reviews_place_user;
+DROP TABLE IF EXISTS place_reviews;
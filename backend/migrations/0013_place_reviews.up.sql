@@ -0,0 +1,31 @@
+-- Create the place review table. A user has at most one review per place,
+-- a review removed by its author or by a moderator is soft-deleted.
+CREATE TABLE IF NOT EXISTS place_reviews (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    place_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    rating SMALLINT NOT NULL,
+    content_text TEXT NOT NULL DEFAULT '',
+    edited_at TIMESTAMP NULL,
+    -- helpful_count is the number of review_votes rows, kept in sync by the votes
+    helpful_count INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (place_id) REFERENCES places(id),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT chk_place_reviews_rating CHECK (rating BETWEEN 1 AND 5)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_place_reviews_place_user ON place_reviews (place_id, user_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_place_reviews_place_id ON place_reviews (place_id, id DESC) WHERE deleted_at IS NULL;
+
+-- Create the helpful vote table, a user votes at most once per review
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES place_reviews(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
@@ -0,0 +1,9 @@
+-- A repost shares another post as is, a quote shares it with a text of its own.
+-- Both are posts of the sharer pointing at the shared post, which is never a repost itself.
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS repost_of_id BIGINT NULL REFERENCES posts(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS quote_of_id BIGINT NULL REFERENCES posts(id) ON DELETE SET NULL;
+
+-- A user reposts a post at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_posts_repost_of_id ON posts (repost_of_id, user_id) WHERE repost_of_id IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_posts_quote_of_id ON posts (quote_of_id) WHERE quote_of_id IS NOT NULL;
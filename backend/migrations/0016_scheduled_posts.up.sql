@@ -0,0 +1,5 @@
+-- A scheduled post is hidden until publish_at, when the scheduler publishes it and clears the column
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_publish_at ON posts (publish_at) WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS post_draft_media;
+DROP TABLE IF EXISTS post_drafts;
@@ -0,0 +1,33 @@
+-- Create the post draft table. Drafts are never published or fanned out, publishing
+-- a draft creates a post from it and removes the draft.
+CREATE TABLE IF NOT EXISTS post_drafts (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    content_text TEXT NOT NULL DEFAULT '',
+    content_image_path VARCHAR(1000) NOT NULL DEFAULT '',
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    latitude DOUBLE PRECISION NULL,
+    longitude DOUBLE PRECISION NULL,
+    place_name VARCHAR(200) NOT NULL DEFAULT '',
+    place_id BIGINT NULL,
+    trip_id BIGINT NULL,
+    quote_of_id BIGINT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (place_id) REFERENCES places(id) ON DELETE SET NULL,
+    FOREIGN KEY (trip_id) REFERENCES trips(id) ON DELETE SET NULL,
+    FOREIGN KEY (quote_of_id) REFERENCES posts(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_drafts_user_id ON post_drafts (user_id, updated_at DESC);
+
+-- The object keys of the uploads a draft uses, media cleanup keeps them while the draft exists
+CREATE TABLE IF NOT EXISTS post_draft_media (
+    draft_id BIGINT NOT NULL,
+    media_key VARCHAR(1000) NOT NULL,
+    PRIMARY KEY (draft_id, media_key),
+    FOREIGN KEY (draft_id) REFERENCES post_drafts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_draft_media_key ON post_draft_media (media_key);
@@ -0,0 +1,42 @@
+-- Create the poll table, a post has at most one poll
+CREATE TABLE IF NOT EXISTS polls (
+    post_id BIGINT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (post_id) REFERENCES posts(id)
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id BIGSERIAL PRIMARY KEY,
+    post_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    text VARCHAR(100) NOT NULL,
+    FOREIGN KEY (post_id) REFERENCES polls(post_id) ON DELETE CASCADE,
+    CONSTRAINT uq_poll_options_post_position UNIQUE (post_id, position)
+);
+
+-- A ballot is the single vote of a user on a poll. Changing a vote updates the ballot
+-- row first, which serializes concurrent votes of the same user.
+CREATE TABLE IF NOT EXISTS poll_ballots (
+    post_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES polls(post_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- The options chosen in a ballot, one row per option
+CREATE TABLE IF NOT EXISTS poll_votes (
+    post_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    option_id BIGINT NOT NULL,
+    PRIMARY KEY (post_id, user_id, option_id),
+    FOREIGN KEY (post_id, user_id) REFERENCES poll_ballots(post_id, user_id) ON DELETE CASCADE,
+    FOREIGN KEY (option_id) REFERENCES poll_options(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_votes_option_id ON poll_votes (option_id);
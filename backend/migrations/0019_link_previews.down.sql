@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_post_links_url;
+DROP TABLE IF EXISTS post_links;
+DROP TABLE IF EXISTS link_previews;
@@ -0,0 +1,22 @@
+-- Create the link preview cache, shared by every post linking to the same URL.
+-- Failed fetches are cached too so that broken links are not fetched for every post.
+CREATE TABLE IF NOT EXISTS link_previews (
+    url VARCHAR(2048) PRIMARY KEY,
+    fetched_at TIMESTAMPTZ NOT NULL,
+    failed BOOLEAN NOT NULL DEFAULT FALSE,
+    title VARCHAR(300) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    image_url VARCHAR(2048) NOT NULL DEFAULT '',
+    site_name VARCHAR(300) NOT NULL DEFAULT ''
+);
+
+-- Create the table of the links found in post texts, in order of appearance
+CREATE TABLE IF NOT EXISTS post_links (
+    post_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    PRIMARY KEY (post_id, position),
+    FOREIGN KEY (post_id) REFERENCES posts(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_links_url ON post_links (url);
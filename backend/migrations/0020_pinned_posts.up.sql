@@ -0,0 +1,5 @@
+-- A pinned post is shown at the top of the profile of its author, pins are ordered by pinned_at
+ALTER TABLE posts
+ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_pinned ON posts (user_id, pinned_at DESC) WHERE pinned_at IS NOT NULL;
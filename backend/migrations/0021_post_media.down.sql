@@ -0,0 +1,35 @@
+-- Join the media back into space-separated image paths, alt texts and sizes are lost
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_image_path VARCHAR(1000);
+ALTER TABLE post_revisions ADD COLUMN IF NOT EXISTS content_image_path VARCHAR(1000);
+ALTER TABLE post_drafts ADD COLUMN IF NOT EXISTS content_image_path VARCHAR(1000) NOT NULL DEFAULT '';
+
+UPDATE posts SET content_image_path = media.paths
+FROM (SELECT post_id, string_agg(url, ' ' ORDER BY position) AS paths FROM post_media GROUP BY post_id) AS media
+WHERE media.post_id = posts.id;
+
+UPDATE post_revisions SET content_image_path = media.paths
+FROM (SELECT revision_id, string_agg(url, ' ' ORDER BY position) AS paths FROM post_revision_media GROUP BY revision_id) AS media
+WHERE media.revision_id = post_revisions.id;
+
+UPDATE post_drafts SET content_image_path = media.paths
+FROM (SELECT draft_id, string_agg(url, ' ' ORDER BY position) AS paths FROM post_draft_media GROUP BY draft_id) AS media
+WHERE media.draft_id = post_drafts.id;
+
+CREATE TABLE IF NOT EXISTS post_draft_media_keys (
+    draft_id BIGINT NOT NULL,
+    media_key VARCHAR(1000) NOT NULL,
+    PRIMARY KEY (draft_id, media_key),
+    FOREIGN KEY (draft_id) REFERENCES post_drafts(id) ON DELETE CASCADE
+);
+
+INSERT INTO post_draft_media_keys (draft_id, media_key)
+SELECT draft_id, storage_key FROM post_draft_media WHERE storage_key <> ''
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS post_draft_media;
+ALTER TABLE post_draft_media_keys RENAME TO post_draft_media;
+ALTER INDEX IF EXISTS post_draft_media_keys_pkey RENAME TO post_draft_media_pkey;
+CREATE INDEX IF NOT EXISTS idx_post_draft_media_key ON post_draft_media (media_key);
+
+DROP TABLE IF EXISTS post_revision_media;
+DROP TABLE IF EXISTS post_media;
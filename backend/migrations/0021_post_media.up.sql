@@ -0,0 +1,82 @@
+-- Replace the space-separated image paths of posts, revisions and drafts with one row per
+-- media item. storage_key is the object key of the upload, it is empty for media migrated
+-- from image paths and then derived from the url by the service.
+CREATE TABLE IF NOT EXISTS post_media (
+    post_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    storage_key VARCHAR(1000) NOT NULL DEFAULT '',
+    mime_type VARCHAR(100) NOT NULL DEFAULT '',
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    alt_text VARCHAR(1000) NOT NULL DEFAULT '',
+    blurhash VARCHAR(100) NOT NULL DEFAULT '',
+    PRIMARY KEY (post_id, position),
+    FOREIGN KEY (post_id) REFERENCES posts(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_media_storage_key ON post_media (storage_key);
+CREATE INDEX IF NOT EXISTS idx_post_media_url ON post_media (url);
+
+CREATE TABLE IF NOT EXISTS post_revision_media (
+    revision_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    storage_key VARCHAR(1000) NOT NULL DEFAULT '',
+    mime_type VARCHAR(100) NOT NULL DEFAULT '',
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    alt_text VARCHAR(1000) NOT NULL DEFAULT '',
+    blurhash VARCHAR(100) NOT NULL DEFAULT '',
+    PRIMARY KEY (revision_id, position),
+    FOREIGN KEY (revision_id) REFERENCES post_revisions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_revision_media_storage_key ON post_revision_media (storage_key);
+CREATE INDEX IF NOT EXISTS idx_post_revision_media_url ON post_revision_media (url);
+
+-- The draft media table only held the object keys used by drafts, it now holds their media
+DROP TABLE IF EXISTS post_draft_media;
+
+CREATE TABLE IF NOT EXISTS post_draft_media (
+    draft_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    storage_key VARCHAR(1000) NOT NULL DEFAULT '',
+    mime_type VARCHAR(100) NOT NULL DEFAULT '',
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    alt_text VARCHAR(1000) NOT NULL DEFAULT '',
+    blurhash VARCHAR(100) NOT NULL DEFAULT '',
+    PRIMARY KEY (draft_id, position),
+    FOREIGN KEY (draft_id) REFERENCES post_drafts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_draft_media_storage_key ON post_draft_media (storage_key);
+CREATE INDEX IF NOT EXISTS idx_post_draft_media_url ON post_draft_media (url);
+
+-- Split the existing image paths, blank paths were an artifact of joining an empty list
+INSERT INTO post_media (post_id, position, url)
+SELECT posts.id, paths.position - 1, paths.url
+FROM posts,
+    regexp_split_to_table(btrim(posts.content_image_path), '\s+') WITH ORDINALITY AS paths(url, position)
+WHERE btrim(COALESCE(posts.content_image_path, '')) <> ''
+ON CONFLICT DO NOTHING;
+
+INSERT INTO post_revision_media (revision_id, position, url)
+SELECT post_revisions.id, paths.position - 1, paths.url
+FROM post_revisions,
+    regexp_split_to_table(btrim(post_revisions.content_image_path), '\s+') WITH ORDINALITY AS paths(url, position)
+WHERE btrim(COALESCE(post_revisions.content_image_path, '')) <> ''
+ON CONFLICT DO NOTHING;
+
+INSERT INTO post_draft_media (draft_id, position, url)
+SELECT post_drafts.id, paths.position - 1, paths.url
+FROM post_drafts,
+    regexp_split_to_table(btrim(post_drafts.content_image_path), '\s+') WITH ORDINALITY AS paths(url, position)
+WHERE btrim(post_drafts.content_image_path) <> ''
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS content_image_path;
+ALTER TABLE post_revisions DROP COLUMN IF EXISTS content_image_path;
+ALTER TABLE post_drafts DROP COLUMN IF EXISTS content_image_path;
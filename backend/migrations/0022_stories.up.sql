@@ -0,0 +1,22 @@
+-- A story is an image shown to the followers of its author until expires_at, 24 hours after it
+-- was posted. Expired stories are deleted with their image by the authpost service, who saw
+-- a story is kept in Redis and expires with it.
+CREATE TABLE IF NOT EXISTS stories (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    storage_key VARCHAR(1000) NOT NULL DEFAULT '',
+    mime_type VARCHAR(100) NOT NULL DEFAULT '',
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    alt_text VARCHAR(1000) NOT NULL DEFAULT '',
+    blurhash VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stories_user ON stories (user_id, expires_at);
+CREATE INDEX IF NOT EXISTS idx_stories_expires_at ON stories (expires_at);
+CREATE INDEX IF NOT EXISTS idx_stories_storage_key ON stories (storage_key);
+CREATE INDEX IF NOT EXISTS idx_stories_url ON stories (url);
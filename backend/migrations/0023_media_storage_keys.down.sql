@@ -0,0 +1,2 @@
+-- The cleared storage keys are derived from the url by the service, there is nothing to restore
+SELECT 1;
@@ -0,0 +1,7 @@
+-- Storage keys used to be derived from the path of any media url, whatever its host, and from
+-- the path of the upload urls of the webapp instead of the key they encode. Clear them so they
+-- are derived from the url again by the service, which only gives a key to uploads.
+UPDATE post_media SET storage_key = '' WHERE url LIKE '%//%' OR url LIKE '/api/v1/binaries/%';
+UPDATE post_revision_media SET storage_key = '' WHERE url LIKE '%//%' OR url LIKE '/api/v1/binaries/%';
+UPDATE post_draft_media SET storage_key = '' WHERE url LIKE '%//%' OR url LIKE '/api/v1/binaries/%';
+UPDATE stories SET storage_key = '' WHERE url LIKE '%//%' OR url LIKE '/api/v1/binaries/%';
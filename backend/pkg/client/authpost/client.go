@@ -55,6 +55,10 @@ func (a *randomClient) GetUserDetailInfo(ctx context.Context, in *pb_aap.GetUser
 	return a.clients[rand.Intn(len(a.clients))].GetUserDetailInfo(ctx, in, opts...)
 }
 
+func (a *randomClient) AutocompleteUsers(ctx context.Context, in *pb_aap.AutocompleteUsersRequest, opts ...grpc.CallOption) (*pb_aap.AutocompleteUsersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].AutocompleteUsers(ctx, in, opts...)
+}
+
 // Group: Friends
 
 func (a *randomClient) GetUserFollower(ctx context.Context, in *pb_aap.GetUserFollowerRequest, opts ...grpc.CallOption) (*pb_aap.GetUserFollowerResponse, error) {
@@ -87,6 +91,10 @@ func (a *randomClient) GetPostDetailInfo(ctx context.Context, in *pb_aap.GetPost
 	return a.clients[rand.Intn(len(a.clients))].GetPostDetailInfo(ctx, in, opts...)
 }
 
+func (a *randomClient) GetPostsByIds(ctx context.Context, in *pb_aap.GetPostsByIdsRequest, opts ...grpc.CallOption) (*pb_aap.GetPostsByIdsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPostsByIds(ctx, in, opts...)
+}
+
 func (a *randomClient) EditPost(ctx context.Context, in *pb_aap.EditPostRequest, opts ...grpc.CallOption) (*pb_aap.EditPostResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].EditPost(ctx, in, opts...)
 }
@@ -102,3 +110,257 @@ func (a *randomClient) CommentPost(ctx context.Context, in *pb_aap.CommentPostRe
 func (a *randomClient) LikePost(ctx context.Context, in *pb_aap.LikePostRequest, opts ...grpc.CallOption) (*pb_aap.LikePostResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].LikePost(ctx, in, opts...)
 }
+
+func (a *randomClient) GetTrashedPosts(ctx context.Context, in *pb_aap.GetTrashedPostsRequest, opts ...grpc.CallOption) (*pb_aap.GetTrashedPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetTrashedPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) RestorePost(ctx context.Context, in *pb_aap.RestorePostRequest, opts ...grpc.CallOption) (*pb_aap.RestorePostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RestorePost(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPostRevisions(ctx context.Context, in *pb_aap.GetPostRevisionsRequest, opts ...grpc.CallOption) (*pb_aap.GetPostRevisionsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPostRevisions(ctx, in, opts...)
+}
+
+func (a *randomClient) RevertPost(ctx context.Context, in *pb_aap.RevertPostRequest, opts ...grpc.CallOption) (*pb_aap.RevertPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RevertPost(ctx, in, opts...)
+}
+
+func (a *randomClient) GetNearbyPosts(ctx context.Context, in *pb_aap.GetNearbyPostsRequest, opts ...grpc.CallOption) (*pb_aap.GetNearbyPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetNearbyPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) RepostPost(ctx context.Context, in *pb_aap.RepostPostRequest, opts ...grpc.CallOption) (*pb_aap.RepostPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RepostPost(ctx, in, opts...)
+}
+
+func (a *randomClient) UndoRepost(ctx context.Context, in *pb_aap.UndoRepostRequest, opts ...grpc.CallOption) (*pb_aap.UndoRepostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UndoRepost(ctx, in, opts...)
+}
+
+func (a *randomClient) GetScheduledPosts(ctx context.Context, in *pb_aap.GetScheduledPostsRequest, opts ...grpc.CallOption) (*pb_aap.GetScheduledPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetScheduledPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) ReschedulePost(ctx context.Context, in *pb_aap.ReschedulePostRequest, opts ...grpc.CallOption) (*pb_aap.ReschedulePostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ReschedulePost(ctx, in, opts...)
+}
+
+func (a *randomClient) CancelScheduledPost(ctx context.Context, in *pb_aap.CancelScheduledPostRequest, opts ...grpc.CallOption) (*pb_aap.CancelScheduledPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CancelScheduledPost(ctx, in, opts...)
+}
+
+// Group: Hashtags
+
+func (a *randomClient) GetHashtagPosts(ctx context.Context, in *pb_aap.GetHashtagPostsRequest, opts ...grpc.CallOption) (*pb_aap.GetHashtagPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetHashtagPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) GetHashtagInfo(ctx context.Context, in *pb_aap.GetHashtagInfoRequest, opts ...grpc.CallOption) (*pb_aap.GetHashtagInfoResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetHashtagInfo(ctx, in, opts...)
+}
+
+// Group: Search
+
+func (a *randomClient) Search(ctx context.Context, in *pb_aap.SearchRequest, opts ...grpc.CallOption) (*pb_aap.SearchResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].Search(ctx, in, opts...)
+}
+
+// Group: Places
+
+func (a *randomClient) CreatePlace(ctx context.Context, in *pb_aap.CreatePlaceRequest, opts ...grpc.CallOption) (*pb_aap.CreatePlaceResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreatePlace(ctx, in, opts...)
+}
+
+func (a *randomClient) SearchPlaces(ctx context.Context, in *pb_aap.SearchPlacesRequest, opts ...grpc.CallOption) (*pb_aap.SearchPlacesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SearchPlaces(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPlace(ctx context.Context, in *pb_aap.GetPlaceRequest, opts ...grpc.CallOption) (*pb_aap.GetPlaceResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPlace(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPlacePosts(ctx context.Context, in *pb_aap.GetPlacePostsRequest, opts ...grpc.CallOption) (*pb_aap.GetPlacePostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPlacePosts(ctx, in, opts...)
+}
+
+func (a *randomClient) MergePlaces(ctx context.Context, in *pb_aap.MergePlacesRequest, opts ...grpc.CallOption) (*pb_aap.MergePlacesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].MergePlaces(ctx, in, opts...)
+}
+
+// Group: Trips
+
+func (a *randomClient) CreateTrip(ctx context.Context, in *pb_aap.CreateTripRequest, opts ...grpc.CallOption) (*pb_aap.CreateTripResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateTrip(ctx, in, opts...)
+}
+
+func (a *randomClient) EditTrip(ctx context.Context, in *pb_aap.EditTripRequest, opts ...grpc.CallOption) (*pb_aap.EditTripResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EditTrip(ctx, in, opts...)
+}
+
+func (a *randomClient) PublishTrip(ctx context.Context, in *pb_aap.PublishTripRequest, opts ...grpc.CallOption) (*pb_aap.PublishTripResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].PublishTrip(ctx, in, opts...)
+}
+
+func (a *randomClient) GetTrip(ctx context.Context, in *pb_aap.GetTripRequest, opts ...grpc.CallOption) (*pb_aap.GetTripResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetTrip(ctx, in, opts...)
+}
+
+func (a *randomClient) InviteTripMember(ctx context.Context, in *pb_aap.InviteTripMemberRequest, opts ...grpc.CallOption) (*pb_aap.InviteTripMemberResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].InviteTripMember(ctx, in, opts...)
+}
+
+func (a *randomClient) RespondTripInvitation(ctx context.Context, in *pb_aap.RespondTripInvitationRequest, opts ...grpc.CallOption) (*pb_aap.RespondTripInvitationResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RespondTripInvitation(ctx, in, opts...)
+}
+
+func (a *randomClient) GetTripInvitations(ctx context.Context, in *pb_aap.GetTripInvitationsRequest, opts ...grpc.CallOption) (*pb_aap.GetTripInvitationsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetTripInvitations(ctx, in, opts...)
+}
+
+func (a *randomClient) UpdateTripMember(ctx context.Context, in *pb_aap.UpdateTripMemberRequest, opts ...grpc.CallOption) (*pb_aap.UpdateTripMemberResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UpdateTripMember(ctx, in, opts...)
+}
+
+func (a *randomClient) RemoveTripMember(ctx context.Context, in *pb_aap.RemoveTripMemberRequest, opts ...grpc.CallOption) (*pb_aap.RemoveTripMemberResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RemoveTripMember(ctx, in, opts...)
+}
+
+func (a *randomClient) RemoveTripPost(ctx context.Context, in *pb_aap.RemoveTripPostRequest, opts ...grpc.CallOption) (*pb_aap.RemoveTripPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RemoveTripPost(ctx, in, opts...)
+}
+
+// Group: Travel
+
+func (a *randomClient) GetTravelMap(ctx context.Context, in *pb_aap.GetTravelMapRequest, opts ...grpc.CallOption) (*pb_aap.GetTravelMapResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetTravelMap(ctx, in, opts...)
+}
+
+func (a *randomClient) GetTravelStats(ctx context.Context, in *pb_aap.GetTravelStatsRequest, opts ...grpc.CallOption) (*pb_aap.GetTravelStatsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetTravelStats(ctx, in, opts...)
+}
+
+func (a *randomClient) AddTravelVisit(ctx context.Context, in *pb_aap.AddTravelVisitRequest, opts ...grpc.CallOption) (*pb_aap.AddTravelVisitResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].AddTravelVisit(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteTravelVisit(ctx context.Context, in *pb_aap.DeleteTravelVisitRequest, opts ...grpc.CallOption) (*pb_aap.DeleteTravelVisitResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteTravelVisit(ctx, in, opts...)
+}
+
+// Group: Reviews
+
+func (a *randomClient) CreatePlaceReview(ctx context.Context, in *pb_aap.CreatePlaceReviewRequest, opts ...grpc.CallOption) (*pb_aap.CreatePlaceReviewResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreatePlaceReview(ctx, in, opts...)
+}
+
+func (a *randomClient) EditPlaceReview(ctx context.Context, in *pb_aap.EditPlaceReviewRequest, opts ...grpc.CallOption) (*pb_aap.EditPlaceReviewResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EditPlaceReview(ctx, in, opts...)
+}
+
+func (a *randomClient) DeletePlaceReview(ctx context.Context, in *pb_aap.DeletePlaceReviewRequest, opts ...grpc.CallOption) (*pb_aap.DeletePlaceReviewResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeletePlaceReview(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPlaceReview(ctx context.Context, in *pb_aap.GetPlaceReviewRequest, opts ...grpc.CallOption) (*pb_aap.GetPlaceReviewResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPlaceReview(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPlaceReviews(ctx context.Context, in *pb_aap.GetPlaceReviewsRequest, opts ...grpc.CallOption) (*pb_aap.GetPlaceReviewsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPlaceReviews(ctx, in, opts...)
+}
+
+func (a *randomClient) VoteReviewHelpful(ctx context.Context, in *pb_aap.VoteReviewHelpfulRequest, opts ...grpc.CallOption) (*pb_aap.VoteReviewHelpfulResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VoteReviewHelpful(ctx, in, opts...)
+}
+
+// Group: Bookmarks
+
+func (a *randomClient) AddBookmark(ctx context.Context, in *pb_aap.AddBookmarkRequest, opts ...grpc.CallOption) (*pb_aap.AddBookmarkResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].AddBookmark(ctx, in, opts...)
+}
+
+func (a *randomClient) RemoveBookmark(ctx context.Context, in *pb_aap.RemoveBookmarkRequest, opts ...grpc.CallOption) (*pb_aap.RemoveBookmarkResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RemoveBookmark(ctx, in, opts...)
+}
+
+func (a *randomClient) GetBookmarks(ctx context.Context, in *pb_aap.GetBookmarksRequest, opts ...grpc.CallOption) (*pb_aap.GetBookmarksResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetBookmarks(ctx, in, opts...)
+}
+
+func (a *randomClient) CreateBookmarkCollection(ctx context.Context, in *pb_aap.CreateBookmarkCollectionRequest, opts ...grpc.CallOption) (*pb_aap.CreateBookmarkCollectionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateBookmarkCollection(ctx, in, opts...)
+}
+
+func (a *randomClient) GetBookmarkCollections(ctx context.Context, in *pb_aap.GetBookmarkCollectionsRequest, opts ...grpc.CallOption) (*pb_aap.GetBookmarkCollectionsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetBookmarkCollections(ctx, in, opts...)
+}
+
+func (a *randomClient) EditBookmarkCollection(ctx context.Context, in *pb_aap.EditBookmarkCollectionRequest, opts ...grpc.CallOption) (*pb_aap.EditBookmarkCollectionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EditBookmarkCollection(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteBookmarkCollection(ctx context.Context, in *pb_aap.DeleteBookmarkCollectionRequest, opts ...grpc.CallOption) (*pb_aap.DeleteBookmarkCollectionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteBookmarkCollection(ctx, in, opts...)
+}
+
+// Group: Drafts
+
+func (a *randomClient) CreateDraft(ctx context.Context, in *pb_aap.CreateDraftRequest, opts ...grpc.CallOption) (*pb_aap.CreateDraftResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateDraft(ctx, in, opts...)
+}
+
+func (a *randomClient) GetDrafts(ctx context.Context, in *pb_aap.GetDraftsRequest, opts ...grpc.CallOption) (*pb_aap.GetDraftsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetDrafts(ctx, in, opts...)
+}
+
+func (a *randomClient) EditDraft(ctx context.Context, in *pb_aap.EditDraftRequest, opts ...grpc.CallOption) (*pb_aap.EditDraftResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EditDraft(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteDraft(ctx context.Context, in *pb_aap.DeleteDraftRequest, opts ...grpc.CallOption) (*pb_aap.DeleteDraftResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteDraft(ctx, in, opts...)
+}
+
+func (a *randomClient) PublishDraft(ctx context.Context, in *pb_aap.PublishDraftRequest, opts ...grpc.CallOption) (*pb_aap.PublishDraftResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].PublishDraft(ctx, in, opts...)
+}
+
+// Group: Polls
+
+func (a *randomClient) VotePoll(ctx context.Context, in *pb_aap.VotePollRequest, opts ...grpc.CallOption) (*pb_aap.VotePollResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VotePoll(ctx, in, opts...)
+}
+
+// Group: Pins
+
+func (a *randomClient) PinPost(ctx context.Context, in *pb_aap.PinPostRequest, opts ...grpc.CallOption) (*pb_aap.PinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].PinPost(ctx, in, opts...)
+}
+
+func (a *randomClient) UnpinPost(ctx context.Context, in *pb_aap.UnpinPostRequest, opts ...grpc.CallOption) (*pb_aap.UnpinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnpinPost(ctx, in, opts...)
+}
+
+// Group: Stories
+
+func (a *randomClient) CreateStory(ctx context.Context, in *pb_aap.CreateStoryRequest, opts ...grpc.CallOption) (*pb_aap.CreateStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateStory(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteStory(ctx context.Context, in *pb_aap.DeleteStoryRequest, opts ...grpc.CallOption) (*pb_aap.DeleteStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteStory(ctx, in, opts...)
+}
+
+func (a *randomClient) GetStoryTray(ctx context.Context, in *pb_aap.GetStoryTrayRequest, opts ...grpc.CallOption) (*pb_aap.GetStoryTrayResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetStoryTray(ctx, in, opts...)
+}
+
+func (a *randomClient) ViewStory(ctx context.Context, in *pb_aap.ViewStoryRequest, opts ...grpc.CallOption) (*pb_aap.ViewStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ViewStory(ctx, in, opts...)
+}
+
+func (a *randomClient) GetStoryViewers(ctx context.Context, in *pb_aap.GetStoryViewersRequest, opts ...grpc.CallOption) (*pb_aap.GetStoryViewersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetStoryViewers(ctx, in, opts...)
+}
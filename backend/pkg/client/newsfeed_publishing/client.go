@@ -13,6 +13,13 @@ import (
 // Client defines the interface for the Newsfeed Publishing client
 type Client interface {
 	PublishPost(ctx context.Context, in *pb_nfp.PublishPostRequest) (*pb_nfp.PublishPostResponse, error)
+	PublishMention(ctx context.Context, in *pb_nfp.PublishMentionRequest) (*pb_nfp.PublishMentionResponse, error)
+	PublishTrip(ctx context.Context, in *pb_nfp.PublishTripRequest) (*pb_nfp.PublishTripResponse, error)
+	PublishTripInvitation(ctx context.Context, in *pb_nfp.PublishTripInvitationRequest) (*pb_nfp.PublishTripInvitationResponse, error)
+	PublishReview(ctx context.Context, in *pb_nfp.PublishReviewRequest) (*pb_nfp.PublishReviewResponse, error)
+	PublishRepost(ctx context.Context, in *pb_nfp.PublishRepostRequest) (*pb_nfp.PublishRepostResponse, error)
+	UnpublishRepost(ctx context.Context, in *pb_nfp.UnpublishRepostRequest) (*pb_nfp.UnpublishRepostResponse, error)
+	PublishStory(ctx context.Context, in *pb_nfp.PublishStoryRequest) (*pb_nfp.PublishStoryResponse, error)
 }
 
 // NewClient creates a new client for the Newsfeed Publishing service
@@ -45,3 +52,38 @@ type randomClient struct {
 func (rc *randomClient) PublishPost(ctx context.Context, in *pb_nfp.PublishPostRequest) (*pb_nfp.PublishPostResponse, error) {
 	return rc.clients[rand.Intn(len(rc.clients))].PublishPost(ctx, in)
 }
+
+// PublishMention forwards to a random client
+func (rc *randomClient) PublishMention(ctx context.Context, in *pb_nfp.PublishMentionRequest) (*pb_nfp.PublishMentionResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishMention(ctx, in)
+}
+
+// PublishTrip forwards to a random client
+func (rc *randomClient) PublishTrip(ctx context.Context, in *pb_nfp.PublishTripRequest) (*pb_nfp.PublishTripResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishTrip(ctx, in)
+}
+
+// PublishTripInvitation forwards to a random client
+func (rc *randomClient) PublishTripInvitation(ctx context.Context, in *pb_nfp.PublishTripInvitationRequest) (*pb_nfp.PublishTripInvitationResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishTripInvitation(ctx, in)
+}
+
+// PublishReview forwards to a random client
+func (rc *randomClient) PublishReview(ctx context.Context, in *pb_nfp.PublishReviewRequest) (*pb_nfp.PublishReviewResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishReview(ctx, in)
+}
+
+// PublishRepost forwards to a random client
+func (rc *randomClient) PublishRepost(ctx context.Context, in *pb_nfp.PublishRepostRequest) (*pb_nfp.PublishRepostResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishRepost(ctx, in)
+}
+
+// UnpublishRepost forwards to a random client
+func (rc *randomClient) UnpublishRepost(ctx context.Context, in *pb_nfp.UnpublishRepostRequest) (*pb_nfp.UnpublishRepostResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].UnpublishRepost(ctx, in)
+}
+
+// PublishStory forwards to a random client
+func (rc *randomClient) PublishStory(ctx context.Context, in *pb_nfp.PublishStoryRequest) (*pb_nfp.PublishStoryResponse, error) {
+	return rc.clients[rand.Intn(len(rc.clients))].PublishStory(ctx, in)
+}
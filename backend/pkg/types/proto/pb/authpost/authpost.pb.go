@@ -21,6 +21,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PostVisibility controls who is allowed to read a post
+type PostVisibility int32
+
+const (
+	PostVisibility_PUBLIC    PostVisibility = 0
+	PostVisibility_FOLLOWERS PostVisibility = 1
+	PostVisibility_PRIVATE   PostVisibility = 2
+)
+
+// Enum value maps for PostVisibility.
+var (
+	PostVisibility_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS",
+		2: "PRIVATE",
+	}
+	PostVisibility_value = map[string]int32{
+		"PUBLIC":    0,
+		"FOLLOWERS": 1,
+		"PRIVATE":   2,
+	}
+)
+
+func (x PostVisibility) Enum() *PostVisibility {
+	p := new(PostVisibility)
+	*p = x
+	return p
+}
+
+func (x PostVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[0].Descriptor()
+}
+
+func (PostVisibility) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[0]
+}
+
+func (x PostVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostVisibility.Descriptor instead.
+func (PostVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{0}
+}
+
+// TripRole is the role of a user in a trip. Editors can edit the trip and remove posts from it,
+// viewers can read the trip and add their own posts to it.
+type TripRole int32
+
+const (
+	TripRole_VIEWER TripRole = 0
+	TripRole_EDITOR TripRole = 1
+	TripRole_OWNER  TripRole = 2
+)
+
+// Enum value maps for TripRole.
+var (
+	TripRole_name = map[int32]string{
+		0: "VIEWER",
+		1: "EDITOR",
+		2: "OWNER",
+	}
+	TripRole_value = map[string]int32{
+		"VIEWER": 0,
+		"EDITOR": 1,
+		"OWNER":  2,
+	}
+)
+
+func (x TripRole) Enum() *TripRole {
+	p := new(TripRole)
+	*p = x
+	return p
+}
+
+func (x TripRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TripRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[1].Descriptor()
+}
+
+func (TripRole) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[1]
+}
+
+func (x TripRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TripRole.Descriptor instead.
+func (TripRole) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{1}
+}
+
 type CheckUserAuthenticationResponse_CheckUserAuthenticationStatus int32
 
 const (
@@ -54,11 +155,11 @@ func (x CheckUserAuthenticationResponse_CheckUserAuthenticationStatus) String()
 }
 
 func (CheckUserAuthenticationResponse_CheckUserAuthenticationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[0].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[2].Descriptor()
 }
 
 func (CheckUserAuthenticationResponse_CheckUserAuthenticationStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[0]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[2]
 }
 
 func (x CheckUserAuthenticationResponse_CheckUserAuthenticationStatus) Number() protoreflect.EnumNumber {
@@ -103,11 +204,11 @@ func (x CreateUserResponse_CreateUserStatus) String() string {
 }
 
 func (CreateUserResponse_CreateUserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[1].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[3].Descriptor()
 }
 
 func (CreateUserResponse_CreateUserStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[1]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[3]
 }
 
 func (x CreateUserResponse_CreateUserStatus) Number() protoreflect.EnumNumber {
@@ -149,11 +250,11 @@ func (x EditUserResponse_EditUserStatus) String() string {
 }
 
 func (EditUserResponse_EditUserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[2].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[4].Descriptor()
 }
 
 func (EditUserResponse_EditUserStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[2]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[4]
 }
 
 func (x EditUserResponse_EditUserStatus) Number() protoreflect.EnumNumber {
@@ -195,11 +296,11 @@ func (x GetUserDetailInfoResponse_GetUserDetailInfoStatus) String() string {
 }
 
 func (GetUserDetailInfoResponse_GetUserDetailInfoStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[3].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[5].Descriptor()
 }
 
 func (GetUserDetailInfoResponse_GetUserDetailInfoStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[3]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[5]
 }
 
 func (x GetUserDetailInfoResponse_GetUserDetailInfoStatus) Number() protoreflect.EnumNumber {
@@ -211,6 +312,52 @@ func (GetUserDetailInfoResponse_GetUserDetailInfoStatus) EnumDescriptor() ([]byt
 	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{7, 0}
 }
 
+type AutocompleteUsersResponse_AutocompleteUsersStatus int32
+
+const (
+	AutocompleteUsersResponse_OK             AutocompleteUsersResponse_AutocompleteUsersStatus = 0
+	AutocompleteUsersResponse_INVALID_PREFIX AutocompleteUsersResponse_AutocompleteUsersStatus = 1
+)
+
+// Enum value maps for AutocompleteUsersResponse_AutocompleteUsersStatus.
+var (
+	AutocompleteUsersResponse_AutocompleteUsersStatus_name = map[int32]string{
+		0: "OK",
+		1: "INVALID_PREFIX",
+	}
+	AutocompleteUsersResponse_AutocompleteUsersStatus_value = map[string]int32{
+		"OK":             0,
+		"INVALID_PREFIX": 1,
+	}
+)
+
+func (x AutocompleteUsersResponse_AutocompleteUsersStatus) Enum() *AutocompleteUsersResponse_AutocompleteUsersStatus {
+	p := new(AutocompleteUsersResponse_AutocompleteUsersStatus)
+	*p = x
+	return p
+}
+
+func (x AutocompleteUsersResponse_AutocompleteUsersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AutocompleteUsersResponse_AutocompleteUsersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[6].Descriptor()
+}
+
+func (AutocompleteUsersResponse_AutocompleteUsersStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[6]
+}
+
+func (x AutocompleteUsersResponse_AutocompleteUsersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AutocompleteUsersResponse_AutocompleteUsersStatus.Descriptor instead.
+func (AutocompleteUsersResponse_AutocompleteUsersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type GetUserFollowerResponse_GetUserFollowerStatus int32
 
 const (
@@ -241,11 +388,11 @@ func (x GetUserFollowerResponse_GetUserFollowerStatus) String() string {
 }
 
 func (GetUserFollowerResponse_GetUserFollowerStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[4].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[7].Descriptor()
 }
 
 func (GetUserFollowerResponse_GetUserFollowerStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[4]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[7]
 }
 
 func (x GetUserFollowerResponse_GetUserFollowerStatus) Number() protoreflect.EnumNumber {
@@ -254,7 +401,7 @@ func (x GetUserFollowerResponse_GetUserFollowerStatus) Number() protoreflect.Enu
 
 // Deprecated: Use GetUserFollowerResponse_GetUserFollowerStatus.Descriptor instead.
 func (GetUserFollowerResponse_GetUserFollowerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{10, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type GetUserFollowingResponse_GetUserFollowingStatus int32
@@ -287,11 +434,11 @@ func (x GetUserFollowingResponse_GetUserFollowingStatus) String() string {
 }
 
 func (GetUserFollowingResponse_GetUserFollowingStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[5].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[8].Descriptor()
 }
 
 func (GetUserFollowingResponse_GetUserFollowingStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[5]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[8]
 }
 
 func (x GetUserFollowingResponse_GetUserFollowingStatus) Number() protoreflect.EnumNumber {
@@ -300,7 +447,7 @@ func (x GetUserFollowingResponse_GetUserFollowingStatus) Number() protoreflect.E
 
 // Deprecated: Use GetUserFollowingResponse_GetUserFollowingStatus.Descriptor instead.
 func (GetUserFollowingResponse_GetUserFollowingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{12, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type FollowUserResponse_FollowUserStatus int32
@@ -336,11 +483,11 @@ func (x FollowUserResponse_FollowUserStatus) String() string {
 }
 
 func (FollowUserResponse_FollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[6].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[9].Descriptor()
 }
 
 func (FollowUserResponse_FollowUserStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[6]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[9]
 }
 
 func (x FollowUserResponse_FollowUserStatus) Number() protoreflect.EnumNumber {
@@ -349,7 +496,7 @@ func (x FollowUserResponse_FollowUserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FollowUserResponse_FollowUserStatus.Descriptor instead.
 func (FollowUserResponse_FollowUserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{14, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type UnfollowUserResponse_UnfollowUserStatus int32
@@ -385,11 +532,11 @@ func (x UnfollowUserResponse_UnfollowUserStatus) String() string {
 }
 
 func (UnfollowUserResponse_UnfollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[7].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[10].Descriptor()
 }
 
 func (UnfollowUserResponse_UnfollowUserStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[7]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[10]
 }
 
 func (x UnfollowUserResponse_UnfollowUserStatus) Number() protoreflect.EnumNumber {
@@ -398,7 +545,7 @@ func (x UnfollowUserResponse_UnfollowUserStatus) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use UnfollowUserResponse_UnfollowUserStatus.Descriptor instead.
 func (UnfollowUserResponse_UnfollowUserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{16, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type GetUserPostsResponse_GetUserPostsStatus int32
@@ -431,11 +578,11 @@ func (x GetUserPostsResponse_GetUserPostsStatus) String() string {
 }
 
 func (GetUserPostsResponse_GetUserPostsStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[8].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[11].Descriptor()
 }
 
 func (GetUserPostsResponse_GetUserPostsStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[8]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[11]
 }
 
 func (x GetUserPostsResponse_GetUserPostsStatus) Number() protoreflect.EnumNumber {
@@ -444,14 +591,28 @@ func (x GetUserPostsResponse_GetUserPostsStatus) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use GetUserPostsResponse_GetUserPostsStatus.Descriptor instead.
 func (GetUserPostsResponse_GetUserPostsStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{18, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type CreatePostResponse_CreatePostStatus int32
 
 const (
-	CreatePostResponse_OK             CreatePostResponse_CreatePostStatus = 0
-	CreatePostResponse_USER_NOT_FOUND CreatePostResponse_CreatePostStatus = 1
+	CreatePostResponse_OK               CreatePostResponse_CreatePostStatus = 0
+	CreatePostResponse_USER_NOT_FOUND   CreatePostResponse_CreatePostStatus = 1
+	CreatePostResponse_INVALID_LOCATION CreatePostResponse_CreatePostStatus = 2
+	CreatePostResponse_PLACE_NOT_FOUND  CreatePostResponse_CreatePostStatus = 3
+	// TRIP_NOT_FOUND is also returned for trips the user cannot add posts to
+	CreatePostResponse_TRIP_NOT_FOUND CreatePostResponse_CreatePostStatus = 4
+	// QUOTED_POST_NOT_FOUND is also returned for posts the user is not allowed to share
+	CreatePostResponse_QUOTED_POST_NOT_FOUND CreatePostResponse_CreatePostStatus = 5
+	// INVALID_PUBLISH_AT is returned for publish_at in the past or too far ahead
+	CreatePostResponse_INVALID_PUBLISH_AT CreatePostResponse_CreatePostStatus = 6
+	// INVALID_POLL is returned for polls with too few or too many options,
+	// duplicated or blank options, or an expiry out of range
+	CreatePostResponse_INVALID_POLL CreatePostResponse_CreatePostStatus = 7
+	// INVALID_MEDIA is returned for too many media, or media with an invalid url,
+	// mime type, size, alt text or blurhash
+	CreatePostResponse_INVALID_MEDIA CreatePostResponse_CreatePostStatus = 8
 )
 
 // Enum value maps for CreatePostResponse_CreatePostStatus.
@@ -459,10 +620,24 @@ var (
 	CreatePostResponse_CreatePostStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "INVALID_LOCATION",
+		3: "PLACE_NOT_FOUND",
+		4: "TRIP_NOT_FOUND",
+		5: "QUOTED_POST_NOT_FOUND",
+		6: "INVALID_PUBLISH_AT",
+		7: "INVALID_POLL",
+		8: "INVALID_MEDIA",
 	}
 	CreatePostResponse_CreatePostStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+		"OK":                    0,
+		"USER_NOT_FOUND":        1,
+		"INVALID_LOCATION":      2,
+		"PLACE_NOT_FOUND":       3,
+		"TRIP_NOT_FOUND":        4,
+		"QUOTED_POST_NOT_FOUND": 5,
+		"INVALID_PUBLISH_AT":    6,
+		"INVALID_POLL":          7,
+		"INVALID_MEDIA":         8,
 	}
 )
 
@@ -477,11 +652,11 @@ func (x CreatePostResponse_CreatePostStatus) String() string {
 }
 
 func (CreatePostResponse_CreatePostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[9].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[12].Descriptor()
 }
 
 func (CreatePostResponse_CreatePostStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[9]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[12]
 }
 
 func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
@@ -490,7 +665,7 @@ func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreatePostResponse_CreatePostStatus.Descriptor instead.
 func (CreatePostResponse_CreatePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{20, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type GetPostDetailInfoResponse_GetPostDetailInfoStatus int32
@@ -523,11 +698,11 @@ func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) String() string {
 }
 
 func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[10].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[13].Descriptor()
 }
 
 func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[10]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[13]
 }
 
 func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Number() protoreflect.EnumNumber {
@@ -536,16 +711,20 @@ func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Number() protoreflect
 
 // Deprecated: Use GetPostDetailInfoResponse_GetPostDetailInfoStatus.Descriptor instead.
 func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{22, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type EditPostResponse_EditPostStatus int32
 
 const (
-	EditPostResponse_OK             EditPostResponse_EditPostStatus = 0
-	EditPostResponse_POST_NOT_FOUND EditPostResponse_EditPostStatus = 1
-	EditPostResponse_NOT_ALLOWED    EditPostResponse_EditPostStatus = 2
-	EditPostResponse_USER_NOT_FOUND EditPostResponse_EditPostStatus = 3
+	EditPostResponse_OK               EditPostResponse_EditPostStatus = 0
+	EditPostResponse_POST_NOT_FOUND   EditPostResponse_EditPostStatus = 1
+	EditPostResponse_NOT_ALLOWED      EditPostResponse_EditPostStatus = 2
+	EditPostResponse_USER_NOT_FOUND   EditPostResponse_EditPostStatus = 3
+	EditPostResponse_INVALID_LOCATION EditPostResponse_EditPostStatus = 4
+	EditPostResponse_PLACE_NOT_FOUND  EditPostResponse_EditPostStatus = 5
+	EditPostResponse_TRIP_NOT_FOUND   EditPostResponse_EditPostStatus = 6
+	EditPostResponse_INVALID_MEDIA    EditPostResponse_EditPostStatus = 7
 )
 
 // Enum value maps for EditPostResponse_EditPostStatus.
@@ -555,12 +734,20 @@ var (
 		1: "POST_NOT_FOUND",
 		2: "NOT_ALLOWED",
 		3: "USER_NOT_FOUND",
+		4: "INVALID_LOCATION",
+		5: "PLACE_NOT_FOUND",
+		6: "TRIP_NOT_FOUND",
+		7: "INVALID_MEDIA",
 	}
 	EditPostResponse_EditPostStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"NOT_ALLOWED":    2,
-		"USER_NOT_FOUND": 3,
+		"OK":               0,
+		"POST_NOT_FOUND":   1,
+		"NOT_ALLOWED":      2,
+		"USER_NOT_FOUND":   3,
+		"INVALID_LOCATION": 4,
+		"PLACE_NOT_FOUND":  5,
+		"TRIP_NOT_FOUND":   6,
+		"INVALID_MEDIA":    7,
 	}
 )
 
@@ -575,11 +762,11 @@ func (x EditPostResponse_EditPostStatus) String() string {
 }
 
 func (EditPostResponse_EditPostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[11].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[14].Descriptor()
 }
 
 func (EditPostResponse_EditPostStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[11]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[14]
 }
 
 func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
@@ -588,7 +775,7 @@ func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EditPostResponse_EditPostStatus.Descriptor instead.
 func (EditPostResponse_EditPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{24, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type DeletePostResponse_DeletePostStatus int32
@@ -627,11 +814,11 @@ func (x DeletePostResponse_DeletePostStatus) String() string {
 }
 
 func (DeletePostResponse_DeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[12].Descriptor()
+	return file_pkg_types_proto_authpost_proto_enumTypes[15].Descriptor()
 }
 
 func (DeletePostResponse_DeletePostStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[12]
+	return &file_pkg_types_proto_authpost_proto_enumTypes[15]
 }
 
 func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
@@ -640,2689 +827,20317 @@ func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeletePostResponse_DeletePostStatus.Descriptor instead.
 func (DeletePostResponse_DeletePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{26, 0}
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{29, 0}
 }
 
-type CommentPostResponse_CommentPostStatus int32
+type GetTrashedPostsResponse_GetTrashedPostsStatus int32
 
 const (
-	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
-	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
-	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+	GetTrashedPostsResponse_OK             GetTrashedPostsResponse_GetTrashedPostsStatus = 0
+	GetTrashedPostsResponse_USER_NOT_FOUND GetTrashedPostsResponse_GetTrashedPostsStatus = 1
 )
 
-// Enum value maps for CommentPostResponse_CommentPostStatus.
+// Enum value maps for GetTrashedPostsResponse_GetTrashedPostsStatus.
 var (
-	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+	GetTrashedPostsResponse_GetTrashedPostsStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-		2: "USER_NOT_FOUND",
+		1: "USER_NOT_FOUND",
 	}
-	CommentPostResponse_CommentPostStatus_value = map[string]int32{
+	GetTrashedPostsResponse_GetTrashedPostsStatus_value = map[string]int32{
 		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"USER_NOT_FOUND": 2,
+		"USER_NOT_FOUND": 1,
 	}
 )
 
-func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
-	p := new(CommentPostResponse_CommentPostStatus)
+func (x GetTrashedPostsResponse_GetTrashedPostsStatus) Enum() *GetTrashedPostsResponse_GetTrashedPostsStatus {
+	p := new(GetTrashedPostsResponse_GetTrashedPostsStatus)
 	*p = x
 	return p
 }
 
-func (x CommentPostResponse_CommentPostStatus) String() string {
+func (x GetTrashedPostsResponse_GetTrashedPostsStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[13].Descriptor()
+func (GetTrashedPostsResponse_GetTrashedPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[16].Descriptor()
 }
 
-func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[13]
+func (GetTrashedPostsResponse_GetTrashedPostsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[16]
 }
 
-func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+func (x GetTrashedPostsResponse_GetTrashedPostsStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
-func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{28, 0}
+// Deprecated: Use GetTrashedPostsResponse_GetTrashedPostsStatus.Descriptor instead.
+func (GetTrashedPostsResponse_GetTrashedPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{31, 0}
 }
 
-type LikePostResponse_LikePostStatus int32
+type RestorePostResponse_RestorePostStatus int32
 
 const (
-	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
-	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 1
-	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 2
+	RestorePostResponse_OK             RestorePostResponse_RestorePostStatus = 0
+	RestorePostResponse_POST_NOT_FOUND RestorePostResponse_RestorePostStatus = 1
+	RestorePostResponse_NOT_ALLOWED    RestorePostResponse_RestorePostStatus = 2
+	RestorePostResponse_USER_NOT_FOUND RestorePostResponse_RestorePostStatus = 3
 )
 
-// Enum value maps for LikePostResponse_LikePostStatus.
+// Enum value maps for RestorePostResponse_RestorePostStatus.
 var (
-	LikePostResponse_LikePostStatus_name = map[int32]string{
+	RestorePostResponse_RestorePostStatus_name = map[int32]string{
 		0: "OK",
 		1: "POST_NOT_FOUND",
-		2: "USER_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "USER_NOT_FOUND",
 	}
-	LikePostResponse_LikePostStatus_value = map[string]int32{
+	RestorePostResponse_RestorePostStatus_value = map[string]int32{
 		"OK":             0,
 		"POST_NOT_FOUND": 1,
-		"USER_NOT_FOUND": 2,
+		"NOT_ALLOWED":    2,
+		"USER_NOT_FOUND": 3,
 	}
 )
 
-func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
-	p := new(LikePostResponse_LikePostStatus)
+func (x RestorePostResponse_RestorePostStatus) Enum() *RestorePostResponse_RestorePostStatus {
+	p := new(RestorePostResponse_RestorePostStatus)
 	*p = x
 	return p
 }
 
-func (x LikePostResponse_LikePostStatus) String() string {
+func (x RestorePostResponse_RestorePostStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_authpost_proto_enumTypes[14].Descriptor()
+func (RestorePostResponse_RestorePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[17].Descriptor()
 }
 
-func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_authpost_proto_enumTypes[14]
+func (RestorePostResponse_RestorePostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[17]
 }
 
-func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+func (x RestorePostResponse_RestorePostStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
-func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use RestorePostResponse_RestorePostStatus.Descriptor instead.
+func (RestorePostResponse_RestorePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{34, 0}
 }
 
-type CheckUserAuthenticationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetPostRevisionsResponse_GetPostRevisionsStatus int32
 
-	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-}
+const (
+	GetPostRevisionsResponse_OK             GetPostRevisionsResponse_GetPostRevisionsStatus = 0
+	GetPostRevisionsResponse_POST_NOT_FOUND GetPostRevisionsResponse_GetPostRevisionsStatus = 1
+	GetPostRevisionsResponse_NOT_ALLOWED    GetPostRevisionsResponse_GetPostRevisionsStatus = 2
+)
 
-func (x *CheckUserAuthenticationRequest) Reset() {
-	*x = CheckUserAuthenticationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetPostRevisionsResponse_GetPostRevisionsStatus.
+var (
+	GetPostRevisionsResponse_GetPostRevisionsStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "NOT_ALLOWED",
 	}
-}
+	GetPostRevisionsResponse_GetPostRevisionsStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"NOT_ALLOWED":    2,
+	}
+)
 
-func (x *CheckUserAuthenticationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetPostRevisionsResponse_GetPostRevisionsStatus) Enum() *GetPostRevisionsResponse_GetPostRevisionsStatus {
+	p := new(GetPostRevisionsResponse_GetPostRevisionsStatus)
+	*p = x
+	return p
 }
 
-func (*CheckUserAuthenticationRequest) ProtoMessage() {}
+func (x GetPostRevisionsResponse_GetPostRevisionsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CheckUserAuthenticationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetPostRevisionsResponse_GetPostRevisionsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[18].Descriptor()
 }
 
-// Deprecated: Use CheckUserAuthenticationRequest.ProtoReflect.Descriptor instead.
-func (*CheckUserAuthenticationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{0}
+func (GetPostRevisionsResponse_GetPostRevisionsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[18]
 }
 
-func (x *CheckUserAuthenticationRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (x GetPostRevisionsResponse_GetPostRevisionsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CheckUserAuthenticationRequest) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+// Deprecated: Use GetPostRevisionsResponse_GetPostRevisionsStatus.Descriptor instead.
+func (GetPostRevisionsResponse_GetPostRevisionsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{36, 0}
 }
 
-type CheckUserAuthenticationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RevertPostResponse_RevertPostStatus int32
 
-	Status CheckUserAuthenticationResponse_CheckUserAuthenticationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CheckUserAuthenticationResponse_CheckUserAuthenticationStatus" json:"status,omitempty"`
-	UserId int64                                                         `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	RevertPostResponse_OK                 RevertPostResponse_RevertPostStatus = 0
+	RevertPostResponse_POST_NOT_FOUND     RevertPostResponse_RevertPostStatus = 1
+	RevertPostResponse_NOT_ALLOWED        RevertPostResponse_RevertPostStatus = 2
+	RevertPostResponse_USER_NOT_FOUND     RevertPostResponse_RevertPostStatus = 3
+	RevertPostResponse_REVISION_NOT_FOUND RevertPostResponse_RevertPostStatus = 4
+)
 
-func (x *CheckUserAuthenticationResponse) Reset() {
-	*x = CheckUserAuthenticationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RevertPostResponse_RevertPostStatus.
+var (
+	RevertPostResponse_RevertPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "USER_NOT_FOUND",
+		4: "REVISION_NOT_FOUND",
 	}
-}
+	RevertPostResponse_RevertPostStatus_value = map[string]int32{
+		"OK":                 0,
+		"POST_NOT_FOUND":     1,
+		"NOT_ALLOWED":        2,
+		"USER_NOT_FOUND":     3,
+		"REVISION_NOT_FOUND": 4,
+	}
+)
 
-func (x *CheckUserAuthenticationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RevertPostResponse_RevertPostStatus) Enum() *RevertPostResponse_RevertPostStatus {
+	p := new(RevertPostResponse_RevertPostStatus)
+	*p = x
+	return p
 }
 
-func (*CheckUserAuthenticationResponse) ProtoMessage() {}
+func (x RevertPostResponse_RevertPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CheckUserAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RevertPostResponse_RevertPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[19].Descriptor()
 }
 
-// Deprecated: Use CheckUserAuthenticationResponse.ProtoReflect.Descriptor instead.
-func (*CheckUserAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{1}
+func (RevertPostResponse_RevertPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[19]
 }
 
-func (x *CheckUserAuthenticationResponse) GetStatus() CheckUserAuthenticationResponse_CheckUserAuthenticationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CheckUserAuthenticationResponse_OK
+func (x RevertPostResponse_RevertPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CheckUserAuthenticationResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use RevertPostResponse_RevertPostStatus.Descriptor instead.
+func (RevertPostResponse_RevertPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{39, 0}
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetNearbyPostsResponse_GetNearbyPostsStatus int32
 
-	UserName     string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string                 `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-	FirstName    string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName     string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
-	Email        string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-}
+const (
+	GetNearbyPostsResponse_OK               GetNearbyPostsResponse_GetNearbyPostsStatus = 0
+	GetNearbyPostsResponse_INVALID_LOCATION GetNearbyPostsResponse_GetNearbyPostsStatus = 1
+)
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetNearbyPostsResponse_GetNearbyPostsStatus.
+var (
+	GetNearbyPostsResponse_GetNearbyPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "INVALID_LOCATION",
 	}
-}
+	GetNearbyPostsResponse_GetNearbyPostsStatus_value = map[string]int32{
+		"OK":               0,
+		"INVALID_LOCATION": 1,
+	}
+)
 
-func (x *CreateUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetNearbyPostsResponse_GetNearbyPostsStatus) Enum() *GetNearbyPostsResponse_GetNearbyPostsStatus {
+	p := new(GetNearbyPostsResponse_GetNearbyPostsStatus)
+	*p = x
+	return p
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
-
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x GetNearbyPostsResponse_GetNearbyPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{2}
+func (GetNearbyPostsResponse_GetNearbyPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[20].Descriptor()
 }
 
-func (x *CreateUserRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (GetNearbyPostsResponse_GetNearbyPostsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[20]
 }
 
-func (x *CreateUserRequest) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+func (x GetNearbyPostsResponse_GetNearbyPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateUserRequest) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+// Deprecated: Use GetNearbyPostsResponse_GetNearbyPostsStatus.Descriptor instead.
+func (GetNearbyPostsResponse_GetNearbyPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *CreateUserRequest) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
-}
+type RepostPostResponse_RepostPostStatus int32
 
-func (x *CreateUserRequest) GetDateOfBirth() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
+const (
+	RepostPostResponse_OK             RepostPostResponse_RepostPostStatus = 0
+	RepostPostResponse_USER_NOT_FOUND RepostPostResponse_RepostPostStatus = 1
+	RepostPostResponse_POST_NOT_FOUND RepostPostResponse_RepostPostStatus = 2
+	// NOT_ALLOWED is returned for posts that are not public
+	RepostPostResponse_NOT_ALLOWED RepostPostResponse_RepostPostStatus = 3
+	// ALREADY_REPOSTED comes with the id of the existing repost
+	RepostPostResponse_ALREADY_REPOSTED RepostPostResponse_RepostPostStatus = 4
+)
+
+// Enum value maps for RepostPostResponse_RepostPostStatus.
+var (
+	RepostPostResponse_RepostPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "ALREADY_REPOSTED",
 	}
-	return nil
+	RepostPostResponse_RepostPostStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"POST_NOT_FOUND":   2,
+		"NOT_ALLOWED":      3,
+		"ALREADY_REPOSTED": 4,
+	}
+)
+
+func (x RepostPostResponse_RepostPostStatus) Enum() *RepostPostResponse_RepostPostStatus {
+	p := new(RepostPostResponse_RepostPostStatus)
+	*p = x
+	return p
 }
 
-func (x *CreateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x RepostPostResponse_RepostPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreateUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (RepostPostResponse_RepostPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[21].Descriptor()
+}
 
-	Status CreateUserResponse_CreateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateUserResponse_CreateUserStatus" json:"status,omitempty"`
-	UserId int64                               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (RepostPostResponse_RepostPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[21]
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RepostPostResponse_RepostPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RepostPostResponse_RepostPostStatus.Descriptor instead.
+func (RepostPostResponse_RepostPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{44, 0}
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+type UndoRepostResponse_UndoRepostStatus int32
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	UndoRepostResponse_OK             UndoRepostResponse_UndoRepostStatus = 0
+	UndoRepostResponse_USER_NOT_FOUND UndoRepostResponse_UndoRepostStatus = 1
+	UndoRepostResponse_NOT_REPOSTED   UndoRepostResponse_UndoRepostStatus = 2
+)
+
+// Enum value maps for UndoRepostResponse_UndoRepostStatus.
+var (
+	UndoRepostResponse_UndoRepostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_REPOSTED",
 	}
-	return mi.MessageOf(x)
+	UndoRepostResponse_UndoRepostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_REPOSTED":   2,
+	}
+)
+
+func (x UndoRepostResponse_UndoRepostStatus) Enum() *UndoRepostResponse_UndoRepostStatus {
+	p := new(UndoRepostResponse_UndoRepostStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{3}
+func (x UndoRepostResponse_UndoRepostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateUserResponse) GetStatus() CreateUserResponse_CreateUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CreateUserResponse_OK
+func (UndoRepostResponse_UndoRepostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[22].Descriptor()
 }
 
-func (x *CreateUserResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (UndoRepostResponse_UndoRepostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[22]
 }
 
-type EditUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x UndoRepostResponse_UndoRepostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserPassword   *string                `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
-	FirstName      *string                `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName       *string                `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	DateOfBirth    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"`
-	ProfilePicture *string                `protobuf:"bytes,6,opt,name=profile_picture,json=profilePicture,proto3,oneof" json:"profile_picture,omitempty"`
-	CoverPicture   *string                `protobuf:"bytes,7,opt,name=cover_picture,json=coverPicture,proto3,oneof" json:"cover_picture,omitempty"`
+// Deprecated: Use UndoRepostResponse_UndoRepostStatus.Descriptor instead.
+func (UndoRepostResponse_UndoRepostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *EditUserRequest) Reset() {
-	*x = EditUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type GetScheduledPostsResponse_GetScheduledPostsStatus int32
+
+const (
+	GetScheduledPostsResponse_OK             GetScheduledPostsResponse_GetScheduledPostsStatus = 0
+	GetScheduledPostsResponse_USER_NOT_FOUND GetScheduledPostsResponse_GetScheduledPostsStatus = 1
+)
+
+// Enum value maps for GetScheduledPostsResponse_GetScheduledPostsStatus.
+var (
+	GetScheduledPostsResponse_GetScheduledPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
+	GetScheduledPostsResponse_GetScheduledPostsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetScheduledPostsResponse_GetScheduledPostsStatus) Enum() *GetScheduledPostsResponse_GetScheduledPostsStatus {
+	p := new(GetScheduledPostsResponse_GetScheduledPostsStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetScheduledPostsResponse_GetScheduledPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*EditUserRequest) ProtoMessage() {}
+func (GetScheduledPostsResponse_GetScheduledPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[23].Descriptor()
+}
 
-func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetScheduledPostsResponse_GetScheduledPostsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[23]
 }
 
-// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
-func (*EditUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{4}
+func (x GetScheduledPostsResponse_GetScheduledPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetScheduledPostsResponse_GetScheduledPostsStatus.Descriptor instead.
+func (GetScheduledPostsResponse_GetScheduledPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{48, 0}
 }
 
-func (x *EditUserRequest) GetUserPassword() string {
-	if x != nil && x.UserPassword != nil {
-		return *x.UserPassword
+type ReschedulePostResponse_ReschedulePostStatus int32
+
+const (
+	ReschedulePostResponse_OK             ReschedulePostResponse_ReschedulePostStatus = 0
+	ReschedulePostResponse_USER_NOT_FOUND ReschedulePostResponse_ReschedulePostStatus = 1
+	ReschedulePostResponse_POST_NOT_FOUND ReschedulePostResponse_ReschedulePostStatus = 2
+	ReschedulePostResponse_NOT_ALLOWED    ReschedulePostResponse_ReschedulePostStatus = 3
+	// NOT_SCHEDULED is returned for posts that are already published
+	ReschedulePostResponse_NOT_SCHEDULED      ReschedulePostResponse_ReschedulePostStatus = 4
+	ReschedulePostResponse_INVALID_PUBLISH_AT ReschedulePostResponse_ReschedulePostStatus = 5
+)
+
+// Enum value maps for ReschedulePostResponse_ReschedulePostStatus.
+var (
+	ReschedulePostResponse_ReschedulePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "NOT_SCHEDULED",
+		5: "INVALID_PUBLISH_AT",
 	}
-	return ""
+	ReschedulePostResponse_ReschedulePostStatus_value = map[string]int32{
+		"OK":                 0,
+		"USER_NOT_FOUND":     1,
+		"POST_NOT_FOUND":     2,
+		"NOT_ALLOWED":        3,
+		"NOT_SCHEDULED":      4,
+		"INVALID_PUBLISH_AT": 5,
+	}
+)
+
+func (x ReschedulePostResponse_ReschedulePostStatus) Enum() *ReschedulePostResponse_ReschedulePostStatus {
+	p := new(ReschedulePostResponse_ReschedulePostStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserRequest) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
+func (x ReschedulePostResponse_ReschedulePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *EditUserRequest) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
-	}
-	return ""
+func (ReschedulePostResponse_ReschedulePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[24].Descriptor()
 }
 
-func (x *EditUserRequest) GetDateOfBirth() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return nil
+func (ReschedulePostResponse_ReschedulePostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[24]
 }
 
-func (x *EditUserRequest) GetProfilePicture() string {
-	if x != nil && x.ProfilePicture != nil {
-		return *x.ProfilePicture
-	}
-	return ""
+func (x ReschedulePostResponse_ReschedulePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserRequest) GetCoverPicture() string {
-	if x != nil && x.CoverPicture != nil {
-		return *x.CoverPicture
-	}
-	return ""
+// Deprecated: Use ReschedulePostResponse_ReschedulePostStatus.Descriptor instead.
+func (ReschedulePostResponse_ReschedulePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{51, 0}
 }
 
-type EditUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CancelScheduledPostResponse_CancelScheduledPostStatus int32
 
-	Status EditUserResponse_EditUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditUserResponse_EditUserStatus" json:"status,omitempty"`
-}
+const (
+	CancelScheduledPostResponse_OK             CancelScheduledPostResponse_CancelScheduledPostStatus = 0
+	CancelScheduledPostResponse_USER_NOT_FOUND CancelScheduledPostResponse_CancelScheduledPostStatus = 1
+	CancelScheduledPostResponse_POST_NOT_FOUND CancelScheduledPostResponse_CancelScheduledPostStatus = 2
+	CancelScheduledPostResponse_NOT_ALLOWED    CancelScheduledPostResponse_CancelScheduledPostStatus = 3
+	CancelScheduledPostResponse_NOT_SCHEDULED  CancelScheduledPostResponse_CancelScheduledPostStatus = 4
+)
 
-func (x *EditUserResponse) Reset() {
-	*x = EditUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CancelScheduledPostResponse_CancelScheduledPostStatus.
+var (
+	CancelScheduledPostResponse_CancelScheduledPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "NOT_SCHEDULED",
+	}
+	CancelScheduledPostResponse_CancelScheduledPostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"POST_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+		"NOT_SCHEDULED":  4,
 	}
+)
+
+func (x CancelScheduledPostResponse_CancelScheduledPostStatus) Enum() *CancelScheduledPostResponse_CancelScheduledPostStatus {
+	p := new(CancelScheduledPostResponse_CancelScheduledPostStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CancelScheduledPostResponse_CancelScheduledPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*EditUserResponse) ProtoMessage() {}
+func (CancelScheduledPostResponse_CancelScheduledPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[25].Descriptor()
+}
 
-func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CancelScheduledPostResponse_CancelScheduledPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[25]
 }
 
-// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
-func (*EditUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{5}
+func (x CancelScheduledPostResponse_CancelScheduledPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserResponse) GetStatus() EditUserResponse_EditUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return EditUserResponse_OK
+// Deprecated: Use CancelScheduledPostResponse_CancelScheduledPostStatus.Descriptor instead.
+func (CancelScheduledPostResponse_CancelScheduledPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{53, 0}
 }
 
-type GetUserDetailInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetHashtagPostsResponse_GetHashtagPostsStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	GetHashtagPostsResponse_OK                GetHashtagPostsResponse_GetHashtagPostsStatus = 0
+	GetHashtagPostsResponse_HASHTAG_NOT_FOUND GetHashtagPostsResponse_GetHashtagPostsStatus = 1
+)
 
-func (x *GetUserDetailInfoRequest) Reset() {
-	*x = GetUserDetailInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetHashtagPostsResponse_GetHashtagPostsStatus.
+var (
+	GetHashtagPostsResponse_GetHashtagPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "HASHTAG_NOT_FOUND",
 	}
+	GetHashtagPostsResponse_GetHashtagPostsStatus_value = map[string]int32{
+		"OK":                0,
+		"HASHTAG_NOT_FOUND": 1,
+	}
+)
+
+func (x GetHashtagPostsResponse_GetHashtagPostsStatus) Enum() *GetHashtagPostsResponse_GetHashtagPostsStatus {
+	p := new(GetHashtagPostsResponse_GetHashtagPostsStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserDetailInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetHashtagPostsResponse_GetHashtagPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserDetailInfoRequest) ProtoMessage() {}
+func (GetHashtagPostsResponse_GetHashtagPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[26].Descriptor()
+}
 
-func (x *GetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetHashtagPostsResponse_GetHashtagPostsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[26]
 }
 
-// Deprecated: Use GetUserDetailInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetUserDetailInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{6}
+func (x GetHashtagPostsResponse_GetHashtagPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserDetailInfoRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetHashtagPostsResponse_GetHashtagPostsStatus.Descriptor instead.
+func (GetHashtagPostsResponse_GetHashtagPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{55, 0}
 }
 
-type GetUserDetailInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetHashtagInfoResponse_GetHashtagInfoStatus int32
 
-	Status GetUserDetailInfoResponse_GetUserDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserDetailInfoResponse_GetUserDetailInfoStatus" json:"status,omitempty"`
-	User   *UserDetailInfo                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-}
+const (
+	GetHashtagInfoResponse_OK                GetHashtagInfoResponse_GetHashtagInfoStatus = 0
+	GetHashtagInfoResponse_HASHTAG_NOT_FOUND GetHashtagInfoResponse_GetHashtagInfoStatus = 1
+)
 
-func (x *GetUserDetailInfoResponse) Reset() {
-	*x = GetUserDetailInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetHashtagInfoResponse_GetHashtagInfoStatus.
+var (
+	GetHashtagInfoResponse_GetHashtagInfoStatus_name = map[int32]string{
+		0: "OK",
+		1: "HASHTAG_NOT_FOUND",
 	}
+	GetHashtagInfoResponse_GetHashtagInfoStatus_value = map[string]int32{
+		"OK":                0,
+		"HASHTAG_NOT_FOUND": 1,
+	}
+)
+
+func (x GetHashtagInfoResponse_GetHashtagInfoStatus) Enum() *GetHashtagInfoResponse_GetHashtagInfoStatus {
+	p := new(GetHashtagInfoResponse_GetHashtagInfoStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserDetailInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetHashtagInfoResponse_GetHashtagInfoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserDetailInfoResponse) ProtoMessage() {}
+func (GetHashtagInfoResponse_GetHashtagInfoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[27].Descriptor()
+}
 
-func (x *GetUserDetailInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetHashtagInfoResponse_GetHashtagInfoStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[27]
 }
 
-// Deprecated: Use GetUserDetailInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetUserDetailInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{7}
+func (x GetHashtagInfoResponse_GetHashtagInfoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserDetailInfoResponse) GetStatus() GetUserDetailInfoResponse_GetUserDetailInfoStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserDetailInfoResponse_OK
+// Deprecated: Use GetHashtagInfoResponse_GetHashtagInfoStatus.Descriptor instead.
+func (GetHashtagInfoResponse_GetHashtagInfoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{57, 0}
 }
 
-func (x *GetUserDetailInfoResponse) GetUser() *UserDetailInfo {
-	if x != nil {
-		return x.User
+type SearchRequest_SearchType int32
+
+const (
+	SearchRequest_POSTS SearchRequest_SearchType = 0
+	SearchRequest_USERS SearchRequest_SearchType = 1
+)
+
+// Enum value maps for SearchRequest_SearchType.
+var (
+	SearchRequest_SearchType_name = map[int32]string{
+		0: "POSTS",
+		1: "USERS",
 	}
-	return nil
+	SearchRequest_SearchType_value = map[string]int32{
+		"POSTS": 0,
+		"USERS": 1,
+	}
+)
+
+func (x SearchRequest_SearchType) Enum() *SearchRequest_SearchType {
+	p := new(SearchRequest_SearchType)
+	*p = x
+	return p
 }
 
-type UserDetailInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x SearchRequest_SearchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName       string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	FirstName      string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName       string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
-	Email          string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	ProfilePicture string                 `protobuf:"bytes,7,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
-	CoverPicture   string                 `protobuf:"bytes,8,opt,name=cover_picture,json=coverPicture,proto3" json:"cover_picture,omitempty"`
+func (SearchRequest_SearchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[28].Descriptor()
 }
 
-func (x *UserDetailInfo) Reset() {
-	*x = UserDetailInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (SearchRequest_SearchType) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[28]
 }
 
-func (x *UserDetailInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SearchRequest_SearchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*UserDetailInfo) ProtoMessage() {}
+// Deprecated: Use SearchRequest_SearchType.Descriptor instead.
+func (SearchRequest_SearchType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{59, 0}
+}
 
-func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type SearchResponse_SearchStatus int32
+
+const (
+	SearchResponse_OK             SearchResponse_SearchStatus = 0
+	SearchResponse_INVALID_QUERY  SearchResponse_SearchStatus = 1
+	SearchResponse_INVALID_CURSOR SearchResponse_SearchStatus = 2
+)
+
+// Enum value maps for SearchResponse_SearchStatus.
+var (
+	SearchResponse_SearchStatus_name = map[int32]string{
+		0: "OK",
+		1: "INVALID_QUERY",
+		2: "INVALID_CURSOR",
 	}
-	return mi.MessageOf(x)
+	SearchResponse_SearchStatus_value = map[string]int32{
+		"OK":             0,
+		"INVALID_QUERY":  1,
+		"INVALID_CURSOR": 2,
+	}
+)
+
+func (x SearchResponse_SearchStatus) Enum() *SearchResponse_SearchStatus {
+	p := new(SearchResponse_SearchStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
-func (*UserDetailInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{8}
+func (x SearchResponse_SearchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UserDetailInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (SearchResponse_SearchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[29].Descriptor()
 }
 
-func (x *UserDetailInfo) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (SearchResponse_SearchStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[29]
 }
 
-func (x *UserDetailInfo) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (x SearchResponse_SearchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserDetailInfo) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+// Deprecated: Use SearchResponse_SearchStatus.Descriptor instead.
+func (SearchResponse_SearchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{60, 0}
 }
 
-func (x *UserDetailInfo) GetDateOfBirth() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return nil
-}
+type CreatePlaceResponse_CreatePlaceStatus int32
 
-func (x *UserDetailInfo) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
+const (
+	CreatePlaceResponse_OK             CreatePlaceResponse_CreatePlaceStatus = 0
+	CreatePlaceResponse_USER_NOT_FOUND CreatePlaceResponse_CreatePlaceStatus = 1
+	CreatePlaceResponse_INVALID_PLACE  CreatePlaceResponse_CreatePlaceStatus = 2
+)
 
-func (x *UserDetailInfo) GetProfilePicture() string {
-	if x != nil {
-		return x.ProfilePicture
+// Enum value maps for CreatePlaceResponse_CreatePlaceStatus.
+var (
+	CreatePlaceResponse_CreatePlaceStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_PLACE",
 	}
-	return ""
+	CreatePlaceResponse_CreatePlaceStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_PLACE":  2,
+	}
+)
+
+func (x CreatePlaceResponse_CreatePlaceStatus) Enum() *CreatePlaceResponse_CreatePlaceStatus {
+	p := new(CreatePlaceResponse_CreatePlaceStatus)
+	*p = x
+	return p
 }
 
-func (x *UserDetailInfo) GetCoverPicture() string {
-	if x != nil {
-		return x.CoverPicture
-	}
-	return ""
+func (x CreatePlaceResponse_CreatePlaceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserFollowerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (CreatePlaceResponse_CreatePlaceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[30].Descriptor()
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (CreatePlaceResponse_CreatePlaceStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[30]
 }
 
-func (x *GetUserFollowerRequest) Reset() {
-	*x = GetUserFollowerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x CreatePlaceResponse_CreatePlaceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use CreatePlaceResponse_CreatePlaceStatus.Descriptor instead.
+func (CreatePlaceResponse_CreatePlaceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{62, 0}
 }
 
-func (*GetUserFollowerRequest) ProtoMessage() {}
+type SearchPlacesResponse_SearchPlacesStatus int32
 
-func (x *GetUserFollowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	SearchPlacesResponse_OK            SearchPlacesResponse_SearchPlacesStatus = 0
+	SearchPlacesResponse_INVALID_QUERY SearchPlacesResponse_SearchPlacesStatus = 1
+)
+
+// Enum value maps for SearchPlacesResponse_SearchPlacesStatus.
+var (
+	SearchPlacesResponse_SearchPlacesStatus_name = map[int32]string{
+		0: "OK",
+		1: "INVALID_QUERY",
 	}
-	return mi.MessageOf(x)
-}
+	SearchPlacesResponse_SearchPlacesStatus_value = map[string]int32{
+		"OK":            0,
+		"INVALID_QUERY": 1,
+	}
+)
 
-// Deprecated: Use GetUserFollowerRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFollowerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{9}
+func (x SearchPlacesResponse_SearchPlacesStatus) Enum() *SearchPlacesResponse_SearchPlacesStatus {
+	p := new(SearchPlacesResponse_SearchPlacesStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowerRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x SearchPlacesResponse_SearchPlacesStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserFollowerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (SearchPlacesResponse_SearchPlacesStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[31].Descriptor()
+}
 
-	Status       GetUserFollowerResponse_GetUserFollowerStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserFollowerResponse_GetUserFollowerStatus" json:"status,omitempty"`
-	FollowersIds []int64                                       `protobuf:"varint,2,rep,packed,name=followersIds,proto3" json:"followersIds,omitempty"`
+func (SearchPlacesResponse_SearchPlacesStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[31]
 }
 
-func (x *GetUserFollowerResponse) Reset() {
-	*x = GetUserFollowerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x SearchPlacesResponse_SearchPlacesStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use SearchPlacesResponse_SearchPlacesStatus.Descriptor instead.
+func (SearchPlacesResponse_SearchPlacesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{64, 0}
 }
 
-func (*GetUserFollowerResponse) ProtoMessage() {}
+type GetPlaceResponse_GetPlaceStatus int32
 
-func (x *GetUserFollowerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetPlaceResponse_OK              GetPlaceResponse_GetPlaceStatus = 0
+	GetPlaceResponse_PLACE_NOT_FOUND GetPlaceResponse_GetPlaceStatus = 1
+)
+
+// Enum value maps for GetPlaceResponse_GetPlaceStatus.
+var (
+	GetPlaceResponse_GetPlaceStatus_name = map[int32]string{
+		0: "OK",
+		1: "PLACE_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	GetPlaceResponse_GetPlaceStatus_value = map[string]int32{
+		"OK":              0,
+		"PLACE_NOT_FOUND": 1,
+	}
+)
 
-// Deprecated: Use GetUserFollowerResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFollowerResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{10}
+func (x GetPlaceResponse_GetPlaceStatus) Enum() *GetPlaceResponse_GetPlaceStatus {
+	p := new(GetPlaceResponse_GetPlaceStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowerResponse) GetStatus() GetUserFollowerResponse_GetUserFollowerStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserFollowerResponse_OK
+func (x GetPlaceResponse_GetPlaceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetUserFollowerResponse) GetFollowersIds() []int64 {
-	if x != nil {
-		return x.FollowersIds
-	}
-	return nil
+func (GetPlaceResponse_GetPlaceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[32].Descriptor()
 }
 
-type GetUserFollowingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (GetPlaceResponse_GetPlaceStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[32]
 }
 
-func (x *GetUserFollowingRequest) Reset() {
-	*x = GetUserFollowingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetPlaceResponse_GetPlaceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetPlaceResponse_GetPlaceStatus.Descriptor instead.
+func (GetPlaceResponse_GetPlaceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{66, 0}
 }
 
-func (*GetUserFollowingRequest) ProtoMessage() {}
+type GetPlacePostsResponse_GetPlacePostsStatus int32
 
-func (x *GetUserFollowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetPlacePostsResponse_OK              GetPlacePostsResponse_GetPlacePostsStatus = 0
+	GetPlacePostsResponse_PLACE_NOT_FOUND GetPlacePostsResponse_GetPlacePostsStatus = 1
+)
+
+// Enum value maps for GetPlacePostsResponse_GetPlacePostsStatus.
+var (
+	GetPlacePostsResponse_GetPlacePostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "PLACE_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	GetPlacePostsResponse_GetPlacePostsStatus_value = map[string]int32{
+		"OK":              0,
+		"PLACE_NOT_FOUND": 1,
+	}
+)
 
-// Deprecated: Use GetUserFollowingRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFollowingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{11}
+func (x GetPlacePostsResponse_GetPlacePostsStatus) Enum() *GetPlacePostsResponse_GetPlacePostsStatus {
+	p := new(GetPlacePostsResponse_GetPlacePostsStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowingRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x GetPlacePostsResponse_GetPlacePostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserFollowingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetPlacePostsResponse_GetPlacePostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[33].Descriptor()
+}
 
-	Status        GetUserFollowingResponse_GetUserFollowingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserFollowingResponse_GetUserFollowingStatus" json:"status,omitempty"`
-	FollowingsIds []int64                                         `protobuf:"varint,2,rep,packed,name=followingsIds,proto3" json:"followingsIds,omitempty"`
+func (GetPlacePostsResponse_GetPlacePostsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[33]
 }
 
-func (x *GetUserFollowingResponse) Reset() {
-	*x = GetUserFollowingResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetPlacePostsResponse_GetPlacePostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetPlacePostsResponse_GetPlacePostsStatus.Descriptor instead.
+func (GetPlacePostsResponse_GetPlacePostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{68, 0}
 }
 
-func (*GetUserFollowingResponse) ProtoMessage() {}
+type MergePlacesResponse_MergePlacesStatus int32
 
-func (x *GetUserFollowingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	MergePlacesResponse_OK              MergePlacesResponse_MergePlacesStatus = 0
+	MergePlacesResponse_USER_NOT_FOUND  MergePlacesResponse_MergePlacesStatus = 1
+	MergePlacesResponse_NOT_ALLOWED     MergePlacesResponse_MergePlacesStatus = 2
+	MergePlacesResponse_PLACE_NOT_FOUND MergePlacesResponse_MergePlacesStatus = 3
+	MergePlacesResponse_SAME_PLACE      MergePlacesResponse_MergePlacesStatus = 4
+)
+
+// Enum value maps for MergePlacesResponse_MergePlacesStatus.
+var (
+	MergePlacesResponse_MergePlacesStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "PLACE_NOT_FOUND",
+		4: "SAME_PLACE",
 	}
-	return mi.MessageOf(x)
-}
+	MergePlacesResponse_MergePlacesStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"NOT_ALLOWED":     2,
+		"PLACE_NOT_FOUND": 3,
+		"SAME_PLACE":      4,
+	}
+)
 
-// Deprecated: Use GetUserFollowingResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFollowingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{12}
+func (x MergePlacesResponse_MergePlacesStatus) Enum() *MergePlacesResponse_MergePlacesStatus {
+	p := new(MergePlacesResponse_MergePlacesStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowingResponse) GetStatus() GetUserFollowingResponse_GetUserFollowingStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserFollowingResponse_OK
+func (x MergePlacesResponse_MergePlacesStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetUserFollowingResponse) GetFollowingsIds() []int64 {
-	if x != nil {
-		return x.FollowingsIds
-	}
-	return nil
+func (MergePlacesResponse_MergePlacesStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[34].Descriptor()
 }
 
-type FollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+func (MergePlacesResponse_MergePlacesStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[34]
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x MergePlacesResponse_MergePlacesStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use MergePlacesResponse_MergePlacesStatus.Descriptor instead.
+func (MergePlacesResponse_MergePlacesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{70, 0}
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+type CreateTripResponse_CreateTripStatus int32
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	CreateTripResponse_OK              CreateTripResponse_CreateTripStatus = 0
+	CreateTripResponse_USER_NOT_FOUND  CreateTripResponse_CreateTripStatus = 1
+	CreateTripResponse_INVALID_TRIP    CreateTripResponse_CreateTripStatus = 2
+	CreateTripResponse_PLACE_NOT_FOUND CreateTripResponse_CreateTripStatus = 3
+)
+
+// Enum value maps for CreateTripResponse_CreateTripStatus.
+var (
+	CreateTripResponse_CreateTripStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_TRIP",
+		3: "PLACE_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	CreateTripResponse_CreateTripStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"INVALID_TRIP":    2,
+		"PLACE_NOT_FOUND": 3,
+	}
+)
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{13}
+func (x CreateTripResponse_CreateTripStatus) Enum() *CreateTripResponse_CreateTripStatus {
+	p := new(CreateTripResponse_CreateTripStatus)
+	*p = x
+	return p
 }
 
-func (x *FollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x CreateTripResponse_CreateTripStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *FollowUserRequest) GetFollowingId() int64 {
-	if x != nil {
-		return x.FollowingId
-	}
-	return 0
+func (CreateTripResponse_CreateTripStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[35].Descriptor()
 }
 
-type FollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status FollowUserResponse_FollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.FollowUserResponse_FollowUserStatus" json:"status,omitempty"`
+func (CreateTripResponse_CreateTripStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[35]
 }
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x CreateTripResponse_CreateTripStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use CreateTripResponse_CreateTripStatus.Descriptor instead.
+func (CreateTripResponse_CreateTripStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{72, 0}
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+type EditTripResponse_EditTripStatus int32
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	EditTripResponse_OK              EditTripResponse_EditTripStatus = 0
+	EditTripResponse_USER_NOT_FOUND  EditTripResponse_EditTripStatus = 1
+	EditTripResponse_TRIP_NOT_FOUND  EditTripResponse_EditTripStatus = 2
+	EditTripResponse_NOT_ALLOWED     EditTripResponse_EditTripStatus = 3
+	EditTripResponse_INVALID_TRIP    EditTripResponse_EditTripStatus = 4
+	EditTripResponse_PLACE_NOT_FOUND EditTripResponse_EditTripStatus = 5
+)
+
+// Enum value maps for EditTripResponse_EditTripStatus.
+var (
+	EditTripResponse_EditTripStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "INVALID_TRIP",
+		5: "PLACE_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	EditTripResponse_EditTripStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"TRIP_NOT_FOUND":  2,
+		"NOT_ALLOWED":     3,
+		"INVALID_TRIP":    4,
+		"PLACE_NOT_FOUND": 5,
+	}
+)
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{14}
+func (x EditTripResponse_EditTripStatus) Enum() *EditTripResponse_EditTripStatus {
+	p := new(EditTripResponse_EditTripStatus)
+	*p = x
+	return p
 }
 
-func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return FollowUserResponse_OK
+func (x EditTripResponse_EditTripStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type UnfollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (EditTripResponse_EditTripStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[36].Descriptor()
+}
 
-	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+func (EditTripResponse_EditTripStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[36]
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x EditTripResponse_EditTripStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use EditTripResponse_EditTripStatus.Descriptor instead.
+func (EditTripResponse_EditTripStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{74, 0}
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+type PublishTripResponse_PublishTripStatus int32
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	PublishTripResponse_OK             PublishTripResponse_PublishTripStatus = 0
+	PublishTripResponse_USER_NOT_FOUND PublishTripResponse_PublishTripStatus = 1
+	PublishTripResponse_TRIP_NOT_FOUND PublishTripResponse_PublishTripStatus = 2
+	PublishTripResponse_NOT_ALLOWED    PublishTripResponse_PublishTripStatus = 3
+)
+
+// Enum value maps for PublishTripResponse_PublishTripStatus.
+var (
+	PublishTripResponse_PublishTripStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-	return mi.MessageOf(x)
+	PublishTripResponse_PublishTripStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"TRIP_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+	}
+)
+
+func (x PublishTripResponse_PublishTripStatus) Enum() *PublishTripResponse_PublishTripStatus {
+	p := new(PublishTripResponse_PublishTripStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{15}
+func (x PublishTripResponse_PublishTripStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UnfollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (PublishTripResponse_PublishTripStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[37].Descriptor()
 }
 
-func (x *UnfollowUserRequest) GetFollowingId() int64 {
-	if x != nil {
-		return x.FollowingId
-	}
-	return 0
+func (PublishTripResponse_PublishTripStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[37]
 }
 
-type UnfollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x PublishTripResponse_PublishTripStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Status UnfollowUserResponse_UnfollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.UnfollowUserResponse_UnfollowUserStatus" json:"status,omitempty"`
+// Deprecated: Use PublishTripResponse_PublishTripStatus.Descriptor instead.
+func (PublishTripResponse_PublishTripStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{76, 0}
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type GetTripResponse_GetTripStatus int32
+
+const (
+	GetTripResponse_OK             GetTripResponse_GetTripStatus = 0
+	GetTripResponse_TRIP_NOT_FOUND GetTripResponse_GetTripStatus = 1
+)
+
+// Enum value maps for GetTripResponse_GetTripStatus.
+var (
+	GetTripResponse_GetTripStatus_name = map[int32]string{
+		0: "OK",
+		1: "TRIP_NOT_FOUND",
+	}
+	GetTripResponse_GetTripStatus_value = map[string]int32{
+		"OK":             0,
+		"TRIP_NOT_FOUND": 1,
 	}
+)
+
+func (x GetTripResponse_GetTripStatus) Enum() *GetTripResponse_GetTripStatus {
+	p := new(GetTripResponse_GetTripStatus)
+	*p = x
+	return p
 }
 
-func (x *UnfollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetTripResponse_GetTripStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (GetTripResponse_GetTripStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[38].Descriptor()
+}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetTripResponse_GetTripStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[38]
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{16}
+func (x GetTripResponse_GetTripStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UnfollowUserResponse_OK
+// Deprecated: Use GetTripResponse_GetTripStatus.Descriptor instead.
+func (GetTripResponse_GetTripStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{78, 0}
 }
 
-type GetUserPostsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type InviteTripMemberResponse_InviteTripMemberStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	InviteTripMemberResponse_OK                InviteTripMemberResponse_InviteTripMemberStatus = 0
+	InviteTripMemberResponse_USER_NOT_FOUND    InviteTripMemberResponse_InviteTripMemberStatus = 1
+	InviteTripMemberResponse_TRIP_NOT_FOUND    InviteTripMemberResponse_InviteTripMemberStatus = 2
+	InviteTripMemberResponse_NOT_ALLOWED       InviteTripMemberResponse_InviteTripMemberStatus = 3
+	InviteTripMemberResponse_INVITEE_NOT_FOUND InviteTripMemberResponse_InviteTripMemberStatus = 4
+	InviteTripMemberResponse_ALREADY_MEMBER    InviteTripMemberResponse_InviteTripMemberStatus = 5
+	InviteTripMemberResponse_INVALID_ROLE      InviteTripMemberResponse_InviteTripMemberStatus = 6
+)
 
-func (x *GetUserPostsRequest) Reset() {
-	*x = GetUserPostsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for InviteTripMemberResponse_InviteTripMemberStatus.
+var (
+	InviteTripMemberResponse_InviteTripMemberStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "INVITEE_NOT_FOUND",
+		5: "ALREADY_MEMBER",
+		6: "INVALID_ROLE",
+	}
+	InviteTripMemberResponse_InviteTripMemberStatus_value = map[string]int32{
+		"OK":                0,
+		"USER_NOT_FOUND":    1,
+		"TRIP_NOT_FOUND":    2,
+		"NOT_ALLOWED":       3,
+		"INVITEE_NOT_FOUND": 4,
+		"ALREADY_MEMBER":    5,
+		"INVALID_ROLE":      6,
 	}
+)
+
+func (x InviteTripMemberResponse_InviteTripMemberStatus) Enum() *InviteTripMemberResponse_InviteTripMemberStatus {
+	p := new(InviteTripMemberResponse_InviteTripMemberStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserPostsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x InviteTripMemberResponse_InviteTripMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserPostsRequest) ProtoMessage() {}
+func (InviteTripMemberResponse_InviteTripMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[39].Descriptor()
+}
 
-func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (InviteTripMemberResponse_InviteTripMemberStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[39]
 }
 
-// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{17}
+func (x InviteTripMemberResponse_InviteTripMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserPostsRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use InviteTripMemberResponse_InviteTripMemberStatus.Descriptor instead.
+func (InviteTripMemberResponse_InviteTripMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{83, 0}
 }
 
-type GetUserPostsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RespondTripInvitationResponse_RespondTripInvitationStatus int32
 
-	Status   GetUserPostsResponse_GetUserPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserPostsResponse_GetUserPostsStatus" json:"status,omitempty"`
-	PostsIds []int64                                 `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
-}
+const (
+	RespondTripInvitationResponse_OK                   RespondTripInvitationResponse_RespondTripInvitationStatus = 0
+	RespondTripInvitationResponse_USER_NOT_FOUND       RespondTripInvitationResponse_RespondTripInvitationStatus = 1
+	RespondTripInvitationResponse_INVITATION_NOT_FOUND RespondTripInvitationResponse_RespondTripInvitationStatus = 2
+)
 
-func (x *GetUserPostsResponse) Reset() {
-	*x = GetUserPostsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RespondTripInvitationResponse_RespondTripInvitationStatus.
+var (
+	RespondTripInvitationResponse_RespondTripInvitationStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVITATION_NOT_FOUND",
 	}
+	RespondTripInvitationResponse_RespondTripInvitationStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"INVITATION_NOT_FOUND": 2,
+	}
+)
+
+func (x RespondTripInvitationResponse_RespondTripInvitationStatus) Enum() *RespondTripInvitationResponse_RespondTripInvitationStatus {
+	p := new(RespondTripInvitationResponse_RespondTripInvitationStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserPostsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RespondTripInvitationResponse_RespondTripInvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserPostsResponse) ProtoMessage() {}
-
-func (x *GetUserPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RespondTripInvitationResponse_RespondTripInvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[40].Descriptor()
 }
 
-// Deprecated: Use GetUserPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPostsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{18}
+func (RespondTripInvitationResponse_RespondTripInvitationStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[40]
 }
 
-func (x *GetUserPostsResponse) GetStatus() GetUserPostsResponse_GetUserPostsStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserPostsResponse_OK
+func (x RespondTripInvitationResponse_RespondTripInvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserPostsResponse) GetPostsIds() []int64 {
-	if x != nil {
-		return x.PostsIds
-	}
-	return nil
+// Deprecated: Use RespondTripInvitationResponse_RespondTripInvitationStatus.Descriptor instead.
+func (RespondTripInvitationResponse_RespondTripInvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{85, 0}
 }
 
-type CreatePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetTripInvitationsResponse_GetTripInvitationsStatus int32
 
-	UserId           int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string   `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath []string `protobuf:"bytes,3,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool     `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
-}
+const (
+	GetTripInvitationsResponse_OK             GetTripInvitationsResponse_GetTripInvitationsStatus = 0
+	GetTripInvitationsResponse_USER_NOT_FOUND GetTripInvitationsResponse_GetTripInvitationsStatus = 1
+)
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetTripInvitationsResponse_GetTripInvitationsStatus.
+var (
+	GetTripInvitationsResponse_GetTripInvitationsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-}
+	GetTripInvitationsResponse_GetTripInvitationsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
 
-func (x *CreatePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetTripInvitationsResponse_GetTripInvitationsStatus) Enum() *GetTripInvitationsResponse_GetTripInvitationsStatus {
+	p := new(GetTripInvitationsResponse_GetTripInvitationsStatus)
+	*p = x
+	return p
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (x GetTripInvitationsResponse_GetTripInvitationsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetTripInvitationsResponse_GetTripInvitationsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[41].Descriptor()
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{19}
+func (GetTripInvitationsResponse_GetTripInvitationsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[41]
 }
 
-func (x *CreatePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x GetTripInvitationsResponse_GetTripInvitationsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+// Deprecated: Use GetTripInvitationsResponse_GetTripInvitationsStatus.Descriptor instead.
+func (GetTripInvitationsResponse_GetTripInvitationsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{87, 0}
 }
 
-func (x *CreatePostRequest) GetContentImagePath() []string {
-	if x != nil {
-		return x.ContentImagePath
+type UpdateTripMemberResponse_UpdateTripMemberStatus int32
+
+const (
+	UpdateTripMemberResponse_OK               UpdateTripMemberResponse_UpdateTripMemberStatus = 0
+	UpdateTripMemberResponse_USER_NOT_FOUND   UpdateTripMemberResponse_UpdateTripMemberStatus = 1
+	UpdateTripMemberResponse_TRIP_NOT_FOUND   UpdateTripMemberResponse_UpdateTripMemberStatus = 2
+	UpdateTripMemberResponse_NOT_ALLOWED      UpdateTripMemberResponse_UpdateTripMemberStatus = 3
+	UpdateTripMemberResponse_MEMBER_NOT_FOUND UpdateTripMemberResponse_UpdateTripMemberStatus = 4
+	UpdateTripMemberResponse_INVALID_ROLE     UpdateTripMemberResponse_UpdateTripMemberStatus = 5
+)
+
+// Enum value maps for UpdateTripMemberResponse_UpdateTripMemberStatus.
+var (
+	UpdateTripMemberResponse_UpdateTripMemberStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "MEMBER_NOT_FOUND",
+		5: "INVALID_ROLE",
 	}
-	return nil
+	UpdateTripMemberResponse_UpdateTripMemberStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"TRIP_NOT_FOUND":   2,
+		"NOT_ALLOWED":      3,
+		"MEMBER_NOT_FOUND": 4,
+		"INVALID_ROLE":     5,
+	}
+)
+
+func (x UpdateTripMemberResponse_UpdateTripMemberStatus) Enum() *UpdateTripMemberResponse_UpdateTripMemberStatus {
+	p := new(UpdateTripMemberResponse_UpdateTripMemberStatus)
+	*p = x
+	return p
 }
 
-func (x *CreatePostRequest) GetVisible() bool {
-	if x != nil {
-		return x.Visible
-	}
-	return false
+func (x UpdateTripMemberResponse_UpdateTripMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreatePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UpdateTripMemberResponse_UpdateTripMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[42].Descriptor()
+}
 
-	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
-	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+func (UpdateTripMemberResponse_UpdateTripMemberStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[42]
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UpdateTripMemberResponse_UpdateTripMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UpdateTripMemberResponse_UpdateTripMemberStatus.Descriptor instead.
+func (UpdateTripMemberResponse_UpdateTripMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{90, 0}
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+type RemoveTripMemberResponse_RemoveTripMemberStatus int32
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RemoveTripMemberResponse_OK               RemoveTripMemberResponse_RemoveTripMemberStatus = 0
+	RemoveTripMemberResponse_USER_NOT_FOUND   RemoveTripMemberResponse_RemoveTripMemberStatus = 1
+	RemoveTripMemberResponse_TRIP_NOT_FOUND   RemoveTripMemberResponse_RemoveTripMemberStatus = 2
+	RemoveTripMemberResponse_NOT_ALLOWED      RemoveTripMemberResponse_RemoveTripMemberStatus = 3
+	RemoveTripMemberResponse_MEMBER_NOT_FOUND RemoveTripMemberResponse_RemoveTripMemberStatus = 4
+)
+
+// Enum value maps for RemoveTripMemberResponse_RemoveTripMemberStatus.
+var (
+	RemoveTripMemberResponse_RemoveTripMemberStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "MEMBER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	RemoveTripMemberResponse_RemoveTripMemberStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"TRIP_NOT_FOUND":   2,
+		"NOT_ALLOWED":      3,
+		"MEMBER_NOT_FOUND": 4,
+	}
+)
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{20}
+func (x RemoveTripMemberResponse_RemoveTripMemberStatus) Enum() *RemoveTripMemberResponse_RemoveTripMemberStatus {
+	p := new(RemoveTripMemberResponse_RemoveTripMemberStatus)
+	*p = x
+	return p
 }
 
-func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CreatePostResponse_OK
+func (x RemoveTripMemberResponse_RemoveTripMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreatePostResponse) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (RemoveTripMemberResponse_RemoveTripMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[43].Descriptor()
 }
 
-type GetPostDetailInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+func (RemoveTripMemberResponse_RemoveTripMemberStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[43]
 }
 
-func (x *GetPostDetailInfoRequest) Reset() {
-	*x = GetPostDetailInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RemoveTripMemberResponse_RemoveTripMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetPostDetailInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RemoveTripMemberResponse_RemoveTripMemberStatus.Descriptor instead.
+func (RemoveTripMemberResponse_RemoveTripMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{92, 0}
 }
 
-func (*GetPostDetailInfoRequest) ProtoMessage() {}
+type RemoveTripPostResponse_RemoveTripPostStatus int32
 
-func (x *GetPostDetailInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RemoveTripPostResponse_OK             RemoveTripPostResponse_RemoveTripPostStatus = 0
+	RemoveTripPostResponse_USER_NOT_FOUND RemoveTripPostResponse_RemoveTripPostStatus = 1
+	RemoveTripPostResponse_TRIP_NOT_FOUND RemoveTripPostResponse_RemoveTripPostStatus = 2
+	RemoveTripPostResponse_NOT_ALLOWED    RemoveTripPostResponse_RemoveTripPostStatus = 3
+	RemoveTripPostResponse_POST_NOT_FOUND RemoveTripPostResponse_RemoveTripPostStatus = 4
+)
+
+// Enum value maps for RemoveTripPostResponse_RemoveTripPostStatus.
+var (
+	RemoveTripPostResponse_RemoveTripPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TRIP_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "POST_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	RemoveTripPostResponse_RemoveTripPostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"TRIP_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+		"POST_NOT_FOUND": 4,
+	}
+)
 
-// Deprecated: Use GetPostDetailInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetPostDetailInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{21}
+func (x RemoveTripPostResponse_RemoveTripPostStatus) Enum() *RemoveTripPostResponse_RemoveTripPostStatus {
+	p := new(RemoveTripPostResponse_RemoveTripPostStatus)
+	*p = x
+	return p
 }
 
-func (x *GetPostDetailInfoRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x RemoveTripPostResponse_RemoveTripPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetPostDetailInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (RemoveTripPostResponse_RemoveTripPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[44].Descriptor()
+}
 
-	Status GetPostDetailInfoResponse_GetPostDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPostDetailInfoResponse_GetPostDetailInfoStatus" json:"status,omitempty"`
-	Post   *PostDetailInfo                                   `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+func (RemoveTripPostResponse_RemoveTripPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[44]
 }
 
-func (x *GetPostDetailInfoResponse) Reset() {
-	*x = GetPostDetailInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RemoveTripPostResponse_RemoveTripPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetPostDetailInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RemoveTripPostResponse_RemoveTripPostStatus.Descriptor instead.
+func (RemoveTripPostResponse_RemoveTripPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{94, 0}
 }
 
-func (*GetPostDetailInfoResponse) ProtoMessage() {}
+type GetTravelMapResponse_GetTravelMapStatus int32
 
-func (x *GetPostDetailInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetTravelMapResponse_OK             GetTravelMapResponse_GetTravelMapStatus = 0
+	GetTravelMapResponse_USER_NOT_FOUND GetTravelMapResponse_GetTravelMapStatus = 1
+)
+
+// Enum value maps for GetTravelMapResponse_GetTravelMapStatus.
+var (
+	GetTravelMapResponse_GetTravelMapStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	GetTravelMapResponse_GetTravelMapStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetTravelMapResponse_GetTravelMapStatus) Enum() *GetTravelMapResponse_GetTravelMapStatus {
+	p := new(GetTravelMapResponse_GetTravelMapStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetPostDetailInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetPostDetailInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{22}
+func (x GetTravelMapResponse_GetTravelMapStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetPostDetailInfoResponse) GetStatus() GetPostDetailInfoResponse_GetPostDetailInfoStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetPostDetailInfoResponse_OK
+func (GetTravelMapResponse_GetTravelMapStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[45].Descriptor()
 }
 
-func (x *GetPostDetailInfoResponse) GetPost() *PostDetailInfo {
-	if x != nil {
-		return x.Post
-	}
-	return nil
+func (GetTravelMapResponse_GetTravelMapStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[45]
 }
 
-type EditPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId           int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId           int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ContentText      *string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
-	ContentImagePath *string `protobuf:"bytes,4,opt,name=content_image_path,json=contentImagePath,proto3,oneof" json:"content_image_path,omitempty"`
-	Visible          *bool   `protobuf:"varint,5,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
+func (x GetTravelMapResponse_GetTravelMapStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostRequest) Reset() {
-	*x = EditPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Deprecated: Use GetTravelMapResponse_GetTravelMapStatus.Descriptor instead.
+func (GetTravelMapResponse_GetTravelMapStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{96, 0}
 }
 
-func (x *EditPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+type GetTravelStatsResponse_GetTravelStatsStatus int32
 
-func (*EditPostRequest) ProtoMessage() {}
+const (
+	GetTravelStatsResponse_OK             GetTravelStatsResponse_GetTravelStatsStatus = 0
+	GetTravelStatsResponse_USER_NOT_FOUND GetTravelStatsResponse_GetTravelStatsStatus = 1
+)
 
-func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Enum value maps for GetTravelStatsResponse_GetTravelStatsStatus.
+var (
+	GetTravelStatsResponse_GetTravelStatsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	GetTravelStatsResponse_GetTravelStatsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
 
-// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
-func (*EditPostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{23}
+func (x GetTravelStatsResponse_GetTravelStatsStatus) Enum() *GetTravelStatsResponse_GetTravelStatsStatus {
+	p := new(GetTravelStatsResponse_GetTravelStatsStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x GetTravelStatsResponse_GetTravelStatsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *EditPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (GetTravelStatsResponse_GetTravelStatsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[46].Descriptor()
 }
 
-func (x *EditPostRequest) GetContentText() string {
-	if x != nil && x.ContentText != nil {
-		return *x.ContentText
-	}
-	return ""
+func (GetTravelStatsResponse_GetTravelStatsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[46]
 }
 
-func (x *EditPostRequest) GetContentImagePath() string {
-	if x != nil && x.ContentImagePath != nil {
-		return *x.ContentImagePath
-	}
-	return ""
+func (x GetTravelStatsResponse_GetTravelStatsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostRequest) GetVisible() bool {
-	if x != nil && x.Visible != nil {
-		return *x.Visible
-	}
-	return false
+// Deprecated: Use GetTravelStatsResponse_GetTravelStatsStatus.Descriptor instead.
+func (GetTravelStatsResponse_GetTravelStatsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{101, 0}
 }
 
-type EditPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AddTravelVisitResponse_AddTravelVisitStatus int32
 
-	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditPostResponse_EditPostStatus" json:"status,omitempty"`
-}
+const (
+	AddTravelVisitResponse_OK             AddTravelVisitResponse_AddTravelVisitStatus = 0
+	AddTravelVisitResponse_USER_NOT_FOUND AddTravelVisitResponse_AddTravelVisitStatus = 1
+	AddTravelVisitResponse_INVALID_VISIT  AddTravelVisitResponse_AddTravelVisitStatus = 2
+)
 
-func (x *EditPostResponse) Reset() {
-	*x = EditPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AddTravelVisitResponse_AddTravelVisitStatus.
+var (
+	AddTravelVisitResponse_AddTravelVisitStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_VISIT",
+	}
+	AddTravelVisitResponse_AddTravelVisitStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_VISIT":  2,
 	}
+)
+
+func (x AddTravelVisitResponse_AddTravelVisitStatus) Enum() *AddTravelVisitResponse_AddTravelVisitStatus {
+	p := new(AddTravelVisitResponse_AddTravelVisitStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AddTravelVisitResponse_AddTravelVisitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*EditPostResponse) ProtoMessage() {}
+func (AddTravelVisitResponse_AddTravelVisitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[47].Descriptor()
+}
 
-func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AddTravelVisitResponse_AddTravelVisitStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[47]
 }
 
-// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
-func (*EditPostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{24}
+func (x AddTravelVisitResponse_AddTravelVisitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return EditPostResponse_OK
+// Deprecated: Use AddTravelVisitResponse_AddTravelVisitStatus.Descriptor instead.
+func (AddTravelVisitResponse_AddTravelVisitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{103, 0}
 }
 
-type DeletePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DeleteTravelVisitResponse_DeleteTravelVisitStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-}
+const (
+	DeleteTravelVisitResponse_OK              DeleteTravelVisitResponse_DeleteTravelVisitStatus = 0
+	DeleteTravelVisitResponse_USER_NOT_FOUND  DeleteTravelVisitResponse_DeleteTravelVisitStatus = 1
+	DeleteTravelVisitResponse_VISIT_NOT_FOUND DeleteTravelVisitResponse_DeleteTravelVisitStatus = 2
+)
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DeleteTravelVisitResponse_DeleteTravelVisitStatus.
+var (
+	DeleteTravelVisitResponse_DeleteTravelVisitStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "VISIT_NOT_FOUND",
 	}
-}
+	DeleteTravelVisitResponse_DeleteTravelVisitStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"VISIT_NOT_FOUND": 2,
+	}
+)
 
-func (x *DeletePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DeleteTravelVisitResponse_DeleteTravelVisitStatus) Enum() *DeleteTravelVisitResponse_DeleteTravelVisitStatus {
+	p := new(DeleteTravelVisitResponse_DeleteTravelVisitStatus)
+	*p = x
+	return p
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (x DeleteTravelVisitResponse_DeleteTravelVisitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DeleteTravelVisitResponse_DeleteTravelVisitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[48].Descriptor()
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{25}
+func (DeleteTravelVisitResponse_DeleteTravelVisitStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[48]
 }
 
-func (x *DeletePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x DeleteTravelVisitResponse_DeleteTravelVisitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use DeleteTravelVisitResponse_DeleteTravelVisitStatus.Descriptor instead.
+func (DeleteTravelVisitResponse_DeleteTravelVisitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{105, 0}
 }
 
-type DeletePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CreatePlaceReviewResponse_CreatePlaceReviewStatus int32
 
-	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
-}
+const (
+	CreatePlaceReviewResponse_OK               CreatePlaceReviewResponse_CreatePlaceReviewStatus = 0
+	CreatePlaceReviewResponse_USER_NOT_FOUND   CreatePlaceReviewResponse_CreatePlaceReviewStatus = 1
+	CreatePlaceReviewResponse_PLACE_NOT_FOUND  CreatePlaceReviewResponse_CreatePlaceReviewStatus = 2
+	CreatePlaceReviewResponse_INVALID_REVIEW   CreatePlaceReviewResponse_CreatePlaceReviewStatus = 3
+	CreatePlaceReviewResponse_ALREADY_REVIEWED CreatePlaceReviewResponse_CreatePlaceReviewStatus = 4
+)
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CreatePlaceReviewResponse_CreatePlaceReviewStatus.
+var (
+	CreatePlaceReviewResponse_CreatePlaceReviewStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "PLACE_NOT_FOUND",
+		3: "INVALID_REVIEW",
+		4: "ALREADY_REVIEWED",
+	}
+	CreatePlaceReviewResponse_CreatePlaceReviewStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"PLACE_NOT_FOUND":  2,
+		"INVALID_REVIEW":   3,
+		"ALREADY_REVIEWED": 4,
 	}
+)
+
+func (x CreatePlaceReviewResponse_CreatePlaceReviewStatus) Enum() *CreatePlaceReviewResponse_CreatePlaceReviewStatus {
+	p := new(CreatePlaceReviewResponse_CreatePlaceReviewStatus)
+	*p = x
+	return p
 }
 
-func (x *DeletePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CreatePlaceReviewResponse_CreatePlaceReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (CreatePlaceReviewResponse_CreatePlaceReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[49].Descriptor()
+}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CreatePlaceReviewResponse_CreatePlaceReviewStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[49]
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{26}
+func (x CreatePlaceReviewResponse_CreatePlaceReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return DeletePostResponse_OK
+// Deprecated: Use CreatePlaceReviewResponse_CreatePlaceReviewStatus.Descriptor instead.
+func (CreatePlaceReviewResponse_CreatePlaceReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{107, 0}
 }
 
-type CommentPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type EditPlaceReviewResponse_EditPlaceReviewStatus int32
 
-	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-}
+const (
+	EditPlaceReviewResponse_OK               EditPlaceReviewResponse_EditPlaceReviewStatus = 0
+	EditPlaceReviewResponse_USER_NOT_FOUND   EditPlaceReviewResponse_EditPlaceReviewStatus = 1
+	EditPlaceReviewResponse_REVIEW_NOT_FOUND EditPlaceReviewResponse_EditPlaceReviewStatus = 2
+	EditPlaceReviewResponse_NOT_ALLOWED      EditPlaceReviewResponse_EditPlaceReviewStatus = 3
+	EditPlaceReviewResponse_INVALID_REVIEW   EditPlaceReviewResponse_EditPlaceReviewStatus = 4
+)
 
-func (x *CommentPostRequest) Reset() {
-	*x = CommentPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for EditPlaceReviewResponse_EditPlaceReviewStatus.
+var (
+	EditPlaceReviewResponse_EditPlaceReviewStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "REVIEW_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "INVALID_REVIEW",
 	}
+	EditPlaceReviewResponse_EditPlaceReviewStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"REVIEW_NOT_FOUND": 2,
+		"NOT_ALLOWED":      3,
+		"INVALID_REVIEW":   4,
+	}
+)
+
+func (x EditPlaceReviewResponse_EditPlaceReviewStatus) Enum() *EditPlaceReviewResponse_EditPlaceReviewStatus {
+	p := new(EditPlaceReviewResponse_EditPlaceReviewStatus)
+	*p = x
+	return p
 }
 
-func (x *CommentPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x EditPlaceReviewResponse_EditPlaceReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CommentPostRequest) ProtoMessage() {}
+func (EditPlaceReviewResponse_EditPlaceReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[50].Descriptor()
+}
 
-func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (EditPlaceReviewResponse_EditPlaceReviewStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[50]
 }
 
-// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
-func (*CommentPostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{27}
+func (x EditPlaceReviewResponse_EditPlaceReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use EditPlaceReviewResponse_EditPlaceReviewStatus.Descriptor instead.
+func (EditPlaceReviewResponse_EditPlaceReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{109, 0}
 }
 
-func (x *CommentPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+type DeletePlaceReviewResponse_DeletePlaceReviewStatus int32
+
+const (
+	DeletePlaceReviewResponse_OK               DeletePlaceReviewResponse_DeletePlaceReviewStatus = 0
+	DeletePlaceReviewResponse_USER_NOT_FOUND   DeletePlaceReviewResponse_DeletePlaceReviewStatus = 1
+	DeletePlaceReviewResponse_REVIEW_NOT_FOUND DeletePlaceReviewResponse_DeletePlaceReviewStatus = 2
+	DeletePlaceReviewResponse_NOT_ALLOWED      DeletePlaceReviewResponse_DeletePlaceReviewStatus = 3
+)
+
+// Enum value maps for DeletePlaceReviewResponse_DeletePlaceReviewStatus.
+var (
+	DeletePlaceReviewResponse_DeletePlaceReviewStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "REVIEW_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-	return 0
+	DeletePlaceReviewResponse_DeletePlaceReviewStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"REVIEW_NOT_FOUND": 2,
+		"NOT_ALLOWED":      3,
+	}
+)
+
+func (x DeletePlaceReviewResponse_DeletePlaceReviewStatus) Enum() *DeletePlaceReviewResponse_DeletePlaceReviewStatus {
+	p := new(DeletePlaceReviewResponse_DeletePlaceReviewStatus)
+	*p = x
+	return p
 }
 
-func (x *CommentPostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (x DeletePlaceReviewResponse_DeletePlaceReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CommentPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (DeletePlaceReviewResponse_DeletePlaceReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[51].Descriptor()
+}
 
-	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
-	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+func (DeletePlaceReviewResponse_DeletePlaceReviewStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[51]
 }
 
-func (x *CommentPostResponse) Reset() {
-	*x = CommentPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x DeletePlaceReviewResponse_DeletePlaceReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use DeletePlaceReviewResponse_DeletePlaceReviewStatus.Descriptor instead.
+func (DeletePlaceReviewResponse_DeletePlaceReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{111, 0}
 }
 
-func (*CommentPostResponse) ProtoMessage() {}
+type GetPlaceReviewResponse_GetPlaceReviewStatus int32
 
-func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetPlaceReviewResponse_OK               GetPlaceReviewResponse_GetPlaceReviewStatus = 0
+	GetPlaceReviewResponse_REVIEW_NOT_FOUND GetPlaceReviewResponse_GetPlaceReviewStatus = 1
+)
+
+// Enum value maps for GetPlaceReviewResponse_GetPlaceReviewStatus.
+var (
+	GetPlaceReviewResponse_GetPlaceReviewStatus_name = map[int32]string{
+		0: "OK",
+		1: "REVIEW_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	GetPlaceReviewResponse_GetPlaceReviewStatus_value = map[string]int32{
+		"OK":               0,
+		"REVIEW_NOT_FOUND": 1,
+	}
+)
+
+func (x GetPlaceReviewResponse_GetPlaceReviewStatus) Enum() *GetPlaceReviewResponse_GetPlaceReviewStatus {
+	p := new(GetPlaceReviewResponse_GetPlaceReviewStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
-func (*CommentPostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{28}
+func (x GetPlaceReviewResponse_GetPlaceReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CommentPostResponse_OK
+func (GetPlaceReviewResponse_GetPlaceReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[52].Descriptor()
 }
 
-func (x *CommentPostResponse) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
-	}
-	return 0
+func (GetPlaceReviewResponse_GetPlaceReviewStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[52]
 }
 
-type LikePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x GetPlaceReviewResponse_GetPlaceReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+// Deprecated: Use GetPlaceReviewResponse_GetPlaceReviewStatus.Descriptor instead.
+func (GetPlaceReviewResponse_GetPlaceReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{113, 0}
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type GetPlaceReviewsRequest_ReviewSort int32
+
+const (
+	GetPlaceReviewsRequest_NEWEST        GetPlaceReviewsRequest_ReviewSort = 0
+	GetPlaceReviewsRequest_MOST_HELPFUL  GetPlaceReviewsRequest_ReviewSort = 1
+	GetPlaceReviewsRequest_HIGHEST_RATED GetPlaceReviewsRequest_ReviewSort = 2
+	GetPlaceReviewsRequest_LOWEST_RATED  GetPlaceReviewsRequest_ReviewSort = 3
+)
+
+// Enum value maps for GetPlaceReviewsRequest_ReviewSort.
+var (
+	GetPlaceReviewsRequest_ReviewSort_name = map[int32]string{
+		0: "NEWEST",
+		1: "MOST_HELPFUL",
+		2: "HIGHEST_RATED",
+		3: "LOWEST_RATED",
 	}
+	GetPlaceReviewsRequest_ReviewSort_value = map[string]int32{
+		"NEWEST":        0,
+		"MOST_HELPFUL":  1,
+		"HIGHEST_RATED": 2,
+		"LOWEST_RATED":  3,
+	}
+)
+
+func (x GetPlaceReviewsRequest_ReviewSort) Enum() *GetPlaceReviewsRequest_ReviewSort {
+	p := new(GetPlaceReviewsRequest_ReviewSort)
+	*p = x
+	return p
 }
 
-func (x *LikePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetPlaceReviewsRequest_ReviewSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (GetPlaceReviewsRequest_ReviewSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[53].Descriptor()
+}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetPlaceReviewsRequest_ReviewSort) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[53]
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{29}
+func (x GetPlaceReviewsRequest_ReviewSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LikePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetPlaceReviewsRequest_ReviewSort.Descriptor instead.
+func (GetPlaceReviewsRequest_ReviewSort) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{114, 0}
 }
 
-func (x *LikePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+type GetPlaceReviewsResponse_GetPlaceReviewsStatus int32
+
+const (
+	GetPlaceReviewsResponse_OK              GetPlaceReviewsResponse_GetPlaceReviewsStatus = 0
+	GetPlaceReviewsResponse_PLACE_NOT_FOUND GetPlaceReviewsResponse_GetPlaceReviewsStatus = 1
+	GetPlaceReviewsResponse_INVALID_CURSOR  GetPlaceReviewsResponse_GetPlaceReviewsStatus = 2
+)
+
+// Enum value maps for GetPlaceReviewsResponse_GetPlaceReviewsStatus.
+var (
+	GetPlaceReviewsResponse_GetPlaceReviewsStatus_name = map[int32]string{
+		0: "OK",
+		1: "PLACE_NOT_FOUND",
+		2: "INVALID_CURSOR",
 	}
-	return 0
+	GetPlaceReviewsResponse_GetPlaceReviewsStatus_value = map[string]int32{
+		"OK":              0,
+		"PLACE_NOT_FOUND": 1,
+		"INVALID_CURSOR":  2,
+	}
+)
+
+func (x GetPlaceReviewsResponse_GetPlaceReviewsStatus) Enum() *GetPlaceReviewsResponse_GetPlaceReviewsStatus {
+	p := new(GetPlaceReviewsResponse_GetPlaceReviewsStatus)
+	*p = x
+	return p
 }
 
-type LikePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x GetPlaceReviewsResponse_GetPlaceReviewsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+func (GetPlaceReviewsResponse_GetPlaceReviewsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[54].Descriptor()
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (GetPlaceReviewsResponse_GetPlaceReviewsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[54]
 }
 
-func (x *LikePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetPlaceReviewsResponse_GetPlaceReviewsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+// Deprecated: Use GetPlaceReviewsResponse_GetPlaceReviewsStatus.Descriptor instead.
+func (GetPlaceReviewsResponse_GetPlaceReviewsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{115, 0}
+}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type VoteReviewHelpfulResponse_VoteReviewHelpfulStatus int32
+
+const (
+	VoteReviewHelpfulResponse_OK               VoteReviewHelpfulResponse_VoteReviewHelpfulStatus = 0
+	VoteReviewHelpfulResponse_USER_NOT_FOUND   VoteReviewHelpfulResponse_VoteReviewHelpfulStatus = 1
+	VoteReviewHelpfulResponse_REVIEW_NOT_FOUND VoteReviewHelpfulResponse_VoteReviewHelpfulStatus = 2
+	VoteReviewHelpfulResponse_NOT_ALLOWED      VoteReviewHelpfulResponse_VoteReviewHelpfulStatus = 3
+)
+
+// Enum value maps for VoteReviewHelpfulResponse_VoteReviewHelpfulStatus.
+var (
+	VoteReviewHelpfulResponse_VoteReviewHelpfulStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "REVIEW_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-	return mi.MessageOf(x)
-}
+	VoteReviewHelpfulResponse_VoteReviewHelpfulStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"REVIEW_NOT_FOUND": 2,
+		"NOT_ALLOWED":      3,
+	}
+)
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{30}
+func (x VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) Enum() *VoteReviewHelpfulResponse_VoteReviewHelpfulStatus {
+	p := new(VoteReviewHelpfulResponse_VoteReviewHelpfulStatus)
+	*p = x
+	return p
 }
 
-func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return LikePostResponse_OK
+func (x VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type PostDetailInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[55].Descriptor()
+}
 
-	PostId           int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string                 `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath []string               `protobuf:"bytes,4,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool                   `protobuf:"varint,5,opt,name=visible,proto3" json:"visible,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Comments         []*Comment             `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
-	LikedUsers       []*Like                `protobuf:"bytes,8,rep,name=liked_users,json=likedUsers,proto3" json:"liked_users,omitempty"`
+func (VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[55]
 }
 
-func (x *PostDetailInfo) Reset() {
-	*x = PostDetailInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PostDetailInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use VoteReviewHelpfulResponse_VoteReviewHelpfulStatus.Descriptor instead.
+func (VoteReviewHelpfulResponse_VoteReviewHelpfulStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{117, 0}
 }
 
-func (*PostDetailInfo) ProtoMessage() {}
+type AddBookmarkResponse_AddBookmarkStatus int32
 
-func (x *PostDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	AddBookmarkResponse_OK                   AddBookmarkResponse_AddBookmarkStatus = 0
+	AddBookmarkResponse_USER_NOT_FOUND       AddBookmarkResponse_AddBookmarkStatus = 1
+	AddBookmarkResponse_POST_NOT_FOUND       AddBookmarkResponse_AddBookmarkStatus = 2
+	AddBookmarkResponse_COLLECTION_NOT_FOUND AddBookmarkResponse_AddBookmarkStatus = 3
+)
+
+// Enum value maps for AddBookmarkResponse_AddBookmarkStatus.
+var (
+	AddBookmarkResponse_AddBookmarkStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "COLLECTION_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	AddBookmarkResponse_AddBookmarkStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"POST_NOT_FOUND":       2,
+		"COLLECTION_NOT_FOUND": 3,
+	}
+)
+
+func (x AddBookmarkResponse_AddBookmarkStatus) Enum() *AddBookmarkResponse_AddBookmarkStatus {
+	p := new(AddBookmarkResponse_AddBookmarkStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use PostDetailInfo.ProtoReflect.Descriptor instead.
-func (*PostDetailInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{31}
+func (x AddBookmarkResponse_AddBookmarkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PostDetailInfo) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (AddBookmarkResponse_AddBookmarkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[56].Descriptor()
 }
 
-func (x *PostDetailInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (AddBookmarkResponse_AddBookmarkStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[56]
 }
 
-func (x *PostDetailInfo) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (x AddBookmarkResponse_AddBookmarkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PostDetailInfo) GetContentImagePath() []string {
-	if x != nil {
-		return x.ContentImagePath
-	}
-	return nil
+// Deprecated: Use AddBookmarkResponse_AddBookmarkStatus.Descriptor instead.
+func (AddBookmarkResponse_AddBookmarkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{121, 0}
 }
 
-func (x *PostDetailInfo) GetVisible() bool {
-	if x != nil {
-		return x.Visible
+type RemoveBookmarkResponse_RemoveBookmarkStatus int32
+
+const (
+	RemoveBookmarkResponse_OK             RemoveBookmarkResponse_RemoveBookmarkStatus = 0
+	RemoveBookmarkResponse_USER_NOT_FOUND RemoveBookmarkResponse_RemoveBookmarkStatus = 1
+	RemoveBookmarkResponse_NOT_BOOKMARKED RemoveBookmarkResponse_RemoveBookmarkStatus = 2
+)
+
+// Enum value maps for RemoveBookmarkResponse_RemoveBookmarkStatus.
+var (
+	RemoveBookmarkResponse_RemoveBookmarkStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_BOOKMARKED",
 	}
-	return false
+	RemoveBookmarkResponse_RemoveBookmarkStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_BOOKMARKED": 2,
+	}
+)
+
+func (x RemoveBookmarkResponse_RemoveBookmarkStatus) Enum() *RemoveBookmarkResponse_RemoveBookmarkStatus {
+	p := new(RemoveBookmarkResponse_RemoveBookmarkStatus)
+	*p = x
+	return p
 }
 
-func (x *PostDetailInfo) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x RemoveBookmarkResponse_RemoveBookmarkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PostDetailInfo) GetComments() []*Comment {
-	if x != nil {
-		return x.Comments
-	}
-	return nil
+func (RemoveBookmarkResponse_RemoveBookmarkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[57].Descriptor()
 }
 
-func (x *PostDetailInfo) GetLikedUsers() []*Like {
-	if x != nil {
-		return x.LikedUsers
-	}
-	return nil
+func (RemoveBookmarkResponse_RemoveBookmarkStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[57]
 }
 
-type Comment struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x RemoveBookmarkResponse_RemoveBookmarkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	CommentId   int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId      int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+// Deprecated: Use RemoveBookmarkResponse_RemoveBookmarkStatus.Descriptor instead.
+func (RemoveBookmarkResponse_RemoveBookmarkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{123, 0}
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type GetBookmarksResponse_GetBookmarksStatus int32
+
+const (
+	GetBookmarksResponse_OK                   GetBookmarksResponse_GetBookmarksStatus = 0
+	GetBookmarksResponse_USER_NOT_FOUND       GetBookmarksResponse_GetBookmarksStatus = 1
+	GetBookmarksResponse_COLLECTION_NOT_FOUND GetBookmarksResponse_GetBookmarksStatus = 2
+)
+
+// Enum value maps for GetBookmarksResponse_GetBookmarksStatus.
+var (
+	GetBookmarksResponse_GetBookmarksStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "COLLECTION_NOT_FOUND",
+	}
+	GetBookmarksResponse_GetBookmarksStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"COLLECTION_NOT_FOUND": 2,
 	}
+)
+
+func (x GetBookmarksResponse_GetBookmarksStatus) Enum() *GetBookmarksResponse_GetBookmarksStatus {
+	p := new(GetBookmarksResponse_GetBookmarksStatus)
+	*p = x
+	return p
 }
 
-func (x *Comment) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetBookmarksResponse_GetBookmarksStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Comment) ProtoMessage() {}
+func (GetBookmarksResponse_GetBookmarksStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[58].Descriptor()
+}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetBookmarksResponse_GetBookmarksStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[58]
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{32}
+func (x GetBookmarksResponse_GetBookmarksStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Comment) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
-	}
-	return 0
+// Deprecated: Use GetBookmarksResponse_GetBookmarksStatus.Descriptor instead.
+func (GetBookmarksResponse_GetBookmarksStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{125, 0}
 }
 
-func (x *Comment) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+type CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus int32
+
+const (
+	CreateBookmarkCollectionResponse_OK             CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus = 0
+	CreateBookmarkCollectionResponse_USER_NOT_FOUND CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus = 1
+	CreateBookmarkCollectionResponse_INVALID_NAME   CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus = 2
+	CreateBookmarkCollectionResponse_ALREADY_EXISTS CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus = 3
+)
+
+// Enum value maps for CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus.
+var (
+	CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_NAME",
+		3: "ALREADY_EXISTS",
 	}
-	return 0
+	CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_NAME":   2,
+		"ALREADY_EXISTS": 3,
+	}
+)
+
+func (x CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) Enum() *CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus {
+	p := new(CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus)
+	*p = x
+	return p
 }
 
-func (x *Comment) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Comment) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[59].Descriptor()
 }
 
-type Like struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[59]
+}
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Like) Reset() {
-	*x = Like{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_authpost_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus.Descriptor instead.
+func (CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{128, 0}
+}
+
+type GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus int32
+
+const (
+	GetBookmarkCollectionsResponse_OK             GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus = 0
+	GetBookmarkCollectionsResponse_USER_NOT_FOUND GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus = 1
+)
+
+// Enum value maps for GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus.
+var (
+	GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+	}
+	GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
 	}
+)
+
+func (x GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) Enum() *GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus {
+	p := new(GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus)
+	*p = x
+	return p
 }
 
-func (x *Like) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Like) ProtoMessage() {}
+func (GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[60].Descriptor()
+}
 
-func (x *Like) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_authpost_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[60]
+}
+
+func (x GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus.Descriptor instead.
+func (GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{130, 0}
+}
+
+type EditBookmarkCollectionResponse_EditBookmarkCollectionStatus int32
+
+const (
+	EditBookmarkCollectionResponse_OK                   EditBookmarkCollectionResponse_EditBookmarkCollectionStatus = 0
+	EditBookmarkCollectionResponse_USER_NOT_FOUND       EditBookmarkCollectionResponse_EditBookmarkCollectionStatus = 1
+	EditBookmarkCollectionResponse_COLLECTION_NOT_FOUND EditBookmarkCollectionResponse_EditBookmarkCollectionStatus = 2
+	EditBookmarkCollectionResponse_INVALID_NAME         EditBookmarkCollectionResponse_EditBookmarkCollectionStatus = 3
+	EditBookmarkCollectionResponse_ALREADY_EXISTS       EditBookmarkCollectionResponse_EditBookmarkCollectionStatus = 4
+)
+
+// Enum value maps for EditBookmarkCollectionResponse_EditBookmarkCollectionStatus.
+var (
+	EditBookmarkCollectionResponse_EditBookmarkCollectionStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "COLLECTION_NOT_FOUND",
+		3: "INVALID_NAME",
+		4: "ALREADY_EXISTS",
 	}
-	return mi.MessageOf(x)
+	EditBookmarkCollectionResponse_EditBookmarkCollectionStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"COLLECTION_NOT_FOUND": 2,
+		"INVALID_NAME":         3,
+		"ALREADY_EXISTS":       4,
+	}
+)
+
+func (x EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) Enum() *EditBookmarkCollectionResponse_EditBookmarkCollectionStatus {
+	p := new(EditBookmarkCollectionResponse_EditBookmarkCollectionStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Like.ProtoReflect.Descriptor instead.
-func (*Like) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{33}
+func (x EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Like) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+func (EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[61].Descriptor()
+}
+
+func (EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[61]
+}
+
+func (x EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EditBookmarkCollectionResponse_EditBookmarkCollectionStatus.Descriptor instead.
+func (EditBookmarkCollectionResponse_EditBookmarkCollectionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{133, 0}
+}
+
+type DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus int32
+
+const (
+	DeleteBookmarkCollectionResponse_OK                   DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus = 0
+	DeleteBookmarkCollectionResponse_USER_NOT_FOUND       DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus = 1
+	DeleteBookmarkCollectionResponse_COLLECTION_NOT_FOUND DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus = 2
+)
+
+// Enum value maps for DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus.
+var (
+	DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "COLLECTION_NOT_FOUND",
 	}
-	return 0
+	DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"COLLECTION_NOT_FOUND": 2,
+	}
+)
+
+func (x DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) Enum() *DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus {
+	p := new(DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus)
+	*p = x
+	return p
 }
 
-func (x *Like) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+func (x DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[62].Descriptor()
+}
+
+func (DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[62]
+}
+
+func (x DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus.Descriptor instead.
+func (DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{135, 0}
+}
+
+type CommentPostResponse_CommentPostStatus int32
+
+const (
+	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
+	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
+	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+)
+
+// Enum value maps for CommentPostResponse_CommentPostStatus.
+var (
+	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
 	}
-	return 0
+	CommentPostResponse_CommentPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+	}
+)
+
+func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
+	p := new(CommentPostResponse_CommentPostStatus)
+	*p = x
+	return p
 }
 
-var File_pkg_types_proto_authpost_proto protoreflect.FileDescriptor
+func (x CommentPostResponse_CommentPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-var file_pkg_types_proto_authpost_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a, 0x1e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0xec, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x47, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a,
-	0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52,
-	0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x22, 0xe7,
-	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72,
-	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xb9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x43, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55,
-	0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54,
-	0x45, 0x44, 0x10, 0x02, 0x22, 0x9e, 0x03, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x20, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69,
-	0x72, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0c, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a,
-	0x0e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a,
-	0x0e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x33, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xd5, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x0e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
-	0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc9,
-	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x73, 0x49, 0x64, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x4f, 0x0a, 0x11, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x12,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
-	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52,
-	0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22,
-	0x51, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x97, 0x01,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x2e,
-	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x33,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x70, 0x6f, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x0f,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22,
-	0xa8, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
-	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
-	0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x53, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50,
-	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22,
-	0xc2, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
-	0x43, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
-	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x4c, 0x69,
-	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x40, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50,
-	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
-	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x02, 0x22, 0xc8, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69,
-	0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d,
-	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a,
-	0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x7d,
-	0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a,
-	0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0xe9, 0x09, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12,
-	0x70, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08,
-	0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12,
-	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55,
-	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
-	0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x68, 0x6f, 0x61, 0x6e, 0x67, 0x4e, 0x67, 0x75, 0x79, 0x65, 0x6e, 0x44, 0x65, 0x76,
-	0x33, 0x2f, 0x57, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x2f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
-	0x73, 0x74, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[63].Descriptor()
+}
+
+func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[63]
+}
+
+func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
+func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{137, 0}
 }
 
+type LikePostResponse_LikePostStatus int32
+
+const (
+	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
+	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 1
+	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 2
+)
+
+// Enum value maps for LikePostResponse_LikePostStatus.
 var (
-	file_pkg_types_proto_authpost_proto_rawDescOnce sync.Once
-	file_pkg_types_proto_authpost_proto_rawDescData = file_pkg_types_proto_authpost_proto_rawDesc
+	LikePostResponse_LikePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+	}
+	LikePostResponse_LikePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+	}
 )
 
-func file_pkg_types_proto_authpost_proto_rawDescGZIP() []byte {
-	file_pkg_types_proto_authpost_proto_rawDescOnce.Do(func() {
-		file_pkg_types_proto_authpost_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_types_proto_authpost_proto_rawDescData)
-	})
-	return file_pkg_types_proto_authpost_proto_rawDescData
+func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
+	p := new(LikePostResponse_LikePostStatus)
+	*p = x
+	return p
 }
 
-var file_pkg_types_proto_authpost_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
-var file_pkg_types_proto_authpost_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_pkg_types_proto_authpost_proto_goTypes = []interface{}{
-	(CheckUserAuthenticationResponse_CheckUserAuthenticationStatus)(0), // 0: authpost.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
-	(CreateUserResponse_CreateUserStatus)(0),                           // 1: authpost.CreateUserResponse.CreateUserStatus
-	(EditUserResponse_EditUserStatus)(0),                               // 2: authpost.EditUserResponse.EditUserStatus
-	(GetUserDetailInfoResponse_GetUserDetailInfoStatus)(0),             // 3: authpost.GetUserDetailInfoResponse.GetUserDetailInfoStatus
-	(GetUserFollowerResponse_GetUserFollowerStatus)(0),                 // 4: authpost.GetUserFollowerResponse.GetUserFollowerStatus
-	(GetUserFollowingResponse_GetUserFollowingStatus)(0),               // 5: authpost.GetUserFollowingResponse.GetUserFollowingStatus
-	(FollowUserResponse_FollowUserStatus)(0),                           // 6: authpost.FollowUserResponse.FollowUserStatus
-	(UnfollowUserResponse_UnfollowUserStatus)(0),                       // 7: authpost.UnfollowUserResponse.UnfollowUserStatus
-	(GetUserPostsResponse_GetUserPostsStatus)(0),                       // 8: authpost.GetUserPostsResponse.GetUserPostsStatus
-	(CreatePostResponse_CreatePostStatus)(0),                           // 9: authpost.CreatePostResponse.CreatePostStatus
-	(GetPostDetailInfoResponse_GetPostDetailInfoStatus)(0),             // 10: authpost.GetPostDetailInfoResponse.GetPostDetailInfoStatus
-	(EditPostResponse_EditPostStatus)(0),                               // 11: authpost.EditPostResponse.EditPostStatus
-	(DeletePostResponse_DeletePostStatus)(0),                           // 12: authpost.DeletePostResponse.DeletePostStatus
-	(CommentPostResponse_CommentPostStatus)(0),                         // 13: authpost.CommentPostResponse.CommentPostStatus
-	(LikePostResponse_LikePostStatus)(0),                               // 14: authpost.LikePostResponse.LikePostStatus
-	(*CheckUserAuthenticationRequest)(nil),                             // 15: authpost.CheckUserAuthenticationRequest
-	(*CheckUserAuthenticationResponse)(nil),                            // 16: authpost.CheckUserAuthenticationResponse
-	(*CreateUserRequest)(nil),                                          // 17: authpost.CreateUserRequest
-	(*CreateUserResponse)(nil),                                         // 18: authpost.CreateUserResponse
-	(*EditUserRequest)(nil),                                            // 19: authpost.EditUserRequest
-	(*EditUserResponse)(nil),                                           // 20: authpost.EditUserResponse
-	(*GetUserDetailInfoRequest)(nil),                                   // 21: authpost.GetUserDetailInfoRequest
-	(*GetUserDetailInfoResponse)(nil),                                  // 22: authpost.GetUserDetailInfoResponse
-	(*UserDetailInfo)(nil),                                             // 23: authpost.UserDetailInfo
-	(*GetUserFollowerRequest)(nil),                                     // 24: authpost.GetUserFollowerRequest
-	(*GetUserFollowerResponse)(nil),                                    // 25: authpost.GetUserFollowerResponse
-	(*GetUserFollowingRequest)(nil),                                    // 26: authpost.GetUserFollowingRequest
-	(*GetUserFollowingResponse)(nil),                                   // 27: authpost.GetUserFollowingResponse
-	(*FollowUserRequest)(nil),                                          // 28: authpost.FollowUserRequest
-	(*FollowUserResponse)(nil),                                         // 29: authpost.FollowUserResponse
-	(*UnfollowUserRequest)(nil),                                        // 30: authpost.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),                                       // 31: authpost.UnfollowUserResponse
-	(*GetUserPostsRequest)(nil),                                        // 32: authpost.GetUserPostsRequest
-	(*GetUserPostsResponse)(nil),                                       // 33: authpost.GetUserPostsResponse
-	(*CreatePostRequest)(nil),                                          // 34: authpost.CreatePostRequest
-	(*CreatePostResponse)(nil),                                         // 35: authpost.CreatePostResponse
-	(*GetPostDetailInfoRequest)(nil),                                   // 36: authpost.GetPostDetailInfoRequest
-	(*GetPostDetailInfoResponse)(nil),                                  // 37: authpost.GetPostDetailInfoResponse
-	(*EditPostRequest)(nil),                                            // 38: authpost.EditPostRequest
-	(*EditPostResponse)(nil),                                           // 39: authpost.EditPostResponse
-	(*DeletePostRequest)(nil),                                          // 40: authpost.DeletePostRequest
-	(*DeletePostResponse)(nil),                                         // 41: authpost.DeletePostResponse
-	(*CommentPostRequest)(nil),                                         // 42: authpost.CommentPostRequest
-	(*CommentPostResponse)(nil),                                        // 43: authpost.CommentPostResponse
-	(*LikePostRequest)(nil),                                            // 44: authpost.LikePostRequest
-	(*LikePostResponse)(nil),                                           // 45: authpost.LikePostResponse
-	(*PostDetailInfo)(nil),                                             // 46: authpost.PostDetailInfo
-	(*Comment)(nil),                                                    // 47: authpost.Comment
-	(*Like)(nil),                                                       // 48: authpost.Like
-	(*timestamppb.Timestamp)(nil),                                      // 49: google.protobuf.Timestamp
+func (x LikePostResponse_LikePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-var file_pkg_types_proto_authpost_proto_depIdxs = []int32{
-	0,  // 0: authpost.CheckUserAuthenticationResponse.status:type_name -> authpost.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
-	49, // 1: authpost.CreateUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	1,  // 2: authpost.CreateUserResponse.status:type_name -> authpost.CreateUserResponse.CreateUserStatus
-	49, // 3: authpost.EditUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	2,  // 4: authpost.EditUserResponse.status:type_name -> authpost.EditUserResponse.EditUserStatus
-	3,  // 5: authpost.GetUserDetailInfoResponse.status:type_name -> authpost.GetUserDetailInfoResponse.GetUserDetailInfoStatus
-	23, // 6: authpost.GetUserDetailInfoResponse.user:type_name -> authpost.UserDetailInfo
-	49, // 7: authpost.UserDetailInfo.date_of_birth:type_name -> google.protobuf.Timestamp
-	4,  // 8: authpost.GetUserFollowerResponse.status:type_name -> authpost.GetUserFollowerResponse.GetUserFollowerStatus
-	5,  // 9: authpost.GetUserFollowingResponse.status:type_name -> authpost.GetUserFollowingResponse.GetUserFollowingStatus
-	6,  // 10: authpost.FollowUserResponse.status:type_name -> authpost.FollowUserResponse.FollowUserStatus
-	7,  // 11: authpost.UnfollowUserResponse.status:type_name -> authpost.UnfollowUserResponse.UnfollowUserStatus
-	8,  // 12: authpost.GetUserPostsResponse.status:type_name -> authpost.GetUserPostsResponse.GetUserPostsStatus
-	9,  // 13: authpost.CreatePostResponse.status:type_name -> authpost.CreatePostResponse.CreatePostStatus
-	10, // 14: authpost.GetPostDetailInfoResponse.status:type_name -> authpost.GetPostDetailInfoResponse.GetPostDetailInfoStatus
-	46, // 15: authpost.GetPostDetailInfoResponse.post:type_name -> authpost.PostDetailInfo
-	11, // 16: authpost.EditPostResponse.status:type_name -> authpost.EditPostResponse.EditPostStatus
-	12, // 17: authpost.DeletePostResponse.status:type_name -> authpost.DeletePostResponse.DeletePostStatus
-	13, // 18: authpost.CommentPostResponse.status:type_name -> authpost.CommentPostResponse.CommentPostStatus
-	14, // 19: authpost.LikePostResponse.status:type_name -> authpost.LikePostResponse.LikePostStatus
-	49, // 20: authpost.PostDetailInfo.created_at:type_name -> google.protobuf.Timestamp
-	47, // 21: authpost.PostDetailInfo.comments:type_name -> authpost.Comment
-	48, // 22: authpost.PostDetailInfo.liked_users:type_name -> authpost.Like
-	15, // 23: authpost.AuthenticateAndPost.CheckUserAuthentication:input_type -> authpost.CheckUserAuthenticationRequest
-	17, // 24: authpost.AuthenticateAndPost.CreateUser:input_type -> authpost.CreateUserRequest
-	19, // 25: authpost.AuthenticateAndPost.EditUser:input_type -> authpost.EditUserRequest
-	21, // 26: authpost.AuthenticateAndPost.GetUserDetailInfo:input_type -> authpost.GetUserDetailInfoRequest
-	24, // 27: authpost.AuthenticateAndPost.GetUserFollower:input_type -> authpost.GetUserFollowerRequest
-	26, // 28: authpost.AuthenticateAndPost.GetUserFollowing:input_type -> authpost.GetUserFollowingRequest
-	28, // 29: authpost.AuthenticateAndPost.FollowUser:input_type -> authpost.FollowUserRequest
-	30, // 30: authpost.AuthenticateAndPost.UnfollowUser:input_type -> authpost.UnfollowUserRequest
-	32, // 31: authpost.AuthenticateAndPost.GetUserPosts:input_type -> authpost.GetUserPostsRequest
-	34, // 32: authpost.AuthenticateAndPost.CreatePost:input_type -> authpost.CreatePostRequest
-	36, // 33: authpost.AuthenticateAndPost.GetPostDetailInfo:input_type -> authpost.GetPostDetailInfoRequest
-	38, // 34: authpost.AuthenticateAndPost.EditPost:input_type -> authpost.EditPostRequest
-	40, // 35: authpost.AuthenticateAndPost.DeletePost:input_type -> authpost.DeletePostRequest
-	42, // 36: authpost.AuthenticateAndPost.CommentPost:input_type -> authpost.CommentPostRequest
-	44, // 37: authpost.AuthenticateAndPost.LikePost:input_type -> authpost.LikePostRequest
-	16, // 38: authpost.AuthenticateAndPost.CheckUserAuthentication:output_type -> authpost.CheckUserAuthenticationResponse
-	18, // 39: authpost.AuthenticateAndPost.CreateUser:output_type -> authpost.CreateUserResponse
-	20, // 40: authpost.AuthenticateAndPost.EditUser:output_type -> authpost.EditUserResponse
-	22, // 41: authpost.AuthenticateAndPost.GetUserDetailInfo:output_type -> authpost.GetUserDetailInfoResponse
-	25, // 42: authpost.AuthenticateAndPost.GetUserFollower:output_type -> authpost.GetUserFollowerResponse
-	27, // 43: authpost.AuthenticateAndPost.GetUserFollowing:output_type -> authpost.GetUserFollowingResponse
-	29, // 44: authpost.AuthenticateAndPost.FollowUser:output_type -> authpost.FollowUserResponse
-	31, // 45: authpost.AuthenticateAndPost.UnfollowUser:output_type -> authpost.UnfollowUserResponse
-	33, // 46: authpost.AuthenticateAndPost.GetUserPosts:output_type -> authpost.GetUserPostsResponse
-	35, // 47: authpost.AuthenticateAndPost.CreatePost:output_type -> authpost.CreatePostResponse
-	37, // 48: authpost.AuthenticateAndPost.GetPostDetailInfo:output_type -> authpost.GetPostDetailInfoResponse
-	39, // 49: authpost.AuthenticateAndPost.EditPost:output_type -> authpost.EditPostResponse
-	41, // 50: authpost.AuthenticateAndPost.DeletePost:output_type -> authpost.DeletePostResponse
-	43, // 51: authpost.AuthenticateAndPost.CommentPost:output_type -> authpost.CommentPostResponse
-	45, // 52: authpost.AuthenticateAndPost.LikePost:output_type -> authpost.LikePostResponse
-	38, // [38:53] is the sub-list for method output_type
-	23, // [23:38] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[64].Descriptor()
 }
 
-func init() { file_pkg_types_proto_authpost_proto_init() }
-func file_pkg_types_proto_authpost_proto_init() {
-	if File_pkg_types_proto_authpost_proto != nil {
-		return
+func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[64]
+}
+
+func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
+func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{139, 0}
+}
+
+type CreateDraftResponse_CreateDraftStatus int32
+
+const (
+	CreateDraftResponse_OK                    CreateDraftResponse_CreateDraftStatus = 0
+	CreateDraftResponse_USER_NOT_FOUND        CreateDraftResponse_CreateDraftStatus = 1
+	CreateDraftResponse_INVALID_LOCATION      CreateDraftResponse_CreateDraftStatus = 2
+	CreateDraftResponse_PLACE_NOT_FOUND       CreateDraftResponse_CreateDraftStatus = 3
+	CreateDraftResponse_TRIP_NOT_FOUND        CreateDraftResponse_CreateDraftStatus = 4
+	CreateDraftResponse_QUOTED_POST_NOT_FOUND CreateDraftResponse_CreateDraftStatus = 5
+	CreateDraftResponse_TOO_MANY_DRAFTS       CreateDraftResponse_CreateDraftStatus = 6
+	CreateDraftResponse_INVALID_MEDIA         CreateDraftResponse_CreateDraftStatus = 7
+)
+
+// Enum value maps for CreateDraftResponse_CreateDraftStatus.
+var (
+	CreateDraftResponse_CreateDraftStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_LOCATION",
+		3: "PLACE_NOT_FOUND",
+		4: "TRIP_NOT_FOUND",
+		5: "QUOTED_POST_NOT_FOUND",
+		6: "TOO_MANY_DRAFTS",
+		7: "INVALID_MEDIA",
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_types_proto_authpost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckUserAuthenticationRequest); i {
+	CreateDraftResponse_CreateDraftStatus_value = map[string]int32{
+		"OK":                    0,
+		"USER_NOT_FOUND":        1,
+		"INVALID_LOCATION":      2,
+		"PLACE_NOT_FOUND":       3,
+		"TRIP_NOT_FOUND":        4,
+		"QUOTED_POST_NOT_FOUND": 5,
+		"TOO_MANY_DRAFTS":       6,
+		"INVALID_MEDIA":         7,
+	}
+)
+
+func (x CreateDraftResponse_CreateDraftStatus) Enum() *CreateDraftResponse_CreateDraftStatus {
+	p := new(CreateDraftResponse_CreateDraftStatus)
+	*p = x
+	return p
+}
+
+func (x CreateDraftResponse_CreateDraftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateDraftResponse_CreateDraftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[65].Descriptor()
+}
+
+func (CreateDraftResponse_CreateDraftStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[65]
+}
+
+func (x CreateDraftResponse_CreateDraftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateDraftResponse_CreateDraftStatus.Descriptor instead.
+func (CreateDraftResponse_CreateDraftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{148, 0}
+}
+
+type GetDraftsResponse_GetDraftsStatus int32
+
+const (
+	GetDraftsResponse_OK             GetDraftsResponse_GetDraftsStatus = 0
+	GetDraftsResponse_USER_NOT_FOUND GetDraftsResponse_GetDraftsStatus = 1
+)
+
+// Enum value maps for GetDraftsResponse_GetDraftsStatus.
+var (
+	GetDraftsResponse_GetDraftsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+	}
+	GetDraftsResponse_GetDraftsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetDraftsResponse_GetDraftsStatus) Enum() *GetDraftsResponse_GetDraftsStatus {
+	p := new(GetDraftsResponse_GetDraftsStatus)
+	*p = x
+	return p
+}
+
+func (x GetDraftsResponse_GetDraftsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetDraftsResponse_GetDraftsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[66].Descriptor()
+}
+
+func (GetDraftsResponse_GetDraftsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[66]
+}
+
+func (x GetDraftsResponse_GetDraftsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetDraftsResponse_GetDraftsStatus.Descriptor instead.
+func (GetDraftsResponse_GetDraftsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{150, 0}
+}
+
+type EditDraftResponse_EditDraftStatus int32
+
+const (
+	EditDraftResponse_OK             EditDraftResponse_EditDraftStatus = 0
+	EditDraftResponse_USER_NOT_FOUND EditDraftResponse_EditDraftStatus = 1
+	// DRAFT_NOT_FOUND is also returned for the drafts of other users
+	EditDraftResponse_DRAFT_NOT_FOUND       EditDraftResponse_EditDraftStatus = 2
+	EditDraftResponse_INVALID_LOCATION      EditDraftResponse_EditDraftStatus = 3
+	EditDraftResponse_PLACE_NOT_FOUND       EditDraftResponse_EditDraftStatus = 4
+	EditDraftResponse_TRIP_NOT_FOUND        EditDraftResponse_EditDraftStatus = 5
+	EditDraftResponse_QUOTED_POST_NOT_FOUND EditDraftResponse_EditDraftStatus = 6
+	EditDraftResponse_INVALID_MEDIA         EditDraftResponse_EditDraftStatus = 7
+)
+
+// Enum value maps for EditDraftResponse_EditDraftStatus.
+var (
+	EditDraftResponse_EditDraftStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "DRAFT_NOT_FOUND",
+		3: "INVALID_LOCATION",
+		4: "PLACE_NOT_FOUND",
+		5: "TRIP_NOT_FOUND",
+		6: "QUOTED_POST_NOT_FOUND",
+		7: "INVALID_MEDIA",
+	}
+	EditDraftResponse_EditDraftStatus_value = map[string]int32{
+		"OK":                    0,
+		"USER_NOT_FOUND":        1,
+		"DRAFT_NOT_FOUND":       2,
+		"INVALID_LOCATION":      3,
+		"PLACE_NOT_FOUND":       4,
+		"TRIP_NOT_FOUND":        5,
+		"QUOTED_POST_NOT_FOUND": 6,
+		"INVALID_MEDIA":         7,
+	}
+)
+
+func (x EditDraftResponse_EditDraftStatus) Enum() *EditDraftResponse_EditDraftStatus {
+	p := new(EditDraftResponse_EditDraftStatus)
+	*p = x
+	return p
+}
+
+func (x EditDraftResponse_EditDraftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EditDraftResponse_EditDraftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[67].Descriptor()
+}
+
+func (EditDraftResponse_EditDraftStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[67]
+}
+
+func (x EditDraftResponse_EditDraftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EditDraftResponse_EditDraftStatus.Descriptor instead.
+func (EditDraftResponse_EditDraftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{153, 0}
+}
+
+type DeleteDraftResponse_DeleteDraftStatus int32
+
+const (
+	DeleteDraftResponse_OK              DeleteDraftResponse_DeleteDraftStatus = 0
+	DeleteDraftResponse_USER_NOT_FOUND  DeleteDraftResponse_DeleteDraftStatus = 1
+	DeleteDraftResponse_DRAFT_NOT_FOUND DeleteDraftResponse_DeleteDraftStatus = 2
+)
+
+// Enum value maps for DeleteDraftResponse_DeleteDraftStatus.
+var (
+	DeleteDraftResponse_DeleteDraftStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "DRAFT_NOT_FOUND",
+	}
+	DeleteDraftResponse_DeleteDraftStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"DRAFT_NOT_FOUND": 2,
+	}
+)
+
+func (x DeleteDraftResponse_DeleteDraftStatus) Enum() *DeleteDraftResponse_DeleteDraftStatus {
+	p := new(DeleteDraftResponse_DeleteDraftStatus)
+	*p = x
+	return p
+}
+
+func (x DeleteDraftResponse_DeleteDraftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteDraftResponse_DeleteDraftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[68].Descriptor()
+}
+
+func (DeleteDraftResponse_DeleteDraftStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[68]
+}
+
+func (x DeleteDraftResponse_DeleteDraftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteDraftResponse_DeleteDraftStatus.Descriptor instead.
+func (DeleteDraftResponse_DeleteDraftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{155, 0}
+}
+
+type PublishDraftResponse_PublishDraftStatus int32
+
+const (
+	PublishDraftResponse_OK              PublishDraftResponse_PublishDraftStatus = 0
+	PublishDraftResponse_USER_NOT_FOUND  PublishDraftResponse_PublishDraftStatus = 1
+	PublishDraftResponse_DRAFT_NOT_FOUND PublishDraftResponse_PublishDraftStatus = 2
+	// EMPTY_DRAFT is returned for drafts without text
+	PublishDraftResponse_EMPTY_DRAFT           PublishDraftResponse_PublishDraftStatus = 3
+	PublishDraftResponse_INVALID_LOCATION      PublishDraftResponse_PublishDraftStatus = 4
+	PublishDraftResponse_PLACE_NOT_FOUND       PublishDraftResponse_PublishDraftStatus = 5
+	PublishDraftResponse_TRIP_NOT_FOUND        PublishDraftResponse_PublishDraftStatus = 6
+	PublishDraftResponse_QUOTED_POST_NOT_FOUND PublishDraftResponse_PublishDraftStatus = 7
+	PublishDraftResponse_INVALID_PUBLISH_AT    PublishDraftResponse_PublishDraftStatus = 8
+	// INVALID_MEDIA is returned for drafts whose media no longer pass the checks of a new post
+	PublishDraftResponse_INVALID_MEDIA PublishDraftResponse_PublishDraftStatus = 9
+)
+
+// Enum value maps for PublishDraftResponse_PublishDraftStatus.
+var (
+	PublishDraftResponse_PublishDraftStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "DRAFT_NOT_FOUND",
+		3: "EMPTY_DRAFT",
+		4: "INVALID_LOCATION",
+		5: "PLACE_NOT_FOUND",
+		6: "TRIP_NOT_FOUND",
+		7: "QUOTED_POST_NOT_FOUND",
+		8: "INVALID_PUBLISH_AT",
+		9: "INVALID_MEDIA",
+	}
+	PublishDraftResponse_PublishDraftStatus_value = map[string]int32{
+		"OK":                    0,
+		"USER_NOT_FOUND":        1,
+		"DRAFT_NOT_FOUND":       2,
+		"EMPTY_DRAFT":           3,
+		"INVALID_LOCATION":      4,
+		"PLACE_NOT_FOUND":       5,
+		"TRIP_NOT_FOUND":        6,
+		"QUOTED_POST_NOT_FOUND": 7,
+		"INVALID_PUBLISH_AT":    8,
+		"INVALID_MEDIA":         9,
+	}
+)
+
+func (x PublishDraftResponse_PublishDraftStatus) Enum() *PublishDraftResponse_PublishDraftStatus {
+	p := new(PublishDraftResponse_PublishDraftStatus)
+	*p = x
+	return p
+}
+
+func (x PublishDraftResponse_PublishDraftStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishDraftResponse_PublishDraftStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[69].Descriptor()
+}
+
+func (PublishDraftResponse_PublishDraftStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[69]
+}
+
+func (x PublishDraftResponse_PublishDraftStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishDraftResponse_PublishDraftStatus.Descriptor instead.
+func (PublishDraftResponse_PublishDraftStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{157, 0}
+}
+
+type VotePollResponse_VotePollStatus int32
+
+const (
+	VotePollResponse_OK             VotePollResponse_VotePollStatus = 0
+	VotePollResponse_USER_NOT_FOUND VotePollResponse_VotePollStatus = 1
+	VotePollResponse_POST_NOT_FOUND VotePollResponse_VotePollStatus = 2
+	VotePollResponse_POLL_NOT_FOUND VotePollResponse_VotePollStatus = 3
+	VotePollResponse_POLL_CLOSED    VotePollResponse_VotePollStatus = 4
+	// INVALID_OPTIONS is returned for options of another poll, duplicated options,
+	// no option, or several options in a single choice poll
+	VotePollResponse_INVALID_OPTIONS VotePollResponse_VotePollStatus = 5
+)
+
+// Enum value maps for VotePollResponse_VotePollStatus.
+var (
+	VotePollResponse_VotePollStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "POLL_NOT_FOUND",
+		4: "POLL_CLOSED",
+		5: "INVALID_OPTIONS",
+	}
+	VotePollResponse_VotePollStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"POST_NOT_FOUND":  2,
+		"POLL_NOT_FOUND":  3,
+		"POLL_CLOSED":     4,
+		"INVALID_OPTIONS": 5,
+	}
+)
+
+func (x VotePollResponse_VotePollStatus) Enum() *VotePollResponse_VotePollStatus {
+	p := new(VotePollResponse_VotePollStatus)
+	*p = x
+	return p
+}
+
+func (x VotePollResponse_VotePollStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VotePollResponse_VotePollStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[70].Descriptor()
+}
+
+func (VotePollResponse_VotePollStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[70]
+}
+
+func (x VotePollResponse_VotePollStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VotePollResponse_VotePollStatus.Descriptor instead.
+func (VotePollResponse_VotePollStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{161, 0}
+}
+
+type PinPostResponse_PinPostStatus int32
+
+const (
+	PinPostResponse_OK             PinPostResponse_PinPostStatus = 0
+	PinPostResponse_USER_NOT_FOUND PinPostResponse_PinPostStatus = 1
+	PinPostResponse_POST_NOT_FOUND PinPostResponse_PinPostStatus = 2
+	// NOT_ALLOWED is returned for posts of other users and for reposts
+	PinPostResponse_NOT_ALLOWED PinPostResponse_PinPostStatus = 3
+	// NOT_PINNABLE is returned for private and scheduled posts
+	PinPostResponse_NOT_PINNABLE  PinPostResponse_PinPostStatus = 4
+	PinPostResponse_TOO_MANY_PINS PinPostResponse_PinPostStatus = 5
+)
+
+// Enum value maps for PinPostResponse_PinPostStatus.
+var (
+	PinPostResponse_PinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "NOT_PINNABLE",
+		5: "TOO_MANY_PINS",
+	}
+	PinPostResponse_PinPostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"POST_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+		"NOT_PINNABLE":   4,
+		"TOO_MANY_PINS":  5,
+	}
+)
+
+func (x PinPostResponse_PinPostStatus) Enum() *PinPostResponse_PinPostStatus {
+	p := new(PinPostResponse_PinPostStatus)
+	*p = x
+	return p
+}
+
+func (x PinPostResponse_PinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PinPostResponse_PinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[71].Descriptor()
+}
+
+func (PinPostResponse_PinPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[71]
+}
+
+func (x PinPostResponse_PinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PinPostResponse_PinPostStatus.Descriptor instead.
+func (PinPostResponse_PinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{163, 0}
+}
+
+type UnpinPostResponse_UnpinPostStatus int32
+
+const (
+	UnpinPostResponse_OK             UnpinPostResponse_UnpinPostStatus = 0
+	UnpinPostResponse_USER_NOT_FOUND UnpinPostResponse_UnpinPostStatus = 1
+	UnpinPostResponse_POST_NOT_FOUND UnpinPostResponse_UnpinPostStatus = 2
+	UnpinPostResponse_NOT_ALLOWED    UnpinPostResponse_UnpinPostStatus = 3
+	UnpinPostResponse_NOT_PINNED     UnpinPostResponse_UnpinPostStatus = 4
+)
+
+// Enum value maps for UnpinPostResponse_UnpinPostStatus.
+var (
+	UnpinPostResponse_UnpinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "NOT_ALLOWED",
+		4: "NOT_PINNED",
+	}
+	UnpinPostResponse_UnpinPostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"POST_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+		"NOT_PINNED":     4,
+	}
+)
+
+func (x UnpinPostResponse_UnpinPostStatus) Enum() *UnpinPostResponse_UnpinPostStatus {
+	p := new(UnpinPostResponse_UnpinPostStatus)
+	*p = x
+	return p
+}
+
+func (x UnpinPostResponse_UnpinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnpinPostResponse_UnpinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[72].Descriptor()
+}
+
+func (UnpinPostResponse_UnpinPostStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[72]
+}
+
+func (x UnpinPostResponse_UnpinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnpinPostResponse_UnpinPostStatus.Descriptor instead.
+func (UnpinPostResponse_UnpinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{165, 0}
+}
+
+type GetPostsByIdsResponse_GetPostsByIdsStatus int32
+
+const (
+	GetPostsByIdsResponse_OK             GetPostsByIdsResponse_GetPostsByIdsStatus = 0
+	GetPostsByIdsResponse_TOO_MANY_POSTS GetPostsByIdsResponse_GetPostsByIdsStatus = 1
+)
+
+// Enum value maps for GetPostsByIdsResponse_GetPostsByIdsStatus.
+var (
+	GetPostsByIdsResponse_GetPostsByIdsStatus_name = map[int32]string{
+		0: "OK",
+		1: "TOO_MANY_POSTS",
+	}
+	GetPostsByIdsResponse_GetPostsByIdsStatus_value = map[string]int32{
+		"OK":             0,
+		"TOO_MANY_POSTS": 1,
+	}
+)
+
+func (x GetPostsByIdsResponse_GetPostsByIdsStatus) Enum() *GetPostsByIdsResponse_GetPostsByIdsStatus {
+	p := new(GetPostsByIdsResponse_GetPostsByIdsStatus)
+	*p = x
+	return p
+}
+
+func (x GetPostsByIdsResponse_GetPostsByIdsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPostsByIdsResponse_GetPostsByIdsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[73].Descriptor()
+}
+
+func (GetPostsByIdsResponse_GetPostsByIdsStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[73]
+}
+
+func (x GetPostsByIdsResponse_GetPostsByIdsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPostsByIdsResponse_GetPostsByIdsStatus.Descriptor instead.
+func (GetPostsByIdsResponse_GetPostsByIdsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{169, 0}
+}
+
+type CreateStoryResponse_CreateStoryStatus int32
+
+const (
+	CreateStoryResponse_OK             CreateStoryResponse_CreateStoryStatus = 0
+	CreateStoryResponse_USER_NOT_FOUND CreateStoryResponse_CreateStoryStatus = 1
+	// INVALID_MEDIA is returned when the media is missing, is not an image, or has an invalid
+	// size, alt text or blurhash
+	CreateStoryResponse_INVALID_MEDIA CreateStoryResponse_CreateStoryStatus = 2
+)
+
+// Enum value maps for CreateStoryResponse_CreateStoryStatus.
+var (
+	CreateStoryResponse_CreateStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_MEDIA",
+	}
+	CreateStoryResponse_CreateStoryStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_MEDIA":  2,
+	}
+)
+
+func (x CreateStoryResponse_CreateStoryStatus) Enum() *CreateStoryResponse_CreateStoryStatus {
+	p := new(CreateStoryResponse_CreateStoryStatus)
+	*p = x
+	return p
+}
+
+func (x CreateStoryResponse_CreateStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateStoryResponse_CreateStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[74].Descriptor()
+}
+
+func (CreateStoryResponse_CreateStoryStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[74]
+}
+
+func (x CreateStoryResponse_CreateStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateStoryResponse_CreateStoryStatus.Descriptor instead.
+func (CreateStoryResponse_CreateStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{173, 0}
+}
+
+type DeleteStoryResponse_DeleteStoryStatus int32
+
+const (
+	DeleteStoryResponse_OK DeleteStoryResponse_DeleteStoryStatus = 0
+	// STORY_NOT_FOUND is also returned for expired stories
+	DeleteStoryResponse_STORY_NOT_FOUND DeleteStoryResponse_DeleteStoryStatus = 1
+	DeleteStoryResponse_NOT_ALLOWED     DeleteStoryResponse_DeleteStoryStatus = 2
+)
+
+// Enum value maps for DeleteStoryResponse_DeleteStoryStatus.
+var (
+	DeleteStoryResponse_DeleteStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+		2: "NOT_ALLOWED",
+	}
+	DeleteStoryResponse_DeleteStoryStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+		"NOT_ALLOWED":     2,
+	}
+)
+
+func (x DeleteStoryResponse_DeleteStoryStatus) Enum() *DeleteStoryResponse_DeleteStoryStatus {
+	p := new(DeleteStoryResponse_DeleteStoryStatus)
+	*p = x
+	return p
+}
+
+func (x DeleteStoryResponse_DeleteStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteStoryResponse_DeleteStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[75].Descriptor()
+}
+
+func (DeleteStoryResponse_DeleteStoryStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[75]
+}
+
+func (x DeleteStoryResponse_DeleteStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteStoryResponse_DeleteStoryStatus.Descriptor instead.
+func (DeleteStoryResponse_DeleteStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{175, 0}
+}
+
+type GetStoryTrayResponse_GetStoryTrayStatus int32
+
+const (
+	GetStoryTrayResponse_OK             GetStoryTrayResponse_GetStoryTrayStatus = 0
+	GetStoryTrayResponse_USER_NOT_FOUND GetStoryTrayResponse_GetStoryTrayStatus = 1
+)
+
+// Enum value maps for GetStoryTrayResponse_GetStoryTrayStatus.
+var (
+	GetStoryTrayResponse_GetStoryTrayStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+	}
+	GetStoryTrayResponse_GetStoryTrayStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetStoryTrayResponse_GetStoryTrayStatus) Enum() *GetStoryTrayResponse_GetStoryTrayStatus {
+	p := new(GetStoryTrayResponse_GetStoryTrayStatus)
+	*p = x
+	return p
+}
+
+func (x GetStoryTrayResponse_GetStoryTrayStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStoryTrayResponse_GetStoryTrayStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[76].Descriptor()
+}
+
+func (GetStoryTrayResponse_GetStoryTrayStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[76]
+}
+
+func (x GetStoryTrayResponse_GetStoryTrayStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStoryTrayResponse_GetStoryTrayStatus.Descriptor instead.
+func (GetStoryTrayResponse_GetStoryTrayStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{177, 0}
+}
+
+type ViewStoryResponse_ViewStoryStatus int32
+
+const (
+	ViewStoryResponse_OK ViewStoryResponse_ViewStoryStatus = 0
+	// STORY_NOT_FOUND is also returned for expired stories and stories the viewer may not see
+	ViewStoryResponse_STORY_NOT_FOUND ViewStoryResponse_ViewStoryStatus = 1
+)
+
+// Enum value maps for ViewStoryResponse_ViewStoryStatus.
+var (
+	ViewStoryResponse_ViewStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+	}
+	ViewStoryResponse_ViewStoryStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+	}
+)
+
+func (x ViewStoryResponse_ViewStoryStatus) Enum() *ViewStoryResponse_ViewStoryStatus {
+	p := new(ViewStoryResponse_ViewStoryStatus)
+	*p = x
+	return p
+}
+
+func (x ViewStoryResponse_ViewStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ViewStoryResponse_ViewStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[77].Descriptor()
+}
+
+func (ViewStoryResponse_ViewStoryStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[77]
+}
+
+func (x ViewStoryResponse_ViewStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ViewStoryResponse_ViewStoryStatus.Descriptor instead.
+func (ViewStoryResponse_ViewStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{181, 0}
+}
+
+type GetStoryViewersResponse_GetStoryViewersStatus int32
+
+const (
+	GetStoryViewersResponse_OK              GetStoryViewersResponse_GetStoryViewersStatus = 0
+	GetStoryViewersResponse_STORY_NOT_FOUND GetStoryViewersResponse_GetStoryViewersStatus = 1
+	GetStoryViewersResponse_NOT_ALLOWED     GetStoryViewersResponse_GetStoryViewersStatus = 2
+)
+
+// Enum value maps for GetStoryViewersResponse_GetStoryViewersStatus.
+var (
+	GetStoryViewersResponse_GetStoryViewersStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+		2: "NOT_ALLOWED",
+	}
+	GetStoryViewersResponse_GetStoryViewersStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+		"NOT_ALLOWED":     2,
+	}
+)
+
+func (x GetStoryViewersResponse_GetStoryViewersStatus) Enum() *GetStoryViewersResponse_GetStoryViewersStatus {
+	p := new(GetStoryViewersResponse_GetStoryViewersStatus)
+	*p = x
+	return p
+}
+
+func (x GetStoryViewersResponse_GetStoryViewersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStoryViewersResponse_GetStoryViewersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_authpost_proto_enumTypes[78].Descriptor()
+}
+
+func (GetStoryViewersResponse_GetStoryViewersStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_authpost_proto_enumTypes[78]
+}
+
+func (x GetStoryViewersResponse_GetStoryViewersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStoryViewersResponse_GetStoryViewersStatus.Descriptor instead.
+func (GetStoryViewersResponse_GetStoryViewersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{183, 0}
+}
+
+type CheckUserAuthenticationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+}
+
+func (x *CheckUserAuthenticationRequest) Reset() {
+	*x = CheckUserAuthenticationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserAuthenticationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserAuthenticationRequest) ProtoMessage() {}
+
+func (x *CheckUserAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*CheckUserAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckUserAuthenticationRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CheckUserAuthenticationRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+type CheckUserAuthenticationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CheckUserAuthenticationResponse_CheckUserAuthenticationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CheckUserAuthenticationResponse_CheckUserAuthenticationStatus" json:"status,omitempty"`
+	UserId int64                                                         `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CheckUserAuthenticationResponse) Reset() {
+	*x = CheckUserAuthenticationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserAuthenticationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserAuthenticationResponse) ProtoMessage() {}
+
+func (x *CheckUserAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*CheckUserAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckUserAuthenticationResponse) GetStatus() CheckUserAuthenticationResponse_CheckUserAuthenticationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CheckUserAuthenticationResponse_OK
+}
+
+func (x *CheckUserAuthenticationResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName     string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string                 `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	FirstName    string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName     string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Email        string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreateUserResponse_CreateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateUserResponse_CreateUserStatus" json:"status,omitempty"`
+	UserId int64                               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateUserResponse) GetStatus() CreateUserResponse_CreateUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateUserResponse_OK
+}
+
+func (x *CreateUserResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EditUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPassword   *string                `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
+	FirstName      *string                `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName       *string                `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	DateOfBirth    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"`
+	ProfilePicture *string                `protobuf:"bytes,6,opt,name=profile_picture,json=profilePicture,proto3,oneof" json:"profile_picture,omitempty"`
+	CoverPicture   *string                `protobuf:"bytes,7,opt,name=cover_picture,json=coverPicture,proto3,oneof" json:"cover_picture,omitempty"`
+}
+
+func (x *EditUserRequest) Reset() {
+	*x = EditUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserRequest) ProtoMessage() {}
+
+func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
+func (*EditUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EditUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditUserRequest) GetUserPassword() string {
+	if x != nil && x.UserPassword != nil {
+		return *x.UserPassword
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *EditUserRequest) GetProfilePicture() string {
+	if x != nil && x.ProfilePicture != nil {
+		return *x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetCoverPicture() string {
+	if x != nil && x.CoverPicture != nil {
+		return *x.CoverPicture
+	}
+	return ""
+}
+
+type EditUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditUserResponse_EditUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditUserResponse_EditUserStatus" json:"status,omitempty"`
+}
+
+func (x *EditUserResponse) Reset() {
+	*x = EditUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserResponse) ProtoMessage() {}
+
+func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
+func (*EditUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditUserResponse) GetStatus() EditUserResponse_EditUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditUserResponse_OK
+}
+
+type GetUserDetailInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// viewer_id is the user reading the profile, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetUserDetailInfoRequest) Reset() {
+	*x = GetUserDetailInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDetailInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailInfoRequest) ProtoMessage() {}
+
+func (x *GetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDetailInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserDetailInfoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserDetailInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetUserDetailInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetUserDetailInfoResponse_GetUserDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserDetailInfoResponse_GetUserDetailInfoStatus" json:"status,omitempty"`
+	User   *UserDetailInfo                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserDetailInfoResponse) Reset() {
+	*x = GetUserDetailInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDetailInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailInfoResponse) ProtoMessage() {}
+
+func (x *GetUserDetailInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDetailInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserDetailInfoResponse) GetStatus() GetUserDetailInfoResponse_GetUserDetailInfoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserDetailInfoResponse_OK
+}
+
+func (x *GetUserDetailInfoResponse) GetUser() *UserDetailInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserDetailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName      string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Email          string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	ProfilePicture string                 `protobuf:"bytes,7,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	CoverPicture   string                 `protobuf:"bytes,8,opt,name=cover_picture,json=coverPicture,proto3" json:"cover_picture,omitempty"`
+	// Per-viewer fields, only set when the request carries a viewer_id.
+	// date_of_birth and email are only returned to the user themselves.
+	Following  bool `protobuf:"varint,9,opt,name=following,proto3" json:"following,omitempty"`                      // the viewer follows this user
+	FollowedBy bool `protobuf:"varint,10,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"` // this user follows the viewer
+	// pinned_post_ids are the posts pinned to the profile that the viewer can read, most recently pinned first
+	PinnedPostIds []int64 `protobuf:"varint,11,rep,packed,name=pinned_post_ids,json=pinnedPostIds,proto3" json:"pinned_post_ids,omitempty"`
+}
+
+func (x *UserDetailInfo) Reset() {
+	*x = UserDetailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetailInfo) ProtoMessage() {}
+
+func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
+func (*UserDetailInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserDetailInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UserDetailInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetCoverPicture() string {
+	if x != nil {
+		return x.CoverPicture
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *UserDetailInfo) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *UserDetailInfo) GetPinnedPostIds() []int64 {
+	if x != nil {
+		return x.PinnedPostIds
+	}
+	return nil
+}
+
+// AutocompleteUsers returns the users whose user name, first name, last name or full name
+// starts with the prefix. Users the viewer follows come first.
+type AutocompleteUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prefix is matched case-insensitively, a leading '@' is ignored
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// viewer_id is the user typing, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit    int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AutocompleteUsersRequest) Reset() {
+	*x = AutocompleteUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutocompleteUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteUsersRequest) ProtoMessage() {}
+
+func (x *AutocompleteUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteUsersRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AutocompleteUsersRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AutocompleteUsersRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *AutocompleteUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AutocompleteUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status AutocompleteUsersResponse_AutocompleteUsersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.AutocompleteUsersResponse_AutocompleteUsersStatus" json:"status,omitempty"`
+	// users are returned without date_of_birth and email
+	Users []*UserDetailInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AutocompleteUsersResponse) Reset() {
+	*x = AutocompleteUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutocompleteUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteUsersResponse) ProtoMessage() {}
+
+func (x *AutocompleteUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteUsersResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AutocompleteUsersResponse) GetStatus() AutocompleteUsersResponse_AutocompleteUsersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AutocompleteUsersResponse_OK
+}
+
+func (x *AutocompleteUsersResponse) GetUsers() []*UserDetailInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUserFollowerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserFollowerRequest) Reset() {
+	*x = GetUserFollowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowerRequest) ProtoMessage() {}
+
+func (x *GetUserFollowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowerRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFollowerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserFollowerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserFollowerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       GetUserFollowerResponse_GetUserFollowerStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserFollowerResponse_GetUserFollowerStatus" json:"status,omitempty"`
+	FollowersIds []int64                                       `protobuf:"varint,2,rep,packed,name=followersIds,proto3" json:"followersIds,omitempty"`
+}
+
+func (x *GetUserFollowerResponse) Reset() {
+	*x = GetUserFollowerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowerResponse) ProtoMessage() {}
+
+func (x *GetUserFollowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowerResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFollowerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserFollowerResponse) GetStatus() GetUserFollowerResponse_GetUserFollowerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserFollowerResponse_OK
+}
+
+func (x *GetUserFollowerResponse) GetFollowersIds() []int64 {
+	if x != nil {
+		return x.FollowersIds
+	}
+	return nil
+}
+
+type GetUserFollowingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserFollowingRequest) Reset() {
+	*x = GetUserFollowingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowingRequest) ProtoMessage() {}
+
+func (x *GetUserFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowingRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserFollowingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserFollowingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        GetUserFollowingResponse_GetUserFollowingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserFollowingResponse_GetUserFollowingStatus" json:"status,omitempty"`
+	FollowingsIds []int64                                         `protobuf:"varint,2,rep,packed,name=followingsIds,proto3" json:"followingsIds,omitempty"`
+}
+
+func (x *GetUserFollowingResponse) Reset() {
+	*x = GetUserFollowingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowingResponse) ProtoMessage() {}
+
+func (x *GetUserFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowingResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUserFollowingResponse) GetStatus() GetUserFollowingResponse_GetUserFollowingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserFollowingResponse_OK
+}
+
+func (x *GetUserFollowingResponse) GetFollowingsIds() []int64 {
+	if x != nil {
+		return x.FollowingsIds
+	}
+	return nil
+}
+
+type FollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowUserRequest) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status FollowUserResponse_FollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.FollowUserResponse_FollowUserStatus" json:"status,omitempty"`
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowUserResponse_OK
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnfollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnfollowUserRequest) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnfollowUserResponse_UnfollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.UnfollowUserResponse_UnfollowUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnfollowUserResponse_OK
+}
+
+type GetUserPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// viewer_id is the user reading the posts, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetUserPostsRequest) Reset() {
+	*x = GetUserPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsRequest) ProtoMessage() {}
+
+func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserPostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetUserPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   GetUserPostsResponse_GetUserPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetUserPostsResponse_GetUserPostsStatus" json:"status,omitempty"`
+	PostsIds []int64                                 `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+}
+
+func (x *GetUserPostsResponse) Reset() {
+	*x = GetUserPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsResponse) ProtoMessage() {}
+
+func (x *GetUserPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserPostsResponse) GetStatus() GetUserPostsResponse_GetUserPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserPostsResponse_OK
+}
+
+func (x *GetUserPostsResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText string `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	// Deprecated: use visibility. Only consulted when visibility is not set.
+	Visible    bool            `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
+	Visibility *PostVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=authpost.PostVisibility,oneof" json:"visibility,omitempty"`
+	Location   *Location       `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// place_id checks the post in at a place, 0 for none. Without a location the post
+	// takes the coordinates of the place.
+	PlaceId int64 `protobuf:"varint,7,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	// trip_id adds the post to a trip of the user, 0 for none
+	TripId int64 `protobuf:"varint,8,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	// quote_of_post_id makes the post a quote of another public post, 0 for none.
+	// Quoting a repost quotes its original post.
+	QuoteOfPostId int64 `protobuf:"varint,9,opt,name=quote_of_post_id,json=quoteOfPostId,proto3" json:"quote_of_post_id,omitempty"`
+	// publish_at schedules the post, it stays hidden from everyone but its author until then.
+	// Unset publishes the post right away.
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// poll attaches a poll to the post, unset for none
+	Poll *PollInput `protobuf:"bytes,11,opt,name=poll,proto3" json:"poll,omitempty"`
+	// media are the images and videos of the post, in display order
+	Media []*PostMedia `protobuf:"bytes,12,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreatePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *CreatePostRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *CreatePostRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetQuoteOfPostId() int64 {
+	if x != nil {
+		return x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPoll() *PollInput {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// PollInput describes the poll of a new post: 2 to 6 distinct options, closing at expires_at
+type PollInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options        []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MultipleChoice bool                   `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	// anonymous polls never reveal who voted for which option
+	Anonymous bool `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+}
+
+func (x *PollInput) Reset() {
+	*x = PollInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollInput) ProtoMessage() {}
+
+func (x *PollInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollInput.ProtoReflect.Descriptor instead.
+func (*PollInput) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PollInput) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollInput) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PollInput) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *PollInput) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
+	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreatePostResponse_OK
+}
+
+func (x *CreatePostResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetPostDetailInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// viewer_id is the user reading the post, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetPostDetailInfoRequest) Reset() {
+	*x = GetPostDetailInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostDetailInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostDetailInfoRequest) ProtoMessage() {}
+
+func (x *GetPostDetailInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostDetailInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPostDetailInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPostDetailInfoRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostDetailInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetPostDetailInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPostDetailInfoResponse_GetPostDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPostDetailInfoResponse_GetPostDetailInfoStatus" json:"status,omitempty"`
+	Post   *PostDetailInfo                                   `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *GetPostDetailInfoResponse) Reset() {
+	*x = GetPostDetailInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostDetailInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostDetailInfoResponse) ProtoMessage() {}
+
+func (x *GetPostDetailInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostDetailInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPostDetailInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPostDetailInfoResponse) GetStatus() GetPostDetailInfoResponse_GetPostDetailInfoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostDetailInfoResponse_OK
+}
+
+func (x *GetPostDetailInfoResponse) GetPost() *PostDetailInfo {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type EditPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId      int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText *string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+	// Deprecated: use visibility. Only consulted when visibility is not set.
+	Visible    *bool           `protobuf:"varint,5,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
+	Visibility *PostVisibility `protobuf:"varint,6,opt,name=visibility,proto3,enum=authpost.PostVisibility,oneof" json:"visibility,omitempty"`
+	// location replaces the location of the post, remove_location clears it
+	Location       *Location `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	RemoveLocation bool      `protobuf:"varint,8,opt,name=remove_location,json=removeLocation,proto3" json:"remove_location,omitempty"`
+	// place_id moves the check-in of the post to another place, remove_place clears it
+	PlaceId     *int64 `protobuf:"varint,9,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
+	RemovePlace bool   `protobuf:"varint,10,opt,name=remove_place,json=removePlace,proto3" json:"remove_place,omitempty"`
+	// trip_id moves the post to another trip, remove_trip takes it out of its trip
+	TripId     *int64 `protobuf:"varint,11,opt,name=trip_id,json=tripId,proto3,oneof" json:"trip_id,omitempty"`
+	RemoveTrip bool   `protobuf:"varint,12,opt,name=remove_trip,json=removeTrip,proto3" json:"remove_trip,omitempty"`
+	// media replaces the media of the post when set, an empty list removes them
+	Media *PostMediaList `protobuf:"bytes,13,opt,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *EditPostRequest) Reset() {
+	*x = EditPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRequest) ProtoMessage() {}
+
+func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
+func (*EditPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EditPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetVisible() bool {
+	if x != nil && x.Visible != nil {
+		return *x.Visible
+	}
+	return false
+}
+
+func (x *EditPostRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *EditPostRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *EditPostRequest) GetRemoveLocation() bool {
+	if x != nil {
+		return x.RemoveLocation
+	}
+	return false
+}
+
+func (x *EditPostRequest) GetPlaceId() int64 {
+	if x != nil && x.PlaceId != nil {
+		return *x.PlaceId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetRemovePlace() bool {
+	if x != nil {
+		return x.RemovePlace
+	}
+	return false
+}
+
+func (x *EditPostRequest) GetTripId() int64 {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetRemoveTrip() bool {
+	if x != nil {
+		return x.RemoveTrip
+	}
+	return false
+}
+
+func (x *EditPostRequest) GetMedia() *PostMediaList {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type EditPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditPostResponse_EditPostStatus" json:"status,omitempty"`
+}
+
+func (x *EditPostResponse) Reset() {
+	*x = EditPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResponse) ProtoMessage() {}
+
+func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
+func (*EditPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditPostResponse_OK
+}
+
+// DeletePost moves a post to its owner's trash, see GetTrashedPosts and RestorePost
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeletePostResponse_OK
+}
+
+// GetTrashedPosts lists the posts a user deleted that can still be restored
+type GetTrashedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetTrashedPostsRequest) Reset() {
+	*x = GetTrashedPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedPostsRequest) ProtoMessage() {}
+
+func (x *GetTrashedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrashedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTrashedPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetTrashedPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetTrashedPostsResponse_GetTrashedPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetTrashedPostsResponse_GetTrashedPostsStatus" json:"status,omitempty"`
+	Posts  []*TrashedPost                                `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetTrashedPostsResponse) Reset() {
+	*x = GetTrashedPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedPostsResponse) ProtoMessage() {}
+
+func (x *GetTrashedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrashedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTrashedPostsResponse) GetStatus() GetTrashedPostsResponse_GetTrashedPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTrashedPostsResponse_OK
+}
+
+func (x *GetTrashedPostsResponse) GetPosts() []*TrashedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type TrashedPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText string                 `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// purge_at is when the post is permanently removed
+	PurgeAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	Media   []*PostMedia           `protobuf:"bytes,7,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *TrashedPost) Reset() {
+	*x = TrashedPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedPost) ProtoMessage() {}
+
+func (x *TrashedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedPost.ProtoReflect.Descriptor instead.
+func (*TrashedPost) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TrashedPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TrashedPost) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *TrashedPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TrashedPost) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashedPost) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+func (x *TrashedPost) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type RestorePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestorePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RestorePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RestorePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RestorePostResponse_RestorePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RestorePostResponse_RestorePostStatus" json:"status,omitempty"`
+}
+
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResponse) ProtoMessage() {}
+
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestorePostResponse) GetStatus() RestorePostResponse_RestorePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RestorePostResponse_OK
+}
+
+// GetPostRevisions lists every version of a post, oldest first. Only the author may read them.
+type GetPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId   int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetPostRevisionsRequest) Reset() {
+	*x = GetPostRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPostRevisionsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostRevisionsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetPostRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    GetPostRevisionsResponse_GetPostRevisionsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPostRevisionsResponse_GetPostRevisionsStatus" json:"status,omitempty"`
+	Revisions []*PostRevision                                 `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetPostRevisionsResponse) Reset() {
+	*x = GetPostRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsResponse) ProtoMessage() {}
+
+func (x *GetPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPostRevisionsResponse) GetStatus() GetPostRevisionsResponse_GetPostRevisionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostRevisionsResponse_OK
+}
+
+func (x *GetPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision    int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	ContentText string                 `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Visibility  PostVisibility         `protobuf:"varint,4,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Media       []*PostMedia           `protobuf:"bytes,6,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PostRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PostRevision) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *PostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostRevision) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// RevertPost restores the content of an earlier revision, recording it as a new revision
+type RevertPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId   int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Revision int32 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RevertPostRequest) Reset() {
+	*x = RevertPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertPostRequest) ProtoMessage() {}
+
+func (x *RevertPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertPostRequest.ProtoReflect.Descriptor instead.
+func (*RevertPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevertPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevertPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *RevertPostRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RevertPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RevertPostResponse_RevertPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RevertPostResponse_RevertPostStatus" json:"status,omitempty"`
+}
+
+func (x *RevertPostResponse) Reset() {
+	*x = RevertPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertPostResponse) ProtoMessage() {}
+
+func (x *RevertPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertPostResponse.ProtoReflect.Descriptor instead.
+func (*RevertPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevertPostResponse) GetStatus() RevertPostResponse_RevertPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RevertPostResponse_OK
+}
+
+// GetNearbyPosts lists the posts the viewer is allowed to read within radius_km of a point, closest first
+type GetNearbyPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm  float64 `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// viewer_id is the user reading the posts, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit    int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetNearbyPostsRequest) Reset() {
+	*x = GetNearbyPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNearbyPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyPostsRequest) ProtoMessage() {}
+
+func (x *GetNearbyPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyPostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNearbyPostsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNearbyPostsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetNearbyPostsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *GetNearbyPostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetNearbyPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetNearbyPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetNearbyPostsResponse_GetNearbyPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetNearbyPostsResponse_GetNearbyPostsStatus" json:"status,omitempty"`
+	Posts  []*NearbyPost                               `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetNearbyPostsResponse) Reset() {
+	*x = GetNearbyPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNearbyPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyPostsResponse) ProtoMessage() {}
+
+func (x *GetNearbyPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyPostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetNearbyPostsResponse) GetStatus() GetNearbyPostsResponse_GetNearbyPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetNearbyPostsResponse_OK
+}
+
+func (x *GetNearbyPostsResponse) GetPosts() []*NearbyPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type NearbyPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId     int64   `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	DistanceKm float64 `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+}
+
+func (x *NearbyPost) Reset() {
+	*x = NearbyPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearbyPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPost) ProtoMessage() {}
+
+func (x *NearbyPost) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPost.ProtoReflect.Descriptor instead.
+func (*NearbyPost) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NearbyPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *NearbyPost) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+// RepostPost shares a public post with the followers of the user, reposting a repost
+// shares its original post. repost_id is the post created for the repost.
+type RepostPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *RepostPostRequest) Reset() {
+	*x = RepostPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepostPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostPostRequest) ProtoMessage() {}
+
+func (x *RepostPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostPostRequest.ProtoReflect.Descriptor instead.
+func (*RepostPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RepostPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RepostPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RepostPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   RepostPostResponse_RepostPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RepostPostResponse_RepostPostStatus" json:"status,omitempty"`
+	RepostId int64                               `protobuf:"varint,2,opt,name=repost_id,json=repostId,proto3" json:"repost_id,omitempty"`
+}
+
+func (x *RepostPostResponse) Reset() {
+	*x = RepostPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepostPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostPostResponse) ProtoMessage() {}
+
+func (x *RepostPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostPostResponse.ProtoReflect.Descriptor instead.
+func (*RepostPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RepostPostResponse) GetStatus() RepostPostResponse_RepostPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RepostPostResponse_OK
+}
+
+func (x *RepostPostResponse) GetRepostId() int64 {
+	if x != nil {
+		return x.RepostId
+	}
+	return 0
+}
+
+// UndoRepost deletes the repost of a post by the user
+type UndoRepostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UndoRepostRequest) Reset() {
+	*x = UndoRepostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRepostRequest) ProtoMessage() {}
+
+func (x *UndoRepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRepostRequest.ProtoReflect.Descriptor instead.
+func (*UndoRepostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UndoRepostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UndoRepostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UndoRepostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UndoRepostResponse_UndoRepostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.UndoRepostResponse_UndoRepostStatus" json:"status,omitempty"`
+}
+
+func (x *UndoRepostResponse) Reset() {
+	*x = UndoRepostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRepostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRepostResponse) ProtoMessage() {}
+
+func (x *UndoRepostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRepostResponse.ProtoReflect.Descriptor instead.
+func (*UndoRepostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UndoRepostResponse) GetStatus() UndoRepostResponse_UndoRepostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UndoRepostResponse_OK
+}
+
+// GetScheduledPosts lists the posts a user scheduled, the next to be published first
+type GetScheduledPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetScheduledPostsRequest) Reset() {
+	*x = GetScheduledPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPostsRequest) ProtoMessage() {}
+
+func (x *GetScheduledPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledPostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetScheduledPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetScheduledPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetScheduledPostsResponse_GetScheduledPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetScheduledPostsResponse_GetScheduledPostsStatus" json:"status,omitempty"`
+	Posts  []*ScheduledPost                                  `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetScheduledPostsResponse) Reset() {
+	*x = GetScheduledPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPostsResponse) ProtoMessage() {}
+
+func (x *GetScheduledPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledPostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetScheduledPostsResponse) GetStatus() GetScheduledPostsResponse_GetScheduledPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetScheduledPostsResponse_OK
+}
+
+func (x *GetScheduledPostsResponse) GetPosts() []*ScheduledPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type ScheduledPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText string                 `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Visibility  PostVisibility         `protobuf:"varint,4,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PublishAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Media       []*PostMedia           `protobuf:"bytes,7,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *ScheduledPost) Reset() {
+	*x = ScheduledPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPost) ProtoMessage() {}
+
+func (x *ScheduledPost) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPost.ProtoReflect.Descriptor instead.
+func (*ScheduledPost) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ScheduledPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ScheduledPost) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *ScheduledPost) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *ScheduledPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledPost) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ScheduledPost) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// ReschedulePost moves the publication of a scheduled post to another time, its poll
+// is moved by as much so it stays open as long
+type ReschedulePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId    int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+}
+
+func (x *ReschedulePostRequest) Reset() {
+	*x = ReschedulePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReschedulePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReschedulePostRequest) ProtoMessage() {}
+
+func (x *ReschedulePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReschedulePostRequest.ProtoReflect.Descriptor instead.
+func (*ReschedulePostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReschedulePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReschedulePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReschedulePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type ReschedulePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ReschedulePostResponse_ReschedulePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.ReschedulePostResponse_ReschedulePostStatus" json:"status,omitempty"`
+}
+
+func (x *ReschedulePostResponse) Reset() {
+	*x = ReschedulePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReschedulePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReschedulePostResponse) ProtoMessage() {}
+
+func (x *ReschedulePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReschedulePostResponse.ProtoReflect.Descriptor instead.
+func (*ReschedulePostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReschedulePostResponse) GetStatus() ReschedulePostResponse_ReschedulePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReschedulePostResponse_OK
+}
+
+// CancelScheduledPost moves a scheduled post to the trash before it is published.
+// Restoring it from the trash schedules it again.
+type CancelScheduledPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CancelScheduledPostRequest) Reset() {
+	*x = CancelScheduledPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPostRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPostRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelScheduledPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelScheduledPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type CancelScheduledPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CancelScheduledPostResponse_CancelScheduledPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CancelScheduledPostResponse_CancelScheduledPostStatus" json:"status,omitempty"`
+}
+
+func (x *CancelScheduledPostResponse) Reset() {
+	*x = CancelScheduledPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPostResponse) ProtoMessage() {}
+
+func (x *CancelScheduledPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPostResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CancelScheduledPostResponse) GetStatus() CancelScheduledPostResponse_CancelScheduledPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CancelScheduledPostResponse_OK
+}
+
+// GetHashtagPosts lists the posts using a hashtag, newest first
+type GetHashtagPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// viewer_id is the user reading the posts, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// cursor is the next_cursor of the previous page, 0 for the first page
+	Cursor int64 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetHashtagPostsRequest) Reset() {
+	*x = GetHashtagPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHashtagPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagPostsRequest) ProtoMessage() {}
+
+func (x *GetHashtagPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetHashtagPostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetHashtagPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetHashtagPostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetHashtagPostsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetHashtagPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetHashtagPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   GetHashtagPostsResponse_GetHashtagPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetHashtagPostsResponse_GetHashtagPostsStatus" json:"status,omitempty"`
+	PostsIds []int64                                       `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	// next_cursor is 0 when there are no more posts
+	NextCursor int64 `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetHashtagPostsResponse) Reset() {
+	*x = GetHashtagPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHashtagPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagPostsResponse) ProtoMessage() {}
+
+func (x *GetHashtagPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetHashtagPostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetHashtagPostsResponse) GetStatus() GetHashtagPostsResponse_GetHashtagPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetHashtagPostsResponse_OK
+}
+
+func (x *GetHashtagPostsResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+func (x *GetHashtagPostsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// GetHashtagInfo returns the usage statistics of a hashtag, computed over public posts
+type GetHashtagInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *GetHashtagInfoRequest) Reset() {
+	*x = GetHashtagInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHashtagInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagInfoRequest) ProtoMessage() {}
+
+func (x *GetHashtagInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetHashtagInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetHashtagInfoRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetHashtagInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  GetHashtagInfoResponse_GetHashtagInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetHashtagInfoResponse_GetHashtagInfoStatus" json:"status,omitempty"`
+	Hashtag *HashtagInfo                                `protobuf:"bytes,2,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+}
+
+func (x *GetHashtagInfoResponse) Reset() {
+	*x = GetHashtagInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHashtagInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagInfoResponse) ProtoMessage() {}
+
+func (x *GetHashtagInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetHashtagInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetHashtagInfoResponse) GetStatus() GetHashtagInfoResponse_GetHashtagInfoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetHashtagInfoResponse_OK
+}
+
+func (x *GetHashtagInfoResponse) GetHashtag() *HashtagInfo {
+	if x != nil {
+		return x.Hashtag
+	}
+	return nil
+}
+
+type HashtagInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag         string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PostCount   int64  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	AuthorCount int64  `protobuf:"varint,3,opt,name=author_count,json=authorCount,proto3" json:"author_count,omitempty"`
+	// recent_post_count is the number of posts from the last 7 days
+	RecentPostCount int64                  `protobuf:"varint,4,opt,name=recent_post_count,json=recentPostCount,proto3" json:"recent_post_count,omitempty"`
+	FirstUsedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_used_at,json=firstUsedAt,proto3" json:"first_used_at,omitempty"`
+	LastUsedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+}
+
+func (x *HashtagInfo) Reset() {
+	*x = HashtagInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashtagInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashtagInfo) ProtoMessage() {}
+
+func (x *HashtagInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashtagInfo.ProtoReflect.Descriptor instead.
+func (*HashtagInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HashtagInfo) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *HashtagInfo) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *HashtagInfo) GetAuthorCount() int64 {
+	if x != nil {
+		return x.AuthorCount
+	}
+	return 0
+}
+
+func (x *HashtagInfo) GetRecentPostCount() int64 {
+	if x != nil {
+		return x.RecentPostCount
+	}
+	return 0
+}
+
+func (x *HashtagInfo) GetFirstUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstUsedAt
+	}
+	return nil
+}
+
+func (x *HashtagInfo) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// Search ranks posts by full-text relevance or users by name similarity, best matches first.
+// Posts are limited to the ones the viewer is allowed to read.
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string                   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Type  SearchRequest_SearchType `protobuf:"varint,2,opt,name=type,proto3,enum=authpost.SearchRequest_SearchType" json:"type,omitempty"`
+	// viewer_id is the user searching, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetType() SearchRequest_SearchType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchRequest_POSTS
+}
+
+func (x *SearchRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SearchResponse_SearchStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.SearchResponse_SearchStatus" json:"status,omitempty"`
+	// posts_ids is set for POSTS searches
+	PostsIds []int64 `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	// users is set for USERS searches, without date_of_birth and email
+	Users []*UserDetailInfo `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	// next_cursor is empty when there are no more results
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SearchResponse) GetStatus() SearchResponse_SearchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SearchResponse_OK
+}
+
+func (x *SearchResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetUsers() []*UserDetailInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// CreatePlace adds a place to the catalog, any user may create one
+type CreatePlaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category  string  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Country   string  `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	City      string  `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *CreatePlaceRequest) Reset() {
+	*x = CreatePlaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaceRequest) ProtoMessage() {}
+
+func (x *CreatePlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaceRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreatePlaceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePlaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePlaceRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreatePlaceRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreatePlaceRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreatePlaceRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreatePlaceRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreatePlaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  CreatePlaceResponse_CreatePlaceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreatePlaceResponse_CreatePlaceStatus" json:"status,omitempty"`
+	PlaceId int64                                 `protobuf:"varint,2,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+}
+
+func (x *CreatePlaceResponse) Reset() {
+	*x = CreatePlaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaceResponse) ProtoMessage() {}
+
+func (x *CreatePlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaceResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreatePlaceResponse) GetStatus() CreatePlaceResponse_CreatePlaceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreatePlaceResponse_OK
+}
+
+func (x *CreatePlaceResponse) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+// SearchPlaces finds places by name similarity, or by distance when near is set.
+// At least one of query and near is required.
+type SearchPlacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// category restricts the results to one category, empty for all of them
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// near sorts the results by distance and keeps the ones within radius_km, its place_name is ignored
+	Near     *Location `protobuf:"bytes,3,opt,name=near,proto3" json:"near,omitempty"`
+	RadiusKm float64   `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	Limit    int32     `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchPlacesRequest) Reset() {
+	*x = SearchPlacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlacesRequest) ProtoMessage() {}
+
+func (x *SearchPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlacesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SearchPlacesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPlacesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchPlacesRequest) GetNear() *Location {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+func (x *SearchPlacesRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *SearchPlacesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPlacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SearchPlacesResponse_SearchPlacesStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.SearchPlacesResponse_SearchPlacesStatus" json:"status,omitempty"`
+	Places []*Place                                `protobuf:"bytes,2,rep,name=places,proto3" json:"places,omitempty"`
+}
+
+func (x *SearchPlacesResponse) Reset() {
+	*x = SearchPlacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPlacesResponse) ProtoMessage() {}
+
+func (x *SearchPlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPlacesResponse.ProtoReflect.Descriptor instead.
+func (*SearchPlacesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SearchPlacesResponse) GetStatus() SearchPlacesResponse_SearchPlacesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SearchPlacesResponse_OK
+}
+
+func (x *SearchPlacesResponse) GetPlaces() []*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+// GetPlace returns a place with its check-in statistics, computed over public posts.
+// A place that was merged returns the place it was merged into.
+type GetPlaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceId int64 `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+}
+
+func (x *GetPlaceRequest) Reset() {
+	*x = GetPlaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceRequest) ProtoMessage() {}
+
+func (x *GetPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetPlaceRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+type GetPlaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPlaceResponse_GetPlaceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPlaceResponse_GetPlaceStatus" json:"status,omitempty"`
+	Place  *Place                          `protobuf:"bytes,2,opt,name=place,proto3" json:"place,omitempty"`
+}
+
+func (x *GetPlaceResponse) Reset() {
+	*x = GetPlaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceResponse) ProtoMessage() {}
+
+func (x *GetPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPlaceResponse) GetStatus() GetPlaceResponse_GetPlaceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPlaceResponse_OK
+}
+
+func (x *GetPlaceResponse) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+// GetPlacePosts lists the check-ins at a place, newest first
+type GetPlacePostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceId int64 `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	// viewer_id is the user reading the posts, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// cursor is the next_cursor of the previous page, 0 for the first page
+	Cursor int64 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPlacePostsRequest) Reset() {
+	*x = GetPlacePostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacePostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacePostsRequest) ProtoMessage() {}
+
+func (x *GetPlacePostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacePostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlacePostsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetPlacePostsRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *GetPlacePostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetPlacePostsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetPlacePostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPlacePostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   GetPlacePostsResponse_GetPlacePostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPlacePostsResponse_GetPlacePostsStatus" json:"status,omitempty"`
+	PostsIds []int64                                   `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	// next_cursor is 0 when there are no more posts
+	NextCursor int64 `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetPlacePostsResponse) Reset() {
+	*x = GetPlacePostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlacePostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlacePostsResponse) ProtoMessage() {}
+
+func (x *GetPlacePostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlacePostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlacePostsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPlacePostsResponse) GetStatus() GetPlacePostsResponse_GetPlacePostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPlacePostsResponse_OK
+}
+
+func (x *GetPlacePostsResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+func (x *GetPlacePostsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// MergePlaces moves the check-ins of a duplicate place to another place and removes the duplicate.
+// Only moderators may merge places.
+type MergePlacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourcePlaceId int64 `protobuf:"varint,2,opt,name=source_place_id,json=sourcePlaceId,proto3" json:"source_place_id,omitempty"`
+	TargetPlaceId int64 `protobuf:"varint,3,opt,name=target_place_id,json=targetPlaceId,proto3" json:"target_place_id,omitempty"`
+}
+
+func (x *MergePlacesRequest) Reset() {
+	*x = MergePlacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePlacesRequest) ProtoMessage() {}
+
+func (x *MergePlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePlacesRequest.ProtoReflect.Descriptor instead.
+func (*MergePlacesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MergePlacesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergePlacesRequest) GetSourcePlaceId() int64 {
+	if x != nil {
+		return x.SourcePlaceId
+	}
+	return 0
+}
+
+func (x *MergePlacesRequest) GetTargetPlaceId() int64 {
+	if x != nil {
+		return x.TargetPlaceId
+	}
+	return 0
+}
+
+type MergePlacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status MergePlacesResponse_MergePlacesStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.MergePlacesResponse_MergePlacesStatus" json:"status,omitempty"`
+	// moved_posts_count is the number of check-ins moved to the target place
+	MovedPostsCount int64 `protobuf:"varint,2,opt,name=moved_posts_count,json=movedPostsCount,proto3" json:"moved_posts_count,omitempty"`
+}
+
+func (x *MergePlacesResponse) Reset() {
+	*x = MergePlacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePlacesResponse) ProtoMessage() {}
+
+func (x *MergePlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePlacesResponse.ProtoReflect.Descriptor instead.
+func (*MergePlacesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MergePlacesResponse) GetStatus() MergePlacesResponse_MergePlacesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MergePlacesResponse_OK
+}
+
+func (x *MergePlacesResponse) GetMovedPostsCount() int64 {
+	if x != nil {
+		return x.MovedPostsCount
+	}
+	return 0
+}
+
+// CreateTrip creates a draft trip, only visible to its owner and members until PublishTrip
+type CreateTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is unset for trips that are not over yet
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CoverImage string                 `protobuf:"bytes,5,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Visibility PostVisibility         `protobuf:"varint,6,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	// place_ids are the places visited during the trip, in order
+	PlaceIds []int64 `protobuf:"varint,7,rep,packed,name=place_ids,json=placeIds,proto3" json:"place_ids,omitempty"`
+}
+
+func (x *CreateTripRequest) Reset() {
+	*x = CreateTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripRequest) ProtoMessage() {}
+
+func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripRequest.ProtoReflect.Descriptor instead.
+func (*CreateTripRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateTripRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTripRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateTripRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateTripRequest) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *CreateTripRequest) GetPlaceIds() []int64 {
+	if x != nil {
+		return x.PlaceIds
+	}
+	return nil
+}
+
+type CreateTripResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreateTripResponse_CreateTripStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateTripResponse_CreateTripStatus" json:"status,omitempty"`
+	TripId int64                               `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+}
+
+func (x *CreateTripResponse) Reset() {
+	*x = CreateTripResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripResponse) ProtoMessage() {}
+
+func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripResponse.ProtoReflect.Descriptor instead.
+func (*CreateTripResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateTripResponse) GetStatus() CreateTripResponse_CreateTripStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateTripResponse_OK
+}
+
+func (x *CreateTripResponse) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type EditTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId    int64                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Title     *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date replaces the end of the trip, remove_end_date clears it
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RemoveEndDate bool                   `protobuf:"varint,6,opt,name=remove_end_date,json=removeEndDate,proto3" json:"remove_end_date,omitempty"`
+	CoverImage    *string                `protobuf:"bytes,7,opt,name=cover_image,json=coverImage,proto3,oneof" json:"cover_image,omitempty"`
+	Visibility    *PostVisibility        `protobuf:"varint,8,opt,name=visibility,proto3,enum=authpost.PostVisibility,oneof" json:"visibility,omitempty"`
+	// place_ids replaces the visited places when update_places is set
+	PlaceIds     []int64 `protobuf:"varint,9,rep,packed,name=place_ids,json=placeIds,proto3" json:"place_ids,omitempty"`
+	UpdatePlaces bool    `protobuf:"varint,10,opt,name=update_places,json=updatePlaces,proto3" json:"update_places,omitempty"`
+}
+
+func (x *EditTripRequest) Reset() {
+	*x = EditTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditTripRequest) ProtoMessage() {}
+
+func (x *EditTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditTripRequest.ProtoReflect.Descriptor instead.
+func (*EditTripRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *EditTripRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditTripRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *EditTripRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EditTripRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *EditTripRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *EditTripRequest) GetRemoveEndDate() bool {
+	if x != nil {
+		return x.RemoveEndDate
+	}
+	return false
+}
+
+func (x *EditTripRequest) GetCoverImage() string {
+	if x != nil && x.CoverImage != nil {
+		return *x.CoverImage
+	}
+	return ""
+}
+
+func (x *EditTripRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *EditTripRequest) GetPlaceIds() []int64 {
+	if x != nil {
+		return x.PlaceIds
+	}
+	return nil
+}
+
+func (x *EditTripRequest) GetUpdatePlaces() bool {
+	if x != nil {
+		return x.UpdatePlaces
+	}
+	return false
+}
+
+type EditTripResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditTripResponse_EditTripStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditTripResponse_EditTripStatus" json:"status,omitempty"`
+}
+
+func (x *EditTripResponse) Reset() {
+	*x = EditTripResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditTripResponse) ProtoMessage() {}
+
+func (x *EditTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditTripResponse.ProtoReflect.Descriptor instead.
+func (*EditTripResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EditTripResponse) GetStatus() EditTripResponse_EditTripStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditTripResponse_OK
+}
+
+// PublishTrip makes a draft trip visible according to its visibility and announces it to the owner's followers.
+// Publishing a trip that is already published does nothing.
+type PublishTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+}
+
+func (x *PublishTripRequest) Reset() {
+	*x = PublishTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripRequest) ProtoMessage() {}
+
+func (x *PublishTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripRequest.ProtoReflect.Descriptor instead.
+func (*PublishTripRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PublishTripRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishTripRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type PublishTripResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishTripResponse_PublishTripStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.PublishTripResponse_PublishTripStatus" json:"status,omitempty"`
+}
+
+func (x *PublishTripResponse) Reset() {
+	*x = PublishTripResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripResponse) ProtoMessage() {}
+
+func (x *PublishTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripResponse.ProtoReflect.Descriptor instead.
+func (*PublishTripResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PublishTripResponse) GetStatus() PublishTripResponse_PublishTripStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishTripResponse_OK
+}
+
+// GetTrip returns a trip with the timeline of the posts the viewer is allowed to read
+type GetTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId int64 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	// viewer_id is the user reading the trip, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetTripRequest) Reset() {
+	*x = GetTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripRequest) ProtoMessage() {}
+
+func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripRequest.ProtoReflect.Descriptor instead.
+func (*GetTripRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTripRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *GetTripRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetTripResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetTripResponse_GetTripStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetTripResponse_GetTripStatus" json:"status,omitempty"`
+	Trip   *Trip                         `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+}
+
+func (x *GetTripResponse) Reset() {
+	*x = GetTripResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripResponse) ProtoMessage() {}
+
+func (x *GetTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripResponse.ProtoReflect.Descriptor instead.
+func (*GetTripResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetTripResponse) GetStatus() GetTripResponse_GetTripStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTripResponse_OK
+}
+
+func (x *GetTripResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type Trip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId     int64                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId     int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CoverImage string                 `protobuf:"bytes,6,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Visibility PostVisibility         `protobuf:"varint,7,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	// published_at is unset for drafts
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// places are the visited places in order, without their statistics
+	Places []*Place `protobuf:"bytes,10,rep,name=places,proto3" json:"places,omitempty"`
+	// timeline lists the posts of the trip oldest first
+	Timeline []*TripPost `protobuf:"bytes,11,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// members are the accepted members of the trip, with the pending invitations when the viewer is the owner
+	Members []*TripMember `protobuf:"bytes,12,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Trip) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *Trip) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Trip) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Trip) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Trip) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Trip) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *Trip) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *Trip) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetPlaces() []*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *Trip) GetTimeline() []*TripPost {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *Trip) GetMembers() []*TripMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TripPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// place_id is 0 when the post is not a check-in
+	PlaceId int64 `protobuf:"varint,3,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId  int64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *TripPost) Reset() {
+	*x = TripPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripPost) ProtoMessage() {}
+
+func (x *TripPost) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripPost.ProtoReflect.Descriptor instead.
+func (*TripPost) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TripPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *TripPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TripPost) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *TripPost) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TripMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role    TripRole `protobuf:"varint,2,opt,name=role,proto3,enum=authpost.TripRole" json:"role,omitempty"`
+	Pending bool     `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *TripMember) Reset() {
+	*x = TripMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripMember) ProtoMessage() {}
+
+func (x *TripMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripMember.ProtoReflect.Descriptor instead.
+func (*TripMember) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TripMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TripMember) GetRole() TripRole {
+	if x != nil {
+		return x.Role
+	}
+	return TripRole_VIEWER
+}
+
+func (x *TripMember) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+// InviteTripMember invites a user to a trip. Only the owner can invite.
+type InviteTripMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId    int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	InviteeId int64 `protobuf:"varint,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+	// role is VIEWER or EDITOR
+	Role TripRole `protobuf:"varint,4,opt,name=role,proto3,enum=authpost.TripRole" json:"role,omitempty"`
+}
+
+func (x *InviteTripMemberRequest) Reset() {
+	*x = InviteTripMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteTripMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTripMemberRequest) ProtoMessage() {}
+
+func (x *InviteTripMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTripMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteTripMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *InviteTripMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteTripMemberRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *InviteTripMemberRequest) GetInviteeId() int64 {
+	if x != nil {
+		return x.InviteeId
+	}
+	return 0
+}
+
+func (x *InviteTripMemberRequest) GetRole() TripRole {
+	if x != nil {
+		return x.Role
+	}
+	return TripRole_VIEWER
+}
+
+type InviteTripMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status InviteTripMemberResponse_InviteTripMemberStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.InviteTripMemberResponse_InviteTripMemberStatus" json:"status,omitempty"`
+}
+
+func (x *InviteTripMemberResponse) Reset() {
+	*x = InviteTripMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteTripMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTripMemberResponse) ProtoMessage() {}
+
+func (x *InviteTripMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTripMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteTripMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *InviteTripMemberResponse) GetStatus() InviteTripMemberResponse_InviteTripMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InviteTripMemberResponse_OK
+}
+
+// RespondTripInvitation accepts or declines an invitation to a trip
+type RespondTripInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Accept bool  `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondTripInvitationRequest) Reset() {
+	*x = RespondTripInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondTripInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTripInvitationRequest) ProtoMessage() {}
+
+func (x *RespondTripInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTripInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondTripInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RespondTripInvitationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RespondTripInvitationRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *RespondTripInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondTripInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RespondTripInvitationResponse_RespondTripInvitationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RespondTripInvitationResponse_RespondTripInvitationStatus" json:"status,omitempty"`
+}
+
+func (x *RespondTripInvitationResponse) Reset() {
+	*x = RespondTripInvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondTripInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTripInvitationResponse) ProtoMessage() {}
+
+func (x *RespondTripInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTripInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RespondTripInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RespondTripInvitationResponse) GetStatus() RespondTripInvitationResponse_RespondTripInvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RespondTripInvitationResponse_OK
+}
+
+// GetTripInvitations returns the pending invitations of a user, newest first
+type GetTripInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetTripInvitationsRequest) Reset() {
+	*x = GetTripInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTripInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripInvitationsRequest) ProtoMessage() {}
+
+func (x *GetTripInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetTripInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetTripInvitationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetTripInvitationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      GetTripInvitationsResponse_GetTripInvitationsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetTripInvitationsResponse_GetTripInvitationsStatus" json:"status,omitempty"`
+	Invitations []*TripInvitation                                   `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *GetTripInvitationsResponse) Reset() {
+	*x = GetTripInvitationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTripInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripInvitationsResponse) ProtoMessage() {}
+
+func (x *GetTripInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetTripInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetTripInvitationsResponse) GetStatus() GetTripInvitationsResponse_GetTripInvitationsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTripInvitationsResponse_OK
+}
+
+func (x *GetTripInvitationsResponse) GetInvitations() []*TripInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type TripInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId    int64                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	InvitedBy int64                  `protobuf:"varint,3,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	Role      TripRole               `protobuf:"varint,4,opt,name=role,proto3,enum=authpost.TripRole" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *TripInvitation) Reset() {
+	*x = TripInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripInvitation) ProtoMessage() {}
+
+func (x *TripInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripInvitation.ProtoReflect.Descriptor instead.
+func (*TripInvitation) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TripInvitation) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripInvitation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TripInvitation) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+func (x *TripInvitation) GetRole() TripRole {
+	if x != nil {
+		return x.Role
+	}
+	return TripRole_VIEWER
+}
+
+func (x *TripInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UpdateTripMember changes the role of a member or of a pending invitation. Only the owner can change roles.
+type UpdateTripMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId   int64    `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	MemberId int64    `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role     TripRole `protobuf:"varint,4,opt,name=role,proto3,enum=authpost.TripRole" json:"role,omitempty"`
+}
+
+func (x *UpdateTripMemberRequest) Reset() {
+	*x = UpdateTripMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTripMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTripMemberRequest) ProtoMessage() {}
+
+func (x *UpdateTripMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTripMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTripMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateTripMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateTripMemberRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *UpdateTripMemberRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *UpdateTripMemberRequest) GetRole() TripRole {
+	if x != nil {
+		return x.Role
+	}
+	return TripRole_VIEWER
+}
+
+type UpdateTripMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UpdateTripMemberResponse_UpdateTripMemberStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.UpdateTripMemberResponse_UpdateTripMemberStatus" json:"status,omitempty"`
+}
+
+func (x *UpdateTripMemberResponse) Reset() {
+	*x = UpdateTripMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTripMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTripMemberResponse) ProtoMessage() {}
+
+func (x *UpdateTripMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTripMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTripMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateTripMemberResponse) GetStatus() UpdateTripMemberResponse_UpdateTripMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UpdateTripMemberResponse_OK
+}
+
+// RemoveTripMember removes a member or cancels an invitation. The owner can remove anyone, members can leave.
+// The posts of a removed member leave the trip.
+type RemoveTripMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId   int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	MemberId int64 `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *RemoveTripMemberRequest) Reset() {
+	*x = RemoveTripMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTripMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTripMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTripMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTripMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTripMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RemoveTripMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveTripMemberRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *RemoveTripMemberRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+type RemoveTripMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RemoveTripMemberResponse_RemoveTripMemberStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RemoveTripMemberResponse_RemoveTripMemberStatus" json:"status,omitempty"`
+}
+
+func (x *RemoveTripMemberResponse) Reset() {
+	*x = RemoveTripMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTripMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTripMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTripMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTripMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTripMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RemoveTripMemberResponse) GetStatus() RemoveTripMemberResponse_RemoveTripMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RemoveTripMemberResponse_OK
+}
+
+// RemoveTripPost takes a post out of a trip. The owner and editors can remove any post, authors their own.
+type RemoveTripPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PostId int64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *RemoveTripPostRequest) Reset() {
+	*x = RemoveTripPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTripPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTripPostRequest) ProtoMessage() {}
+
+func (x *RemoveTripPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTripPostRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTripPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RemoveTripPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveTripPostRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *RemoveTripPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RemoveTripPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RemoveTripPostResponse_RemoveTripPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RemoveTripPostResponse_RemoveTripPostStatus" json:"status,omitempty"`
+}
+
+func (x *RemoveTripPostResponse) Reset() {
+	*x = RemoveTripPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTripPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTripPostResponse) ProtoMessage() {}
+
+func (x *RemoveTripPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTripPostResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTripPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RemoveTripPostResponse) GetStatus() RemoveTripPostResponse_RemoveTripPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RemoveTripPostResponse_OK
+}
+
+// GetTravelMap lists the countries and cities a user visited that the viewer is allowed to see.
+// Visits come from the user's check-ins and from the visits the user added by hand.
+type GetTravelMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// viewer_id is the user reading the map, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetTravelMapRequest) Reset() {
+	*x = GetTravelMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTravelMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTravelMapRequest) ProtoMessage() {}
+
+func (x *GetTravelMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTravelMapRequest.ProtoReflect.Descriptor instead.
+func (*GetTravelMapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetTravelMapRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTravelMapRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetTravelMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetTravelMapResponse_GetTravelMapStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetTravelMapResponse_GetTravelMapStatus" json:"status,omitempty"`
+	// countries are ordered by their first visit
+	Countries []*VisitedCountry `protobuf:"bytes,2,rep,name=countries,proto3" json:"countries,omitempty"`
+	// visits are the visits added by hand, newest first
+	Visits []*TravelVisit `protobuf:"bytes,3,rep,name=visits,proto3" json:"visits,omitempty"`
+}
+
+func (x *GetTravelMapResponse) Reset() {
+	*x = GetTravelMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTravelMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTravelMapResponse) ProtoMessage() {}
+
+func (x *GetTravelMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTravelMapResponse.ProtoReflect.Descriptor instead.
+func (*GetTravelMapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetTravelMapResponse) GetStatus() GetTravelMapResponse_GetTravelMapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTravelMapResponse_OK
+}
+
+func (x *GetTravelMapResponse) GetCountries() []*VisitedCountry {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *GetTravelMapResponse) GetVisits() []*TravelVisit {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+type VisitedCountry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country        string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	FirstVisitedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_visited_at,json=firstVisitedAt,proto3" json:"first_visited_at,omitempty"`
+	// posts_count counts the visible posts located in the country
+	PostsCount int64          `protobuf:"varint,3,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+	Cities     []*VisitedCity `protobuf:"bytes,4,rep,name=cities,proto3" json:"cities,omitempty"`
+}
+
+func (x *VisitedCountry) Reset() {
+	*x = VisitedCountry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitedCountry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitedCountry) ProtoMessage() {}
+
+func (x *VisitedCountry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitedCountry.ProtoReflect.Descriptor instead.
+func (*VisitedCountry) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VisitedCountry) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *VisitedCountry) GetFirstVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstVisitedAt
+	}
+	return nil
+}
+
+func (x *VisitedCountry) GetPostsCount() int64 {
+	if x != nil {
+		return x.PostsCount
+	}
+	return 0
+}
+
+func (x *VisitedCountry) GetCities() []*VisitedCity {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type VisitedCity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City           string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	FirstVisitedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_visited_at,json=firstVisitedAt,proto3" json:"first_visited_at,omitempty"`
+	PostsCount     int64                  `protobuf:"varint,3,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+}
+
+func (x *VisitedCity) Reset() {
+	*x = VisitedCity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitedCity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitedCity) ProtoMessage() {}
+
+func (x *VisitedCity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitedCity.ProtoReflect.Descriptor instead.
+func (*VisitedCity) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *VisitedCity) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *VisitedCity) GetFirstVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstVisitedAt
+	}
+	return nil
+}
+
+func (x *VisitedCity) GetPostsCount() int64 {
+	if x != nil {
+		return x.PostsCount
+	}
+	return 0
+}
+
+type TravelVisit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VisitId    int64                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Country    string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	City       string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	VisitedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visited_at,json=visitedAt,proto3" json:"visited_at,omitempty"`
+	Visibility PostVisibility         `protobuf:"varint,5,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+}
+
+func (x *TravelVisit) Reset() {
+	*x = TravelVisit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelVisit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelVisit) ProtoMessage() {}
+
+func (x *TravelVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelVisit.ProtoReflect.Descriptor instead.
+func (*TravelVisit) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TravelVisit) GetVisitId() int64 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *TravelVisit) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *TravelVisit) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TravelVisit) GetVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisitedAt
+	}
+	return nil
+}
+
+func (x *TravelVisit) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+// GetTravelStats sums up the visits of a user the viewer is allowed to see
+type GetTravelStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// viewer_id is the user reading the stats, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetTravelStatsRequest) Reset() {
+	*x = GetTravelStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTravelStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTravelStatsRequest) ProtoMessage() {}
+
+func (x *GetTravelStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTravelStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTravelStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetTravelStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTravelStatsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetTravelStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         GetTravelStatsResponse_GetTravelStatsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetTravelStatsResponse_GetTravelStatsStatus" json:"status,omitempty"`
+	CountriesCount int64                                       `protobuf:"varint,2,opt,name=countries_count,json=countriesCount,proto3" json:"countries_count,omitempty"`
+	CitiesCount    int64                                       `protobuf:"varint,3,opt,name=cities_count,json=citiesCount,proto3" json:"cities_count,omitempty"`
+	// places_count counts the distinct places the user checked in at
+	PlacesCount int64 `protobuf:"varint,4,opt,name=places_count,json=placesCount,proto3" json:"places_count,omitempty"`
+	// posts_count counts the located posts
+	PostsCount int64 `protobuf:"varint,5,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+	// distance_km is the great-circle distance between consecutive located posts
+	DistanceKm float64 `protobuf:"fixed64,6,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// first_visited_at and last_visited_at are unset when there is no visit
+	FirstVisitedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_visited_at,json=firstVisitedAt,proto3" json:"first_visited_at,omitempty"`
+	LastVisitedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_visited_at,json=lastVisitedAt,proto3" json:"last_visited_at,omitempty"`
+}
+
+func (x *GetTravelStatsResponse) Reset() {
+	*x = GetTravelStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTravelStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTravelStatsResponse) ProtoMessage() {}
+
+func (x *GetTravelStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTravelStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTravelStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetTravelStatsResponse) GetStatus() GetTravelStatsResponse_GetTravelStatsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTravelStatsResponse_OK
+}
+
+func (x *GetTravelStatsResponse) GetCountriesCount() int64 {
+	if x != nil {
+		return x.CountriesCount
+	}
+	return 0
+}
+
+func (x *GetTravelStatsResponse) GetCitiesCount() int64 {
+	if x != nil {
+		return x.CitiesCount
+	}
+	return 0
+}
+
+func (x *GetTravelStatsResponse) GetPlacesCount() int64 {
+	if x != nil {
+		return x.PlacesCount
+	}
+	return 0
+}
+
+func (x *GetTravelStatsResponse) GetPostsCount() int64 {
+	if x != nil {
+		return x.PostsCount
+	}
+	return 0
+}
+
+func (x *GetTravelStatsResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *GetTravelStatsResponse) GetFirstVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstVisitedAt
+	}
+	return nil
+}
+
+func (x *GetTravelStatsResponse) GetLastVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVisitedAt
+	}
+	return nil
+}
+
+// AddTravelVisit records a visit to a country, or to a city of a country, without a post
+type AddTravelVisitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// city is optional
+	City string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	// visited_at defaults to now, it cannot be in the future
+	VisitedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=visited_at,json=visitedAt,proto3" json:"visited_at,omitempty"`
+	Visibility PostVisibility         `protobuf:"varint,5,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+}
+
+func (x *AddTravelVisitRequest) Reset() {
+	*x = AddTravelVisitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTravelVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTravelVisitRequest) ProtoMessage() {}
+
+func (x *AddTravelVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTravelVisitRequest.ProtoReflect.Descriptor instead.
+func (*AddTravelVisitRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AddTravelVisitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddTravelVisitRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddTravelVisitRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddTravelVisitRequest) GetVisitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VisitedAt
+	}
+	return nil
+}
+
+func (x *AddTravelVisitRequest) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+type AddTravelVisitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  AddTravelVisitResponse_AddTravelVisitStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.AddTravelVisitResponse_AddTravelVisitStatus" json:"status,omitempty"`
+	VisitId int64                                       `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+}
+
+func (x *AddTravelVisitResponse) Reset() {
+	*x = AddTravelVisitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTravelVisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTravelVisitResponse) ProtoMessage() {}
+
+func (x *AddTravelVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTravelVisitResponse.ProtoReflect.Descriptor instead.
+func (*AddTravelVisitResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AddTravelVisitResponse) GetStatus() AddTravelVisitResponse_AddTravelVisitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddTravelVisitResponse_OK
+}
+
+func (x *AddTravelVisitResponse) GetVisitId() int64 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+// DeleteTravelVisit removes a visit added by hand. Visits coming from posts follow their post.
+type DeleteTravelVisitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VisitId int64 `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+}
+
+func (x *DeleteTravelVisitRequest) Reset() {
+	*x = DeleteTravelVisitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTravelVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTravelVisitRequest) ProtoMessage() {}
+
+func (x *DeleteTravelVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTravelVisitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTravelVisitRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteTravelVisitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteTravelVisitRequest) GetVisitId() int64 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+type DeleteTravelVisitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteTravelVisitResponse_DeleteTravelVisitStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeleteTravelVisitResponse_DeleteTravelVisitStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteTravelVisitResponse) Reset() {
+	*x = DeleteTravelVisitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTravelVisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTravelVisitResponse) ProtoMessage() {}
+
+func (x *DeleteTravelVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTravelVisitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTravelVisitResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteTravelVisitResponse) GetStatus() DeleteTravelVisitResponse_DeleteTravelVisitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteTravelVisitResponse_OK
+}
+
+// CreatePlaceReview rates a place from 1 to 5 stars. A user has at most one review per place, see EditPlaceReview.
+type CreatePlaceReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaceId     int64  `protobuf:"varint,2,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	Rating      int32  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	ContentText string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+}
+
+func (x *CreatePlaceReviewRequest) Reset() {
+	*x = CreatePlaceReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaceReviewRequest) ProtoMessage() {}
+
+func (x *CreatePlaceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaceReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreatePlaceReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePlaceReviewRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *CreatePlaceReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreatePlaceReviewRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+type CreatePlaceReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   CreatePlaceReviewResponse_CreatePlaceReviewStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreatePlaceReviewResponse_CreatePlaceReviewStatus" json:"status,omitempty"`
+	ReviewId int64                                             `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+}
+
+func (x *CreatePlaceReviewResponse) Reset() {
+	*x = CreatePlaceReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlaceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaceReviewResponse) ProtoMessage() {}
+
+func (x *CreatePlaceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaceReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CreatePlaceReviewResponse) GetStatus() CreatePlaceReviewResponse_CreatePlaceReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreatePlaceReviewResponse_OK
+}
+
+func (x *CreatePlaceReviewResponse) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+// EditPlaceReview updates the fields that are set. Only the author may edit a review.
+type EditPlaceReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewId    int64   `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Rating      *int32  `protobuf:"varint,3,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	ContentText *string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+}
+
+func (x *EditPlaceReviewRequest) Reset() {
+	*x = EditPlaceReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPlaceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPlaceReviewRequest) ProtoMessage() {}
+
+func (x *EditPlaceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPlaceReviewRequest.ProtoReflect.Descriptor instead.
+func (*EditPlaceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *EditPlaceReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditPlaceReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *EditPlaceReviewRequest) GetRating() int32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *EditPlaceReviewRequest) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+type EditPlaceReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditPlaceReviewResponse_EditPlaceReviewStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditPlaceReviewResponse_EditPlaceReviewStatus" json:"status,omitempty"`
+}
+
+func (x *EditPlaceReviewResponse) Reset() {
+	*x = EditPlaceReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPlaceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPlaceReviewResponse) ProtoMessage() {}
+
+func (x *EditPlaceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPlaceReviewResponse.ProtoReflect.Descriptor instead.
+func (*EditPlaceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *EditPlaceReviewResponse) GetStatus() EditPlaceReviewResponse_EditPlaceReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditPlaceReviewResponse_OK
+}
+
+// DeletePlaceReview removes a review. The author and moderators may delete it.
+type DeletePlaceReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewId int64 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+}
+
+func (x *DeletePlaceReviewRequest) Reset() {
+	*x = DeletePlaceReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlaceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaceReviewRequest) ProtoMessage() {}
+
+func (x *DeletePlaceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaceReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DeletePlaceReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeletePlaceReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type DeletePlaceReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeletePlaceReviewResponse_DeletePlaceReviewStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeletePlaceReviewResponse_DeletePlaceReviewStatus" json:"status,omitempty"`
+}
+
+func (x *DeletePlaceReviewResponse) Reset() {
+	*x = DeletePlaceReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlaceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaceReviewResponse) ProtoMessage() {}
+
+func (x *DeletePlaceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaceReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeletePlaceReviewResponse) GetStatus() DeletePlaceReviewResponse_DeletePlaceReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeletePlaceReviewResponse_OK
+}
+
+type GetPlaceReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId int64 `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// viewer_id is the user reading the review, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetPlaceReviewRequest) Reset() {
+	*x = GetPlaceReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceReviewRequest) ProtoMessage() {}
+
+func (x *GetPlaceReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaceReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetPlaceReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *GetPlaceReviewRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetPlaceReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPlaceReviewResponse_GetPlaceReviewStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPlaceReviewResponse_GetPlaceReviewStatus" json:"status,omitempty"`
+	Review *PlaceReview                                `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *GetPlaceReviewResponse) Reset() {
+	*x = GetPlaceReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceReviewResponse) ProtoMessage() {}
+
+func (x *GetPlaceReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaceReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetPlaceReviewResponse) GetStatus() GetPlaceReviewResponse_GetPlaceReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPlaceReviewResponse_OK
+}
+
+func (x *GetPlaceReviewResponse) GetReview() *PlaceReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// GetPlaceReviews lists the reviews of a place in the requested order, with the rating summary of the place
+type GetPlaceReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceId int64 `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	// viewer_id is the user reading the reviews, 0 for anonymous viewers
+	ViewerId int64                             `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Sort     GetPlaceReviewsRequest_ReviewSort `protobuf:"varint,3,opt,name=sort,proto3,enum=authpost.GetPlaceReviewsRequest_ReviewSort" json:"sort,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPlaceReviewsRequest) Reset() {
+	*x = GetPlaceReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceReviewsRequest) ProtoMessage() {}
+
+func (x *GetPlaceReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaceReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetPlaceReviewsRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *GetPlaceReviewsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetPlaceReviewsRequest) GetSort() GetPlaceReviewsRequest_ReviewSort {
+	if x != nil {
+		return x.Sort
+	}
+	return GetPlaceReviewsRequest_NEWEST
+}
+
+func (x *GetPlaceReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPlaceReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPlaceReviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     GetPlaceReviewsResponse_GetPlaceReviewsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPlaceReviewsResponse_GetPlaceReviewsStatus" json:"status,omitempty"`
+	Reviews    []*PlaceReview                                `protobuf:"bytes,2,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	NextCursor string                                        `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Summary    *RatingSummary                                `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *GetPlaceReviewsResponse) Reset() {
+	*x = GetPlaceReviewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaceReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaceReviewsResponse) ProtoMessage() {}
+
+func (x *GetPlaceReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaceReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaceReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetPlaceReviewsResponse) GetStatus() GetPlaceReviewsResponse_GetPlaceReviewsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPlaceReviewsResponse_OK
+}
+
+func (x *GetPlaceReviewsResponse) GetReviews() []*PlaceReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *GetPlaceReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPlaceReviewsResponse) GetSummary() *RatingSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// VoteReviewHelpful marks a review as helpful, or removes the vote when helpful is false.
+// Authors cannot vote on their own reviews.
+type VoteReviewHelpfulRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewId int64 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Helpful  bool  `protobuf:"varint,3,opt,name=helpful,proto3" json:"helpful,omitempty"`
+}
+
+func (x *VoteReviewHelpfulRequest) Reset() {
+	*x = VoteReviewHelpfulRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteReviewHelpfulRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteReviewHelpfulRequest) ProtoMessage() {}
+
+func (x *VoteReviewHelpfulRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteReviewHelpfulRequest.ProtoReflect.Descriptor instead.
+func (*VoteReviewHelpfulRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *VoteReviewHelpfulRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VoteReviewHelpfulRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *VoteReviewHelpfulRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+type VoteReviewHelpfulResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status VoteReviewHelpfulResponse_VoteReviewHelpfulStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.VoteReviewHelpfulResponse_VoteReviewHelpfulStatus" json:"status,omitempty"`
+}
+
+func (x *VoteReviewHelpfulResponse) Reset() {
+	*x = VoteReviewHelpfulResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteReviewHelpfulResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteReviewHelpfulResponse) ProtoMessage() {}
+
+func (x *VoteReviewHelpfulResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteReviewHelpfulResponse.ProtoReflect.Descriptor instead.
+func (*VoteReviewHelpfulResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *VoteReviewHelpfulResponse) GetStatus() VoteReviewHelpfulResponse_VoteReviewHelpfulStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VoteReviewHelpfulResponse_OK
+}
+
+type PlaceReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId     int64  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	PlaceId      int64  `protobuf:"varint,2,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId       int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating       int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	ContentText  string `protobuf:"bytes,5,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	HelpfulCount int64  `protobuf:"varint,6,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"`
+	// voted_helpful is set when the viewer voted the review helpful
+	VotedHelpful bool                   `protobuf:"varint,7,opt,name=voted_helpful,json=votedHelpful,proto3" json:"voted_helpful,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// edited_at is unset for reviews that were never edited
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+}
+
+func (x *PlaceReview) Reset() {
+	*x = PlaceReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReview) ProtoMessage() {}
+
+func (x *PlaceReview) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReview.ProtoReflect.Descriptor instead.
+func (*PlaceReview) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PlaceReview) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *PlaceReview) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *PlaceReview) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlaceReview) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlaceReview) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PlaceReview) GetHelpfulCount() int64 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *PlaceReview) GetVotedHelpful() bool {
+	if x != nil {
+		return x.VotedHelpful
+	}
+	return false
+}
+
+func (x *PlaceReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlaceReview) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+// RatingSummary aggregates the ratings of a place, counts has the number of reviews per star, 1 to 5
+type RatingSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Average float64 `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Counts  []int64 `protobuf:"varint,3,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *RatingSummary) Reset() {
+	*x = RatingSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingSummary) ProtoMessage() {}
+
+func (x *RatingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingSummary.ProtoReflect.Descriptor instead.
+func (*RatingSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RatingSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// AddBookmark saves a post the user is allowed to read. Saving a bookmarked post again
+// moves it to collection_id, 0 takes it out of its collection.
+type AddBookmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId       int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CollectionId int64 `protobuf:"varint,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *AddBookmarkRequest) Reset() {
+	*x = AddBookmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkRequest) ProtoMessage() {}
+
+func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AddBookmarkRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddBookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *AddBookmarkRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type AddBookmarkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status AddBookmarkResponse_AddBookmarkStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.AddBookmarkResponse_AddBookmarkStatus" json:"status,omitempty"`
+}
+
+func (x *AddBookmarkResponse) Reset() {
+	*x = AddBookmarkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkResponse) ProtoMessage() {}
+
+func (x *AddBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*AddBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AddBookmarkResponse) GetStatus() AddBookmarkResponse_AddBookmarkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddBookmarkResponse_OK
+}
+
+type RemoveBookmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *RemoveBookmarkRequest) Reset() {
+	*x = RemoveBookmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkRequest) ProtoMessage() {}
+
+func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RemoveBookmarkRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveBookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RemoveBookmarkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RemoveBookmarkResponse_RemoveBookmarkStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.RemoveBookmarkResponse_RemoveBookmarkStatus" json:"status,omitempty"`
+}
+
+func (x *RemoveBookmarkResponse) Reset() {
+	*x = RemoveBookmarkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkResponse) ProtoMessage() {}
+
+func (x *RemoveBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RemoveBookmarkResponse) GetStatus() RemoveBookmarkResponse_RemoveBookmarkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RemoveBookmarkResponse_OK
+}
+
+// GetBookmarks lists the saved posts of a user, last saved first. Posts that were deleted
+// or that the user may no longer read are left out.
+type GetBookmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// collection_id limits the bookmarks to a collection, 0 for every bookmark
+	CollectionId int64 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// cursor is the next_cursor of the previous page, 0 for the first page
+	Cursor int64 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetBookmarksRequest) Reset() {
+	*x = GetBookmarksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarksRequest) ProtoMessage() {}
+
+func (x *GetBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*GetBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetBookmarksRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetBookmarksRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *GetBookmarksRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetBookmarksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetBookmarksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    GetBookmarksResponse_GetBookmarksStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetBookmarksResponse_GetBookmarksStatus" json:"status,omitempty"`
+	Bookmarks []*Bookmark                             `protobuf:"bytes,2,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	// next_cursor is 0 when there are no more bookmarks
+	NextCursor int64 `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetBookmarksResponse) Reset() {
+	*x = GetBookmarksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarksResponse) ProtoMessage() {}
+
+func (x *GetBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*GetBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetBookmarksResponse) GetStatus() GetBookmarksResponse_GetBookmarksStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetBookmarksResponse_OK
+}
+
+func (x *GetBookmarksResponse) GetBookmarks() []*Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+func (x *GetBookmarksResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type Bookmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// collection_id is 0 for bookmarks outside any collection
+	CollectionId int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Bookmark) Reset() {
+	*x = Bookmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bookmark) ProtoMessage() {}
+
+func (x *Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bookmark.ProtoReflect.Descriptor instead.
+func (*Bookmark) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Bookmark) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Bookmark) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *Bookmark) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateBookmarkCollection adds a collection, names are unique per user regardless of case
+type CreateBookmarkCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateBookmarkCollectionRequest) Reset() {
+	*x = CreateBookmarkCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBookmarkCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkCollectionRequest) ProtoMessage() {}
+
+func (x *CreateBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateBookmarkCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateBookmarkCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus" json:"status,omitempty"`
+	CollectionId int64                                                           `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *CreateBookmarkCollectionResponse) Reset() {
+	*x = CreateBookmarkCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBookmarkCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkCollectionResponse) ProtoMessage() {}
+
+func (x *CreateBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateBookmarkCollectionResponse) GetStatus() CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateBookmarkCollectionResponse_OK
+}
+
+func (x *CreateBookmarkCollectionResponse) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+// GetBookmarkCollections lists the collections of a user by name
+type GetBookmarkCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetBookmarkCollectionsRequest) Reset() {
+	*x = GetBookmarkCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookmarkCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarkCollectionsRequest) ProtoMessage() {}
+
+func (x *GetBookmarkCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarkCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookmarkCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetBookmarkCollectionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetBookmarkCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus" json:"status,omitempty"`
+	Collections []*BookmarkCollection                                       `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *GetBookmarkCollectionsResponse) Reset() {
+	*x = GetBookmarkCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookmarkCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarkCollectionsResponse) ProtoMessage() {}
+
+func (x *GetBookmarkCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarkCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookmarkCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetBookmarkCollectionsResponse) GetStatus() GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetBookmarkCollectionsResponse_OK
+}
+
+func (x *GetBookmarkCollectionsResponse) GetCollections() []*BookmarkCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type BookmarkCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// bookmarks_count only counts the posts the user may still read
+	BookmarksCount int64                  `protobuf:"varint,3,opt,name=bookmarks_count,json=bookmarksCount,proto3" json:"bookmarks_count,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *BookmarkCollection) Reset() {
+	*x = BookmarkCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookmarkCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkCollection) ProtoMessage() {}
+
+func (x *BookmarkCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkCollection.ProtoReflect.Descriptor instead.
+func (*BookmarkCollection) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *BookmarkCollection) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *BookmarkCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookmarkCollection) GetBookmarksCount() int64 {
+	if x != nil {
+		return x.BookmarksCount
+	}
+	return 0
+}
+
+func (x *BookmarkCollection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type EditBookmarkCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId int64  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EditBookmarkCollectionRequest) Reset() {
+	*x = EditBookmarkCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditBookmarkCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditBookmarkCollectionRequest) ProtoMessage() {}
+
+func (x *EditBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*EditBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *EditBookmarkCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditBookmarkCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *EditBookmarkCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EditBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditBookmarkCollectionResponse_EditBookmarkCollectionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditBookmarkCollectionResponse_EditBookmarkCollectionStatus" json:"status,omitempty"`
+}
+
+func (x *EditBookmarkCollectionResponse) Reset() {
+	*x = EditBookmarkCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditBookmarkCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditBookmarkCollectionResponse) ProtoMessage() {}
+
+func (x *EditBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*EditBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *EditBookmarkCollectionResponse) GetStatus() EditBookmarkCollectionResponse_EditBookmarkCollectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditBookmarkCollectionResponse_OK
+}
+
+// DeleteBookmarkCollection removes a collection, its bookmarks stay saved outside any collection
+type DeleteBookmarkCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId int64 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *DeleteBookmarkCollectionRequest) Reset() {
+	*x = DeleteBookmarkCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookmarkCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteBookmarkCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteBookmarkCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type DeleteBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteBookmarkCollectionResponse) Reset() {
+	*x = DeleteBookmarkCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBookmarkCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *DeleteBookmarkCollectionResponse) GetStatus() DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteBookmarkCollectionResponse_OK
+}
+
+type CommentPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+}
+
+func (x *CommentPostRequest) Reset() {
+	*x = CommentPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostRequest) ProtoMessage() {}
+
+func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
+func (*CommentPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CommentPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+type CommentPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
+	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CommentPostResponse) Reset() {
+	*x = CommentPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResponse) ProtoMessage() {}
+
+func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
+func (*CommentPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentPostResponse_OK
+}
+
+func (x *CommentPostResponse) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LikePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type LikePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+}
+
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LikePostResponse_OK
+}
+
+type PostDetailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId      int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText string                 `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Visible     bool                   `protobuf:"varint,5,opt,name=visible,proto3" json:"visible,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Comments    []*Comment             `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
+	LikedUsers  []*Like                `protobuf:"bytes,8,rep,name=liked_users,json=likedUsers,proto3" json:"liked_users,omitempty"`
+	Visibility  PostVisibility         `protobuf:"varint,9,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	// Per-viewer fields, only set when the request carries a viewer_id
+	LikedByMe bool `protobuf:"varint,10,opt,name=liked_by_me,json=likedByMe,proto3" json:"liked_by_me,omitempty"`
+	// edited_at is unset when the post was never edited
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// Users mentioned in content_text
+	Mentions []*MentionSpan `protobuf:"bytes,12,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// location is unset when the post is not geotagged
+	Location *Location `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	// place is unset when the post is not a check-in, its statistics are not set
+	Place *Place `protobuf:"bytes,14,opt,name=place,proto3" json:"place,omitempty"`
+	// trip_id is 0 when the post is not part of a trip
+	TripId int64 `protobuf:"varint,15,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	// A repost has no content of its own, repost_of_post_id is the post it shares.
+	// quote_of_post_id is the post a quote shares, 0 once that post is purged.
+	RepostOfPostId int64 `protobuf:"varint,16,opt,name=repost_of_post_id,json=repostOfPostId,proto3" json:"repost_of_post_id,omitempty"`
+	QuoteOfPostId  int64 `protobuf:"varint,17,opt,name=quote_of_post_id,json=quoteOfPostId,proto3" json:"quote_of_post_id,omitempty"`
+	RepostCount    int64 `protobuf:"varint,18,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	QuoteCount     int64 `protobuf:"varint,19,opt,name=quote_count,json=quoteCount,proto3" json:"quote_count,omitempty"`
+	RepostedByMe   bool  `protobuf:"varint,20,opt,name=reposted_by_me,json=repostedByMe,proto3" json:"reposted_by_me,omitempty"`
+	BookmarkedByMe bool  `protobuf:"varint,21,opt,name=bookmarked_by_me,json=bookmarkedByMe,proto3" json:"bookmarked_by_me,omitempty"`
+	// publish_at is set while the post is scheduled, only its author can read it until then
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// poll is unset when the post has no poll
+	Poll *Poll `protobuf:"bytes,23,opt,name=poll,proto3" json:"poll,omitempty"`
+	// link_previews are the previews of the links of content_text that could be fetched, in order
+	LinkPreviews []*LinkPreview `protobuf:"bytes,24,rep,name=link_previews,json=linkPreviews,proto3" json:"link_previews,omitempty"`
+	// media are the images and videos of the post, in display order
+	Media []*PostMedia `protobuf:"bytes,25,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *PostDetailInfo) Reset() {
+	*x = PostDetailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDetailInfo) ProtoMessage() {}
+
+func (x *PostDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDetailInfo.ProtoReflect.Descriptor instead.
+func (*PostDetailInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *PostDetailInfo) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PostDetailInfo) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *PostDetailInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetLikedUsers() []*Like {
+	if x != nil {
+		return x.LikedUsers
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *PostDetailInfo) GetLikedByMe() bool {
+	if x != nil {
+		return x.LikedByMe
+	}
+	return false
+}
+
+func (x *PostDetailInfo) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetMentions() []*MentionSpan {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetRepostOfPostId() int64 {
+	if x != nil {
+		return x.RepostOfPostId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetQuoteOfPostId() int64 {
+	if x != nil {
+		return x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetRepostCount() int64 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetQuoteCount() int64 {
+	if x != nil {
+		return x.QuoteCount
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetRepostedByMe() bool {
+	if x != nil {
+		return x.RepostedByMe
+	}
+	return false
+}
+
+func (x *PostDetailInfo) GetBookmarkedByMe() bool {
+	if x != nil {
+		return x.BookmarkedByMe
+	}
+	return false
+}
+
+func (x *PostDetailInfo) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetLinkPreviews() []*LinkPreview {
+	if x != nil {
+		return x.LinkPreviews
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// LinkPreview is the OpenGraph or Twitter card metadata of a linked page
+type LinkPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl    string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SiteName    string `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId   int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId      int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	// Users mentioned in content_text
+	Mentions []*MentionSpan `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *Comment) GetMentions() []*MentionSpan {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// MentionSpan is a resolved @username in a text.
+// start and end are code point offsets of the "@username" span, end exclusive.
+type MentionSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Start    int32  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End      int32  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *MentionSpan) Reset() {
+	*x = MentionSpan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MentionSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionSpan) ProtoMessage() {}
+
+func (x *MentionSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionSpan.ProtoReflect.Descriptor instead.
+func (*MentionSpan) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *MentionSpan) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *MentionSpan) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MentionSpan) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Location is where a post was written, coordinates are WGS84 degrees
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	PlaceName string  `protobuf:"bytes,3,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetPlaceName() string {
+	if x != nil {
+		return x.PlaceName
+	}
+	return ""
+}
+
+// Place is a point of interest posts can check in at, coordinates are WGS84 degrees
+type Place struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceId   int64                  `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category  string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Country   string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	City      string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	CreatedBy int64                  `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// check_in_count and visitor_count count the public check-ins and their distinct authors
+	CheckInCount int64 `protobuf:"varint,10,opt,name=check_in_count,json=checkInCount,proto3" json:"check_in_count,omitempty"`
+	VisitorCount int64 `protobuf:"varint,11,opt,name=visitor_count,json=visitorCount,proto3" json:"visitor_count,omitempty"`
+	// distance_km is only set by SearchPlaces when near is set
+	DistanceKm float64 `protobuf:"fixed64,12,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// rating_count and rating_average summarize the reviews of the place, rating_average is 0 without reviews
+	RatingCount   int64   `protobuf:"varint,13,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	RatingAverage float64 `protobuf:"fixed64,14,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *Place) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *Place) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Place) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Place) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Place) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Place) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Place) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Place) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Place) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Place) GetCheckInCount() int64 {
+	if x != nil {
+		return x.CheckInCount
+	}
+	return 0
+}
+
+func (x *Place) GetVisitorCount() int64 {
+	if x != nil {
+		return x.VisitorCount
+	}
+	return 0
+}
+
+func (x *Place) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Place) GetRatingCount() int64 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Place) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+type Like struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *Like) Reset() {
+	*x = Like{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Like) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Like) ProtoMessage() {}
+
+func (x *Like) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Like.ProtoReflect.Descriptor instead.
+func (*Like) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *Like) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Like) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// CreateDraft saves a post being written. Drafts are private to their author and are never
+// published until PublishDraft. Their image paths are kept from media cleanup while they exist.
+type CreateDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText   string          `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Visibility    *PostVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=authpost.PostVisibility,oneof" json:"visibility,omitempty"`
+	Location      *Location       `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	PlaceId       int64           `protobuf:"varint,6,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	TripId        int64           `protobuf:"varint,7,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	QuoteOfPostId int64           `protobuf:"varint,8,opt,name=quote_of_post_id,json=quoteOfPostId,proto3" json:"quote_of_post_id,omitempty"`
+	Media         []*PostMedia    `protobuf:"bytes,9,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *CreateDraftRequest) Reset() {
+	*x = CreateDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDraftRequest) ProtoMessage() {}
+
+func (x *CreateDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDraftRequest.ProtoReflect.Descriptor instead.
+func (*CreateDraftRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CreateDraftRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *CreateDraftRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *CreateDraftRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateDraftRequest) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetQuoteOfPostId() int64 {
+	if x != nil {
+		return x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *CreateDraftRequest) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type CreateDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  CreateDraftResponse_CreateDraftStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateDraftResponse_CreateDraftStatus" json:"status,omitempty"`
+	DraftId int64                                 `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+}
+
+func (x *CreateDraftResponse) Reset() {
+	*x = CreateDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDraftResponse) ProtoMessage() {}
+
+func (x *CreateDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDraftResponse.ProtoReflect.Descriptor instead.
+func (*CreateDraftResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *CreateDraftResponse) GetStatus() CreateDraftResponse_CreateDraftStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateDraftResponse_OK
+}
+
+func (x *CreateDraftResponse) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+// GetDrafts lists the drafts of a user, the last edited first
+type GetDraftsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetDraftsRequest) Reset() {
+	*x = GetDraftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftsRequest) ProtoMessage() {}
+
+func (x *GetDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftsRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetDraftsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDraftsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetDraftsResponse_GetDraftsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetDraftsResponse_GetDraftsStatus" json:"status,omitempty"`
+	Drafts []*Draft                          `protobuf:"bytes,2,rep,name=drafts,proto3" json:"drafts,omitempty"`
+}
+
+func (x *GetDraftsResponse) Reset() {
+	*x = GetDraftsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftsResponse) ProtoMessage() {}
+
+func (x *GetDraftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftsResponse.ProtoReflect.Descriptor instead.
+func (*GetDraftsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetDraftsResponse) GetStatus() GetDraftsResponse_GetDraftsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetDraftsResponse_OK
+}
+
+func (x *GetDraftsResponse) GetDrafts() []*Draft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type Draft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DraftId     int64          `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	ContentText string         `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Visibility  PostVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	// location is unset when the draft is not geotagged
+	Location *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// place_id, trip_id and quote_of_post_id are 0 when unset
+	PlaceId       int64                  `protobuf:"varint,6,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	TripId        int64                  `protobuf:"varint,7,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	QuoteOfPostId int64                  `protobuf:"varint,8,opt,name=quote_of_post_id,json=quoteOfPostId,proto3" json:"quote_of_post_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Media         []*PostMedia           `protobuf:"bytes,11,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draft) ProtoMessage() {}
+
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *Draft) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+func (x *Draft) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *Draft) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *Draft) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Draft) GetPlaceId() int64 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *Draft) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *Draft) GetQuoteOfPostId() int64 {
+	if x != nil {
+		return x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *Draft) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Draft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Draft) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// EditDraft updates the fields that are set, like EditPost. It is meant to be called for autosaves.
+type EditDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DraftId        int64           `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	ContentText    *string         `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+	Visibility     *PostVisibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=authpost.PostVisibility,oneof" json:"visibility,omitempty"`
+	Location       *Location       `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	RemoveLocation bool            `protobuf:"varint,7,opt,name=remove_location,json=removeLocation,proto3" json:"remove_location,omitempty"`
+	PlaceId        *int64          `protobuf:"varint,8,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
+	RemovePlace    bool            `protobuf:"varint,9,opt,name=remove_place,json=removePlace,proto3" json:"remove_place,omitempty"`
+	TripId         *int64          `protobuf:"varint,10,opt,name=trip_id,json=tripId,proto3,oneof" json:"trip_id,omitempty"`
+	RemoveTrip     bool            `protobuf:"varint,11,opt,name=remove_trip,json=removeTrip,proto3" json:"remove_trip,omitempty"`
+	QuoteOfPostId  *int64          `protobuf:"varint,12,opt,name=quote_of_post_id,json=quoteOfPostId,proto3,oneof" json:"quote_of_post_id,omitempty"`
+	RemoveQuote    bool            `protobuf:"varint,13,opt,name=remove_quote,json=removeQuote,proto3" json:"remove_quote,omitempty"`
+	// media replaces the media of the draft when set, an empty list removes them
+	Media *PostMediaList `protobuf:"bytes,14,opt,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *EditDraftRequest) Reset() {
+	*x = EditDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditDraftRequest) ProtoMessage() {}
+
+func (x *EditDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditDraftRequest.ProtoReflect.Descriptor instead.
+func (*EditDraftRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *EditDraftRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditDraftRequest) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+func (x *EditDraftRequest) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+func (x *EditDraftRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *EditDraftRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *EditDraftRequest) GetRemoveLocation() bool {
+	if x != nil {
+		return x.RemoveLocation
+	}
+	return false
+}
+
+func (x *EditDraftRequest) GetPlaceId() int64 {
+	if x != nil && x.PlaceId != nil {
+		return *x.PlaceId
+	}
+	return 0
+}
+
+func (x *EditDraftRequest) GetRemovePlace() bool {
+	if x != nil {
+		return x.RemovePlace
+	}
+	return false
+}
+
+func (x *EditDraftRequest) GetTripId() int64 {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return 0
+}
+
+func (x *EditDraftRequest) GetRemoveTrip() bool {
+	if x != nil {
+		return x.RemoveTrip
+	}
+	return false
+}
+
+func (x *EditDraftRequest) GetQuoteOfPostId() int64 {
+	if x != nil && x.QuoteOfPostId != nil {
+		return *x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *EditDraftRequest) GetRemoveQuote() bool {
+	if x != nil {
+		return x.RemoveQuote
+	}
+	return false
+}
+
+func (x *EditDraftRequest) GetMedia() *PostMediaList {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type EditDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditDraftResponse_EditDraftStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.EditDraftResponse_EditDraftStatus" json:"status,omitempty"`
+}
+
+func (x *EditDraftResponse) Reset() {
+	*x = EditDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditDraftResponse) ProtoMessage() {}
+
+func (x *EditDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditDraftResponse.ProtoReflect.Descriptor instead.
+func (*EditDraftResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *EditDraftResponse) GetStatus() EditDraftResponse_EditDraftStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditDraftResponse_OK
+}
+
+type DeleteDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DraftId int64 `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+}
+
+func (x *DeleteDraftRequest) Reset() {
+	*x = DeleteDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftRequest) ProtoMessage() {}
+
+func (x *DeleteDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDraftRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *DeleteDraftRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteDraftRequest) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+type DeleteDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteDraftResponse_DeleteDraftStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeleteDraftResponse_DeleteDraftStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteDraftResponse) Reset() {
+	*x = DeleteDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftResponse) ProtoMessage() {}
+
+func (x *DeleteDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDraftResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteDraftResponse) GetStatus() DeleteDraftResponse_DeleteDraftStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteDraftResponse_OK
+}
+
+// PublishDraft turns a draft into a post, as CreatePost would, and removes the draft.
+// publish_at schedules the post instead of publishing it right away.
+type PublishDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DraftId   int64                  `protobuf:"varint,2,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+}
+
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftRequest) ProtoMessage() {}
+
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PublishDraftRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishDraftRequest) GetDraftId() int64 {
+	if x != nil {
+		return x.DraftId
+	}
+	return 0
+}
+
+func (x *PublishDraftRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type PublishDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishDraftResponse_PublishDraftStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.PublishDraftResponse_PublishDraftStatus" json:"status,omitempty"`
+	PostId int64                                   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PublishDraftResponse) Reset() {
+	*x = PublishDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftResponse) ProtoMessage() {}
+
+func (x *PublishDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishDraftResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *PublishDraftResponse) GetStatus() PublishDraftResponse_PublishDraftStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishDraftResponse_OK
+}
+
+func (x *PublishDraftResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// Poll is the poll of a post as seen by a viewer. The tallies are only set when
+// results_visible: once the viewer voted, once the poll is closed, and for its author.
+type Poll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options        []*PollOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MultipleChoice bool                   `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	Anonymous      bool                   `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Closed         bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	VotersCount    int64                  `protobuf:"varint,6,opt,name=voters_count,json=votersCount,proto3" json:"voters_count,omitempty"`
+	ResultsVisible bool                   `protobuf:"varint,7,opt,name=results_visible,json=resultsVisible,proto3" json:"results_visible,omitempty"`
+	// my_option_ids are the options the viewer voted for
+	MyOptionIds []int64 `protobuf:"varint,8,rep,packed,name=my_option_ids,json=myOptionIds,proto3" json:"my_option_ids,omitempty"`
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Poll) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *Poll) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetVotersCount() int64 {
+	if x != nil {
+		return x.VotersCount
+	}
+	return 0
+}
+
+func (x *Poll) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+func (x *Poll) GetMyOptionIds() []int64 {
+	if x != nil {
+		return x.MyOptionIds
+	}
+	return nil
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionId   int64  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	VotesCount int64  `protobuf:"varint,3,opt,name=votes_count,json=votesCount,proto3" json:"votes_count,omitempty"`
+	// voter_ids is never set for anonymous polls
+	VoterIds []int64 `protobuf:"varint,4,rep,packed,name=voter_ids,json=voterIds,proto3" json:"voter_ids,omitempty"`
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *PollOption) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotesCount() int64 {
+	if x != nil {
+		return x.VotesCount
+	}
+	return 0
+}
+
+func (x *PollOption) GetVoterIds() []int64 {
+	if x != nil {
+		return x.VoterIds
+	}
+	return nil
+}
+
+// VotePoll records the vote of a user, replacing their previous vote until the poll closes
+type VotePollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId    int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OptionIds []int64 `protobuf:"varint,3,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *VotePollRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status VotePollResponse_VotePollStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.VotePollResponse_VotePollStatus" json:"status,omitempty"`
+	// poll is the poll with the new vote counted
+	Poll *Poll `protobuf:"bytes,2,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *VotePollResponse) GetStatus() VotePollResponse_VotePollStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VotePollResponse_OK
+}
+
+func (x *VotePollResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *PinPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PinPostResponse_PinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.PinPostResponse_PinPostStatus" json:"status,omitempty"`
+}
+
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResponse) ProtoMessage() {}
+
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *PinPostResponse) GetStatus() PinPostResponse_PinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PinPostResponse_OK
+}
+
+type UnpinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostRequest) ProtoMessage() {}
+
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *UnpinPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnpinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnpinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnpinPostResponse_UnpinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.UnpinPostResponse_UnpinPostStatus" json:"status,omitempty"`
+}
+
+func (x *UnpinPostResponse) Reset() {
+	*x = UnpinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostResponse) ProtoMessage() {}
+
+func (x *UnpinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *UnpinPostResponse) GetStatus() UnpinPostResponse_UnpinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnpinPostResponse_OK
+}
+
+// PostMedia is an image or video of a post, draft or revision. width and height are in pixels,
+// 0 when unknown. blurhash is a compact placeholder shown while the media loads.
+type PostMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url      string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Width    int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height   int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	AltText  string `protobuf:"bytes,5,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Blurhash string `protobuf:"bytes,6,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+}
+
+func (x *PostMedia) Reset() {
+	*x = PostMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMedia) ProtoMessage() {}
+
+func (x *PostMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMedia.ProtoReflect.Descriptor instead.
+func (*PostMedia) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *PostMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PostMedia) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *PostMedia) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PostMedia) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PostMedia) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *PostMedia) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+// PostMediaList wraps a list of media so that an edit can tell an empty list from an unset one
+type PostMediaList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Media []*PostMedia `protobuf:"bytes,1,rep,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *PostMediaList) Reset() {
+	*x = PostMediaList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMediaList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMediaList) ProtoMessage() {}
+
+func (x *PostMediaList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMediaList.ProtoReflect.Descriptor instead.
+func (*PostMediaList) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *PostMediaList) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// GetPostsByIds loads the posts of a list, such as a page of the newsfeed, in a constant number of queries.
+// Posts that do not exist, are scheduled, or that the viewer may not read are left out.
+type GetPostsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostIds []int64 `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	// viewer_id is the user reading the posts, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetPostsByIdsRequest) Reset() {
+	*x = GetPostsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsByIdsRequest) ProtoMessage() {}
+
+func (x *GetPostsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *GetPostsByIdsRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+func (x *GetPostsByIdsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetPostsByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPostsByIdsResponse_GetPostsByIdsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetPostsByIdsResponse_GetPostsByIdsStatus" json:"status,omitempty"`
+	// posts keep the order of post_ids, duplicated ids are returned once
+	Posts []*PostSummary `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetPostsByIdsResponse) Reset() {
+	*x = GetPostsByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsByIdsResponse) ProtoMessage() {}
+
+func (x *GetPostsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetPostsByIdsResponse) GetStatus() GetPostsByIdsResponse_GetPostsByIdsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostsByIdsResponse_OK
+}
+
+func (x *GetPostsByIdsResponse) GetPosts() []*PostSummary {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// PostSummary is a post as shown in a list. Comments, liked users, polls and link previews
+// are left out, GetPostDetailInfo returns them.
+type PostSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// author is unset once the author deleted their account
+	Author      *PostAuthor            `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	ContentText string                 `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Media       []*PostMedia           `protobuf:"bytes,5,rep,name=media,proto3" json:"media,omitempty"`
+	Visibility  PostVisibility         `protobuf:"varint,6,opt,name=visibility,proto3,enum=authpost.PostVisibility" json:"visibility,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// edited_at is unset when the post was never edited
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Mentions []*MentionSpan         `protobuf:"bytes,9,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// location is unset when the post is not geotagged
+	Location *Location `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	// place is unset when the post is not a check-in, its statistics are not set
+	Place          *Place `protobuf:"bytes,11,opt,name=place,proto3" json:"place,omitempty"`
+	TripId         int64  `protobuf:"varint,12,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	RepostOfPostId int64  `protobuf:"varint,13,opt,name=repost_of_post_id,json=repostOfPostId,proto3" json:"repost_of_post_id,omitempty"`
+	QuoteOfPostId  int64  `protobuf:"varint,14,opt,name=quote_of_post_id,json=quoteOfPostId,proto3" json:"quote_of_post_id,omitempty"`
+	LikeCount      int64  `protobuf:"varint,15,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	CommentCount   int64  `protobuf:"varint,16,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	RepostCount    int64  `protobuf:"varint,17,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	QuoteCount     int64  `protobuf:"varint,18,opt,name=quote_count,json=quoteCount,proto3" json:"quote_count,omitempty"`
+	// Per-viewer fields, only set when the request carries a viewer_id
+	LikedByMe      bool `protobuf:"varint,19,opt,name=liked_by_me,json=likedByMe,proto3" json:"liked_by_me,omitempty"`
+	RepostedByMe   bool `protobuf:"varint,20,opt,name=reposted_by_me,json=repostedByMe,proto3" json:"reposted_by_me,omitempty"`
+	BookmarkedByMe bool `protobuf:"varint,21,opt,name=bookmarked_by_me,json=bookmarkedByMe,proto3" json:"bookmarked_by_me,omitempty"`
+}
+
+func (x *PostSummary) Reset() {
+	*x = PostSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSummary) ProtoMessage() {}
+
+func (x *PostSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSummary.ProtoReflect.Descriptor instead.
+func (*PostSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *PostSummary) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostSummary) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PostSummary) GetAuthor() *PostAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *PostSummary) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PostSummary) GetMedia() []*PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PostSummary) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_PUBLIC
+}
+
+func (x *PostSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostSummary) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *PostSummary) GetMentions() []*MentionSpan {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *PostSummary) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PostSummary) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *PostSummary) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *PostSummary) GetRepostOfPostId() int64 {
+	if x != nil {
+		return x.RepostOfPostId
+	}
+	return 0
+}
+
+func (x *PostSummary) GetQuoteOfPostId() int64 {
+	if x != nil {
+		return x.QuoteOfPostId
+	}
+	return 0
+}
+
+func (x *PostSummary) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *PostSummary) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *PostSummary) GetRepostCount() int64 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *PostSummary) GetQuoteCount() int64 {
+	if x != nil {
+		return x.QuoteCount
+	}
+	return 0
+}
+
+func (x *PostSummary) GetLikedByMe() bool {
+	if x != nil {
+		return x.LikedByMe
+	}
+	return false
+}
+
+func (x *PostSummary) GetRepostedByMe() bool {
+	if x != nil {
+		return x.RepostedByMe
+	}
+	return false
+}
+
+func (x *PostSummary) GetBookmarkedByMe() bool {
+	if x != nil {
+		return x.BookmarkedByMe
+	}
+	return false
+}
+
+// PostAuthor is the public profile of the author of a post
+type PostAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName      string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePicture string `protobuf:"bytes,5,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+}
+
+func (x *PostAuthor) Reset() {
+	*x = PostAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAuthor) ProtoMessage() {}
+
+func (x *PostAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAuthor.ProtoReflect.Descriptor instead.
+func (*PostAuthor) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *PostAuthor) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PostAuthor) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PostAuthor) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *PostAuthor) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *PostAuthor) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+// A story is an image shown to the followers of its author for 24 hours
+type CreateStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// media must be an image
+	Media *PostMedia `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
+}
+
+func (x *CreateStoryRequest) Reset() {
+	*x = CreateStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoryRequest) ProtoMessage() {}
+
+func (x *CreateStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CreateStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateStoryRequest) GetMedia() *PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type CreateStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    CreateStoryResponse_CreateStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.CreateStoryResponse_CreateStoryStatus" json:"status,omitempty"`
+	StoryId   int64                                 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	ExpiresAt *timestamppb.Timestamp                `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *CreateStoryResponse) Reset() {
+	*x = CreateStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoryResponse) ProtoMessage() {}
+
+func (x *CreateStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateStoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *CreateStoryResponse) GetStatus() CreateStoryResponse_CreateStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateStoryResponse_OK
+}
+
+func (x *CreateStoryResponse) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *CreateStoryResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type DeleteStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *DeleteStoryRequest) Reset() {
+	*x = DeleteStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoryRequest) ProtoMessage() {}
+
+func (x *DeleteStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *DeleteStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type DeleteStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteStoryResponse_DeleteStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.DeleteStoryResponse_DeleteStoryStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteStoryResponse) Reset() {
+	*x = DeleteStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoryResponse) ProtoMessage() {}
+
+func (x *DeleteStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *DeleteStoryResponse) GetStatus() DeleteStoryResponse_DeleteStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteStoryResponse_OK
+}
+
+// GetStoryTray returns the users with live stories the viewer can see: the viewer first, then the
+// users they follow with stories they have not seen, then the others, most recent story first
+type GetStoryTrayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewerId int64 `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetStoryTrayRequest) Reset() {
+	*x = GetStoryTrayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryTrayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryTrayRequest) ProtoMessage() {}
+
+func (x *GetStoryTrayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryTrayRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryTrayRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetStoryTrayRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetStoryTrayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  GetStoryTrayResponse_GetStoryTrayStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetStoryTrayResponse_GetStoryTrayStatus" json:"status,omitempty"`
+	Entries []*StoryTrayEntry                       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetStoryTrayResponse) Reset() {
+	*x = GetStoryTrayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryTrayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryTrayResponse) ProtoMessage() {}
+
+func (x *GetStoryTrayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryTrayResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryTrayResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetStoryTrayResponse) GetStatus() GetStoryTrayResponse_GetStoryTrayStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetStoryTrayResponse_OK
+}
+
+func (x *GetStoryTrayResponse) GetEntries() []*StoryTrayEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StoryTrayEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author *PostAuthor `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// stories are the live stories of the author, oldest first
+	Stories []*Story `protobuf:"bytes,2,rep,name=stories,proto3" json:"stories,omitempty"`
+	// has_unseen is set when the viewer did not see every story of the author
+	HasUnseen bool `protobuf:"varint,3,opt,name=has_unseen,json=hasUnseen,proto3" json:"has_unseen,omitempty"`
+}
+
+func (x *StoryTrayEntry) Reset() {
+	*x = StoryTrayEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoryTrayEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryTrayEntry) ProtoMessage() {}
+
+func (x *StoryTrayEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryTrayEntry.ProtoReflect.Descriptor instead.
+func (*StoryTrayEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *StoryTrayEntry) GetAuthor() *PostAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *StoryTrayEntry) GetStories() []*Story {
+	if x != nil {
+		return x.Stories
+	}
+	return nil
+}
+
+func (x *StoryTrayEntry) GetHasUnseen() bool {
+	if x != nil {
+		return x.HasUnseen
+	}
+	return false
+}
+
+type Story struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoryId   int64                  `protobuf:"varint,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	UserId    int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Media     *PostMedia             `protobuf:"bytes,3,opt,name=media,proto3" json:"media,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// seen is set when the viewer saw the story, a user sees their own stories
+	Seen bool `protobuf:"varint,6,opt,name=seen,proto3" json:"seen,omitempty"`
+	// view_count is only set for the author of the story
+	ViewCount int64 `protobuf:"varint,7,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+}
+
+func (x *Story) Reset() {
+	*x = Story{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Story) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Story) ProtoMessage() {}
+
+func (x *Story) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Story.ProtoReflect.Descriptor instead.
+func (*Story) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *Story) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *Story) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Story) GetMedia() *PostMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Story) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Story) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Story) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+func (x *Story) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type ViewStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewerId int64 `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	StoryId  int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *ViewStoryRequest) Reset() {
+	*x = ViewStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewStoryRequest) ProtoMessage() {}
+
+func (x *ViewStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewStoryRequest.ProtoReflect.Descriptor instead.
+func (*ViewStoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ViewStoryRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ViewStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type ViewStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ViewStoryResponse_ViewStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.ViewStoryResponse_ViewStoryStatus" json:"status,omitempty"`
+}
+
+func (x *ViewStoryResponse) Reset() {
+	*x = ViewStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewStoryResponse) ProtoMessage() {}
+
+func (x *ViewStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewStoryResponse.ProtoReflect.Descriptor instead.
+func (*ViewStoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ViewStoryResponse) GetStatus() ViewStoryResponse_ViewStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ViewStoryResponse_OK
+}
+
+// GetStoryViewers returns who saw a story, to its author only
+type GetStoryViewersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *GetStoryViewersRequest) Reset() {
+	*x = GetStoryViewersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryViewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryViewersRequest) ProtoMessage() {}
+
+func (x *GetStoryViewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryViewersRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryViewersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetStoryViewersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetStoryViewersRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type GetStoryViewersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetStoryViewersResponse_GetStoryViewersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authpost.GetStoryViewersResponse_GetStoryViewersStatus" json:"status,omitempty"`
+	// viewers are ordered by the time they saw the story, most recent first
+	Viewers []*StoryViewer `protobuf:"bytes,2,rep,name=viewers,proto3" json:"viewers,omitempty"`
+}
+
+func (x *GetStoryViewersResponse) Reset() {
+	*x = GetStoryViewersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryViewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryViewersResponse) ProtoMessage() {}
+
+func (x *GetStoryViewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryViewersResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryViewersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *GetStoryViewersResponse) GetStatus() GetStoryViewersResponse_GetStoryViewersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetStoryViewersResponse_OK
+}
+
+func (x *GetStoryViewersResponse) GetViewers() []*StoryViewer {
+	if x != nil {
+		return x.Viewers
+	}
+	return nil
+}
+
+type StoryViewer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *PostAuthor            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ViewedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
+}
+
+func (x *StoryViewer) Reset() {
+	*x = StoryViewer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_authpost_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoryViewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryViewer) ProtoMessage() {}
+
+func (x *StoryViewer) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_authpost_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryViewer.ProtoReflect.Descriptor instead.
+func (*StoryViewer) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_authpost_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *StoryViewer) GetUser() *PostAuthor {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *StoryViewer) GetViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedAt
+	}
+	return nil
+}
+
+var File_pkg_types_proto_authpost_proto protoreflect.FileDescriptor
+
+var file_pkg_types_proto_authpost_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a, 0x1e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0xec, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x47, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a,
+	0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52,
+	0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x22, 0xe7,
+	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xb9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x43, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55,
+	0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x22, 0x9e, 0x03, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69,
+	0x72, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0c, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a,
+	0x0e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x50, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd5, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x35,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x8d, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd7, 0x01, 0x0a,
+	0x19, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x35, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52,
+	0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22,
+	0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x39, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22,
+	0x4f, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x22, 0xa1, 0x01, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44,
+	0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x02, 0x22, 0x51, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22, 0x30, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22,
+	0xed, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x4f,
+	0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x41, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0xa7, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xc5, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
+	0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04,
+	0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x41,
+	0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50,
+	0x4f, 0x4c, 0x4c, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x08, 0x22, 0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x22, 0xb2, 0x04, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x02, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x06, 0x74,
+	0x72, 0x69, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x4a, 0x04, 0x08,
+	0x04, 0x10, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0xfb, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xa3, 0x01, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41,
+	0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x12,
+	0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45,
+	0x44, 0x49, 0x41, 0x10, 0x07, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22,
+	0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x46, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x4f,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xea, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x34, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22, 0x87, 0x02, 0x0a,
+	0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x61, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6b, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x04, 0x22, 0xa1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72,
+	0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4e, 0x65, 0x61,
+	0x72, 0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x34,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x22, 0x46, 0x0a, 0x0a, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22, 0x45, 0x0a, 0x11,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x69,
+	0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52,
+	0x45, 0x50, 0x4f, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x22, 0x45, 0x0a, 0x11, 0x55, 0x6e, 0x64,
+	0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x9d, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40,
+	0x0a, 0x10, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xc0,
+	0x02, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49,
+	0x53, 0x48, 0x5f, 0x41, 0x54, 0x10, 0x05, 0x22, 0x4e, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x6f, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11,
+	0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x22, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74,
+	0x61, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61,
+	0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x53, 0x48, 0x54, 0x41, 0x47, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x29, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xcf, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2f, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x48, 0x61, 0x73,
+	0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x74, 0x61,
+	0x67, 0x22, 0x35, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x53, 0x48, 0x54, 0x41, 0x47, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x0b, 0x48, 0x61, 0x73,
+	0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x36,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x22, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x53,
+	0x45, 0x52, 0x53, 0x10, 0x01, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x55, 0x52, 0x53,
+	0x4f, 0x52, 0x10, 0x02, 0x22, 0xc5, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xbd, 0x01, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x22, 0xa2, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x2f,
+	0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x22,
+	0x2c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0xab, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x7c, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x32,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x22, 0x7d, 0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0xf1, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x65,
+	0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x4c,
+	0x41, 0x43, 0x45, 0x10, 0x04, 0x22, 0xac, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x49, 0x50, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x03, 0x22, 0xc8, 0x03, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x48, 0x02, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xcf, 0x01,
+	0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x78, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x69,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x49, 0x50, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c,
+	0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x22,
+	0x46, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49,
+	0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0x46,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x74,
+	0x72, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x22,
+	0x2b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x9e, 0x04, 0x0a,
+	0x04, 0x54, 0x72, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x92, 0x01,
+	0x0a, 0x08, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x67, 0x0a, 0x0a, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x92, 0x01, 0x0a, 0x17,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0x86, 0x02, 0x0a, 0x18, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x96, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x22, 0x68, 0x0a, 0x1c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54,
+	0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x53, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe7, 0x01,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x36, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x70,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69,
+	0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x54, 0x72, 0x69, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xf1,
+	0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81,
+	0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04,
+	0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x10, 0x05, 0x22, 0x68, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xde, 0x01, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x16,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x22, 0x62, 0x0a,
+	0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72,
+	0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6b, 0x0a, 0x14, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x06, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x22, 0x30, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x44, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x43, 0x69, 0x74, 0x79, 0x52, 0x06, 0x63,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x43, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x10, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x4d,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd6, 0x03,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x44, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xc9, 0x01, 0x0a,
+	0x16, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x73, 0x69, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x76, 0x69, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x69, 0x73, 0x69, 0x74, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4a, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x78, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c,
+	0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52,
+	0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10, 0x04, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x17,
+	0x45, 0x64, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x04, 0x22, 0x50, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5c, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0x51, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xcc,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x90, 0x02,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x3f, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x4f, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x53,
+	0x54, 0x5f, 0x48, 0x45, 0x4c, 0x50, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x48,
+	0x49, 0x47, 0x48, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x4c, 0x4f, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x22, 0xb9, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x31, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0x48, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x22, 0x6a, 0x0a, 0x18,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0xd7, 0x02, 0x0a, 0x0b, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x65,
+	0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f,
+	0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x12,
+	0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x41, 0x64,
+	0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x49, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46,
+	0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30,
+	0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x83, 0x01,
+	0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x62, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x03, 0x22, 0x38, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfb,
+	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x45, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x3a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xb1, 0x01, 0x0a,
+	0x12, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x71, 0x0a, 0x1d, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x1e, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a, 0x0a, 0x1c, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
+	0x04, 0x22, 0x5f, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x56, 0x0a, 0x1e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4c, 0x4c,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0xc2, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a,
+	0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x40, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x02, 0x22, 0x98, 0x08, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d,
+	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69,
+	0x6b, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x38,
+	0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c,
+	0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72,
+	0x69, 0x70, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f,
+	0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x4d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x0d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x18, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x91, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xb0, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
+	0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x63, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xca, 0x03, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x22, 0x38, 0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf0, 0x02, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x3d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0xad, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15,
+	0x51, 0x55, 0x4f, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d,
+	0x41, 0x4e, 0x59, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x53, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x07, 0x22,
+	0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73,
+	0x22, 0x2d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22,
+	0xc7, 0x03, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74,
+	0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f,
+	0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4a,
+	0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0xf0, 0x04, 0x0a, 0x10, 0x45, 0x64,
+	0x69, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x01, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x72,
+	0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x12, 0x2c, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04,
+	0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x8a, 0x02, 0x0a,
+	0x11, 0x45, 0x64, 0x69, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x52, 0x41, 0x46, 0x54,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x51,
+	0x55, 0x4f, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x07, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x49, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x22, 0xd8, 0x02, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xdb,
+	0x01, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f,
+	0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x44,
+	0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x07, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x55, 0x42,
+	0x4c, 0x49, 0x53, 0x48, 0x5f, 0x41, 0x54, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x09, 0x22, 0xc0, 0x02, 0x0a,
+	0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0x7b, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x0f,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0xf5, 0x01, 0x0a, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x22, 0x7a, 0x0a, 0x0e,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x22, 0x42, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a,
+	0x0f, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x75, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e,
+	0x59, 0x5f, 0x50, 0x49, 0x4e, 0x53, 0x10, 0x05, 0x22, 0x44, 0x0a, 0x10, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xbc,
+	0x01, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x62, 0x0a, 0x0f, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x22, 0x9f, 0x01,
+	0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x74, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75, 0x72, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x75, 0x72, 0x68, 0x61, 0x73, 0x68, 0x22,
+	0x3a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x22, 0x4e, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42,
+	0x79, 0x49, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22,
+	0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53,
+	0x10, 0x01, 0x22, 0xd8, 0x06, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31,
+	0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x4d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x22, 0xa7, 0x01,
+	0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x22, 0xf8, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc7,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x75, 0x6e, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x55, 0x6e, 0x73,
+	0x65, 0x65, 0x6e, 0x22, 0x8f, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x0f,
+	0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x4c, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x52,
+	0x07, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0x70, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41,
+	0x74, 0x2a, 0x38, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0x2d, 0x0a, 0x08, 0x54,
+	0x72, 0x69, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49, 0x45, 0x57, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x32, 0xed, 0x31, 0x0a, 0x13, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x70, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42,
+	0x79, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72,
+	0x62, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a,
+	0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74,
+	0x61, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x74, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x69,
+	0x70, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x69, 0x70, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64,
+	0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5e, 0x0a, 0x11, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48,
+	0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x65, 0x6c, 0x70,
+	0x66, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x48, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x45,
+	0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1c,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x56, 0x69, 0x65, 0x77,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65,
+	0x77, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x61, 0x6e, 0x67, 0x4e, 0x67,
+	0x75, 0x79, 0x65, 0x6e, 0x44, 0x65, 0x76, 0x33, 0x2f, 0x57, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x53,
+	0x70, 0x68, 0x65, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f, 0x73, 0x74, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x70, 0x6f,
+	0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_types_proto_authpost_proto_rawDescOnce sync.Once
+	file_pkg_types_proto_authpost_proto_rawDescData = file_pkg_types_proto_authpost_proto_rawDesc
+)
+
+func file_pkg_types_proto_authpost_proto_rawDescGZIP() []byte {
+	file_pkg_types_proto_authpost_proto_rawDescOnce.Do(func() {
+		file_pkg_types_proto_authpost_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_types_proto_authpost_proto_rawDescData)
+	})
+	return file_pkg_types_proto_authpost_proto_rawDescData
+}
+
+var file_pkg_types_proto_authpost_proto_enumTypes = make([]protoimpl.EnumInfo, 79)
+var file_pkg_types_proto_authpost_proto_msgTypes = make([]protoimpl.MessageInfo, 185)
+var file_pkg_types_proto_authpost_proto_goTypes = []interface{}{
+	(PostVisibility)(0), // 0: authpost.PostVisibility
+	(TripRole)(0),       // 1: authpost.TripRole
+	(CheckUserAuthenticationResponse_CheckUserAuthenticationStatus)(0),   // 2: authpost.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
+	(CreateUserResponse_CreateUserStatus)(0),                             // 3: authpost.CreateUserResponse.CreateUserStatus
+	(EditUserResponse_EditUserStatus)(0),                                 // 4: authpost.EditUserResponse.EditUserStatus
+	(GetUserDetailInfoResponse_GetUserDetailInfoStatus)(0),               // 5: authpost.GetUserDetailInfoResponse.GetUserDetailInfoStatus
+	(AutocompleteUsersResponse_AutocompleteUsersStatus)(0),               // 6: authpost.AutocompleteUsersResponse.AutocompleteUsersStatus
+	(GetUserFollowerResponse_GetUserFollowerStatus)(0),                   // 7: authpost.GetUserFollowerResponse.GetUserFollowerStatus
+	(GetUserFollowingResponse_GetUserFollowingStatus)(0),                 // 8: authpost.GetUserFollowingResponse.GetUserFollowingStatus
+	(FollowUserResponse_FollowUserStatus)(0),                             // 9: authpost.FollowUserResponse.FollowUserStatus
+	(UnfollowUserResponse_UnfollowUserStatus)(0),                         // 10: authpost.UnfollowUserResponse.UnfollowUserStatus
+	(GetUserPostsResponse_GetUserPostsStatus)(0),                         // 11: authpost.GetUserPostsResponse.GetUserPostsStatus
+	(CreatePostResponse_CreatePostStatus)(0),                             // 12: authpost.CreatePostResponse.CreatePostStatus
+	(GetPostDetailInfoResponse_GetPostDetailInfoStatus)(0),               // 13: authpost.GetPostDetailInfoResponse.GetPostDetailInfoStatus
+	(EditPostResponse_EditPostStatus)(0),                                 // 14: authpost.EditPostResponse.EditPostStatus
+	(DeletePostResponse_DeletePostStatus)(0),                             // 15: authpost.DeletePostResponse.DeletePostStatus
+	(GetTrashedPostsResponse_GetTrashedPostsStatus)(0),                   // 16: authpost.GetTrashedPostsResponse.GetTrashedPostsStatus
+	(RestorePostResponse_RestorePostStatus)(0),                           // 17: authpost.RestorePostResponse.RestorePostStatus
+	(GetPostRevisionsResponse_GetPostRevisionsStatus)(0),                 // 18: authpost.GetPostRevisionsResponse.GetPostRevisionsStatus
+	(RevertPostResponse_RevertPostStatus)(0),                             // 19: authpost.RevertPostResponse.RevertPostStatus
+	(GetNearbyPostsResponse_GetNearbyPostsStatus)(0),                     // 20: authpost.GetNearbyPostsResponse.GetNearbyPostsStatus
+	(RepostPostResponse_RepostPostStatus)(0),                             // 21: authpost.RepostPostResponse.RepostPostStatus
+	(UndoRepostResponse_UndoRepostStatus)(0),                             // 22: authpost.UndoRepostResponse.UndoRepostStatus
+	(GetScheduledPostsResponse_GetScheduledPostsStatus)(0),               // 23: authpost.GetScheduledPostsResponse.GetScheduledPostsStatus
+	(ReschedulePostResponse_ReschedulePostStatus)(0),                     // 24: authpost.ReschedulePostResponse.ReschedulePostStatus
+	(CancelScheduledPostResponse_CancelScheduledPostStatus)(0),           // 25: authpost.CancelScheduledPostResponse.CancelScheduledPostStatus
+	(GetHashtagPostsResponse_GetHashtagPostsStatus)(0),                   // 26: authpost.GetHashtagPostsResponse.GetHashtagPostsStatus
+	(GetHashtagInfoResponse_GetHashtagInfoStatus)(0),                     // 27: authpost.GetHashtagInfoResponse.GetHashtagInfoStatus
+	(SearchRequest_SearchType)(0),                                        // 28: authpost.SearchRequest.SearchType
+	(SearchResponse_SearchStatus)(0),                                     // 29: authpost.SearchResponse.SearchStatus
+	(CreatePlaceResponse_CreatePlaceStatus)(0),                           // 30: authpost.CreatePlaceResponse.CreatePlaceStatus
+	(SearchPlacesResponse_SearchPlacesStatus)(0),                         // 31: authpost.SearchPlacesResponse.SearchPlacesStatus
+	(GetPlaceResponse_GetPlaceStatus)(0),                                 // 32: authpost.GetPlaceResponse.GetPlaceStatus
+	(GetPlacePostsResponse_GetPlacePostsStatus)(0),                       // 33: authpost.GetPlacePostsResponse.GetPlacePostsStatus
+	(MergePlacesResponse_MergePlacesStatus)(0),                           // 34: authpost.MergePlacesResponse.MergePlacesStatus
+	(CreateTripResponse_CreateTripStatus)(0),                             // 35: authpost.CreateTripResponse.CreateTripStatus
+	(EditTripResponse_EditTripStatus)(0),                                 // 36: authpost.EditTripResponse.EditTripStatus
+	(PublishTripResponse_PublishTripStatus)(0),                           // 37: authpost.PublishTripResponse.PublishTripStatus
+	(GetTripResponse_GetTripStatus)(0),                                   // 38: authpost.GetTripResponse.GetTripStatus
+	(InviteTripMemberResponse_InviteTripMemberStatus)(0),                 // 39: authpost.InviteTripMemberResponse.InviteTripMemberStatus
+	(RespondTripInvitationResponse_RespondTripInvitationStatus)(0),       // 40: authpost.RespondTripInvitationResponse.RespondTripInvitationStatus
+	(GetTripInvitationsResponse_GetTripInvitationsStatus)(0),             // 41: authpost.GetTripInvitationsResponse.GetTripInvitationsStatus
+	(UpdateTripMemberResponse_UpdateTripMemberStatus)(0),                 // 42: authpost.UpdateTripMemberResponse.UpdateTripMemberStatus
+	(RemoveTripMemberResponse_RemoveTripMemberStatus)(0),                 // 43: authpost.RemoveTripMemberResponse.RemoveTripMemberStatus
+	(RemoveTripPostResponse_RemoveTripPostStatus)(0),                     // 44: authpost.RemoveTripPostResponse.RemoveTripPostStatus
+	(GetTravelMapResponse_GetTravelMapStatus)(0),                         // 45: authpost.GetTravelMapResponse.GetTravelMapStatus
+	(GetTravelStatsResponse_GetTravelStatsStatus)(0),                     // 46: authpost.GetTravelStatsResponse.GetTravelStatsStatus
+	(AddTravelVisitResponse_AddTravelVisitStatus)(0),                     // 47: authpost.AddTravelVisitResponse.AddTravelVisitStatus
+	(DeleteTravelVisitResponse_DeleteTravelVisitStatus)(0),               // 48: authpost.DeleteTravelVisitResponse.DeleteTravelVisitStatus
+	(CreatePlaceReviewResponse_CreatePlaceReviewStatus)(0),               // 49: authpost.CreatePlaceReviewResponse.CreatePlaceReviewStatus
+	(EditPlaceReviewResponse_EditPlaceReviewStatus)(0),                   // 50: authpost.EditPlaceReviewResponse.EditPlaceReviewStatus
+	(DeletePlaceReviewResponse_DeletePlaceReviewStatus)(0),               // 51: authpost.DeletePlaceReviewResponse.DeletePlaceReviewStatus
+	(GetPlaceReviewResponse_GetPlaceReviewStatus)(0),                     // 52: authpost.GetPlaceReviewResponse.GetPlaceReviewStatus
+	(GetPlaceReviewsRequest_ReviewSort)(0),                               // 53: authpost.GetPlaceReviewsRequest.ReviewSort
+	(GetPlaceReviewsResponse_GetPlaceReviewsStatus)(0),                   // 54: authpost.GetPlaceReviewsResponse.GetPlaceReviewsStatus
+	(VoteReviewHelpfulResponse_VoteReviewHelpfulStatus)(0),               // 55: authpost.VoteReviewHelpfulResponse.VoteReviewHelpfulStatus
+	(AddBookmarkResponse_AddBookmarkStatus)(0),                           // 56: authpost.AddBookmarkResponse.AddBookmarkStatus
+	(RemoveBookmarkResponse_RemoveBookmarkStatus)(0),                     // 57: authpost.RemoveBookmarkResponse.RemoveBookmarkStatus
+	(GetBookmarksResponse_GetBookmarksStatus)(0),                         // 58: authpost.GetBookmarksResponse.GetBookmarksStatus
+	(CreateBookmarkCollectionResponse_CreateBookmarkCollectionStatus)(0), // 59: authpost.CreateBookmarkCollectionResponse.CreateBookmarkCollectionStatus
+	(GetBookmarkCollectionsResponse_GetBookmarkCollectionsStatus)(0),     // 60: authpost.GetBookmarkCollectionsResponse.GetBookmarkCollectionsStatus
+	(EditBookmarkCollectionResponse_EditBookmarkCollectionStatus)(0),     // 61: authpost.EditBookmarkCollectionResponse.EditBookmarkCollectionStatus
+	(DeleteBookmarkCollectionResponse_DeleteBookmarkCollectionStatus)(0), // 62: authpost.DeleteBookmarkCollectionResponse.DeleteBookmarkCollectionStatus
+	(CommentPostResponse_CommentPostStatus)(0),                           // 63: authpost.CommentPostResponse.CommentPostStatus
+	(LikePostResponse_LikePostStatus)(0),                                 // 64: authpost.LikePostResponse.LikePostStatus
+	(CreateDraftResponse_CreateDraftStatus)(0),                           // 65: authpost.CreateDraftResponse.CreateDraftStatus
+	(GetDraftsResponse_GetDraftsStatus)(0),                               // 66: authpost.GetDraftsResponse.GetDraftsStatus
+	(EditDraftResponse_EditDraftStatus)(0),                               // 67: authpost.EditDraftResponse.EditDraftStatus
+	(DeleteDraftResponse_DeleteDraftStatus)(0),                           // 68: authpost.DeleteDraftResponse.DeleteDraftStatus
+	(PublishDraftResponse_PublishDraftStatus)(0),                         // 69: authpost.PublishDraftResponse.PublishDraftStatus
+	(VotePollResponse_VotePollStatus)(0),                                 // 70: authpost.VotePollResponse.VotePollStatus
+	(PinPostResponse_PinPostStatus)(0),                                   // 71: authpost.PinPostResponse.PinPostStatus
+	(UnpinPostResponse_UnpinPostStatus)(0),                               // 72: authpost.UnpinPostResponse.UnpinPostStatus
+	(GetPostsByIdsResponse_GetPostsByIdsStatus)(0),                       // 73: authpost.GetPostsByIdsResponse.GetPostsByIdsStatus
+	(CreateStoryResponse_CreateStoryStatus)(0),                           // 74: authpost.CreateStoryResponse.CreateStoryStatus
+	(DeleteStoryResponse_DeleteStoryStatus)(0),                           // 75: authpost.DeleteStoryResponse.DeleteStoryStatus
+	(GetStoryTrayResponse_GetStoryTrayStatus)(0),                         // 76: authpost.GetStoryTrayResponse.GetStoryTrayStatus
+	(ViewStoryResponse_ViewStoryStatus)(0),                               // 77: authpost.ViewStoryResponse.ViewStoryStatus
+	(GetStoryViewersResponse_GetStoryViewersStatus)(0),                   // 78: authpost.GetStoryViewersResponse.GetStoryViewersStatus
+	(*CheckUserAuthenticationRequest)(nil),                               // 79: authpost.CheckUserAuthenticationRequest
+	(*CheckUserAuthenticationResponse)(nil),                              // 80: authpost.CheckUserAuthenticationResponse
+	(*CreateUserRequest)(nil),                                            // 81: authpost.CreateUserRequest
+	(*CreateUserResponse)(nil),                                           // 82: authpost.CreateUserResponse
+	(*EditUserRequest)(nil),                                              // 83: authpost.EditUserRequest
+	(*EditUserResponse)(nil),                                             // 84: authpost.EditUserResponse
+	(*GetUserDetailInfoRequest)(nil),                                     // 85: authpost.GetUserDetailInfoRequest
+	(*GetUserDetailInfoResponse)(nil),                                    // 86: authpost.GetUserDetailInfoResponse
+	(*UserDetailInfo)(nil),                                               // 87: authpost.UserDetailInfo
+	(*AutocompleteUsersRequest)(nil),                                     // 88: authpost.AutocompleteUsersRequest
+	(*AutocompleteUsersResponse)(nil),                                    // 89: authpost.AutocompleteUsersResponse
+	(*GetUserFollowerRequest)(nil),                                       // 90: authpost.GetUserFollowerRequest
+	(*GetUserFollowerResponse)(nil),                                      // 91: authpost.GetUserFollowerResponse
+	(*GetUserFollowingRequest)(nil),                                      // 92: authpost.GetUserFollowingRequest
+	(*GetUserFollowingResponse)(nil),                                     // 93: authpost.GetUserFollowingResponse
+	(*FollowUserRequest)(nil),                                            // 94: authpost.FollowUserRequest
+	(*FollowUserResponse)(nil),                                           // 95: authpost.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                                          // 96: authpost.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                                         // 97: authpost.UnfollowUserResponse
+	(*GetUserPostsRequest)(nil),                                          // 98: authpost.GetUserPostsRequest
+	(*GetUserPostsResponse)(nil),                                         // 99: authpost.GetUserPostsResponse
+	(*CreatePostRequest)(nil),                                            // 100: authpost.CreatePostRequest
+	(*PollInput)(nil),                                                    // 101: authpost.PollInput
+	(*CreatePostResponse)(nil),                                           // 102: authpost.CreatePostResponse
+	(*GetPostDetailInfoRequest)(nil),                                     // 103: authpost.GetPostDetailInfoRequest
+	(*GetPostDetailInfoResponse)(nil),                                    // 104: authpost.GetPostDetailInfoResponse
+	(*EditPostRequest)(nil),                                              // 105: authpost.EditPostRequest
+	(*EditPostResponse)(nil),                                             // 106: authpost.EditPostResponse
+	(*DeletePostRequest)(nil),                                            // 107: authpost.DeletePostRequest
+	(*DeletePostResponse)(nil),                                           // 108: authpost.DeletePostResponse
+	(*GetTrashedPostsRequest)(nil),                                       // 109: authpost.GetTrashedPostsRequest
+	(*GetTrashedPostsResponse)(nil),                                      // 110: authpost.GetTrashedPostsResponse
+	(*TrashedPost)(nil),                                                  // 111: authpost.TrashedPost
+	(*RestorePostRequest)(nil),                                           // 112: authpost.RestorePostRequest
+	(*RestorePostResponse)(nil),                                          // 113: authpost.RestorePostResponse
+	(*GetPostRevisionsRequest)(nil),                                      // 114: authpost.GetPostRevisionsRequest
+	(*GetPostRevisionsResponse)(nil),                                     // 115: authpost.GetPostRevisionsResponse
+	(*PostRevision)(nil),                                                 // 116: authpost.PostRevision
+	(*RevertPostRequest)(nil),                                            // 117: authpost.RevertPostRequest
+	(*RevertPostResponse)(nil),                                           // 118: authpost.RevertPostResponse
+	(*GetNearbyPostsRequest)(nil),                                        // 119: authpost.GetNearbyPostsRequest
+	(*GetNearbyPostsResponse)(nil),                                       // 120: authpost.GetNearbyPostsResponse
+	(*NearbyPost)(nil),                                                   // 121: authpost.NearbyPost
+	(*RepostPostRequest)(nil),                                            // 122: authpost.RepostPostRequest
+	(*RepostPostResponse)(nil),                                           // 123: authpost.RepostPostResponse
+	(*UndoRepostRequest)(nil),                                            // 124: authpost.UndoRepostRequest
+	(*UndoRepostResponse)(nil),                                           // 125: authpost.UndoRepostResponse
+	(*GetScheduledPostsRequest)(nil),                                     // 126: authpost.GetScheduledPostsRequest
+	(*GetScheduledPostsResponse)(nil),                                    // 127: authpost.GetScheduledPostsResponse
+	(*ScheduledPost)(nil),                                                // 128: authpost.ScheduledPost
+	(*ReschedulePostRequest)(nil),                                        // 129: authpost.ReschedulePostRequest
+	(*ReschedulePostResponse)(nil),                                       // 130: authpost.ReschedulePostResponse
+	(*CancelScheduledPostRequest)(nil),                                   // 131: authpost.CancelScheduledPostRequest
+	(*CancelScheduledPostResponse)(nil),                                  // 132: authpost.CancelScheduledPostResponse
+	(*GetHashtagPostsRequest)(nil),                                       // 133: authpost.GetHashtagPostsRequest
+	(*GetHashtagPostsResponse)(nil),                                      // 134: authpost.GetHashtagPostsResponse
+	(*GetHashtagInfoRequest)(nil),                                        // 135: authpost.GetHashtagInfoRequest
+	(*GetHashtagInfoResponse)(nil),                                       // 136: authpost.GetHashtagInfoResponse
+	(*HashtagInfo)(nil),                                                  // 137: authpost.HashtagInfo
+	(*SearchRequest)(nil),                                                // 138: authpost.SearchRequest
+	(*SearchResponse)(nil),                                               // 139: authpost.SearchResponse
+	(*CreatePlaceRequest)(nil),                                           // 140: authpost.CreatePlaceRequest
+	(*CreatePlaceResponse)(nil),                                          // 141: authpost.CreatePlaceResponse
+	(*SearchPlacesRequest)(nil),                                          // 142: authpost.SearchPlacesRequest
+	(*SearchPlacesResponse)(nil),                                         // 143: authpost.SearchPlacesResponse
+	(*GetPlaceRequest)(nil),                                              // 144: authpost.GetPlaceRequest
+	(*GetPlaceResponse)(nil),                                             // 145: authpost.GetPlaceResponse
+	(*GetPlacePostsRequest)(nil),                                         // 146: authpost.GetPlacePostsRequest
+	(*GetPlacePostsResponse)(nil),                                        // 147: authpost.GetPlacePostsResponse
+	(*MergePlacesRequest)(nil),                                           // 148: authpost.MergePlacesRequest
+	(*MergePlacesResponse)(nil),                                          // 149: authpost.MergePlacesResponse
+	(*CreateTripRequest)(nil),                                            // 150: authpost.CreateTripRequest
+	(*CreateTripResponse)(nil),                                           // 151: authpost.CreateTripResponse
+	(*EditTripRequest)(nil),                                              // 152: authpost.EditTripRequest
+	(*EditTripResponse)(nil),                                             // 153: authpost.EditTripResponse
+	(*PublishTripRequest)(nil),                                           // 154: authpost.PublishTripRequest
+	(*PublishTripResponse)(nil),                                          // 155: authpost.PublishTripResponse
+	(*GetTripRequest)(nil),                                               // 156: authpost.GetTripRequest
+	(*GetTripResponse)(nil),                                              // 157: authpost.GetTripResponse
+	(*Trip)(nil),                                                         // 158: authpost.Trip
+	(*TripPost)(nil),                                                     // 159: authpost.TripPost
+	(*TripMember)(nil),                                                   // 160: authpost.TripMember
+	(*InviteTripMemberRequest)(nil),                                      // 161: authpost.InviteTripMemberRequest
+	(*InviteTripMemberResponse)(nil),                                     // 162: authpost.InviteTripMemberResponse
+	(*RespondTripInvitationRequest)(nil),                                 // 163: authpost.RespondTripInvitationRequest
+	(*RespondTripInvitationResponse)(nil),                                // 164: authpost.RespondTripInvitationResponse
+	(*GetTripInvitationsRequest)(nil),                                    // 165: authpost.GetTripInvitationsRequest
+	(*GetTripInvitationsResponse)(nil),                                   // 166: authpost.GetTripInvitationsResponse
+	(*TripInvitation)(nil),                                               // 167: authpost.TripInvitation
+	(*UpdateTripMemberRequest)(nil),                                      // 168: authpost.UpdateTripMemberRequest
+	(*UpdateTripMemberResponse)(nil),                                     // 169: authpost.UpdateTripMemberResponse
+	(*RemoveTripMemberRequest)(nil),                                      // 170: authpost.RemoveTripMemberRequest
+	(*RemoveTripMemberResponse)(nil),                                     // 171: authpost.RemoveTripMemberResponse
+	(*RemoveTripPostRequest)(nil),                                        // 172: authpost.RemoveTripPostRequest
+	(*RemoveTripPostResponse)(nil),                                       // 173: authpost.RemoveTripPostResponse
+	(*GetTravelMapRequest)(nil),                                          // 174: authpost.GetTravelMapRequest
+	(*GetTravelMapResponse)(nil),                                         // 175: authpost.GetTravelMapResponse
+	(*VisitedCountry)(nil),                                               // 176: authpost.VisitedCountry
+	(*VisitedCity)(nil),                                                  // 177: authpost.VisitedCity
+	(*TravelVisit)(nil),                                                  // 178: authpost.TravelVisit
+	(*GetTravelStatsRequest)(nil),                                        // 179: authpost.GetTravelStatsRequest
+	(*GetTravelStatsResponse)(nil),                                       // 180: authpost.GetTravelStatsResponse
+	(*AddTravelVisitRequest)(nil),                                        // 181: authpost.AddTravelVisitRequest
+	(*AddTravelVisitResponse)(nil),                                       // 182: authpost.AddTravelVisitResponse
+	(*DeleteTravelVisitRequest)(nil),                                     // 183: authpost.DeleteTravelVisitRequest
+	(*DeleteTravelVisitResponse)(nil),                                    // 184: authpost.DeleteTravelVisitResponse
+	(*CreatePlaceReviewRequest)(nil),                                     // 185: authpost.CreatePlaceReviewRequest
+	(*CreatePlaceReviewResponse)(nil),                                    // 186: authpost.CreatePlaceReviewResponse
+	(*EditPlaceReviewRequest)(nil),                                       // 187: authpost.EditPlaceReviewRequest
+	(*EditPlaceReviewResponse)(nil),                                      // 188: authpost.EditPlaceReviewResponse
+	(*DeletePlaceReviewRequest)(nil),                                     // 189: authpost.DeletePlaceReviewRequest
+	(*DeletePlaceReviewResponse)(nil),                                    // 190: authpost.DeletePlaceReviewResponse
+	(*GetPlaceReviewRequest)(nil),                                        // 191: authpost.GetPlaceReviewRequest
+	(*GetPlaceReviewResponse)(nil),                                       // 192: authpost.GetPlaceReviewResponse
+	(*GetPlaceReviewsRequest)(nil),                                       // 193: authpost.GetPlaceReviewsRequest
+	(*GetPlaceReviewsResponse)(nil),                                      // 194: authpost.GetPlaceReviewsResponse
+	(*VoteReviewHelpfulRequest)(nil),                                     // 195: authpost.VoteReviewHelpfulRequest
+	(*VoteReviewHelpfulResponse)(nil),                                    // 196: authpost.VoteReviewHelpfulResponse
+	(*PlaceReview)(nil),                                                  // 197: authpost.PlaceReview
+	(*RatingSummary)(nil),                                                // 198: authpost.RatingSummary
+	(*AddBookmarkRequest)(nil),                                           // 199: authpost.AddBookmarkRequest
+	(*AddBookmarkResponse)(nil),                                          // 200: authpost.AddBookmarkResponse
+	(*RemoveBookmarkRequest)(nil),                                        // 201: authpost.RemoveBookmarkRequest
+	(*RemoveBookmarkResponse)(nil),                                       // 202: authpost.RemoveBookmarkResponse
+	(*GetBookmarksRequest)(nil),                                          // 203: authpost.GetBookmarksRequest
+	(*GetBookmarksResponse)(nil),                                         // 204: authpost.GetBookmarksResponse
+	(*Bookmark)(nil),                                                     // 205: authpost.Bookmark
+	(*CreateBookmarkCollectionRequest)(nil),                              // 206: authpost.CreateBookmarkCollectionRequest
+	(*CreateBookmarkCollectionResponse)(nil),                             // 207: authpost.CreateBookmarkCollectionResponse
+	(*GetBookmarkCollectionsRequest)(nil),                                // 208: authpost.GetBookmarkCollectionsRequest
+	(*GetBookmarkCollectionsResponse)(nil),                               // 209: authpost.GetBookmarkCollectionsResponse
+	(*BookmarkCollection)(nil),                                           // 210: authpost.BookmarkCollection
+	(*EditBookmarkCollectionRequest)(nil),                                // 211: authpost.EditBookmarkCollectionRequest
+	(*EditBookmarkCollectionResponse)(nil),                               // 212: authpost.EditBookmarkCollectionResponse
+	(*DeleteBookmarkCollectionRequest)(nil),                              // 213: authpost.DeleteBookmarkCollectionRequest
+	(*DeleteBookmarkCollectionResponse)(nil),                             // 214: authpost.DeleteBookmarkCollectionResponse
+	(*CommentPostRequest)(nil),                                           // 215: authpost.CommentPostRequest
+	(*CommentPostResponse)(nil),                                          // 216: authpost.CommentPostResponse
+	(*LikePostRequest)(nil),                                              // 217: authpost.LikePostRequest
+	(*LikePostResponse)(nil),                                             // 218: authpost.LikePostResponse
+	(*PostDetailInfo)(nil),                                               // 219: authpost.PostDetailInfo
+	(*LinkPreview)(nil),                                                  // 220: authpost.LinkPreview
+	(*Comment)(nil),                                                      // 221: authpost.Comment
+	(*MentionSpan)(nil),                                                  // 222: authpost.MentionSpan
+	(*Location)(nil),                                                     // 223: authpost.Location
+	(*Place)(nil),                                                        // 224: authpost.Place
+	(*Like)(nil),                                                         // 225: authpost.Like
+	(*CreateDraftRequest)(nil),                                           // 226: authpost.CreateDraftRequest
+	(*CreateDraftResponse)(nil),                                          // 227: authpost.CreateDraftResponse
+	(*GetDraftsRequest)(nil),                                             // 228: authpost.GetDraftsRequest
+	(*GetDraftsResponse)(nil),                                            // 229: authpost.GetDraftsResponse
+	(*Draft)(nil),                                                        // 230: authpost.Draft
+	(*EditDraftRequest)(nil),                                             // 231: authpost.EditDraftRequest
+	(*EditDraftResponse)(nil),                                            // 232: authpost.EditDraftResponse
+	(*DeleteDraftRequest)(nil),                                           // 233: authpost.DeleteDraftRequest
+	(*DeleteDraftResponse)(nil),                                          // 234: authpost.DeleteDraftResponse
+	(*PublishDraftRequest)(nil),                                          // 235: authpost.PublishDraftRequest
+	(*PublishDraftResponse)(nil),                                         // 236: authpost.PublishDraftResponse
+	(*Poll)(nil),                                                         // 237: authpost.Poll
+	(*PollOption)(nil),                                                   // 238: authpost.PollOption
+	(*VotePollRequest)(nil),                                              // 239: authpost.VotePollRequest
+	(*VotePollResponse)(nil),                                             // 240: authpost.VotePollResponse
+	(*PinPostRequest)(nil),                                               // 241: authpost.PinPostRequest
+	(*PinPostResponse)(nil),                                              // 242: authpost.PinPostResponse
+	(*UnpinPostRequest)(nil),                                             // 243: authpost.UnpinPostRequest
+	(*UnpinPostResponse)(nil),                                            // 244: authpost.UnpinPostResponse
+	(*PostMedia)(nil),                                                    // 245: authpost.PostMedia
+	(*PostMediaList)(nil),                                                // 246: authpost.PostMediaList
+	(*GetPostsByIdsRequest)(nil),                                         // 247: authpost.GetPostsByIdsRequest
+	(*GetPostsByIdsResponse)(nil),                                        // 248: authpost.GetPostsByIdsResponse
+	(*PostSummary)(nil),                                                  // 249: authpost.PostSummary
+	(*PostAuthor)(nil),                                                   // 250: authpost.PostAuthor
+	(*CreateStoryRequest)(nil),                                           // 251: authpost.CreateStoryRequest
+	(*CreateStoryResponse)(nil),                                          // 252: authpost.CreateStoryResponse
+	(*DeleteStoryRequest)(nil),                                           // 253: authpost.DeleteStoryRequest
+	(*DeleteStoryResponse)(nil),                                          // 254: authpost.DeleteStoryResponse
+	(*GetStoryTrayRequest)(nil),                                          // 255: authpost.GetStoryTrayRequest
+	(*GetStoryTrayResponse)(nil),                                         // 256: authpost.GetStoryTrayResponse
+	(*StoryTrayEntry)(nil),                                               // 257: authpost.StoryTrayEntry
+	(*Story)(nil),                                                        // 258: authpost.Story
+	(*ViewStoryRequest)(nil),                                             // 259: authpost.ViewStoryRequest
+	(*ViewStoryResponse)(nil),                                            // 260: authpost.ViewStoryResponse
+	(*GetStoryViewersRequest)(nil),                                       // 261: authpost.GetStoryViewersRequest
+	(*GetStoryViewersResponse)(nil),                                      // 262: authpost.GetStoryViewersResponse
+	(*StoryViewer)(nil),                                                  // 263: authpost.StoryViewer
+	(*timestamppb.Timestamp)(nil),                                        // 264: google.protobuf.Timestamp
+}
+var file_pkg_types_proto_authpost_proto_depIdxs = []int32{
+	2,   // 0: authpost.CheckUserAuthenticationResponse.status:type_name -> authpost.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
+	264, // 1: authpost.CreateUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	3,   // 2: authpost.CreateUserResponse.status:type_name -> authpost.CreateUserResponse.CreateUserStatus
+	264, // 3: authpost.EditUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	4,   // 4: authpost.EditUserResponse.status:type_name -> authpost.EditUserResponse.EditUserStatus
+	5,   // 5: authpost.GetUserDetailInfoResponse.status:type_name -> authpost.GetUserDetailInfoResponse.GetUserDetailInfoStatus
+	87,  // 6: authpost.GetUserDetailInfoResponse.user:type_name -> authpost.UserDetailInfo
+	264, // 7: authpost.UserDetailInfo.date_of_birth:type_name -> google.protobuf.Timestamp
+	6,   // 8: authpost.AutocompleteUsersResponse.status:type_name -> authpost.AutocompleteUsersResponse.AutocompleteUsersStatus
+	87,  // 9: authpost.AutocompleteUsersResponse.users:type_name -> authpost.UserDetailInfo
+	7,   // 10: authpost.GetUserFollowerResponse.status:type_name -> authpost.GetUserFollowerResponse.GetUserFollowerStatus
+	8,   // 11: authpost.GetUserFollowingResponse.status:type_name -> authpost.GetUserFollowingResponse.GetUserFollowingStatus
+	9,   // 12: authpost.FollowUserResponse.status:type_name -> authpost.FollowUserResponse.FollowUserStatus
+	10,  // 13: authpost.UnfollowUserResponse.status:type_name -> authpost.UnfollowUserResponse.UnfollowUserStatus
+	11,  // 14: authpost.GetUserPostsResponse.status:type_name -> authpost.GetUserPostsResponse.GetUserPostsStatus
+	0,   // 15: authpost.CreatePostRequest.visibility:type_name -> authpost.PostVisibility
+	223, // 16: authpost.CreatePostRequest.location:type_name -> authpost.Location
+	264, // 17: authpost.CreatePostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	101, // 18: authpost.CreatePostRequest.poll:type_name -> authpost.PollInput
+	245, // 19: authpost.CreatePostRequest.media:type_name -> authpost.PostMedia
+	264, // 20: authpost.PollInput.expires_at:type_name -> google.protobuf.Timestamp
+	12,  // 21: authpost.CreatePostResponse.status:type_name -> authpost.CreatePostResponse.CreatePostStatus
+	13,  // 22: authpost.GetPostDetailInfoResponse.status:type_name -> authpost.GetPostDetailInfoResponse.GetPostDetailInfoStatus
+	219, // 23: authpost.GetPostDetailInfoResponse.post:type_name -> authpost.PostDetailInfo
+	0,   // 24: authpost.EditPostRequest.visibility:type_name -> authpost.PostVisibility
+	223, // 25: authpost.EditPostRequest.location:type_name -> authpost.Location
+	246, // 26: authpost.EditPostRequest.media:type_name -> authpost.PostMediaList
+	14,  // 27: authpost.EditPostResponse.status:type_name -> authpost.EditPostResponse.EditPostStatus
+	15,  // 28: authpost.DeletePostResponse.status:type_name -> authpost.DeletePostResponse.DeletePostStatus
+	16,  // 29: authpost.GetTrashedPostsResponse.status:type_name -> authpost.GetTrashedPostsResponse.GetTrashedPostsStatus
+	111, // 30: authpost.GetTrashedPostsResponse.posts:type_name -> authpost.TrashedPost
+	264, // 31: authpost.TrashedPost.created_at:type_name -> google.protobuf.Timestamp
+	264, // 32: authpost.TrashedPost.deleted_at:type_name -> google.protobuf.Timestamp
+	264, // 33: authpost.TrashedPost.purge_at:type_name -> google.protobuf.Timestamp
+	245, // 34: authpost.TrashedPost.media:type_name -> authpost.PostMedia
+	17,  // 35: authpost.RestorePostResponse.status:type_name -> authpost.RestorePostResponse.RestorePostStatus
+	18,  // 36: authpost.GetPostRevisionsResponse.status:type_name -> authpost.GetPostRevisionsResponse.GetPostRevisionsStatus
+	116, // 37: authpost.GetPostRevisionsResponse.revisions:type_name -> authpost.PostRevision
+	0,   // 38: authpost.PostRevision.visibility:type_name -> authpost.PostVisibility
+	264, // 39: authpost.PostRevision.created_at:type_name -> google.protobuf.Timestamp
+	245, // 40: authpost.PostRevision.media:type_name -> authpost.PostMedia
+	19,  // 41: authpost.RevertPostResponse.status:type_name -> authpost.RevertPostResponse.RevertPostStatus
+	20,  // 42: authpost.GetNearbyPostsResponse.status:type_name -> authpost.GetNearbyPostsResponse.GetNearbyPostsStatus
+	121, // 43: authpost.GetNearbyPostsResponse.posts:type_name -> authpost.NearbyPost
+	21,  // 44: authpost.RepostPostResponse.status:type_name -> authpost.RepostPostResponse.RepostPostStatus
+	22,  // 45: authpost.UndoRepostResponse.status:type_name -> authpost.UndoRepostResponse.UndoRepostStatus
+	23,  // 46: authpost.GetScheduledPostsResponse.status:type_name -> authpost.GetScheduledPostsResponse.GetScheduledPostsStatus
+	128, // 47: authpost.GetScheduledPostsResponse.posts:type_name -> authpost.ScheduledPost
+	0,   // 48: authpost.ScheduledPost.visibility:type_name -> authpost.PostVisibility
+	264, // 49: authpost.ScheduledPost.created_at:type_name -> google.protobuf.Timestamp
+	264, // 50: authpost.ScheduledPost.publish_at:type_name -> google.protobuf.Timestamp
+	245, // 51: authpost.ScheduledPost.media:type_name -> authpost.PostMedia
+	264, // 52: authpost.ReschedulePostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	24,  // 53: authpost.ReschedulePostResponse.status:type_name -> authpost.ReschedulePostResponse.ReschedulePostStatus
+	25,  // 54: authpost.CancelScheduledPostResponse.status:type_name -> authpost.CancelScheduledPostResponse.CancelScheduledPostStatus
+	26,  // 55: authpost.GetHashtagPostsResponse.status:type_name -> authpost.GetHashtagPostsResponse.GetHashtagPostsStatus
+	27,  // 56: authpost.GetHashtagInfoResponse.status:type_name -> authpost.GetHashtagInfoResponse.GetHashtagInfoStatus
+	137, // 57: authpost.GetHashtagInfoResponse.hashtag:type_name -> authpost.HashtagInfo
+	264, // 58: authpost.HashtagInfo.first_used_at:type_name -> google.protobuf.Timestamp
+	264, // 59: authpost.HashtagInfo.last_used_at:type_name -> google.protobuf.Timestamp
+	28,  // 60: authpost.SearchRequest.type:type_name -> authpost.SearchRequest.SearchType
+	29,  // 61: authpost.SearchResponse.status:type_name -> authpost.SearchResponse.SearchStatus
+	87,  // 62: authpost.SearchResponse.users:type_name -> authpost.UserDetailInfo
+	30,  // 63: authpost.CreatePlaceResponse.status:type_name -> authpost.CreatePlaceResponse.CreatePlaceStatus
+	223, // 64: authpost.SearchPlacesRequest.near:type_name -> authpost.Location
+	31,  // 65: authpost.SearchPlacesResponse.status:type_name -> authpost.SearchPlacesResponse.SearchPlacesStatus
+	224, // 66: authpost.SearchPlacesResponse.places:type_name -> authpost.Place
+	32,  // 67: authpost.GetPlaceResponse.status:type_name -> authpost.GetPlaceResponse.GetPlaceStatus
+	224, // 68: authpost.GetPlaceResponse.place:type_name -> authpost.Place
+	33,  // 69: authpost.GetPlacePostsResponse.status:type_name -> authpost.GetPlacePostsResponse.GetPlacePostsStatus
+	34,  // 70: authpost.MergePlacesResponse.status:type_name -> authpost.MergePlacesResponse.MergePlacesStatus
+	264, // 71: authpost.CreateTripRequest.start_date:type_name -> google.protobuf.Timestamp
+	264, // 72: authpost.CreateTripRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 73: authpost.CreateTripRequest.visibility:type_name -> authpost.PostVisibility
+	35,  // 74: authpost.CreateTripResponse.status:type_name -> authpost.CreateTripResponse.CreateTripStatus
+	264, // 75: authpost.EditTripRequest.start_date:type_name -> google.protobuf.Timestamp
+	264, // 76: authpost.EditTripRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 77: authpost.EditTripRequest.visibility:type_name -> authpost.PostVisibility
+	36,  // 78: authpost.EditTripResponse.status:type_name -> authpost.EditTripResponse.EditTripStatus
+	37,  // 79: authpost.PublishTripResponse.status:type_name -> authpost.PublishTripResponse.PublishTripStatus
+	38,  // 80: authpost.GetTripResponse.status:type_name -> authpost.GetTripResponse.GetTripStatus
+	158, // 81: authpost.GetTripResponse.trip:type_name -> authpost.Trip
+	264, // 82: authpost.Trip.start_date:type_name -> google.protobuf.Timestamp
+	264, // 83: authpost.Trip.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 84: authpost.Trip.visibility:type_name -> authpost.PostVisibility
+	264, // 85: authpost.Trip.published_at:type_name -> google.protobuf.Timestamp
+	264, // 86: authpost.Trip.created_at:type_name -> google.protobuf.Timestamp
+	224, // 87: authpost.Trip.places:type_name -> authpost.Place
+	159, // 88: authpost.Trip.timeline:type_name -> authpost.TripPost
+	160, // 89: authpost.Trip.members:type_name -> authpost.TripMember
+	264, // 90: authpost.TripPost.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 91: authpost.TripMember.role:type_name -> authpost.TripRole
+	1,   // 92: authpost.InviteTripMemberRequest.role:type_name -> authpost.TripRole
+	39,  // 93: authpost.InviteTripMemberResponse.status:type_name -> authpost.InviteTripMemberResponse.InviteTripMemberStatus
+	40,  // 94: authpost.RespondTripInvitationResponse.status:type_name -> authpost.RespondTripInvitationResponse.RespondTripInvitationStatus
+	41,  // 95: authpost.GetTripInvitationsResponse.status:type_name -> authpost.GetTripInvitationsResponse.GetTripInvitationsStatus
+	167, // 96: authpost.GetTripInvitationsResponse.invitations:type_name -> authpost.TripInvitation
+	1,   // 97: authpost.TripInvitation.role:type_name -> authpost.TripRole
+	264, // 98: authpost.TripInvitation.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 99: authpost.UpdateTripMemberRequest.role:type_name -> authpost.TripRole
+	42,  // 100: authpost.UpdateTripMemberResponse.status:type_name -> authpost.UpdateTripMemberResponse.UpdateTripMemberStatus
+	43,  // 101: authpost.RemoveTripMemberResponse.status:type_name -> authpost.RemoveTripMemberResponse.RemoveTripMemberStatus
+	44,  // 102: authpost.RemoveTripPostResponse.status:type_name -> authpost.RemoveTripPostResponse.RemoveTripPostStatus
+	45,  // 103: authpost.GetTravelMapResponse.status:type_name -> authpost.GetTravelMapResponse.GetTravelMapStatus
+	176, // 104: authpost.GetTravelMapResponse.countries:type_name -> authpost.VisitedCountry
+	178, // 105: authpost.GetTravelMapResponse.visits:type_name -> authpost.TravelVisit
+	264, // 106: authpost.VisitedCountry.first_visited_at:type_name -> google.protobuf.Timestamp
+	177, // 107: authpost.VisitedCountry.cities:type_name -> authpost.VisitedCity
+	264, // 108: authpost.VisitedCity.first_visited_at:type_name -> google.protobuf.Timestamp
+	264, // 109: authpost.TravelVisit.visited_at:type_name -> google.protobuf.Timestamp
+	0,   // 110: authpost.TravelVisit.visibility:type_name -> authpost.PostVisibility
+	46,  // 111: authpost.GetTravelStatsResponse.status:type_name -> authpost.GetTravelStatsResponse.GetTravelStatsStatus
+	264, // 112: authpost.GetTravelStatsResponse.first_visited_at:type_name -> google.protobuf.Timestamp
+	264, // 113: authpost.GetTravelStatsResponse.last_visited_at:type_name -> google.protobuf.Timestamp
+	264, // 114: authpost.AddTravelVisitRequest.visited_at:type_name -> google.protobuf.Timestamp
+	0,   // 115: authpost.AddTravelVisitRequest.visibility:type_name -> authpost.PostVisibility
+	47,  // 116: authpost.AddTravelVisitResponse.status:type_name -> authpost.AddTravelVisitResponse.AddTravelVisitStatus
+	48,  // 117: authpost.DeleteTravelVisitResponse.status:type_name -> authpost.DeleteTravelVisitResponse.DeleteTravelVisitStatus
+	49,  // 118: authpost.CreatePlaceReviewResponse.status:type_name -> authpost.CreatePlaceReviewResponse.CreatePlaceReviewStatus
+	50,  // 119: authpost.EditPlaceReviewResponse.status:type_name -> authpost.EditPlaceReviewResponse.EditPlaceReviewStatus
+	51,  // 120: authpost.DeletePlaceReviewResponse.status:type_name -> authpost.DeletePlaceReviewResponse.DeletePlaceReviewStatus
+	52,  // 121: authpost.GetPlaceReviewResponse.status:type_name -> authpost.GetPlaceReviewResponse.GetPlaceReviewStatus
+	197, // 122: authpost.GetPlaceReviewResponse.review:type_name -> authpost.PlaceReview
+	53,  // 123: authpost.GetPlaceReviewsRequest.sort:type_name -> authpost.GetPlaceReviewsRequest.ReviewSort
+	54,  // 124: authpost.GetPlaceReviewsResponse.status:type_name -> authpost.GetPlaceReviewsResponse.GetPlaceReviewsStatus
+	197, // 125: authpost.GetPlaceReviewsResponse.reviews:type_name -> authpost.PlaceReview
+	198, // 126: authpost.GetPlaceReviewsResponse.summary:type_name -> authpost.RatingSummary
+	55,  // 127: authpost.VoteReviewHelpfulResponse.status:type_name -> authpost.VoteReviewHelpfulResponse.VoteReviewHelpfulStatus
+	264, // 128: authpost.PlaceReview.created_at:type_name -> google.protobuf.Timestamp
+	264, // 129: authpost.PlaceReview.edited_at:type_name -> google.protobuf.Timestamp
+	56,  // 130: authpost.AddBookmarkResponse.status:type_name -> authpost.AddBookmarkResponse.AddBookmarkStatus
+	57,  // 131: authpost.RemoveBookmarkResponse.status:type_name -> authpost.RemoveBookmarkResponse.RemoveBookmarkStatus
+	58,  // 132: authpost.GetBookmarksResponse.status:type_name -> authpost.GetBookmarksResponse.GetBookmarksStatus
+	205, // 133: authpost.GetBookmarksResponse.bookmarks:type_name -> authpost.Bookmark
+	264, // 134: authpost.Bookmark.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 135: authpost.CreateBookmarkCollectionResponse.status:type_name -> authpost.CreateBookmarkCollectionResponse.CreateBookmarkCollectionStatus
+	60,  // 136: authpost.GetBookmarkCollectionsResponse.status:type_name -> authpost.GetBookmarkCollectionsResponse.GetBookmarkCollectionsStatus
+	210, // 137: authpost.GetBookmarkCollectionsResponse.collections:type_name -> authpost.BookmarkCollection
+	264, // 138: authpost.BookmarkCollection.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 139: authpost.EditBookmarkCollectionResponse.status:type_name -> authpost.EditBookmarkCollectionResponse.EditBookmarkCollectionStatus
+	62,  // 140: authpost.DeleteBookmarkCollectionResponse.status:type_name -> authpost.DeleteBookmarkCollectionResponse.DeleteBookmarkCollectionStatus
+	63,  // 141: authpost.CommentPostResponse.status:type_name -> authpost.CommentPostResponse.CommentPostStatus
+	64,  // 142: authpost.LikePostResponse.status:type_name -> authpost.LikePostResponse.LikePostStatus
+	264, // 143: authpost.PostDetailInfo.created_at:type_name -> google.protobuf.Timestamp
+	221, // 144: authpost.PostDetailInfo.comments:type_name -> authpost.Comment
+	225, // 145: authpost.PostDetailInfo.liked_users:type_name -> authpost.Like
+	0,   // 146: authpost.PostDetailInfo.visibility:type_name -> authpost.PostVisibility
+	264, // 147: authpost.PostDetailInfo.edited_at:type_name -> google.protobuf.Timestamp
+	222, // 148: authpost.PostDetailInfo.mentions:type_name -> authpost.MentionSpan
+	223, // 149: authpost.PostDetailInfo.location:type_name -> authpost.Location
+	224, // 150: authpost.PostDetailInfo.place:type_name -> authpost.Place
+	264, // 151: authpost.PostDetailInfo.publish_at:type_name -> google.protobuf.Timestamp
+	237, // 152: authpost.PostDetailInfo.poll:type_name -> authpost.Poll
+	220, // 153: authpost.PostDetailInfo.link_previews:type_name -> authpost.LinkPreview
+	245, // 154: authpost.PostDetailInfo.media:type_name -> authpost.PostMedia
+	222, // 155: authpost.Comment.mentions:type_name -> authpost.MentionSpan
+	264, // 156: authpost.Place.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 157: authpost.CreateDraftRequest.visibility:type_name -> authpost.PostVisibility
+	223, // 158: authpost.CreateDraftRequest.location:type_name -> authpost.Location
+	245, // 159: authpost.CreateDraftRequest.media:type_name -> authpost.PostMedia
+	65,  // 160: authpost.CreateDraftResponse.status:type_name -> authpost.CreateDraftResponse.CreateDraftStatus
+	66,  // 161: authpost.GetDraftsResponse.status:type_name -> authpost.GetDraftsResponse.GetDraftsStatus
+	230, // 162: authpost.GetDraftsResponse.drafts:type_name -> authpost.Draft
+	0,   // 163: authpost.Draft.visibility:type_name -> authpost.PostVisibility
+	223, // 164: authpost.Draft.location:type_name -> authpost.Location
+	264, // 165: authpost.Draft.created_at:type_name -> google.protobuf.Timestamp
+	264, // 166: authpost.Draft.updated_at:type_name -> google.protobuf.Timestamp
+	245, // 167: authpost.Draft.media:type_name -> authpost.PostMedia
+	0,   // 168: authpost.EditDraftRequest.visibility:type_name -> authpost.PostVisibility
+	223, // 169: authpost.EditDraftRequest.location:type_name -> authpost.Location
+	246, // 170: authpost.EditDraftRequest.media:type_name -> authpost.PostMediaList
+	67,  // 171: authpost.EditDraftResponse.status:type_name -> authpost.EditDraftResponse.EditDraftStatus
+	68,  // 172: authpost.DeleteDraftResponse.status:type_name -> authpost.DeleteDraftResponse.DeleteDraftStatus
+	264, // 173: authpost.PublishDraftRequest.publish_at:type_name -> google.protobuf.Timestamp
+	69,  // 174: authpost.PublishDraftResponse.status:type_name -> authpost.PublishDraftResponse.PublishDraftStatus
+	238, // 175: authpost.Poll.options:type_name -> authpost.PollOption
+	264, // 176: authpost.Poll.expires_at:type_name -> google.protobuf.Timestamp
+	70,  // 177: authpost.VotePollResponse.status:type_name -> authpost.VotePollResponse.VotePollStatus
+	237, // 178: authpost.VotePollResponse.poll:type_name -> authpost.Poll
+	71,  // 179: authpost.PinPostResponse.status:type_name -> authpost.PinPostResponse.PinPostStatus
+	72,  // 180: authpost.UnpinPostResponse.status:type_name -> authpost.UnpinPostResponse.UnpinPostStatus
+	245, // 181: authpost.PostMediaList.media:type_name -> authpost.PostMedia
+	73,  // 182: authpost.GetPostsByIdsResponse.status:type_name -> authpost.GetPostsByIdsResponse.GetPostsByIdsStatus
+	249, // 183: authpost.GetPostsByIdsResponse.posts:type_name -> authpost.PostSummary
+	250, // 184: authpost.PostSummary.author:type_name -> authpost.PostAuthor
+	245, // 185: authpost.PostSummary.media:type_name -> authpost.PostMedia
+	0,   // 186: authpost.PostSummary.visibility:type_name -> authpost.PostVisibility
+	264, // 187: authpost.PostSummary.created_at:type_name -> google.protobuf.Timestamp
+	264, // 188: authpost.PostSummary.edited_at:type_name -> google.protobuf.Timestamp
+	222, // 189: authpost.PostSummary.mentions:type_name -> authpost.MentionSpan
+	223, // 190: authpost.PostSummary.location:type_name -> authpost.Location
+	224, // 191: authpost.PostSummary.place:type_name -> authpost.Place
+	245, // 192: authpost.CreateStoryRequest.media:type_name -> authpost.PostMedia
+	74,  // 193: authpost.CreateStoryResponse.status:type_name -> authpost.CreateStoryResponse.CreateStoryStatus
+	264, // 194: authpost.CreateStoryResponse.expires_at:type_name -> google.protobuf.Timestamp
+	75,  // 195: authpost.DeleteStoryResponse.status:type_name -> authpost.DeleteStoryResponse.DeleteStoryStatus
+	76,  // 196: authpost.GetStoryTrayResponse.status:type_name -> authpost.GetStoryTrayResponse.GetStoryTrayStatus
+	257, // 197: authpost.GetStoryTrayResponse.entries:type_name -> authpost.StoryTrayEntry
+	250, // 198: authpost.StoryTrayEntry.author:type_name -> authpost.PostAuthor
+	258, // 199: authpost.StoryTrayEntry.stories:type_name -> authpost.Story
+	245, // 200: authpost.Story.media:type_name -> authpost.PostMedia
+	264, // 201: authpost.Story.created_at:type_name -> google.protobuf.Timestamp
+	264, // 202: authpost.Story.expires_at:type_name -> google.protobuf.Timestamp
+	77,  // 203: authpost.ViewStoryResponse.status:type_name -> authpost.ViewStoryResponse.ViewStoryStatus
+	78,  // 204: authpost.GetStoryViewersResponse.status:type_name -> authpost.GetStoryViewersResponse.GetStoryViewersStatus
+	263, // 205: authpost.GetStoryViewersResponse.viewers:type_name -> authpost.StoryViewer
+	250, // 206: authpost.StoryViewer.user:type_name -> authpost.PostAuthor
+	264, // 207: authpost.StoryViewer.viewed_at:type_name -> google.protobuf.Timestamp
+	79,  // 208: authpost.AuthenticateAndPost.CheckUserAuthentication:input_type -> authpost.CheckUserAuthenticationRequest
+	81,  // 209: authpost.AuthenticateAndPost.CreateUser:input_type -> authpost.CreateUserRequest
+	83,  // 210: authpost.AuthenticateAndPost.EditUser:input_type -> authpost.EditUserRequest
+	85,  // 211: authpost.AuthenticateAndPost.GetUserDetailInfo:input_type -> authpost.GetUserDetailInfoRequest
+	88,  // 212: authpost.AuthenticateAndPost.AutocompleteUsers:input_type -> authpost.AutocompleteUsersRequest
+	90,  // 213: authpost.AuthenticateAndPost.GetUserFollower:input_type -> authpost.GetUserFollowerRequest
+	92,  // 214: authpost.AuthenticateAndPost.GetUserFollowing:input_type -> authpost.GetUserFollowingRequest
+	94,  // 215: authpost.AuthenticateAndPost.FollowUser:input_type -> authpost.FollowUserRequest
+	96,  // 216: authpost.AuthenticateAndPost.UnfollowUser:input_type -> authpost.UnfollowUserRequest
+	98,  // 217: authpost.AuthenticateAndPost.GetUserPosts:input_type -> authpost.GetUserPostsRequest
+	100, // 218: authpost.AuthenticateAndPost.CreatePost:input_type -> authpost.CreatePostRequest
+	103, // 219: authpost.AuthenticateAndPost.GetPostDetailInfo:input_type -> authpost.GetPostDetailInfoRequest
+	247, // 220: authpost.AuthenticateAndPost.GetPostsByIds:input_type -> authpost.GetPostsByIdsRequest
+	105, // 221: authpost.AuthenticateAndPost.EditPost:input_type -> authpost.EditPostRequest
+	107, // 222: authpost.AuthenticateAndPost.DeletePost:input_type -> authpost.DeletePostRequest
+	215, // 223: authpost.AuthenticateAndPost.CommentPost:input_type -> authpost.CommentPostRequest
+	217, // 224: authpost.AuthenticateAndPost.LikePost:input_type -> authpost.LikePostRequest
+	109, // 225: authpost.AuthenticateAndPost.GetTrashedPosts:input_type -> authpost.GetTrashedPostsRequest
+	112, // 226: authpost.AuthenticateAndPost.RestorePost:input_type -> authpost.RestorePostRequest
+	114, // 227: authpost.AuthenticateAndPost.GetPostRevisions:input_type -> authpost.GetPostRevisionsRequest
+	117, // 228: authpost.AuthenticateAndPost.RevertPost:input_type -> authpost.RevertPostRequest
+	119, // 229: authpost.AuthenticateAndPost.GetNearbyPosts:input_type -> authpost.GetNearbyPostsRequest
+	122, // 230: authpost.AuthenticateAndPost.RepostPost:input_type -> authpost.RepostPostRequest
+	124, // 231: authpost.AuthenticateAndPost.UndoRepost:input_type -> authpost.UndoRepostRequest
+	126, // 232: authpost.AuthenticateAndPost.GetScheduledPosts:input_type -> authpost.GetScheduledPostsRequest
+	129, // 233: authpost.AuthenticateAndPost.ReschedulePost:input_type -> authpost.ReschedulePostRequest
+	131, // 234: authpost.AuthenticateAndPost.CancelScheduledPost:input_type -> authpost.CancelScheduledPostRequest
+	133, // 235: authpost.AuthenticateAndPost.GetHashtagPosts:input_type -> authpost.GetHashtagPostsRequest
+	135, // 236: authpost.AuthenticateAndPost.GetHashtagInfo:input_type -> authpost.GetHashtagInfoRequest
+	138, // 237: authpost.AuthenticateAndPost.Search:input_type -> authpost.SearchRequest
+	140, // 238: authpost.AuthenticateAndPost.CreatePlace:input_type -> authpost.CreatePlaceRequest
+	142, // 239: authpost.AuthenticateAndPost.SearchPlaces:input_type -> authpost.SearchPlacesRequest
+	144, // 240: authpost.AuthenticateAndPost.GetPlace:input_type -> authpost.GetPlaceRequest
+	146, // 241: authpost.AuthenticateAndPost.GetPlacePosts:input_type -> authpost.GetPlacePostsRequest
+	148, // 242: authpost.AuthenticateAndPost.MergePlaces:input_type -> authpost.MergePlacesRequest
+	150, // 243: authpost.AuthenticateAndPost.CreateTrip:input_type -> authpost.CreateTripRequest
+	152, // 244: authpost.AuthenticateAndPost.EditTrip:input_type -> authpost.EditTripRequest
+	154, // 245: authpost.AuthenticateAndPost.PublishTrip:input_type -> authpost.PublishTripRequest
+	156, // 246: authpost.AuthenticateAndPost.GetTrip:input_type -> authpost.GetTripRequest
+	161, // 247: authpost.AuthenticateAndPost.InviteTripMember:input_type -> authpost.InviteTripMemberRequest
+	163, // 248: authpost.AuthenticateAndPost.RespondTripInvitation:input_type -> authpost.RespondTripInvitationRequest
+	165, // 249: authpost.AuthenticateAndPost.GetTripInvitations:input_type -> authpost.GetTripInvitationsRequest
+	168, // 250: authpost.AuthenticateAndPost.UpdateTripMember:input_type -> authpost.UpdateTripMemberRequest
+	170, // 251: authpost.AuthenticateAndPost.RemoveTripMember:input_type -> authpost.RemoveTripMemberRequest
+	172, // 252: authpost.AuthenticateAndPost.RemoveTripPost:input_type -> authpost.RemoveTripPostRequest
+	174, // 253: authpost.AuthenticateAndPost.GetTravelMap:input_type -> authpost.GetTravelMapRequest
+	179, // 254: authpost.AuthenticateAndPost.GetTravelStats:input_type -> authpost.GetTravelStatsRequest
+	181, // 255: authpost.AuthenticateAndPost.AddTravelVisit:input_type -> authpost.AddTravelVisitRequest
+	183, // 256: authpost.AuthenticateAndPost.DeleteTravelVisit:input_type -> authpost.DeleteTravelVisitRequest
+	185, // 257: authpost.AuthenticateAndPost.CreatePlaceReview:input_type -> authpost.CreatePlaceReviewRequest
+	187, // 258: authpost.AuthenticateAndPost.EditPlaceReview:input_type -> authpost.EditPlaceReviewRequest
+	189, // 259: authpost.AuthenticateAndPost.DeletePlaceReview:input_type -> authpost.DeletePlaceReviewRequest
+	191, // 260: authpost.AuthenticateAndPost.GetPlaceReview:input_type -> authpost.GetPlaceReviewRequest
+	193, // 261: authpost.AuthenticateAndPost.GetPlaceReviews:input_type -> authpost.GetPlaceReviewsRequest
+	195, // 262: authpost.AuthenticateAndPost.VoteReviewHelpful:input_type -> authpost.VoteReviewHelpfulRequest
+	199, // 263: authpost.AuthenticateAndPost.AddBookmark:input_type -> authpost.AddBookmarkRequest
+	201, // 264: authpost.AuthenticateAndPost.RemoveBookmark:input_type -> authpost.RemoveBookmarkRequest
+	203, // 265: authpost.AuthenticateAndPost.GetBookmarks:input_type -> authpost.GetBookmarksRequest
+	206, // 266: authpost.AuthenticateAndPost.CreateBookmarkCollection:input_type -> authpost.CreateBookmarkCollectionRequest
+	208, // 267: authpost.AuthenticateAndPost.GetBookmarkCollections:input_type -> authpost.GetBookmarkCollectionsRequest
+	211, // 268: authpost.AuthenticateAndPost.EditBookmarkCollection:input_type -> authpost.EditBookmarkCollectionRequest
+	213, // 269: authpost.AuthenticateAndPost.DeleteBookmarkCollection:input_type -> authpost.DeleteBookmarkCollectionRequest
+	226, // 270: authpost.AuthenticateAndPost.CreateDraft:input_type -> authpost.CreateDraftRequest
+	228, // 271: authpost.AuthenticateAndPost.GetDrafts:input_type -> authpost.GetDraftsRequest
+	231, // 272: authpost.AuthenticateAndPost.EditDraft:input_type -> authpost.EditDraftRequest
+	233, // 273: authpost.AuthenticateAndPost.DeleteDraft:input_type -> authpost.DeleteDraftRequest
+	235, // 274: authpost.AuthenticateAndPost.PublishDraft:input_type -> authpost.PublishDraftRequest
+	239, // 275: authpost.AuthenticateAndPost.VotePoll:input_type -> authpost.VotePollRequest
+	241, // 276: authpost.AuthenticateAndPost.PinPost:input_type -> authpost.PinPostRequest
+	243, // 277: authpost.AuthenticateAndPost.UnpinPost:input_type -> authpost.UnpinPostRequest
+	251, // 278: authpost.AuthenticateAndPost.CreateStory:input_type -> authpost.CreateStoryRequest
+	253, // 279: authpost.AuthenticateAndPost.DeleteStory:input_type -> authpost.DeleteStoryRequest
+	255, // 280: authpost.AuthenticateAndPost.GetStoryTray:input_type -> authpost.GetStoryTrayRequest
+	259, // 281: authpost.AuthenticateAndPost.ViewStory:input_type -> authpost.ViewStoryRequest
+	261, // 282: authpost.AuthenticateAndPost.GetStoryViewers:input_type -> authpost.GetStoryViewersRequest
+	80,  // 283: authpost.AuthenticateAndPost.CheckUserAuthentication:output_type -> authpost.CheckUserAuthenticationResponse
+	82,  // 284: authpost.AuthenticateAndPost.CreateUser:output_type -> authpost.CreateUserResponse
+	84,  // 285: authpost.AuthenticateAndPost.EditUser:output_type -> authpost.EditUserResponse
+	86,  // 286: authpost.AuthenticateAndPost.GetUserDetailInfo:output_type -> authpost.GetUserDetailInfoResponse
+	89,  // 287: authpost.AuthenticateAndPost.AutocompleteUsers:output_type -> authpost.AutocompleteUsersResponse
+	91,  // 288: authpost.AuthenticateAndPost.GetUserFollower:output_type -> authpost.GetUserFollowerResponse
+	93,  // 289: authpost.AuthenticateAndPost.GetUserFollowing:output_type -> authpost.GetUserFollowingResponse
+	95,  // 290: authpost.AuthenticateAndPost.FollowUser:output_type -> authpost.FollowUserResponse
+	97,  // 291: authpost.AuthenticateAndPost.UnfollowUser:output_type -> authpost.UnfollowUserResponse
+	99,  // 292: authpost.AuthenticateAndPost.GetUserPosts:output_type -> authpost.GetUserPostsResponse
+	102, // 293: authpost.AuthenticateAndPost.CreatePost:output_type -> authpost.CreatePostResponse
+	104, // 294: authpost.AuthenticateAndPost.GetPostDetailInfo:output_type -> authpost.GetPostDetailInfoResponse
+	248, // 295: authpost.AuthenticateAndPost.GetPostsByIds:output_type -> authpost.GetPostsByIdsResponse
+	106, // 296: authpost.AuthenticateAndPost.EditPost:output_type -> authpost.EditPostResponse
+	108, // 297: authpost.AuthenticateAndPost.DeletePost:output_type -> authpost.DeletePostResponse
+	216, // 298: authpost.AuthenticateAndPost.CommentPost:output_type -> authpost.CommentPostResponse
+	218, // 299: authpost.AuthenticateAndPost.LikePost:output_type -> authpost.LikePostResponse
+	110, // 300: authpost.AuthenticateAndPost.GetTrashedPosts:output_type -> authpost.GetTrashedPostsResponse
+	113, // 301: authpost.AuthenticateAndPost.RestorePost:output_type -> authpost.RestorePostResponse
+	115, // 302: authpost.AuthenticateAndPost.GetPostRevisions:output_type -> authpost.GetPostRevisionsResponse
+	118, // 303: authpost.AuthenticateAndPost.RevertPost:output_type -> authpost.RevertPostResponse
+	120, // 304: authpost.AuthenticateAndPost.GetNearbyPosts:output_type -> authpost.GetNearbyPostsResponse
+	123, // 305: authpost.AuthenticateAndPost.RepostPost:output_type -> authpost.RepostPostResponse
+	125, // 306: authpost.AuthenticateAndPost.UndoRepost:output_type -> authpost.UndoRepostResponse
+	127, // 307: authpost.AuthenticateAndPost.GetScheduledPosts:output_type -> authpost.GetScheduledPostsResponse
+	130, // 308: authpost.AuthenticateAndPost.ReschedulePost:output_type -> authpost.ReschedulePostResponse
+	132, // 309: authpost.AuthenticateAndPost.CancelScheduledPost:output_type -> authpost.CancelScheduledPostResponse
+	134, // 310: authpost.AuthenticateAndPost.GetHashtagPosts:output_type -> authpost.GetHashtagPostsResponse
+	136, // 311: authpost.AuthenticateAndPost.GetHashtagInfo:output_type -> authpost.GetHashtagInfoResponse
+	139, // 312: authpost.AuthenticateAndPost.Search:output_type -> authpost.SearchResponse
+	141, // 313: authpost.AuthenticateAndPost.CreatePlace:output_type -> authpost.CreatePlaceResponse
+	143, // 314: authpost.AuthenticateAndPost.SearchPlaces:output_type -> authpost.SearchPlacesResponse
+	145, // 315: authpost.AuthenticateAndPost.GetPlace:output_type -> authpost.GetPlaceResponse
+	147, // 316: authpost.AuthenticateAndPost.GetPlacePosts:output_type -> authpost.GetPlacePostsResponse
+	149, // 317: authpost.AuthenticateAndPost.MergePlaces:output_type -> authpost.MergePlacesResponse
+	151, // 318: authpost.AuthenticateAndPost.CreateTrip:output_type -> authpost.CreateTripResponse
+	153, // 319: authpost.AuthenticateAndPost.EditTrip:output_type -> authpost.EditTripResponse
+	155, // 320: authpost.AuthenticateAndPost.PublishTrip:output_type -> authpost.PublishTripResponse
+	157, // 321: authpost.AuthenticateAndPost.GetTrip:output_type -> authpost.GetTripResponse
+	162, // 322: authpost.AuthenticateAndPost.InviteTripMember:output_type -> authpost.InviteTripMemberResponse
+	164, // 323: authpost.AuthenticateAndPost.RespondTripInvitation:output_type -> authpost.RespondTripInvitationResponse
+	166, // 324: authpost.AuthenticateAndPost.GetTripInvitations:output_type -> authpost.GetTripInvitationsResponse
+	169, // 325: authpost.AuthenticateAndPost.UpdateTripMember:output_type -> authpost.UpdateTripMemberResponse
+	171, // 326: authpost.AuthenticateAndPost.RemoveTripMember:output_type -> authpost.RemoveTripMemberResponse
+	173, // 327: authpost.AuthenticateAndPost.RemoveTripPost:output_type -> authpost.RemoveTripPostResponse
+	175, // 328: authpost.AuthenticateAndPost.GetTravelMap:output_type -> authpost.GetTravelMapResponse
+	180, // 329: authpost.AuthenticateAndPost.GetTravelStats:output_type -> authpost.GetTravelStatsResponse
+	182, // 330: authpost.AuthenticateAndPost.AddTravelVisit:output_type -> authpost.AddTravelVisitResponse
+	184, // 331: authpost.AuthenticateAndPost.DeleteTravelVisit:output_type -> authpost.DeleteTravelVisitResponse
+	186, // 332: authpost.AuthenticateAndPost.CreatePlaceReview:output_type -> authpost.CreatePlaceReviewResponse
+	188, // 333: authpost.AuthenticateAndPost.EditPlaceReview:output_type -> authpost.EditPlaceReviewResponse
+	190, // 334: authpost.AuthenticateAndPost.DeletePlaceReview:output_type -> authpost.DeletePlaceReviewResponse
+	192, // 335: authpost.AuthenticateAndPost.GetPlaceReview:output_type -> authpost.GetPlaceReviewResponse
+	194, // 336: authpost.AuthenticateAndPost.GetPlaceReviews:output_type -> authpost.GetPlaceReviewsResponse
+	196, // 337: authpost.AuthenticateAndPost.VoteReviewHelpful:output_type -> authpost.VoteReviewHelpfulResponse
+	200, // 338: authpost.AuthenticateAndPost.AddBookmark:output_type -> authpost.AddBookmarkResponse
+	202, // 339: authpost.AuthenticateAndPost.RemoveBookmark:output_type -> authpost.RemoveBookmarkResponse
+	204, // 340: authpost.AuthenticateAndPost.GetBookmarks:output_type -> authpost.GetBookmarksResponse
+	207, // 341: authpost.AuthenticateAndPost.CreateBookmarkCollection:output_type -> authpost.CreateBookmarkCollectionResponse
+	209, // 342: authpost.AuthenticateAndPost.GetBookmarkCollections:output_type -> authpost.GetBookmarkCollectionsResponse
+	212, // 343: authpost.AuthenticateAndPost.EditBookmarkCollection:output_type -> authpost.EditBookmarkCollectionResponse
+	214, // 344: authpost.AuthenticateAndPost.DeleteBookmarkCollection:output_type -> authpost.DeleteBookmarkCollectionResponse
+	227, // 345: authpost.AuthenticateAndPost.CreateDraft:output_type -> authpost.CreateDraftResponse
+	229, // 346: authpost.AuthenticateAndPost.GetDrafts:output_type -> authpost.GetDraftsResponse
+	232, // 347: authpost.AuthenticateAndPost.EditDraft:output_type -> authpost.EditDraftResponse
+	234, // 348: authpost.AuthenticateAndPost.DeleteDraft:output_type -> authpost.DeleteDraftResponse
+	236, // 349: authpost.AuthenticateAndPost.PublishDraft:output_type -> authpost.PublishDraftResponse
+	240, // 350: authpost.AuthenticateAndPost.VotePoll:output_type -> authpost.VotePollResponse
+	242, // 351: authpost.AuthenticateAndPost.PinPost:output_type -> authpost.PinPostResponse
+	244, // 352: authpost.AuthenticateAndPost.UnpinPost:output_type -> authpost.UnpinPostResponse
+	252, // 353: authpost.AuthenticateAndPost.CreateStory:output_type -> authpost.CreateStoryResponse
+	254, // 354: authpost.AuthenticateAndPost.DeleteStory:output_type -> authpost.DeleteStoryResponse
+	256, // 355: authpost.AuthenticateAndPost.GetStoryTray:output_type -> authpost.GetStoryTrayResponse
+	260, // 356: authpost.AuthenticateAndPost.ViewStory:output_type -> authpost.ViewStoryResponse
+	262, // 357: authpost.AuthenticateAndPost.GetStoryViewers:output_type -> authpost.GetStoryViewersResponse
+	283, // [283:358] is the sub-list for method output_type
+	208, // [208:283] is the sub-list for method input_type
+	208, // [208:208] is the sub-list for extension type_name
+	208, // [208:208] is the sub-list for extension extendee
+	0,   // [0:208] is the sub-list for field type_name
+}
+
+func init() { file_pkg_types_proto_authpost_proto_init() }
+func file_pkg_types_proto_authpost_proto_init() {
+	if File_pkg_types_proto_authpost_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_types_proto_authpost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserAuthenticationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserAuthenticationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDetailInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDetailInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDetailInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutocompleteUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutocompleteUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostDetailInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostDetailInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashedPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashedPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashedPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNearbyPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNearbyPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepostPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepostPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRepostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRepostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReschedulePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReschedulePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHashtagPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHashtagPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHashtagInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHashtagInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashtagInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPlacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPlacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlacePostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlacePostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePlacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePlacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTripResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditTripResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTripResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteTripMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteTripMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondTripInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondTripInvitationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTripInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTripInvitationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTripMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTripMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTripMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTripMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTripPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTripPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTravelMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTravelMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitedCountry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitedCity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TravelVisit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTravelStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTravelStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTravelVisitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTravelVisitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTravelVisitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTravelVisitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaceReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlaceReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPlaceReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPlaceReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlaceReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlaceReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaceReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteReviewHelpfulRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteReviewHelpfulResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBookmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBookmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBookmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBookmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookmarksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookmarksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bookmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookmarkCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBookmarkCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookmarkCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookmarkCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookmarkCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditBookmarkCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditBookmarkCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3333,8 +21148,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckUserAuthenticationResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBookmarkCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3345,8 +21160,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBookmarkCollectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3357,8 +21172,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3369,8 +21184,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3381,8 +21196,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3393,8 +21208,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserDetailInfoRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3405,8 +21220,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserDetailInfoResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDetailInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3417,8 +21232,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDetailInfo); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkPreview); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3429,8 +21244,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowerRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3441,8 +21256,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowerResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MentionSpan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,8 +21268,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowingRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,8 +21280,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowingResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Place); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,8 +21292,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Like); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3489,8 +21304,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDraftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3501,8 +21316,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDraftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3513,8 +21328,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3525,8 +21340,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserPostsRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,8 +21352,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserPostsResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Draft); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3549,8 +21364,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePostRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditDraftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3561,8 +21376,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePostResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditDraftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3573,8 +21388,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostDetailInfoRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDraftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3585,8 +21400,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostDetailInfoResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDraftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3597,8 +21412,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPostRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDraftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3609,8 +21424,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPostResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDraftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3621,8 +21436,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePostRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Poll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3633,8 +21448,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePostResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3645,8 +21460,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3657,8 +21472,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3669,8 +21484,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostRequest); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3681,8 +21496,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostResponse); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3693,8 +21508,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostDetailInfo); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3705,8 +21520,8 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3717,8 +21532,224 @@ func file_pkg_types_proto_authpost_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_types_proto_authpost_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Like); i {
+		file_pkg_types_proto_authpost_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMediaList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryTrayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryTrayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoryTrayEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Story); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryViewersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryViewersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_authpost_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoryViewer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3731,14 +21762,19 @@ func file_pkg_types_proto_authpost_proto_init() {
 		}
 	}
 	file_pkg_types_proto_authpost_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_pkg_types_proto_authpost_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[108].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[147].OneofWrappers = []interface{}{}
+	file_pkg_types_proto_authpost_proto_msgTypes[152].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_types_proto_authpost_proto_rawDesc,
-			NumEnums:      15,
-			NumMessages:   34,
+			NumEnums:      79,
+			NumMessages:   185,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
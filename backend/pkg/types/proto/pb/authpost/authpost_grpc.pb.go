@@ -27,6 +27,7 @@ type AuthenticateAndPostClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	EditUser(ctx context.Context, in *EditUserRequest, opts ...grpc.CallOption) (*EditUserResponse, error)
 	GetUserDetailInfo(ctx context.Context, in *GetUserDetailInfoRequest, opts ...grpc.CallOption) (*GetUserDetailInfoResponse, error)
+	AutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest, opts ...grpc.CallOption) (*AutocompleteUsersResponse, error)
 	// Group: friends
 	GetUserFollower(ctx context.Context, in *GetUserFollowerRequest, opts ...grpc.CallOption) (*GetUserFollowerResponse, error)
 	GetUserFollowing(ctx context.Context, in *GetUserFollowingRequest, opts ...grpc.CallOption) (*GetUserFollowingResponse, error)
@@ -36,10 +37,80 @@ type AuthenticateAndPostClient interface {
 	// Group: posts
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPostDetailInfo(ctx context.Context, in *GetPostDetailInfoRequest, opts ...grpc.CallOption) (*GetPostDetailInfoResponse, error)
+	GetPostsByIds(ctx context.Context, in *GetPostsByIdsRequest, opts ...grpc.CallOption) (*GetPostsByIdsResponse, error)
 	EditPost(ctx context.Context, in *EditPostRequest, opts ...grpc.CallOption) (*EditPostResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
 	CommentPost(ctx context.Context, in *CommentPostRequest, opts ...grpc.CallOption) (*CommentPostResponse, error)
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
+	GetTrashedPosts(ctx context.Context, in *GetTrashedPostsRequest, opts ...grpc.CallOption) (*GetTrashedPostsResponse, error)
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error)
+	RevertPost(ctx context.Context, in *RevertPostRequest, opts ...grpc.CallOption) (*RevertPostResponse, error)
+	GetNearbyPosts(ctx context.Context, in *GetNearbyPostsRequest, opts ...grpc.CallOption) (*GetNearbyPostsResponse, error)
+	RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error)
+	UndoRepost(ctx context.Context, in *UndoRepostRequest, opts ...grpc.CallOption) (*UndoRepostResponse, error)
+	GetScheduledPosts(ctx context.Context, in *GetScheduledPostsRequest, opts ...grpc.CallOption) (*GetScheduledPostsResponse, error)
+	ReschedulePost(ctx context.Context, in *ReschedulePostRequest, opts ...grpc.CallOption) (*ReschedulePostResponse, error)
+	CancelScheduledPost(ctx context.Context, in *CancelScheduledPostRequest, opts ...grpc.CallOption) (*CancelScheduledPostResponse, error)
+	// Group: hashtags
+	GetHashtagPosts(ctx context.Context, in *GetHashtagPostsRequest, opts ...grpc.CallOption) (*GetHashtagPostsResponse, error)
+	GetHashtagInfo(ctx context.Context, in *GetHashtagInfoRequest, opts ...grpc.CallOption) (*GetHashtagInfoResponse, error)
+	// Group: search
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Group: places
+	CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*CreatePlaceResponse, error)
+	SearchPlaces(ctx context.Context, in *SearchPlacesRequest, opts ...grpc.CallOption) (*SearchPlacesResponse, error)
+	GetPlace(ctx context.Context, in *GetPlaceRequest, opts ...grpc.CallOption) (*GetPlaceResponse, error)
+	GetPlacePosts(ctx context.Context, in *GetPlacePostsRequest, opts ...grpc.CallOption) (*GetPlacePostsResponse, error)
+	MergePlaces(ctx context.Context, in *MergePlacesRequest, opts ...grpc.CallOption) (*MergePlacesResponse, error)
+	// Group: trips
+	CreateTrip(ctx context.Context, in *CreateTripRequest, opts ...grpc.CallOption) (*CreateTripResponse, error)
+	EditTrip(ctx context.Context, in *EditTripRequest, opts ...grpc.CallOption) (*EditTripResponse, error)
+	PublishTrip(ctx context.Context, in *PublishTripRequest, opts ...grpc.CallOption) (*PublishTripResponse, error)
+	GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*GetTripResponse, error)
+	InviteTripMember(ctx context.Context, in *InviteTripMemberRequest, opts ...grpc.CallOption) (*InviteTripMemberResponse, error)
+	RespondTripInvitation(ctx context.Context, in *RespondTripInvitationRequest, opts ...grpc.CallOption) (*RespondTripInvitationResponse, error)
+	GetTripInvitations(ctx context.Context, in *GetTripInvitationsRequest, opts ...grpc.CallOption) (*GetTripInvitationsResponse, error)
+	UpdateTripMember(ctx context.Context, in *UpdateTripMemberRequest, opts ...grpc.CallOption) (*UpdateTripMemberResponse, error)
+	RemoveTripMember(ctx context.Context, in *RemoveTripMemberRequest, opts ...grpc.CallOption) (*RemoveTripMemberResponse, error)
+	RemoveTripPost(ctx context.Context, in *RemoveTripPostRequest, opts ...grpc.CallOption) (*RemoveTripPostResponse, error)
+	// Group: travel
+	GetTravelMap(ctx context.Context, in *GetTravelMapRequest, opts ...grpc.CallOption) (*GetTravelMapResponse, error)
+	GetTravelStats(ctx context.Context, in *GetTravelStatsRequest, opts ...grpc.CallOption) (*GetTravelStatsResponse, error)
+	AddTravelVisit(ctx context.Context, in *AddTravelVisitRequest, opts ...grpc.CallOption) (*AddTravelVisitResponse, error)
+	DeleteTravelVisit(ctx context.Context, in *DeleteTravelVisitRequest, opts ...grpc.CallOption) (*DeleteTravelVisitResponse, error)
+	// Group: reviews
+	CreatePlaceReview(ctx context.Context, in *CreatePlaceReviewRequest, opts ...grpc.CallOption) (*CreatePlaceReviewResponse, error)
+	EditPlaceReview(ctx context.Context, in *EditPlaceReviewRequest, opts ...grpc.CallOption) (*EditPlaceReviewResponse, error)
+	DeletePlaceReview(ctx context.Context, in *DeletePlaceReviewRequest, opts ...grpc.CallOption) (*DeletePlaceReviewResponse, error)
+	GetPlaceReview(ctx context.Context, in *GetPlaceReviewRequest, opts ...grpc.CallOption) (*GetPlaceReviewResponse, error)
+	GetPlaceReviews(ctx context.Context, in *GetPlaceReviewsRequest, opts ...grpc.CallOption) (*GetPlaceReviewsResponse, error)
+	VoteReviewHelpful(ctx context.Context, in *VoteReviewHelpfulRequest, opts ...grpc.CallOption) (*VoteReviewHelpfulResponse, error)
+	// Group: bookmarks
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error)
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetBookmarksResponse, error)
+	CreateBookmarkCollection(ctx context.Context, in *CreateBookmarkCollectionRequest, opts ...grpc.CallOption) (*CreateBookmarkCollectionResponse, error)
+	GetBookmarkCollections(ctx context.Context, in *GetBookmarkCollectionsRequest, opts ...grpc.CallOption) (*GetBookmarkCollectionsResponse, error)
+	EditBookmarkCollection(ctx context.Context, in *EditBookmarkCollectionRequest, opts ...grpc.CallOption) (*EditBookmarkCollectionResponse, error)
+	DeleteBookmarkCollection(ctx context.Context, in *DeleteBookmarkCollectionRequest, opts ...grpc.CallOption) (*DeleteBookmarkCollectionResponse, error)
+	// Group: drafts
+	CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*CreateDraftResponse, error)
+	GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error)
+	EditDraft(ctx context.Context, in *EditDraftRequest, opts ...grpc.CallOption) (*EditDraftResponse, error)
+	DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftResponse, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	// Group: polls
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	// Group: pins
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error)
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error)
+	// Group: stories
+	CreateStory(ctx context.Context, in *CreateStoryRequest, opts ...grpc.CallOption) (*CreateStoryResponse, error)
+	DeleteStory(ctx context.Context, in *DeleteStoryRequest, opts ...grpc.CallOption) (*DeleteStoryResponse, error)
+	GetStoryTray(ctx context.Context, in *GetStoryTrayRequest, opts ...grpc.CallOption) (*GetStoryTrayResponse, error)
+	ViewStory(ctx context.Context, in *ViewStoryRequest, opts ...grpc.CallOption) (*ViewStoryResponse, error)
+	GetStoryViewers(ctx context.Context, in *GetStoryViewersRequest, opts ...grpc.CallOption) (*GetStoryViewersResponse, error)
 }
 
 type authenticateAndPostClient struct {
@@ -86,6 +157,15 @@ func (c *authenticateAndPostClient) GetUserDetailInfo(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *authenticateAndPostClient) AutocompleteUsers(ctx context.Context, in *AutocompleteUsersRequest, opts ...grpc.CallOption) (*AutocompleteUsersResponse, error) {
+	out := new(AutocompleteUsersResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/AutocompleteUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticateAndPostClient) GetUserFollower(ctx context.Context, in *GetUserFollowerRequest, opts ...grpc.CallOption) (*GetUserFollowerResponse, error) {
 	out := new(GetUserFollowerResponse)
 	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetUserFollower", in, out, opts...)
@@ -149,6 +229,15 @@ func (c *authenticateAndPostClient) GetPostDetailInfo(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *authenticateAndPostClient) GetPostsByIds(ctx context.Context, in *GetPostsByIdsRequest, opts ...grpc.CallOption) (*GetPostsByIdsResponse, error) {
+	out := new(GetPostsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPostsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticateAndPostClient) EditPost(ctx context.Context, in *EditPostRequest, opts ...grpc.CallOption) (*EditPostResponse, error) {
 	out := new(EditPostResponse)
 	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/EditPost", in, out, opts...)
@@ -185,359 +274,2212 @@ func (c *authenticateAndPostClient) LikePost(ctx context.Context, in *LikePostRe
 	return out, nil
 }
 
-// AuthenticateAndPostServer is the server API for AuthenticateAndPost service.
-// All implementations must embed UnimplementedAuthenticateAndPostServer
-// for forward compatibility
-type AuthenticateAndPostServer interface {
-	// Group: users
-	CheckUserAuthentication(context.Context, *CheckUserAuthenticationRequest) (*CheckUserAuthenticationResponse, error)
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error)
-	GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error)
-	// Group: friends
-	GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error)
-	GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error)
-	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
-	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
-	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error)
-	// Group: posts
-	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
-	GetPostDetailInfo(context.Context, *GetPostDetailInfoRequest) (*GetPostDetailInfoResponse, error)
-	EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error)
-	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
-	CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error)
-	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
-	mustEmbedUnimplementedAuthenticateAndPostServer()
+func (c *authenticateAndPostClient) GetTrashedPosts(ctx context.Context, in *GetTrashedPostsRequest, opts ...grpc.CallOption) (*GetTrashedPostsResponse, error) {
+	out := new(GetTrashedPostsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetTrashedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAuthenticateAndPostServer must be embedded to have forward compatible implementations.
-type UnimplementedAuthenticateAndPostServer struct {
+func (c *authenticateAndPostClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RestorePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedAuthenticateAndPostServer) CheckUserAuthentication(context.Context, *CheckUserAuthenticationRequest) (*CheckUserAuthenticationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckUserAuthentication not implemented")
+func (c *authenticateAndPostClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error) {
+	out := new(GetPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func (c *authenticateAndPostClient) RevertPost(ctx context.Context, in *RevertPostRequest, opts ...grpc.CallOption) (*RevertPostResponse, error) {
+	out := new(RevertPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RevertPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditUser not implemented")
+
+func (c *authenticateAndPostClient) GetNearbyPosts(ctx context.Context, in *GetNearbyPostsRequest, opts ...grpc.CallOption) (*GetNearbyPostsResponse, error) {
+	out := new(GetNearbyPostsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetNearbyPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetailInfo not implemented")
+
+func (c *authenticateAndPostClient) RepostPost(ctx context.Context, in *RepostPostRequest, opts ...grpc.CallOption) (*RepostPostResponse, error) {
+	out := new(RepostPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RepostPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollower not implemented")
+
+func (c *authenticateAndPostClient) UndoRepost(ctx context.Context, in *UndoRepostRequest, opts ...grpc.CallOption) (*UndoRepostResponse, error) {
+	out := new(UndoRepostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/UndoRepost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollowing not implemented")
+
+func (c *authenticateAndPostClient) GetScheduledPosts(ctx context.Context, in *GetScheduledPostsRequest, opts ...grpc.CallOption) (*GetScheduledPostsResponse, error) {
+	out := new(GetScheduledPostsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetScheduledPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+
+func (c *authenticateAndPostClient) ReschedulePost(ctx context.Context, in *ReschedulePostRequest, opts ...grpc.CallOption) (*ReschedulePostResponse, error) {
+	out := new(ReschedulePostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/ReschedulePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+
+func (c *authenticateAndPostClient) CancelScheduledPost(ctx context.Context, in *CancelScheduledPostRequest, opts ...grpc.CallOption) (*CancelScheduledPostResponse, error) {
+	out := new(CancelScheduledPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CancelScheduledPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+
+func (c *authenticateAndPostClient) GetHashtagPosts(ctx context.Context, in *GetHashtagPostsRequest, opts ...grpc.CallOption) (*GetHashtagPostsResponse, error) {
+	out := new(GetHashtagPostsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetHashtagPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+
+func (c *authenticateAndPostClient) GetHashtagInfo(ctx context.Context, in *GetHashtagInfoRequest, opts ...grpc.CallOption) (*GetHashtagInfoResponse, error) {
+	out := new(GetHashtagInfoResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetHashtagInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) GetPostDetailInfo(context.Context, *GetPostDetailInfoRequest) (*GetPostDetailInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostDetailInfo not implemented")
+
+func (c *authenticateAndPostClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditPost not implemented")
+
+func (c *authenticateAndPostClient) CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*CreatePlaceResponse, error) {
+	out := new(CreatePlaceResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreatePlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAuthenticateAndPostServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+func (c *authenticateAndPostClient) SearchPlaces(ctx context.Context, in *SearchPlacesRequest, opts ...grpc.CallOption) (*SearchPlacesResponse, error) {
+	out := new(SearchPlacesResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/SearchPlaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetPlace(ctx context.Context, in *GetPlaceRequest, opts ...grpc.CallOption) (*GetPlaceResponse, error) {
+	out := new(GetPlaceResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetPlacePosts(ctx context.Context, in *GetPlacePostsRequest, opts ...grpc.CallOption) (*GetPlacePostsResponse, error) {
+	out := new(GetPlacePostsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPlacePosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) MergePlaces(ctx context.Context, in *MergePlacesRequest, opts ...grpc.CallOption) (*MergePlacesResponse, error) {
+	out := new(MergePlacesResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/MergePlaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateTrip(ctx context.Context, in *CreateTripRequest, opts ...grpc.CallOption) (*CreateTripResponse, error) {
+	out := new(CreateTripResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreateTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EditTrip(ctx context.Context, in *EditTripRequest, opts ...grpc.CallOption) (*EditTripResponse, error) {
+	out := new(EditTripResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/EditTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) PublishTrip(ctx context.Context, in *PublishTripRequest, opts ...grpc.CallOption) (*PublishTripResponse, error) {
+	out := new(PublishTripResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/PublishTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*GetTripResponse, error) {
+	out := new(GetTripResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) InviteTripMember(ctx context.Context, in *InviteTripMemberRequest, opts ...grpc.CallOption) (*InviteTripMemberResponse, error) {
+	out := new(InviteTripMemberResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/InviteTripMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RespondTripInvitation(ctx context.Context, in *RespondTripInvitationRequest, opts ...grpc.CallOption) (*RespondTripInvitationResponse, error) {
+	out := new(RespondTripInvitationResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RespondTripInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetTripInvitations(ctx context.Context, in *GetTripInvitationsRequest, opts ...grpc.CallOption) (*GetTripInvitationsResponse, error) {
+	out := new(GetTripInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetTripInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UpdateTripMember(ctx context.Context, in *UpdateTripMemberRequest, opts ...grpc.CallOption) (*UpdateTripMemberResponse, error) {
+	out := new(UpdateTripMemberResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/UpdateTripMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RemoveTripMember(ctx context.Context, in *RemoveTripMemberRequest, opts ...grpc.CallOption) (*RemoveTripMemberResponse, error) {
+	out := new(RemoveTripMemberResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RemoveTripMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RemoveTripPost(ctx context.Context, in *RemoveTripPostRequest, opts ...grpc.CallOption) (*RemoveTripPostResponse, error) {
+	out := new(RemoveTripPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RemoveTripPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetTravelMap(ctx context.Context, in *GetTravelMapRequest, opts ...grpc.CallOption) (*GetTravelMapResponse, error) {
+	out := new(GetTravelMapResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetTravelMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetTravelStats(ctx context.Context, in *GetTravelStatsRequest, opts ...grpc.CallOption) (*GetTravelStatsResponse, error) {
+	out := new(GetTravelStatsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetTravelStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) AddTravelVisit(ctx context.Context, in *AddTravelVisitRequest, opts ...grpc.CallOption) (*AddTravelVisitResponse, error) {
+	out := new(AddTravelVisitResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/AddTravelVisit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteTravelVisit(ctx context.Context, in *DeleteTravelVisitRequest, opts ...grpc.CallOption) (*DeleteTravelVisitResponse, error) {
+	out := new(DeleteTravelVisitResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/DeleteTravelVisit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreatePlaceReview(ctx context.Context, in *CreatePlaceReviewRequest, opts ...grpc.CallOption) (*CreatePlaceReviewResponse, error) {
+	out := new(CreatePlaceReviewResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreatePlaceReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EditPlaceReview(ctx context.Context, in *EditPlaceReviewRequest, opts ...grpc.CallOption) (*EditPlaceReviewResponse, error) {
+	out := new(EditPlaceReviewResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/EditPlaceReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeletePlaceReview(ctx context.Context, in *DeletePlaceReviewRequest, opts ...grpc.CallOption) (*DeletePlaceReviewResponse, error) {
+	out := new(DeletePlaceReviewResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/DeletePlaceReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetPlaceReview(ctx context.Context, in *GetPlaceReviewRequest, opts ...grpc.CallOption) (*GetPlaceReviewResponse, error) {
+	out := new(GetPlaceReviewResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPlaceReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetPlaceReviews(ctx context.Context, in *GetPlaceReviewsRequest, opts ...grpc.CallOption) (*GetPlaceReviewsResponse, error) {
+	out := new(GetPlaceReviewsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetPlaceReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) VoteReviewHelpful(ctx context.Context, in *VoteReviewHelpfulRequest, opts ...grpc.CallOption) (*VoteReviewHelpfulResponse, error) {
+	out := new(VoteReviewHelpfulResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/VoteReviewHelpful", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error) {
+	out := new(AddBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/AddBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/RemoveBookmark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetBookmarks(ctx context.Context, in *GetBookmarksRequest, opts ...grpc.CallOption) (*GetBookmarksResponse, error) {
+	out := new(GetBookmarksResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetBookmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateBookmarkCollection(ctx context.Context, in *CreateBookmarkCollectionRequest, opts ...grpc.CallOption) (*CreateBookmarkCollectionResponse, error) {
+	out := new(CreateBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreateBookmarkCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetBookmarkCollections(ctx context.Context, in *GetBookmarkCollectionsRequest, opts ...grpc.CallOption) (*GetBookmarkCollectionsResponse, error) {
+	out := new(GetBookmarkCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetBookmarkCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EditBookmarkCollection(ctx context.Context, in *EditBookmarkCollectionRequest, opts ...grpc.CallOption) (*EditBookmarkCollectionResponse, error) {
+	out := new(EditBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/EditBookmarkCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteBookmarkCollection(ctx context.Context, in *DeleteBookmarkCollectionRequest, opts ...grpc.CallOption) (*DeleteBookmarkCollectionResponse, error) {
+	out := new(DeleteBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/DeleteBookmarkCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateDraft(ctx context.Context, in *CreateDraftRequest, opts ...grpc.CallOption) (*CreateDraftResponse, error) {
+	out := new(CreateDraftResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreateDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error) {
+	out := new(GetDraftsResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetDrafts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EditDraft(ctx context.Context, in *EditDraftRequest, opts ...grpc.CallOption) (*EditDraftResponse, error) {
+	out := new(EditDraftResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/EditDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftResponse, error) {
+	out := new(DeleteDraftResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/DeleteDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/PublishDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/VotePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error) {
+	out := new(PinPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/PinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error) {
+	out := new(UnpinPostResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/UnpinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateStory(ctx context.Context, in *CreateStoryRequest, opts ...grpc.CallOption) (*CreateStoryResponse, error) {
+	out := new(CreateStoryResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/CreateStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteStory(ctx context.Context, in *DeleteStoryRequest, opts ...grpc.CallOption) (*DeleteStoryResponse, error) {
+	out := new(DeleteStoryResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/DeleteStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetStoryTray(ctx context.Context, in *GetStoryTrayRequest, opts ...grpc.CallOption) (*GetStoryTrayResponse, error) {
+	out := new(GetStoryTrayResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetStoryTray", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) ViewStory(ctx context.Context, in *ViewStoryRequest, opts ...grpc.CallOption) (*ViewStoryResponse, error) {
+	out := new(ViewStoryResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/ViewStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetStoryViewers(ctx context.Context, in *GetStoryViewersRequest, opts ...grpc.CallOption) (*GetStoryViewersResponse, error) {
+	out := new(GetStoryViewersResponse)
+	err := c.cc.Invoke(ctx, "/authpost.AuthenticateAndPost/GetStoryViewers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthenticateAndPostServer is the server API for AuthenticateAndPost service.
+// All implementations must embed UnimplementedAuthenticateAndPostServer
+// for forward compatibility
+type AuthenticateAndPostServer interface {
+	// Group: users
+	CheckUserAuthentication(context.Context, *CheckUserAuthenticationRequest) (*CheckUserAuthenticationResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error)
+	GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error)
+	AutocompleteUsers(context.Context, *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error)
+	// Group: friends
+	GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error)
+	GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error)
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
+	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error)
+	// Group: posts
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	GetPostDetailInfo(context.Context, *GetPostDetailInfoRequest) (*GetPostDetailInfoResponse, error)
+	GetPostsByIds(context.Context, *GetPostsByIdsRequest) (*GetPostsByIdsResponse, error)
+	EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error)
+	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
+	GetTrashedPosts(context.Context, *GetTrashedPostsRequest) (*GetTrashedPostsResponse, error)
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	GetPostRevisions(context.Context, *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error)
+	RevertPost(context.Context, *RevertPostRequest) (*RevertPostResponse, error)
+	GetNearbyPosts(context.Context, *GetNearbyPostsRequest) (*GetNearbyPostsResponse, error)
+	RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error)
+	UndoRepost(context.Context, *UndoRepostRequest) (*UndoRepostResponse, error)
+	GetScheduledPosts(context.Context, *GetScheduledPostsRequest) (*GetScheduledPostsResponse, error)
+	ReschedulePost(context.Context, *ReschedulePostRequest) (*ReschedulePostResponse, error)
+	CancelScheduledPost(context.Context, *CancelScheduledPostRequest) (*CancelScheduledPostResponse, error)
+	// Group: hashtags
+	GetHashtagPosts(context.Context, *GetHashtagPostsRequest) (*GetHashtagPostsResponse, error)
+	GetHashtagInfo(context.Context, *GetHashtagInfoRequest) (*GetHashtagInfoResponse, error)
+	// Group: search
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Group: places
+	CreatePlace(context.Context, *CreatePlaceRequest) (*CreatePlaceResponse, error)
+	SearchPlaces(context.Context, *SearchPlacesRequest) (*SearchPlacesResponse, error)
+	GetPlace(context.Context, *GetPlaceRequest) (*GetPlaceResponse, error)
+	GetPlacePosts(context.Context, *GetPlacePostsRequest) (*GetPlacePostsResponse, error)
+	MergePlaces(context.Context, *MergePlacesRequest) (*MergePlacesResponse, error)
+	// Group: trips
+	CreateTrip(context.Context, *CreateTripRequest) (*CreateTripResponse, error)
+	EditTrip(context.Context, *EditTripRequest) (*EditTripResponse, error)
+	PublishTrip(context.Context, *PublishTripRequest) (*PublishTripResponse, error)
+	GetTrip(context.Context, *GetTripRequest) (*GetTripResponse, error)
+	InviteTripMember(context.Context, *InviteTripMemberRequest) (*InviteTripMemberResponse, error)
+	RespondTripInvitation(context.Context, *RespondTripInvitationRequest) (*RespondTripInvitationResponse, error)
+	GetTripInvitations(context.Context, *GetTripInvitationsRequest) (*GetTripInvitationsResponse, error)
+	UpdateTripMember(context.Context, *UpdateTripMemberRequest) (*UpdateTripMemberResponse, error)
+	RemoveTripMember(context.Context, *RemoveTripMemberRequest) (*RemoveTripMemberResponse, error)
+	RemoveTripPost(context.Context, *RemoveTripPostRequest) (*RemoveTripPostResponse, error)
+	// Group: travel
+	GetTravelMap(context.Context, *GetTravelMapRequest) (*GetTravelMapResponse, error)
+	GetTravelStats(context.Context, *GetTravelStatsRequest) (*GetTravelStatsResponse, error)
+	AddTravelVisit(context.Context, *AddTravelVisitRequest) (*AddTravelVisitResponse, error)
+	DeleteTravelVisit(context.Context, *DeleteTravelVisitRequest) (*DeleteTravelVisitResponse, error)
+	// Group: reviews
+	CreatePlaceReview(context.Context, *CreatePlaceReviewRequest) (*CreatePlaceReviewResponse, error)
+	EditPlaceReview(context.Context, *EditPlaceReviewRequest) (*EditPlaceReviewResponse, error)
+	DeletePlaceReview(context.Context, *DeletePlaceReviewRequest) (*DeletePlaceReviewResponse, error)
+	GetPlaceReview(context.Context, *GetPlaceReviewRequest) (*GetPlaceReviewResponse, error)
+	GetPlaceReviews(context.Context, *GetPlaceReviewsRequest) (*GetPlaceReviewsResponse, error)
+	VoteReviewHelpful(context.Context, *VoteReviewHelpfulRequest) (*VoteReviewHelpfulResponse, error)
+	// Group: bookmarks
+	AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error)
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	GetBookmarks(context.Context, *GetBookmarksRequest) (*GetBookmarksResponse, error)
+	CreateBookmarkCollection(context.Context, *CreateBookmarkCollectionRequest) (*CreateBookmarkCollectionResponse, error)
+	GetBookmarkCollections(context.Context, *GetBookmarkCollectionsRequest) (*GetBookmarkCollectionsResponse, error)
+	EditBookmarkCollection(context.Context, *EditBookmarkCollectionRequest) (*EditBookmarkCollectionResponse, error)
+	DeleteBookmarkCollection(context.Context, *DeleteBookmarkCollectionRequest) (*DeleteBookmarkCollectionResponse, error)
+	// Group: drafts
+	CreateDraft(context.Context, *CreateDraftRequest) (*CreateDraftResponse, error)
+	GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error)
+	EditDraft(context.Context, *EditDraftRequest) (*EditDraftResponse, error)
+	DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftResponse, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	// Group: polls
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	// Group: pins
+	PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error)
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error)
+	// Group: stories
+	CreateStory(context.Context, *CreateStoryRequest) (*CreateStoryResponse, error)
+	DeleteStory(context.Context, *DeleteStoryRequest) (*DeleteStoryResponse, error)
+	GetStoryTray(context.Context, *GetStoryTrayRequest) (*GetStoryTrayResponse, error)
+	ViewStory(context.Context, *ViewStoryRequest) (*ViewStoryResponse, error)
+	GetStoryViewers(context.Context, *GetStoryViewersRequest) (*GetStoryViewersResponse, error)
+	mustEmbedUnimplementedAuthenticateAndPostServer()
+}
+
+// UnimplementedAuthenticateAndPostServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthenticateAndPostServer struct {
+}
+
+func (UnimplementedAuthenticateAndPostServer) CheckUserAuthentication(context.Context, *CheckUserAuthenticationRequest) (*CheckUserAuthenticationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserAuthentication not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetailInfo not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) AutocompleteUsers(context.Context, *AutocompleteUsersRequest) (*AutocompleteUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteUsers not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollower not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollowing not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPostDetailInfo(context.Context, *GetPostDetailInfoRequest) (*GetPostDetailInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostDetailInfo not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPostsByIds(context.Context, *GetPostsByIdsRequest) (*GetPostsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByIds not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetTrashedPosts(context.Context, *GetTrashedPostsRequest) (*GetTrashedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrashedPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPostRevisions(context.Context, *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RevertPost(context.Context, *RevertPostRequest) (*RevertPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetNearbyPosts(context.Context, *GetNearbyPostsRequest) (*GetNearbyPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RepostPost(context.Context, *RepostPostRequest) (*RepostPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepostPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UndoRepost(context.Context, *UndoRepostRequest) (*UndoRepostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRepost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetScheduledPosts(context.Context, *GetScheduledPostsRequest) (*GetScheduledPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) ReschedulePost(context.Context, *ReschedulePostRequest) (*ReschedulePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReschedulePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CancelScheduledPost(context.Context, *CancelScheduledPostRequest) (*CancelScheduledPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetHashtagPosts(context.Context, *GetHashtagPostsRequest) (*GetHashtagPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetHashtagInfo(context.Context, *GetHashtagInfoRequest) (*GetHashtagInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagInfo not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreatePlace(context.Context, *CreatePlaceRequest) (*CreatePlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlace not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) SearchPlaces(context.Context, *SearchPlacesRequest) (*SearchPlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPlaces not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPlace(context.Context, *GetPlaceRequest) (*GetPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlace not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPlacePosts(context.Context, *GetPlacePostsRequest) (*GetPlacePostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacePosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) MergePlaces(context.Context, *MergePlacesRequest) (*MergePlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePlaces not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateTrip(context.Context, *CreateTripRequest) (*CreateTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrip not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditTrip(context.Context, *EditTripRequest) (*EditTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTrip not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) PublishTrip(context.Context, *PublishTripRequest) (*PublishTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTrip not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetTrip(context.Context, *GetTripRequest) (*GetTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrip not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) InviteTripMember(context.Context, *InviteTripMemberRequest) (*InviteTripMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteTripMember not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RespondTripInvitation(context.Context, *RespondTripInvitationRequest) (*RespondTripInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondTripInvitation not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetTripInvitations(context.Context, *GetTripInvitationsRequest) (*GetTripInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripInvitations not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UpdateTripMember(context.Context, *UpdateTripMemberRequest) (*UpdateTripMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTripMember not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RemoveTripMember(context.Context, *RemoveTripMemberRequest) (*RemoveTripMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTripMember not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RemoveTripPost(context.Context, *RemoveTripPostRequest) (*RemoveTripPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTripPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetTravelMap(context.Context, *GetTravelMapRequest) (*GetTravelMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTravelMap not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetTravelStats(context.Context, *GetTravelStatsRequest) (*GetTravelStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTravelStats not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) AddTravelVisit(context.Context, *AddTravelVisitRequest) (*AddTravelVisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTravelVisit not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteTravelVisit(context.Context, *DeleteTravelVisitRequest) (*DeleteTravelVisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTravelVisit not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreatePlaceReview(context.Context, *CreatePlaceReviewRequest) (*CreatePlaceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaceReview not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditPlaceReview(context.Context, *EditPlaceReviewRequest) (*EditPlaceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPlaceReview not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeletePlaceReview(context.Context, *DeletePlaceReviewRequest) (*DeletePlaceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaceReview not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPlaceReview(context.Context, *GetPlaceReviewRequest) (*GetPlaceReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceReview not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPlaceReviews(context.Context, *GetPlaceReviewsRequest) (*GetPlaceReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceReviews not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) VoteReviewHelpful(context.Context, *VoteReviewHelpfulRequest) (*VoteReviewHelpfulResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReviewHelpful not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetBookmarks(context.Context, *GetBookmarksRequest) (*GetBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarks not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateBookmarkCollection(context.Context, *CreateBookmarkCollectionRequest) (*CreateBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmarkCollection not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetBookmarkCollections(context.Context, *GetBookmarkCollectionsRequest) (*GetBookmarkCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkCollections not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditBookmarkCollection(context.Context, *EditBookmarkCollectionRequest) (*EditBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditBookmarkCollection not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteBookmarkCollection(context.Context, *DeleteBookmarkCollectionRequest) (*DeleteBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmarkCollection not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateDraft(context.Context, *CreateDraftRequest) (*CreateDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDraft not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrafts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EditDraft(context.Context, *EditDraftRequest) (*EditDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditDraft not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateStory(context.Context, *CreateStoryRequest) (*CreateStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteStory(context.Context, *DeleteStoryRequest) (*DeleteStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetStoryTray(context.Context, *GetStoryTrayRequest) (*GetStoryTrayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryTray not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) ViewStory(context.Context, *ViewStoryRequest) (*ViewStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetStoryViewers(context.Context, *GetStoryViewersRequest) (*GetStoryViewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryViewers not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) mustEmbedUnimplementedAuthenticateAndPostServer() {}
+
+// UnsafeAuthenticateAndPostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthenticateAndPostServer will
+// result in compilation errors.
+type UnsafeAuthenticateAndPostServer interface {
+	mustEmbedUnimplementedAuthenticateAndPostServer()
+}
+
+func RegisterAuthenticateAndPostServer(s grpc.ServiceRegistrar, srv AuthenticateAndPostServer) {
+	s.RegisterService(&AuthenticateAndPost_ServiceDesc, srv)
+}
+
+func _AuthenticateAndPost_CheckUserAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CheckUserAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, req.(*CheckUserAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/EditUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDetailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetUserDetailInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, req.(*GetUserDetailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_AutocompleteUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).AutocompleteUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/AutocompleteUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).AutocompleteUsers(ctx, req.(*AutocompleteUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetUserFollower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, req.(*GetUserFollowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetUserFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, req.(*GetUserFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/UnfollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetUserPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreatePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPostDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostDetailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPostDetailInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, req.(*GetPostDetailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPostsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPostsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPostsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPostsByIds(ctx, req.(*GetPostsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/EditPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditPost(ctx, req.(*EditPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/DeletePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CommentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CommentPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CommentPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CommentPost(ctx, req.(*CommentPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/LikePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).LikePost(ctx, req.(*LikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetTrashedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetTrashedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetTrashedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetTrashedPosts(ctx, req.(*GetTrashedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RestorePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPostRevisions(ctx, req.(*GetPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RevertPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RevertPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RevertPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RevertPost(ctx, req.(*RevertPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetNearbyPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetNearbyPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetNearbyPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetNearbyPosts(ctx, req.(*GetNearbyPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RepostPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RepostPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RepostPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RepostPost(ctx, req.(*RepostPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UndoRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRepostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UndoRepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/UndoRepost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UndoRepost(ctx, req.(*UndoRepostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetScheduledPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetScheduledPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetScheduledPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetScheduledPosts(ctx, req.(*GetScheduledPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_ReschedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReschedulePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).ReschedulePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/ReschedulePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).ReschedulePost(ctx, req.(*ReschedulePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CancelScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CancelScheduledPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CancelScheduledPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CancelScheduledPost(ctx, req.(*CancelScheduledPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetHashtagPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetHashtagPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetHashtagPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetHashtagPosts(ctx, req.(*GetHashtagPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetHashtagInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetHashtagInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetHashtagInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetHashtagInfo(ctx, req.(*GetHashtagInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreatePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreatePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreatePlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreatePlace(ctx, req.(*CreatePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_SearchPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).SearchPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/SearchPlaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).SearchPlaces(ctx, req.(*SearchPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPlace(ctx, req.(*GetPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPlacePosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlacePostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPlacePosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPlacePosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPlacePosts(ctx, req.(*GetPlacePostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_MergePlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).MergePlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/MergePlaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).MergePlaces(ctx, req.(*MergePlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreateTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreateTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreateTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreateTrip(ctx, req.(*CreateTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EditTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/EditTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditTrip(ctx, req.(*EditTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_PublishTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).PublishTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/PublishTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).PublishTrip(ctx, req.(*PublishTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetTrip(ctx, req.(*GetTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_InviteTripMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteTripMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).InviteTripMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/InviteTripMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).InviteTripMember(ctx, req.(*InviteTripMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RespondTripInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondTripInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RespondTripInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RespondTripInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RespondTripInvitation(ctx, req.(*RespondTripInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetTripInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetTripInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetTripInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetTripInvitations(ctx, req.(*GetTripInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UpdateTripMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTripMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UpdateTripMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/UpdateTripMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UpdateTripMember(ctx, req.(*UpdateTripMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RemoveTripMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTripMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RemoveTripMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RemoveTripMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RemoveTripMember(ctx, req.(*RemoveTripMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RemoveTripPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTripPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RemoveTripPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RemoveTripPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RemoveTripPost(ctx, req.(*RemoveTripPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetTravelMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTravelMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetTravelMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetTravelMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetTravelMap(ctx, req.(*GetTravelMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetTravelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTravelStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetTravelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetTravelStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetTravelStats(ctx, req.(*GetTravelStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_AddTravelVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTravelVisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).AddTravelVisit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/AddTravelVisit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).AddTravelVisit(ctx, req.(*AddTravelVisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DeleteTravelVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTravelVisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DeleteTravelVisit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/DeleteTravelVisit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DeleteTravelVisit(ctx, req.(*DeleteTravelVisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreatePlaceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreatePlaceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreatePlaceReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreatePlaceReview(ctx, req.(*CreatePlaceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EditPlaceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPlaceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditPlaceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/EditPlaceReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditPlaceReview(ctx, req.(*EditPlaceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DeletePlaceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DeletePlaceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/DeletePlaceReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DeletePlaceReview(ctx, req.(*DeletePlaceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPlaceReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaceReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPlaceReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPlaceReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPlaceReview(ctx, req.(*GetPlaceReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetPlaceReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaceReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPlaceReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetPlaceReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPlaceReviews(ctx, req.(*GetPlaceReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_VoteReviewHelpful_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReviewHelpfulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).VoteReviewHelpful(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/VoteReviewHelpful",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).VoteReviewHelpful(ctx, req.(*VoteReviewHelpfulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/AddBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthenticateAndPostServer) CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+
+func _AuthenticateAndPost_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/RemoveBookmark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthenticateAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+
+func _AuthenticateAndPost_GetBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetBookmarks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetBookmarks(ctx, req.(*GetBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthenticateAndPostServer) mustEmbedUnimplementedAuthenticateAndPostServer() {}
 
-// UnsafeAuthenticateAndPostServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AuthenticateAndPostServer will
-// result in compilation errors.
-type UnsafeAuthenticateAndPostServer interface {
-	mustEmbedUnimplementedAuthenticateAndPostServer()
+func _AuthenticateAndPost_CreateBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreateBookmarkCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/CreateBookmarkCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreateBookmarkCollection(ctx, req.(*CreateBookmarkCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAuthenticateAndPostServer(s grpc.ServiceRegistrar, srv AuthenticateAndPostServer) {
-	s.RegisterService(&AuthenticateAndPost_ServiceDesc, srv)
+func _AuthenticateAndPost_GetBookmarkCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarkCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetBookmarkCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authpost.AuthenticateAndPost/GetBookmarkCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetBookmarkCollections(ctx, req.(*GetBookmarkCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CheckUserAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserAuthenticationRequest)
+func _AuthenticateAndPost_EditBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditBookmarkCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, in)
+		return srv.(AuthenticateAndPostServer).EditBookmarkCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/CheckUserAuthentication",
+		FullMethod: "/authpost.AuthenticateAndPost/EditBookmarkCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, req.(*CheckUserAuthenticationRequest))
+		return srv.(AuthenticateAndPostServer).EditBookmarkCollection(ctx, req.(*EditBookmarkCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _AuthenticateAndPost_DeleteBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CreateUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).DeleteBookmarkCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/CreateUser",
+		FullMethod: "/authpost.AuthenticateAndPost/DeleteBookmarkCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(AuthenticateAndPostServer).DeleteBookmarkCollection(ctx, req.(*DeleteBookmarkCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditUserRequest)
+func _AuthenticateAndPost_CreateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).EditUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).CreateDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/EditUser",
+		FullMethod: "/authpost.AuthenticateAndPost/CreateDraft",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+		return srv.(AuthenticateAndPostServer).CreateDraft(ctx, req.(*CreateDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserDetailInfoRequest)
+func _AuthenticateAndPost_GetDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetDrafts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/GetUserDetailInfo",
+		FullMethod: "/authpost.AuthenticateAndPost/GetDrafts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, req.(*GetUserDetailInfoRequest))
+		return srv.(AuthenticateAndPostServer).GetDrafts(ctx, req.(*GetDraftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowerRequest)
+func _AuthenticateAndPost_EditDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, in)
+		return srv.(AuthenticateAndPostServer).EditDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/GetUserFollower",
+		FullMethod: "/authpost.AuthenticateAndPost/EditDraft",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, req.(*GetUserFollowerRequest))
+		return srv.(AuthenticateAndPostServer).EditDraft(ctx, req.(*EditDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowingRequest)
+func _AuthenticateAndPost_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, in)
+		return srv.(AuthenticateAndPostServer).DeleteDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/GetUserFollowing",
+		FullMethod: "/authpost.AuthenticateAndPost/DeleteDraft",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, req.(*GetUserFollowingRequest))
+		return srv.(AuthenticateAndPostServer).DeleteDraft(ctx, req.(*DeleteDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _AuthenticateAndPost_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).FollowUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).PublishDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/FollowUser",
+		FullMethod: "/authpost.AuthenticateAndPost/PublishDraft",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(AuthenticateAndPostServer).PublishDraft(ctx, req.(*PublishDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserRequest)
+func _AuthenticateAndPost_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).VotePoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/UnfollowUser",
+		FullMethod: "/authpost.AuthenticateAndPost/VotePoll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		return srv.(AuthenticateAndPostServer).VotePoll(ctx, req.(*VotePollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPostsRequest)
+func _AuthenticateAndPost_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, in)
+		return srv.(AuthenticateAndPostServer).PinPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/GetUserPosts",
+		FullMethod: "/authpost.AuthenticateAndPost/PinPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+		return srv.(AuthenticateAndPostServer).PinPost(ctx, req.(*PinPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _AuthenticateAndPost_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CreatePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).UnpinPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/CreatePost",
+		FullMethod: "/authpost.AuthenticateAndPost/UnpinPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(AuthenticateAndPostServer).UnpinPost(ctx, req.(*UnpinPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetPostDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostDetailInfoRequest)
+func _AuthenticateAndPost_CreateStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, in)
+		return srv.(AuthenticateAndPostServer).CreateStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/GetPostDetailInfo",
+		FullMethod: "/authpost.AuthenticateAndPost/CreateStory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, req.(*GetPostDetailInfoRequest))
+		return srv.(AuthenticateAndPostServer).CreateStory(ctx, req.(*CreateStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditPostRequest)
+func _AuthenticateAndPost_DeleteStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).EditPost(ctx, in)
+		return srv.(AuthenticateAndPostServer).DeleteStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/EditPost",
+		FullMethod: "/authpost.AuthenticateAndPost/DeleteStory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).EditPost(ctx, req.(*EditPostRequest))
+		return srv.(AuthenticateAndPostServer).DeleteStory(ctx, req.(*DeleteStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _AuthenticateAndPost_GetStoryTray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryTrayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).DeletePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetStoryTray(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/DeletePost",
+		FullMethod: "/authpost.AuthenticateAndPost/GetStoryTray",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(AuthenticateAndPostServer).GetStoryTray(ctx, req.(*GetStoryTrayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CommentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentPostRequest)
+func _AuthenticateAndPost_ViewStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CommentPost(ctx, in)
+		return srv.(AuthenticateAndPostServer).ViewStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/CommentPost",
+		FullMethod: "/authpost.AuthenticateAndPost/ViewStory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CommentPost(ctx, req.(*CommentPostRequest))
+		return srv.(AuthenticateAndPostServer).ViewStory(ctx, req.(*ViewStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostRequest)
+func _AuthenticateAndPost_GetStoryViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryViewersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).LikePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetStoryViewers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/authpost.AuthenticateAndPost/LikePost",
+		FullMethod: "/authpost.AuthenticateAndPost/GetStoryViewers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).LikePost(ctx, req.(*LikePostRequest))
+		return srv.(AuthenticateAndPostServer).GetStoryViewers(ctx, req.(*GetStoryViewersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -565,6 +2507,10 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserDetailInfo",
 			Handler:    _AuthenticateAndPost_GetUserDetailInfo_Handler,
 		},
+		{
+			MethodName: "AutocompleteUsers",
+			Handler:    _AuthenticateAndPost_AutocompleteUsers_Handler,
+		},
 		{
 			MethodName: "GetUserFollower",
 			Handler:    _AuthenticateAndPost_GetUserFollower_Handler,
@@ -593,6 +2539,10 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostDetailInfo",
 			Handler:    _AuthenticateAndPost_GetPostDetailInfo_Handler,
 		},
+		{
+			MethodName: "GetPostsByIds",
+			Handler:    _AuthenticateAndPost_GetPostsByIds_Handler,
+		},
 		{
 			MethodName: "EditPost",
 			Handler:    _AuthenticateAndPost_EditPost_Handler,
@@ -609,6 +2559,238 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LikePost",
 			Handler:    _AuthenticateAndPost_LikePost_Handler,
 		},
+		{
+			MethodName: "GetTrashedPosts",
+			Handler:    _AuthenticateAndPost_GetTrashedPosts_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _AuthenticateAndPost_RestorePost_Handler,
+		},
+		{
+			MethodName: "GetPostRevisions",
+			Handler:    _AuthenticateAndPost_GetPostRevisions_Handler,
+		},
+		{
+			MethodName: "RevertPost",
+			Handler:    _AuthenticateAndPost_RevertPost_Handler,
+		},
+		{
+			MethodName: "GetNearbyPosts",
+			Handler:    _AuthenticateAndPost_GetNearbyPosts_Handler,
+		},
+		{
+			MethodName: "RepostPost",
+			Handler:    _AuthenticateAndPost_RepostPost_Handler,
+		},
+		{
+			MethodName: "UndoRepost",
+			Handler:    _AuthenticateAndPost_UndoRepost_Handler,
+		},
+		{
+			MethodName: "GetScheduledPosts",
+			Handler:    _AuthenticateAndPost_GetScheduledPosts_Handler,
+		},
+		{
+			MethodName: "ReschedulePost",
+			Handler:    _AuthenticateAndPost_ReschedulePost_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPost",
+			Handler:    _AuthenticateAndPost_CancelScheduledPost_Handler,
+		},
+		{
+			MethodName: "GetHashtagPosts",
+			Handler:    _AuthenticateAndPost_GetHashtagPosts_Handler,
+		},
+		{
+			MethodName: "GetHashtagInfo",
+			Handler:    _AuthenticateAndPost_GetHashtagInfo_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _AuthenticateAndPost_Search_Handler,
+		},
+		{
+			MethodName: "CreatePlace",
+			Handler:    _AuthenticateAndPost_CreatePlace_Handler,
+		},
+		{
+			MethodName: "SearchPlaces",
+			Handler:    _AuthenticateAndPost_SearchPlaces_Handler,
+		},
+		{
+			MethodName: "GetPlace",
+			Handler:    _AuthenticateAndPost_GetPlace_Handler,
+		},
+		{
+			MethodName: "GetPlacePosts",
+			Handler:    _AuthenticateAndPost_GetPlacePosts_Handler,
+		},
+		{
+			MethodName: "MergePlaces",
+			Handler:    _AuthenticateAndPost_MergePlaces_Handler,
+		},
+		{
+			MethodName: "CreateTrip",
+			Handler:    _AuthenticateAndPost_CreateTrip_Handler,
+		},
+		{
+			MethodName: "EditTrip",
+			Handler:    _AuthenticateAndPost_EditTrip_Handler,
+		},
+		{
+			MethodName: "PublishTrip",
+			Handler:    _AuthenticateAndPost_PublishTrip_Handler,
+		},
+		{
+			MethodName: "GetTrip",
+			Handler:    _AuthenticateAndPost_GetTrip_Handler,
+		},
+		{
+			MethodName: "InviteTripMember",
+			Handler:    _AuthenticateAndPost_InviteTripMember_Handler,
+		},
+		{
+			MethodName: "RespondTripInvitation",
+			Handler:    _AuthenticateAndPost_RespondTripInvitation_Handler,
+		},
+		{
+			MethodName: "GetTripInvitations",
+			Handler:    _AuthenticateAndPost_GetTripInvitations_Handler,
+		},
+		{
+			MethodName: "UpdateTripMember",
+			Handler:    _AuthenticateAndPost_UpdateTripMember_Handler,
+		},
+		{
+			MethodName: "RemoveTripMember",
+			Handler:    _AuthenticateAndPost_RemoveTripMember_Handler,
+		},
+		{
+			MethodName: "RemoveTripPost",
+			Handler:    _AuthenticateAndPost_RemoveTripPost_Handler,
+		},
+		{
+			MethodName: "GetTravelMap",
+			Handler:    _AuthenticateAndPost_GetTravelMap_Handler,
+		},
+		{
+			MethodName: "GetTravelStats",
+			Handler:    _AuthenticateAndPost_GetTravelStats_Handler,
+		},
+		{
+			MethodName: "AddTravelVisit",
+			Handler:    _AuthenticateAndPost_AddTravelVisit_Handler,
+		},
+		{
+			MethodName: "DeleteTravelVisit",
+			Handler:    _AuthenticateAndPost_DeleteTravelVisit_Handler,
+		},
+		{
+			MethodName: "CreatePlaceReview",
+			Handler:    _AuthenticateAndPost_CreatePlaceReview_Handler,
+		},
+		{
+			MethodName: "EditPlaceReview",
+			Handler:    _AuthenticateAndPost_EditPlaceReview_Handler,
+		},
+		{
+			MethodName: "DeletePlaceReview",
+			Handler:    _AuthenticateAndPost_DeletePlaceReview_Handler,
+		},
+		{
+			MethodName: "GetPlaceReview",
+			Handler:    _AuthenticateAndPost_GetPlaceReview_Handler,
+		},
+		{
+			MethodName: "GetPlaceReviews",
+			Handler:    _AuthenticateAndPost_GetPlaceReviews_Handler,
+		},
+		{
+			MethodName: "VoteReviewHelpful",
+			Handler:    _AuthenticateAndPost_VoteReviewHelpful_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _AuthenticateAndPost_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _AuthenticateAndPost_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "GetBookmarks",
+			Handler:    _AuthenticateAndPost_GetBookmarks_Handler,
+		},
+		{
+			MethodName: "CreateBookmarkCollection",
+			Handler:    _AuthenticateAndPost_CreateBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "GetBookmarkCollections",
+			Handler:    _AuthenticateAndPost_GetBookmarkCollections_Handler,
+		},
+		{
+			MethodName: "EditBookmarkCollection",
+			Handler:    _AuthenticateAndPost_EditBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "DeleteBookmarkCollection",
+			Handler:    _AuthenticateAndPost_DeleteBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "CreateDraft",
+			Handler:    _AuthenticateAndPost_CreateDraft_Handler,
+		},
+		{
+			MethodName: "GetDrafts",
+			Handler:    _AuthenticateAndPost_GetDrafts_Handler,
+		},
+		{
+			MethodName: "EditDraft",
+			Handler:    _AuthenticateAndPost_EditDraft_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _AuthenticateAndPost_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _AuthenticateAndPost_PublishDraft_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _AuthenticateAndPost_VotePoll_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _AuthenticateAndPost_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _AuthenticateAndPost_UnpinPost_Handler,
+		},
+		{
+			MethodName: "CreateStory",
+			Handler:    _AuthenticateAndPost_CreateStory_Handler,
+		},
+		{
+			MethodName: "DeleteStory",
+			Handler:    _AuthenticateAndPost_DeleteStory_Handler,
+		},
+		{
+			MethodName: "GetStoryTray",
+			Handler:    _AuthenticateAndPost_GetStoryTray_Handler,
+		},
+		{
+			MethodName: "ViewStory",
+			Handler:    _AuthenticateAndPost_ViewStory_Handler,
+		},
+		{
+			MethodName: "GetStoryViewers",
+			Handler:    _AuthenticateAndPost_GetStoryViewers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/types/proto/authpost.proto",
@@ -66,6 +66,58 @@ func (GetNewsfeedResponse_GetNewsfeedStatus) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type NewsfeedItem_NewsfeedItemType int32
+
+const (
+	NewsfeedItem_POST   NewsfeedItem_NewsfeedItemType = 0
+	NewsfeedItem_TRIP   NewsfeedItem_NewsfeedItemType = 1
+	NewsfeedItem_REVIEW NewsfeedItem_NewsfeedItemType = 2
+	NewsfeedItem_REPOST NewsfeedItem_NewsfeedItemType = 3
+)
+
+// Enum value maps for NewsfeedItem_NewsfeedItemType.
+var (
+	NewsfeedItem_NewsfeedItemType_name = map[int32]string{
+		0: "POST",
+		1: "TRIP",
+		2: "REVIEW",
+		3: "REPOST",
+	}
+	NewsfeedItem_NewsfeedItemType_value = map[string]int32{
+		"POST":   0,
+		"TRIP":   1,
+		"REVIEW": 2,
+		"REPOST": 3,
+	}
+)
+
+func (x NewsfeedItem_NewsfeedItemType) Enum() *NewsfeedItem_NewsfeedItemType {
+	p := new(NewsfeedItem_NewsfeedItemType)
+	*p = x
+	return p
+}
+
+func (x NewsfeedItem_NewsfeedItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NewsfeedItem_NewsfeedItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_proto_enumTypes[1].Descriptor()
+}
+
+func (NewsfeedItem_NewsfeedItemType) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_proto_enumTypes[1]
+}
+
+func (x NewsfeedItem_NewsfeedItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NewsfeedItem_NewsfeedItemType.Descriptor instead.
+func (NewsfeedItem_NewsfeedItemType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type InvalidateCacheResponse_InvalidateCacheStatus int32
 
 const (
@@ -96,11 +148,11 @@ func (x InvalidateCacheResponse_InvalidateCacheStatus) String() string {
 }
 
 func (InvalidateCacheResponse_InvalidateCacheStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_types_proto_newsfeed_proto_enumTypes[1].Descriptor()
+	return file_pkg_types_proto_newsfeed_proto_enumTypes[2].Descriptor()
 }
 
 func (InvalidateCacheResponse_InvalidateCacheStatus) Type() protoreflect.EnumType {
-	return &file_pkg_types_proto_newsfeed_proto_enumTypes[1]
+	return &file_pkg_types_proto_newsfeed_proto_enumTypes[2]
 }
 
 func (x InvalidateCacheResponse_InvalidateCacheStatus) Number() protoreflect.EnumNumber {
@@ -109,7 +161,7 @@ func (x InvalidateCacheResponse_InvalidateCacheStatus) Number() protoreflect.Enu
 
 // Deprecated: Use InvalidateCacheResponse_InvalidateCacheStatus.Descriptor instead.
 func (InvalidateCacheResponse_InvalidateCacheStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{3, 0}
+	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{4, 0}
 }
 
 type GetNewsfeedRequest struct {
@@ -120,6 +172,8 @@ type GetNewsfeedRequest struct {
 	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Page     int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// offset is the position of the first item to read, it replaces page when set
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
 func (x *GetNewsfeedRequest) Reset() {
@@ -175,16 +229,25 @@ func (x *GetNewsfeedRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *GetNewsfeedRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type GetNewsfeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      GetNewsfeedResponse_GetNewsfeedStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed.GetNewsfeedResponse_GetNewsfeedStatus" json:"status,omitempty"`
-	PostsIds    []int64                               `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
-	TotalPages  int32                                 `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	CurrentPage int32                                 `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	TotalItems  int32                                 `protobuf:"varint,5,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	Status GetNewsfeedResponse_GetNewsfeedStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed.GetNewsfeedResponse_GetNewsfeedStatus" json:"status,omitempty"`
+	// posts_ids are the posts of the page, items also contain the other kinds of feed items
+	PostsIds    []int64         `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	TotalPages  int32           `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	CurrentPage int32           `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalItems  int32           `protobuf:"varint,5,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	Items       []*NewsfeedItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
 }
 
 func (x *GetNewsfeedResponse) Reset() {
@@ -254,6 +317,68 @@ func (x *GetNewsfeedResponse) GetTotalItems() int32 {
 	return 0
 }
 
+func (x *GetNewsfeedResponse) GetItems() []*NewsfeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type NewsfeedItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type NewsfeedItem_NewsfeedItemType `protobuf:"varint,1,opt,name=type,proto3,enum=newsfeed.NewsfeedItem_NewsfeedItemType" json:"type,omitempty"`
+	Id   int64                         `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NewsfeedItem) Reset() {
+	*x = NewsfeedItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewsfeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsfeedItem) ProtoMessage() {}
+
+func (x *NewsfeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsfeedItem.ProtoReflect.Descriptor instead.
+func (*NewsfeedItem) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NewsfeedItem) GetType() NewsfeedItem_NewsfeedItemType {
+	if x != nil {
+		return x.Type
+	}
+	return NewsfeedItem_POST
+}
+
+func (x *NewsfeedItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type InvalidateCacheRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -266,7 +391,7 @@ type InvalidateCacheRequest struct {
 func (x *InvalidateCacheRequest) Reset() {
 	*x = InvalidateCacheRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[2]
+		mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -279,7 +404,7 @@ func (x *InvalidateCacheRequest) String() string {
 func (*InvalidateCacheRequest) ProtoMessage() {}
 
 func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[2]
+	mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +417,7 @@ func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateCacheRequest.ProtoReflect.Descriptor instead.
 func (*InvalidateCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{2}
+	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InvalidateCacheRequest) GetPostId() int64 {
@@ -320,7 +445,7 @@ type InvalidateCacheResponse struct {
 func (x *InvalidateCacheResponse) Reset() {
 	*x = InvalidateCacheResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[3]
+		mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +458,7 @@ func (x *InvalidateCacheResponse) String() string {
 func (*InvalidateCacheResponse) ProtoMessage() {}
 
 func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[3]
+	mi := &file_pkg_types_proto_newsfeed_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +471,7 @@ func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateCacheResponse.ProtoReflect.Descriptor instead.
 func (*InvalidateCacheResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{3}
+	return file_pkg_types_proto_newsfeed_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InvalidateCacheResponse) GetStatus() InvalidateCacheResponse_InvalidateCacheStatus {
@@ -361,62 +486,77 @@ var File_pkg_types_proto_newsfeed_proto protoreflect.FileDescriptor
 var file_pkg_types_proto_newsfeed_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x12, 0x47, 0x65,
+	0x12, 0x08, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65,
 	0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
 	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2f, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x45,
-	0x57, 0x53, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01, 0x22, 0x50,
-	0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x22, 0x96, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6e,
-	0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a,
-	0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x32, 0xb2, 0x01, 0x0a, 0x08, 0x4e, 0x65,
-	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77,
-	0x73, 0x66, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65,
-	0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x77, 0x73,
-	0x66, 0x65, 0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x56,
-	0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x61,
-	0x6e, 0x67, 0x4e, 0x67, 0x75, 0x79, 0x65, 0x6e, 0x44, 0x65, 0x76, 0x33, 0x2f, 0x57, 0x61, 0x6e,
-	0x64, 0x65, 0x72, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x3b, 0x6e, 0x65,
-	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6e, 0x65, 0x77,
+	0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77,
+	0x73, 0x66, 0x65, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e,
+	0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x45, 0x57, 0x53, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x45, 0x4d, 0x50,
+	0x54, 0x59, 0x10, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x4e,
+	0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4e, 0x65, 0x77, 0x73,
+	0x66, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x49, 0x50, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x54,
+	0x10, 0x03, 0x22, 0x50, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x37, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x2a, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x32, 0xb2, 0x01,
+	0x0a, 0x08, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x77, 0x73,
+	0x66, 0x65, 0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65,
+	0x65, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65,
+	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x6f, 0x61, 0x6e, 0x67, 0x4e, 0x67, 0x75, 0x79, 0x65, 0x6e, 0x44, 0x65, 0x76, 0x33,
+	0x2f, 0x57, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65,
+	0x64, 0x3b, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -431,28 +571,32 @@ func file_pkg_types_proto_newsfeed_proto_rawDescGZIP() []byte {
 	return file_pkg_types_proto_newsfeed_proto_rawDescData
 }
 
-var file_pkg_types_proto_newsfeed_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_types_proto_newsfeed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_types_proto_newsfeed_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_types_proto_newsfeed_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_types_proto_newsfeed_proto_goTypes = []interface{}{
 	(GetNewsfeedResponse_GetNewsfeedStatus)(0),         // 0: newsfeed.GetNewsfeedResponse.GetNewsfeedStatus
-	(InvalidateCacheResponse_InvalidateCacheStatus)(0), // 1: newsfeed.InvalidateCacheResponse.InvalidateCacheStatus
-	(*GetNewsfeedRequest)(nil),                         // 2: newsfeed.GetNewsfeedRequest
-	(*GetNewsfeedResponse)(nil),                        // 3: newsfeed.GetNewsfeedResponse
-	(*InvalidateCacheRequest)(nil),                     // 4: newsfeed.InvalidateCacheRequest
-	(*InvalidateCacheResponse)(nil),                    // 5: newsfeed.InvalidateCacheResponse
+	(NewsfeedItem_NewsfeedItemType)(0),                 // 1: newsfeed.NewsfeedItem.NewsfeedItemType
+	(InvalidateCacheResponse_InvalidateCacheStatus)(0), // 2: newsfeed.InvalidateCacheResponse.InvalidateCacheStatus
+	(*GetNewsfeedRequest)(nil),                         // 3: newsfeed.GetNewsfeedRequest
+	(*GetNewsfeedResponse)(nil),                        // 4: newsfeed.GetNewsfeedResponse
+	(*NewsfeedItem)(nil),                               // 5: newsfeed.NewsfeedItem
+	(*InvalidateCacheRequest)(nil),                     // 6: newsfeed.InvalidateCacheRequest
+	(*InvalidateCacheResponse)(nil),                    // 7: newsfeed.InvalidateCacheResponse
 }
 var file_pkg_types_proto_newsfeed_proto_depIdxs = []int32{
 	0, // 0: newsfeed.GetNewsfeedResponse.status:type_name -> newsfeed.GetNewsfeedResponse.GetNewsfeedStatus
-	1, // 1: newsfeed.InvalidateCacheResponse.status:type_name -> newsfeed.InvalidateCacheResponse.InvalidateCacheStatus
-	2, // 2: newsfeed.Newsfeed.GetNewsfeed:input_type -> newsfeed.GetNewsfeedRequest
-	4, // 3: newsfeed.Newsfeed.InvalidateCache:input_type -> newsfeed.InvalidateCacheRequest
-	3, // 4: newsfeed.Newsfeed.GetNewsfeed:output_type -> newsfeed.GetNewsfeedResponse
-	5, // 5: newsfeed.Newsfeed.InvalidateCache:output_type -> newsfeed.InvalidateCacheResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: newsfeed.GetNewsfeedResponse.items:type_name -> newsfeed.NewsfeedItem
+	1, // 2: newsfeed.NewsfeedItem.type:type_name -> newsfeed.NewsfeedItem.NewsfeedItemType
+	2, // 3: newsfeed.InvalidateCacheResponse.status:type_name -> newsfeed.InvalidateCacheResponse.InvalidateCacheStatus
+	3, // 4: newsfeed.Newsfeed.GetNewsfeed:input_type -> newsfeed.GetNewsfeedRequest
+	6, // 5: newsfeed.Newsfeed.InvalidateCache:input_type -> newsfeed.InvalidateCacheRequest
+	4, // 6: newsfeed.Newsfeed.GetNewsfeed:output_type -> newsfeed.GetNewsfeedResponse
+	7, // 7: newsfeed.Newsfeed.InvalidateCache:output_type -> newsfeed.InvalidateCacheResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_pkg_types_proto_newsfeed_proto_init() }
@@ -486,7 +630,7 @@ func file_pkg_types_proto_newsfeed_proto_init() {
 			}
 		}
 		file_pkg_types_proto_newsfeed_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvalidateCacheRequest); i {
+			switch v := v.(*NewsfeedItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -498,6 +642,18 @@ func file_pkg_types_proto_newsfeed_proto_init() {
 			}
 		}
 		file_pkg_types_proto_newsfeed_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InvalidateCacheResponse); i {
 			case 0:
 				return &v.state
@@ -515,8 +671,8 @@ func file_pkg_types_proto_newsfeed_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_types_proto_newsfeed_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
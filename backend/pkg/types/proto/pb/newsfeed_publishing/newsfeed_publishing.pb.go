@@ -66,32 +66,1064 @@ func (PublishPostResponse_PublishPostResponseStatus) EnumDescriptor() ([]byte, [
 	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type PublishMentionResponse_PublishMentionResponseStatus int32
+
+const (
+	PublishMentionResponse_OK     PublishMentionResponse_PublishMentionResponseStatus = 0
+	PublishMentionResponse_FAILED PublishMentionResponse_PublishMentionResponseStatus = 1
+)
+
+// Enum value maps for PublishMentionResponse_PublishMentionResponseStatus.
+var (
+	PublishMentionResponse_PublishMentionResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishMentionResponse_PublishMentionResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishMentionResponse_PublishMentionResponseStatus) Enum() *PublishMentionResponse_PublishMentionResponseStatus {
+	p := new(PublishMentionResponse_PublishMentionResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishMentionResponse_PublishMentionResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishMentionResponse_PublishMentionResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[1].Descriptor()
+}
+
+func (PublishMentionResponse_PublishMentionResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[1]
+}
+
+func (x PublishMentionResponse_PublishMentionResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishMentionResponse_PublishMentionResponseStatus.Descriptor instead.
+func (PublishMentionResponse_PublishMentionResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type PublishTripResponse_PublishTripResponseStatus int32
+
+const (
+	PublishTripResponse_OK     PublishTripResponse_PublishTripResponseStatus = 0
+	PublishTripResponse_FAILED PublishTripResponse_PublishTripResponseStatus = 1
+)
+
+// Enum value maps for PublishTripResponse_PublishTripResponseStatus.
+var (
+	PublishTripResponse_PublishTripResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishTripResponse_PublishTripResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishTripResponse_PublishTripResponseStatus) Enum() *PublishTripResponse_PublishTripResponseStatus {
+	p := new(PublishTripResponse_PublishTripResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishTripResponse_PublishTripResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishTripResponse_PublishTripResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[2].Descriptor()
+}
+
+func (PublishTripResponse_PublishTripResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[2]
+}
+
+func (x PublishTripResponse_PublishTripResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishTripResponse_PublishTripResponseStatus.Descriptor instead.
+func (PublishTripResponse_PublishTripResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type PublishTripInvitationRequest_TripInvitationEvent int32
+
+const (
+	PublishTripInvitationRequest_INVITED  PublishTripInvitationRequest_TripInvitationEvent = 0
+	PublishTripInvitationRequest_ACCEPTED PublishTripInvitationRequest_TripInvitationEvent = 1
+)
+
+// Enum value maps for PublishTripInvitationRequest_TripInvitationEvent.
+var (
+	PublishTripInvitationRequest_TripInvitationEvent_name = map[int32]string{
+		0: "INVITED",
+		1: "ACCEPTED",
+	}
+	PublishTripInvitationRequest_TripInvitationEvent_value = map[string]int32{
+		"INVITED":  0,
+		"ACCEPTED": 1,
+	}
+)
+
+func (x PublishTripInvitationRequest_TripInvitationEvent) Enum() *PublishTripInvitationRequest_TripInvitationEvent {
+	p := new(PublishTripInvitationRequest_TripInvitationEvent)
+	*p = x
+	return p
+}
+
+func (x PublishTripInvitationRequest_TripInvitationEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishTripInvitationRequest_TripInvitationEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[3].Descriptor()
+}
+
+func (PublishTripInvitationRequest_TripInvitationEvent) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[3]
+}
+
+func (x PublishTripInvitationRequest_TripInvitationEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishTripInvitationRequest_TripInvitationEvent.Descriptor instead.
+func (PublishTripInvitationRequest_TripInvitationEvent) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type PublishTripInvitationResponse_PublishTripInvitationResponseStatus int32
+
+const (
+	PublishTripInvitationResponse_OK     PublishTripInvitationResponse_PublishTripInvitationResponseStatus = 0
+	PublishTripInvitationResponse_FAILED PublishTripInvitationResponse_PublishTripInvitationResponseStatus = 1
+)
+
+// Enum value maps for PublishTripInvitationResponse_PublishTripInvitationResponseStatus.
+var (
+	PublishTripInvitationResponse_PublishTripInvitationResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishTripInvitationResponse_PublishTripInvitationResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishTripInvitationResponse_PublishTripInvitationResponseStatus) Enum() *PublishTripInvitationResponse_PublishTripInvitationResponseStatus {
+	p := new(PublishTripInvitationResponse_PublishTripInvitationResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishTripInvitationResponse_PublishTripInvitationResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishTripInvitationResponse_PublishTripInvitationResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[4].Descriptor()
+}
+
+func (PublishTripInvitationResponse_PublishTripInvitationResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[4]
+}
+
+func (x PublishTripInvitationResponse_PublishTripInvitationResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishTripInvitationResponse_PublishTripInvitationResponseStatus.Descriptor instead.
+func (PublishTripInvitationResponse_PublishTripInvitationResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type PublishReviewResponse_PublishReviewResponseStatus int32
+
+const (
+	PublishReviewResponse_OK     PublishReviewResponse_PublishReviewResponseStatus = 0
+	PublishReviewResponse_FAILED PublishReviewResponse_PublishReviewResponseStatus = 1
+)
+
+// Enum value maps for PublishReviewResponse_PublishReviewResponseStatus.
+var (
+	PublishReviewResponse_PublishReviewResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishReviewResponse_PublishReviewResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishReviewResponse_PublishReviewResponseStatus) Enum() *PublishReviewResponse_PublishReviewResponseStatus {
+	p := new(PublishReviewResponse_PublishReviewResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishReviewResponse_PublishReviewResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishReviewResponse_PublishReviewResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[5].Descriptor()
+}
+
+func (PublishReviewResponse_PublishReviewResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[5]
+}
+
+func (x PublishReviewResponse_PublishReviewResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishReviewResponse_PublishReviewResponseStatus.Descriptor instead.
+func (PublishReviewResponse_PublishReviewResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type PublishRepostResponse_PublishRepostResponseStatus int32
+
+const (
+	PublishRepostResponse_OK     PublishRepostResponse_PublishRepostResponseStatus = 0
+	PublishRepostResponse_FAILED PublishRepostResponse_PublishRepostResponseStatus = 1
+)
+
+// Enum value maps for PublishRepostResponse_PublishRepostResponseStatus.
+var (
+	PublishRepostResponse_PublishRepostResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishRepostResponse_PublishRepostResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishRepostResponse_PublishRepostResponseStatus) Enum() *PublishRepostResponse_PublishRepostResponseStatus {
+	p := new(PublishRepostResponse_PublishRepostResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishRepostResponse_PublishRepostResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishRepostResponse_PublishRepostResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[6].Descriptor()
+}
+
+func (PublishRepostResponse_PublishRepostResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[6]
+}
+
+func (x PublishRepostResponse_PublishRepostResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishRepostResponse_PublishRepostResponseStatus.Descriptor instead.
+func (PublishRepostResponse_PublishRepostResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type UnpublishRepostResponse_UnpublishRepostResponseStatus int32
+
+const (
+	UnpublishRepostResponse_OK     UnpublishRepostResponse_UnpublishRepostResponseStatus = 0
+	UnpublishRepostResponse_FAILED UnpublishRepostResponse_UnpublishRepostResponseStatus = 1
+)
+
+// Enum value maps for UnpublishRepostResponse_UnpublishRepostResponseStatus.
+var (
+	UnpublishRepostResponse_UnpublishRepostResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	UnpublishRepostResponse_UnpublishRepostResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x UnpublishRepostResponse_UnpublishRepostResponseStatus) Enum() *UnpublishRepostResponse_UnpublishRepostResponseStatus {
+	p := new(UnpublishRepostResponse_UnpublishRepostResponseStatus)
+	*p = x
+	return p
+}
+
+func (x UnpublishRepostResponse_UnpublishRepostResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnpublishRepostResponse_UnpublishRepostResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[7].Descriptor()
+}
+
+func (UnpublishRepostResponse_UnpublishRepostResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[7]
+}
+
+func (x UnpublishRepostResponse_UnpublishRepostResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnpublishRepostResponse_UnpublishRepostResponseStatus.Descriptor instead.
+func (UnpublishRepostResponse_UnpublishRepostResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type PublishStoryResponse_PublishStoryResponseStatus int32
+
+const (
+	PublishStoryResponse_OK     PublishStoryResponse_PublishStoryResponseStatus = 0
+	PublishStoryResponse_FAILED PublishStoryResponse_PublishStoryResponseStatus = 1
+)
+
+// Enum value maps for PublishStoryResponse_PublishStoryResponseStatus.
+var (
+	PublishStoryResponse_PublishStoryResponseStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAILED",
+	}
+	PublishStoryResponse_PublishStoryResponseStatus_value = map[string]int32{
+		"OK":     0,
+		"FAILED": 1,
+	}
+)
+
+func (x PublishStoryResponse_PublishStoryResponseStatus) Enum() *PublishStoryResponse_PublishStoryResponseStatus {
+	p := new(PublishStoryResponse_PublishStoryResponseStatus)
+	*p = x
+	return p
+}
+
+func (x PublishStoryResponse_PublishStoryResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishStoryResponse_PublishStoryResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[8].Descriptor()
+}
+
+func (PublishStoryResponse_PublishStoryResponseStatus) Type() protoreflect.EnumType {
+	return &file_pkg_types_proto_newsfeed_publishing_proto_enumTypes[8]
+}
+
+func (x PublishStoryResponse_PublishStoryResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishStoryResponse_PublishStoryResponseStatus.Descriptor instead.
+func (PublishStoryResponse_PublishStoryResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{15, 0}
+}
+
 type PublishPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PublishPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PublishPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishPostResponse_PublishPostResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishPostResponse_PublishPostResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishPostResponse) Reset() {
+	*x = PublishPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostResponse) ProtoMessage() {}
+
+func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
+func (*PublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishPostResponse) GetStatus() PublishPostResponse_PublishPostResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishPostResponse_OK
+}
+
+// PublishMention notifies users that they were mentioned in a post or a comment
+type PublishMentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the author of the post or comment
+	UserId           int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MentionedUserIds []int64 `protobuf:"varint,2,rep,packed,name=mentioned_user_ids,json=mentionedUserIds,proto3" json:"mentioned_user_ids,omitempty"`
+	PostId           int64   `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// comment_id is 0 when the mention is in the post itself
+	CommentId int64 `protobuf:"varint,4,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *PublishMentionRequest) Reset() {
+	*x = PublishMentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishMentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishMentionRequest) ProtoMessage() {}
+
+func (x *PublishMentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishMentionRequest.ProtoReflect.Descriptor instead.
+func (*PublishMentionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublishMentionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishMentionRequest) GetMentionedUserIds() []int64 {
+	if x != nil {
+		return x.MentionedUserIds
+	}
+	return nil
+}
+
+func (x *PublishMentionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PublishMentionRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type PublishMentionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishMentionResponse_PublishMentionResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishMentionResponse_PublishMentionResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishMentionResponse) Reset() {
+	*x = PublishMentionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishMentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishMentionResponse) ProtoMessage() {}
+
+func (x *PublishMentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishMentionResponse.ProtoReflect.Descriptor instead.
+func (*PublishMentionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PublishMentionResponse) GetStatus() PublishMentionResponse_PublishMentionResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishMentionResponse_OK
+}
+
+// PublishTrip adds a newly published trip to the newsfeed of the owner's followers
+type PublishTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId int64 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+}
+
+func (x *PublishTripRequest) Reset() {
+	*x = PublishTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripRequest) ProtoMessage() {}
+
+func (x *PublishTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripRequest.ProtoReflect.Descriptor instead.
+func (*PublishTripRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PublishTripRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishTripRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type PublishTripResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishTripResponse_PublishTripResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishTripResponse_PublishTripResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishTripResponse) Reset() {
+	*x = PublishTripResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripResponse) ProtoMessage() {}
+
+func (x *PublishTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripResponse.ProtoReflect.Descriptor instead.
+func (*PublishTripResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PublishTripResponse) GetStatus() PublishTripResponse_PublishTripResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishTripResponse_OK
+}
+
+// PublishTripInvitation notifies the invitee of an invitation to a trip, or the owner of an accepted invitation
+type PublishTripInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event PublishTripInvitationRequest_TripInvitationEvent `protobuf:"varint,1,opt,name=event,proto3,enum=newsfeed_publishing.PublishTripInvitationRequest_TripInvitationEvent" json:"event,omitempty"`
+	// user_id is the user who invited or accepted
+	UserId      int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecipientId int64 `protobuf:"varint,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	TripId      int64 `protobuf:"varint,4,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+}
+
+func (x *PublishTripInvitationRequest) Reset() {
+	*x = PublishTripInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripInvitationRequest) ProtoMessage() {}
+
+func (x *PublishTripInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripInvitationRequest.ProtoReflect.Descriptor instead.
+func (*PublishTripInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PublishTripInvitationRequest) GetEvent() PublishTripInvitationRequest_TripInvitationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return PublishTripInvitationRequest_INVITED
+}
+
+func (x *PublishTripInvitationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishTripInvitationRequest) GetRecipientId() int64 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *PublishTripInvitationRequest) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type PublishTripInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishTripInvitationResponse_PublishTripInvitationResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishTripInvitationResponse_PublishTripInvitationResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishTripInvitationResponse) Reset() {
+	*x = PublishTripInvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTripInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTripInvitationResponse) ProtoMessage() {}
+
+func (x *PublishTripInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTripInvitationResponse.ProtoReflect.Descriptor instead.
+func (*PublishTripInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PublishTripInvitationResponse) GetStatus() PublishTripInvitationResponse_PublishTripInvitationResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishTripInvitationResponse_OK
+}
+
+// PublishReview adds a place review to the newsfeeds of the reviewer's followers
+type PublishReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewId int64 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+}
+
+func (x *PublishReviewRequest) Reset() {
+	*x = PublishReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishReviewRequest) ProtoMessage() {}
+
+func (x *PublishReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishReviewRequest.ProtoReflect.Descriptor instead.
+func (*PublishReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PublishReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type PublishReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishReviewResponse_PublishReviewResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishReviewResponse_PublishReviewResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishReviewResponse) Reset() {
+	*x = PublishReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishReviewResponse) ProtoMessage() {}
+
+func (x *PublishReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishReviewResponse.ProtoReflect.Descriptor instead.
+func (*PublishReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PublishReviewResponse) GetStatus() PublishReviewResponse_PublishReviewResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishReviewResponse_OK
+}
+
+// PublishRepost adds a repost to the newsfeeds of the reposter's followers,
+// skipping the followers whose newsfeed already has the original post or a repost of it
+type PublishRepostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RepostId       int64 `protobuf:"varint,2,opt,name=repost_id,json=repostId,proto3" json:"repost_id,omitempty"`
+	OriginalPostId int64 `protobuf:"varint,3,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
+}
+
+func (x *PublishRepostRequest) Reset() {
+	*x = PublishRepostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishRepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishRepostRequest) ProtoMessage() {}
+
+func (x *PublishRepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishRepostRequest.ProtoReflect.Descriptor instead.
+func (*PublishRepostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PublishRepostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishRepostRequest) GetRepostId() int64 {
+	if x != nil {
+		return x.RepostId
+	}
+	return 0
+}
+
+func (x *PublishRepostRequest) GetOriginalPostId() int64 {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return 0
+}
+
+type PublishRepostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishRepostResponse_PublishRepostResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishRepostResponse_PublishRepostResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishRepostResponse) Reset() {
+	*x = PublishRepostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishRepostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishRepostResponse) ProtoMessage() {}
+
+func (x *PublishRepostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishRepostResponse.ProtoReflect.Descriptor instead.
+func (*PublishRepostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublishRepostResponse) GetStatus() PublishRepostResponse_PublishRepostResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishRepostResponse_OK
+}
+
+// UnpublishRepost forgets that a repost brought its original post to the newsfeeds of the reposter's
+// followers, once it is undone or trashed, so the post can be reposted to them again
+type UnpublishRepostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RepostId       int64 `protobuf:"varint,2,opt,name=repost_id,json=repostId,proto3" json:"repost_id,omitempty"`
+	OriginalPostId int64 `protobuf:"varint,3,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
 }
 
-func (x *PublishPostRequest) Reset() {
-	*x = PublishPostRequest{}
+func (x *UnpublishRepostRequest) Reset() {
+	*x = UnpublishRepostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[0]
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostRequest) String() string {
+func (x *UnpublishRepostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostRequest) ProtoMessage() {}
+func (*UnpublishRepostRequest) ProtoMessage() {}
 
-func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[0]
+func (x *UnpublishRepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -102,50 +1134,57 @@ func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
-func (*PublishPostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UnpublishRepostRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishRepostRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PublishPostRequest) GetUserId() int64 {
+func (x *UnpublishRepostRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *PublishPostRequest) GetPostId() int64 {
+func (x *UnpublishRepostRequest) GetRepostId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.RepostId
 	}
 	return 0
 }
 
-type PublishPostResponse struct {
+func (x *UnpublishRepostRequest) GetOriginalPostId() int64 {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return 0
+}
+
+type UnpublishRepostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status PublishPostResponse_PublishPostResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishPostResponse_PublishPostResponseStatus" json:"status,omitempty"`
+	Status UnpublishRepostResponse_UnpublishRepostResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.UnpublishRepostResponse_UnpublishRepostResponseStatus" json:"status,omitempty"`
 }
 
-func (x *PublishPostResponse) Reset() {
-	*x = PublishPostResponse{}
+func (x *UnpublishRepostResponse) Reset() {
+	*x = UnpublishRepostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[1]
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostResponse) String() string {
+func (x *UnpublishRepostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostResponse) ProtoMessage() {}
+func (*UnpublishRepostResponse) ProtoMessage() {}
 
-func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[1]
+func (x *UnpublishRepostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,16 +1195,128 @@ func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
-func (*PublishPostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UnpublishRepostResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishRepostResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PublishPostResponse) GetStatus() PublishPostResponse_PublishPostResponseStatus {
+func (x *UnpublishRepostResponse) GetStatus() UnpublishRepostResponse_UnpublishRepostResponseStatus {
 	if x != nil {
 		return x.Status
 	}
-	return PublishPostResponse_OK
+	return UnpublishRepostResponse_OK
+}
+
+// PublishStory adds the author of a new story to the story tray of each of their followers
+type PublishStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	// created_at is a unix time in seconds, it orders the story tray
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PublishStoryRequest) Reset() {
+	*x = PublishStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishStoryRequest) ProtoMessage() {}
+
+func (x *PublishStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishStoryRequest.ProtoReflect.Descriptor instead.
+func (*PublishStoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PublishStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublishStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *PublishStoryRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type PublishStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PublishStoryResponse_PublishStoryResponseStatus `protobuf:"varint,1,opt,name=status,proto3,enum=newsfeed_publishing.PublishStoryResponse_PublishStoryResponseStatus" json:"status,omitempty"`
+}
+
+func (x *PublishStoryResponse) Reset() {
+	*x = PublishStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishStoryResponse) ProtoMessage() {}
+
+func (x *PublishStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishStoryResponse.ProtoReflect.Descriptor instead.
+func (*PublishStoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PublishStoryResponse) GetStatus() PublishStoryResponse_PublishStoryResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PublishStoryResponse_OK
 }
 
 var File_pkg_types_proto_newsfeed_publishing_proto protoreflect.FileDescriptor
@@ -189,22 +1340,205 @@ var file_pkg_types_proto_newsfeed_publishing_proto_rawDesc = []byte{
 	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x19,
 	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x32, 0x78, 0x0a,
-	0x12, 0x4e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x69, 0x6e, 0x67, 0x12, 0x62, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x27, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x96, 0x01,
+	0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x48, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22,
+	0xa2, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x56, 0x2e, 0x6e, 0x65,
+	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x23, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x4c, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46,
+	0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31,
+	0x0a, 0x1b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x01, 0x22, 0x76, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x78, 0x0a, 0x16, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xb2, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4a, 0x2e, 0x6e,
+	0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x33, 0x0a, 0x1d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x68, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xa6, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66,
+	0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x32, 0xf7, 0x06, 0x0a, 0x12, 0x4e, 0x65, 0x77,
+	0x73, 0x66, 0x65, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x62, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x27,
+	0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x12,
+	0x27, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66,
+	0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x54, 0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x69, 0x70,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54,
+	0x72, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x29, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66,
+	0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x12, 0x29, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75,
 	0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0f, 0x55,
+	0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2b,
+	0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x65,
 	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x6c, 0x5a, 0x6a, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x61, 0x6e, 0x67, 0x4e, 0x67, 0x75, 0x79, 0x65,
-	0x6e, 0x44, 0x65, 0x76, 0x33, 0x2f, 0x57, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x68, 0x65,
-	0x72, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6e, 0x65,
+	0x67, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0c, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x2e, 0x6e, 0x65,
 	0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x3b, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65, 0x64,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x6c, 0x5a, 0x6a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x6f, 0x61, 0x6e, 0x67, 0x4e, 0x67, 0x75, 0x79, 0x65, 0x6e, 0x44, 0x65, 0x76, 0x33,
+	0x2f, 0x57, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x66, 0x65, 0x65,
+	0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3b, 0x6e, 0x65, 0x77,
+	0x73, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -219,22 +1553,66 @@ func file_pkg_types_proto_newsfeed_publishing_proto_rawDescGZIP() []byte {
 	return file_pkg_types_proto_newsfeed_publishing_proto_rawDescData
 }
 
-var file_pkg_types_proto_newsfeed_publishing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_types_proto_newsfeed_publishing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_types_proto_newsfeed_publishing_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_pkg_types_proto_newsfeed_publishing_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_pkg_types_proto_newsfeed_publishing_proto_goTypes = []interface{}{
-	(PublishPostResponse_PublishPostResponseStatus)(0), // 0: newsfeed_publishing.PublishPostResponse.PublishPostResponseStatus
-	(*PublishPostRequest)(nil),                         // 1: newsfeed_publishing.PublishPostRequest
-	(*PublishPostResponse)(nil),                        // 2: newsfeed_publishing.PublishPostResponse
+	(PublishPostResponse_PublishPostResponseStatus)(0),                     // 0: newsfeed_publishing.PublishPostResponse.PublishPostResponseStatus
+	(PublishMentionResponse_PublishMentionResponseStatus)(0),               // 1: newsfeed_publishing.PublishMentionResponse.PublishMentionResponseStatus
+	(PublishTripResponse_PublishTripResponseStatus)(0),                     // 2: newsfeed_publishing.PublishTripResponse.PublishTripResponseStatus
+	(PublishTripInvitationRequest_TripInvitationEvent)(0),                  // 3: newsfeed_publishing.PublishTripInvitationRequest.TripInvitationEvent
+	(PublishTripInvitationResponse_PublishTripInvitationResponseStatus)(0), // 4: newsfeed_publishing.PublishTripInvitationResponse.PublishTripInvitationResponseStatus
+	(PublishReviewResponse_PublishReviewResponseStatus)(0),                 // 5: newsfeed_publishing.PublishReviewResponse.PublishReviewResponseStatus
+	(PublishRepostResponse_PublishRepostResponseStatus)(0),                 // 6: newsfeed_publishing.PublishRepostResponse.PublishRepostResponseStatus
+	(UnpublishRepostResponse_UnpublishRepostResponseStatus)(0),             // 7: newsfeed_publishing.UnpublishRepostResponse.UnpublishRepostResponseStatus
+	(PublishStoryResponse_PublishStoryResponseStatus)(0),                   // 8: newsfeed_publishing.PublishStoryResponse.PublishStoryResponseStatus
+	(*PublishPostRequest)(nil),                                             // 9: newsfeed_publishing.PublishPostRequest
+	(*PublishPostResponse)(nil),                                            // 10: newsfeed_publishing.PublishPostResponse
+	(*PublishMentionRequest)(nil),                                          // 11: newsfeed_publishing.PublishMentionRequest
+	(*PublishMentionResponse)(nil),                                         // 12: newsfeed_publishing.PublishMentionResponse
+	(*PublishTripRequest)(nil),                                             // 13: newsfeed_publishing.PublishTripRequest
+	(*PublishTripResponse)(nil),                                            // 14: newsfeed_publishing.PublishTripResponse
+	(*PublishTripInvitationRequest)(nil),                                   // 15: newsfeed_publishing.PublishTripInvitationRequest
+	(*PublishTripInvitationResponse)(nil),                                  // 16: newsfeed_publishing.PublishTripInvitationResponse
+	(*PublishReviewRequest)(nil),                                           // 17: newsfeed_publishing.PublishReviewRequest
+	(*PublishReviewResponse)(nil),                                          // 18: newsfeed_publishing.PublishReviewResponse
+	(*PublishRepostRequest)(nil),                                           // 19: newsfeed_publishing.PublishRepostRequest
+	(*PublishRepostResponse)(nil),                                          // 20: newsfeed_publishing.PublishRepostResponse
+	(*UnpublishRepostRequest)(nil),                                         // 21: newsfeed_publishing.UnpublishRepostRequest
+	(*UnpublishRepostResponse)(nil),                                        // 22: newsfeed_publishing.UnpublishRepostResponse
+	(*PublishStoryRequest)(nil),                                            // 23: newsfeed_publishing.PublishStoryRequest
+	(*PublishStoryResponse)(nil),                                           // 24: newsfeed_publishing.PublishStoryResponse
 }
 var file_pkg_types_proto_newsfeed_publishing_proto_depIdxs = []int32{
-	0, // 0: newsfeed_publishing.PublishPostResponse.status:type_name -> newsfeed_publishing.PublishPostResponse.PublishPostResponseStatus
-	1, // 1: newsfeed_publishing.NewsfeedPublishing.PublishPost:input_type -> newsfeed_publishing.PublishPostRequest
-	2, // 2: newsfeed_publishing.NewsfeedPublishing.PublishPost:output_type -> newsfeed_publishing.PublishPostResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: newsfeed_publishing.PublishPostResponse.status:type_name -> newsfeed_publishing.PublishPostResponse.PublishPostResponseStatus
+	1,  // 1: newsfeed_publishing.PublishMentionResponse.status:type_name -> newsfeed_publishing.PublishMentionResponse.PublishMentionResponseStatus
+	2,  // 2: newsfeed_publishing.PublishTripResponse.status:type_name -> newsfeed_publishing.PublishTripResponse.PublishTripResponseStatus
+	3,  // 3: newsfeed_publishing.PublishTripInvitationRequest.event:type_name -> newsfeed_publishing.PublishTripInvitationRequest.TripInvitationEvent
+	4,  // 4: newsfeed_publishing.PublishTripInvitationResponse.status:type_name -> newsfeed_publishing.PublishTripInvitationResponse.PublishTripInvitationResponseStatus
+	5,  // 5: newsfeed_publishing.PublishReviewResponse.status:type_name -> newsfeed_publishing.PublishReviewResponse.PublishReviewResponseStatus
+	6,  // 6: newsfeed_publishing.PublishRepostResponse.status:type_name -> newsfeed_publishing.PublishRepostResponse.PublishRepostResponseStatus
+	7,  // 7: newsfeed_publishing.UnpublishRepostResponse.status:type_name -> newsfeed_publishing.UnpublishRepostResponse.UnpublishRepostResponseStatus
+	8,  // 8: newsfeed_publishing.PublishStoryResponse.status:type_name -> newsfeed_publishing.PublishStoryResponse.PublishStoryResponseStatus
+	9,  // 9: newsfeed_publishing.NewsfeedPublishing.PublishPost:input_type -> newsfeed_publishing.PublishPostRequest
+	11, // 10: newsfeed_publishing.NewsfeedPublishing.PublishMention:input_type -> newsfeed_publishing.PublishMentionRequest
+	13, // 11: newsfeed_publishing.NewsfeedPublishing.PublishTrip:input_type -> newsfeed_publishing.PublishTripRequest
+	15, // 12: newsfeed_publishing.NewsfeedPublishing.PublishTripInvitation:input_type -> newsfeed_publishing.PublishTripInvitationRequest
+	17, // 13: newsfeed_publishing.NewsfeedPublishing.PublishReview:input_type -> newsfeed_publishing.PublishReviewRequest
+	19, // 14: newsfeed_publishing.NewsfeedPublishing.PublishRepost:input_type -> newsfeed_publishing.PublishRepostRequest
+	21, // 15: newsfeed_publishing.NewsfeedPublishing.UnpublishRepost:input_type -> newsfeed_publishing.UnpublishRepostRequest
+	23, // 16: newsfeed_publishing.NewsfeedPublishing.PublishStory:input_type -> newsfeed_publishing.PublishStoryRequest
+	10, // 17: newsfeed_publishing.NewsfeedPublishing.PublishPost:output_type -> newsfeed_publishing.PublishPostResponse
+	12, // 18: newsfeed_publishing.NewsfeedPublishing.PublishMention:output_type -> newsfeed_publishing.PublishMentionResponse
+	14, // 19: newsfeed_publishing.NewsfeedPublishing.PublishTrip:output_type -> newsfeed_publishing.PublishTripResponse
+	16, // 20: newsfeed_publishing.NewsfeedPublishing.PublishTripInvitation:output_type -> newsfeed_publishing.PublishTripInvitationResponse
+	18, // 21: newsfeed_publishing.NewsfeedPublishing.PublishReview:output_type -> newsfeed_publishing.PublishReviewResponse
+	20, // 22: newsfeed_publishing.NewsfeedPublishing.PublishRepost:output_type -> newsfeed_publishing.PublishRepostResponse
+	22, // 23: newsfeed_publishing.NewsfeedPublishing.UnpublishRepost:output_type -> newsfeed_publishing.UnpublishRepostResponse
+	24, // 24: newsfeed_publishing.NewsfeedPublishing.PublishStory:output_type -> newsfeed_publishing.PublishStoryResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_types_proto_newsfeed_publishing_proto_init() }
@@ -267,14 +1645,182 @@ func file_pkg_types_proto_newsfeed_publishing_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishMentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishMentionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTripInvitationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishRepostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishRepostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishRepostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishRepostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_types_proto_newsfeed_publishing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_types_proto_newsfeed_publishing_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,13 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NewsfeedPublishingClient interface {
 	PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error)
+	PublishMention(ctx context.Context, in *PublishMentionRequest, opts ...grpc.CallOption) (*PublishMentionResponse, error)
+	PublishTrip(ctx context.Context, in *PublishTripRequest, opts ...grpc.CallOption) (*PublishTripResponse, error)
+	PublishTripInvitation(ctx context.Context, in *PublishTripInvitationRequest, opts ...grpc.CallOption) (*PublishTripInvitationResponse, error)
+	PublishReview(ctx context.Context, in *PublishReviewRequest, opts ...grpc.CallOption) (*PublishReviewResponse, error)
+	PublishRepost(ctx context.Context, in *PublishRepostRequest, opts ...grpc.CallOption) (*PublishRepostResponse, error)
+	UnpublishRepost(ctx context.Context, in *UnpublishRepostRequest, opts ...grpc.CallOption) (*UnpublishRepostResponse, error)
+	PublishStory(ctx context.Context, in *PublishStoryRequest, opts ...grpc.CallOption) (*PublishStoryResponse, error)
 }
 
 type newsfeedPublishingClient struct {
@@ -42,11 +49,81 @@ func (c *newsfeedPublishingClient) PublishPost(ctx context.Context, in *PublishP
 	return out, nil
 }
 
+func (c *newsfeedPublishingClient) PublishMention(ctx context.Context, in *PublishMentionRequest, opts ...grpc.CallOption) (*PublishMentionResponse, error) {
+	out := new(PublishMentionResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishMention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) PublishTrip(ctx context.Context, in *PublishTripRequest, opts ...grpc.CallOption) (*PublishTripResponse, error) {
+	out := new(PublishTripResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) PublishTripInvitation(ctx context.Context, in *PublishTripInvitationRequest, opts ...grpc.CallOption) (*PublishTripInvitationResponse, error) {
+	out := new(PublishTripInvitationResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishTripInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) PublishReview(ctx context.Context, in *PublishReviewRequest, opts ...grpc.CallOption) (*PublishReviewResponse, error) {
+	out := new(PublishReviewResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) PublishRepost(ctx context.Context, in *PublishRepostRequest, opts ...grpc.CallOption) (*PublishRepostResponse, error) {
+	out := new(PublishRepostResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishRepost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) UnpublishRepost(ctx context.Context, in *UnpublishRepostRequest, opts ...grpc.CallOption) (*UnpublishRepostResponse, error) {
+	out := new(UnpublishRepostResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/UnpublishRepost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsfeedPublishingClient) PublishStory(ctx context.Context, in *PublishStoryRequest, opts ...grpc.CallOption) (*PublishStoryResponse, error) {
+	out := new(PublishStoryResponse)
+	err := c.cc.Invoke(ctx, "/newsfeed_publishing.NewsfeedPublishing/PublishStory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NewsfeedPublishingServer is the server API for NewsfeedPublishing service.
 // All implementations must embed UnimplementedNewsfeedPublishingServer
 // for forward compatibility
 type NewsfeedPublishingServer interface {
 	PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error)
+	PublishMention(context.Context, *PublishMentionRequest) (*PublishMentionResponse, error)
+	PublishTrip(context.Context, *PublishTripRequest) (*PublishTripResponse, error)
+	PublishTripInvitation(context.Context, *PublishTripInvitationRequest) (*PublishTripInvitationResponse, error)
+	PublishReview(context.Context, *PublishReviewRequest) (*PublishReviewResponse, error)
+	PublishRepost(context.Context, *PublishRepostRequest) (*PublishRepostResponse, error)
+	UnpublishRepost(context.Context, *UnpublishRepostRequest) (*UnpublishRepostResponse, error)
+	PublishStory(context.Context, *PublishStoryRequest) (*PublishStoryResponse, error)
 	mustEmbedUnimplementedNewsfeedPublishingServer()
 }
 
@@ -57,6 +134,27 @@ type UnimplementedNewsfeedPublishingServer struct {
 func (UnimplementedNewsfeedPublishingServer) PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
 }
+func (UnimplementedNewsfeedPublishingServer) PublishMention(context.Context, *PublishMentionRequest) (*PublishMentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMention not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) PublishTrip(context.Context, *PublishTripRequest) (*PublishTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTrip not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) PublishTripInvitation(context.Context, *PublishTripInvitationRequest) (*PublishTripInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTripInvitation not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) PublishReview(context.Context, *PublishReviewRequest) (*PublishReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishReview not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) PublishRepost(context.Context, *PublishRepostRequest) (*PublishRepostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishRepost not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) UnpublishRepost(context.Context, *UnpublishRepostRequest) (*UnpublishRepostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishRepost not implemented")
+}
+func (UnimplementedNewsfeedPublishingServer) PublishStory(context.Context, *PublishStoryRequest) (*PublishStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishStory not implemented")
+}
 func (UnimplementedNewsfeedPublishingServer) mustEmbedUnimplementedNewsfeedPublishingServer() {}
 
 // UnsafeNewsfeedPublishingServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +186,132 @@ func _NewsfeedPublishing_PublishPost_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsfeedPublishing_PublishMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishMention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishMention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishMention(ctx, req.(*PublishMentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_PublishTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishTrip(ctx, req.(*PublishTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_PublishTripInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTripInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishTripInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishTripInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishTripInvitation(ctx, req.(*PublishTripInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_PublishReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishReview(ctx, req.(*PublishReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_PublishRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRepostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishRepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishRepost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishRepost(ctx, req.(*PublishRepostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_UnpublishRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishRepostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).UnpublishRepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/UnpublishRepost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).UnpublishRepost(ctx, req.(*UnpublishRepostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsfeedPublishing_PublishStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishStoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsfeedPublishingServer).PublishStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/newsfeed_publishing.NewsfeedPublishing/PublishStory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsfeedPublishingServer).PublishStory(ctx, req.(*PublishStoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NewsfeedPublishing_ServiceDesc is the grpc.ServiceDesc for NewsfeedPublishing service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +323,34 @@ var NewsfeedPublishing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishPost",
 			Handler:    _NewsfeedPublishing_PublishPost_Handler,
 		},
+		{
+			MethodName: "PublishMention",
+			Handler:    _NewsfeedPublishing_PublishMention_Handler,
+		},
+		{
+			MethodName: "PublishTrip",
+			Handler:    _NewsfeedPublishing_PublishTrip_Handler,
+		},
+		{
+			MethodName: "PublishTripInvitation",
+			Handler:    _NewsfeedPublishing_PublishTripInvitation_Handler,
+		},
+		{
+			MethodName: "PublishReview",
+			Handler:    _NewsfeedPublishing_PublishReview_Handler,
+		},
+		{
+			MethodName: "PublishRepost",
+			Handler:    _NewsfeedPublishing_PublishRepost_Handler,
+		},
+		{
+			MethodName: "UnpublishRepost",
+			Handler:    _NewsfeedPublishing_UnpublishRepost_Handler,
+		},
+		{
+			MethodName: "PublishStory",
+			Handler:    _NewsfeedPublishing_PublishStory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/types/proto/newsfeed_publishing.proto",
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestBookmarks(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	firstID, err := alice.CreateTestPost("Night market in Taipei", true)
+	if err != nil {
+		t.Fatalf("Failed to create post: %v", err)
+	}
+	secondID, err := alice.CreateTestPost("Hiking Taroko Gorge", true)
+	if err != nil {
+		t.Fatalf("Failed to create post: %v", err)
+	}
+
+	getBookmarks := func(path string) utils.BookmarksResponse {
+		resp, err := bob.GET(path)
+		if err != nil {
+			t.Fatalf("Get bookmarks request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get bookmarks failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var bookmarks utils.BookmarksResponse
+		if err := resp.ParseJSON(&bookmarks); err != nil {
+			t.Fatalf("Failed to parse bookmarks: %v", err)
+		}
+		return bookmarks
+	}
+	getCollections := func() []utils.BookmarkCollectionResponse {
+		resp, err := bob.GET("/users/me/collections")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get collections failed: %v", err)
+		}
+		var collections utils.BookmarkCollectionsResponse
+		if err := resp.ParseJSON(&collections); err != nil {
+			t.Fatalf("Failed to parse collections: %v", err)
+		}
+		return collections.Collections
+	}
+
+	var collectionID int64
+	t.Run("Create Collection", func(t *testing.T) {
+		resp, err := bob.POST("/users/me/collections", utils.BookmarkCollectionRequest{Name: "Taiwan ideas"})
+		if err != nil {
+			t.Fatalf("Create collection request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Create collection failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreateBookmarkCollectionResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create collection response: %v", err)
+		}
+		collectionID = createResp.CollectionID
+
+		resp, err = bob.POST("/users/me/collections", utils.BookmarkCollectionRequest{Name: "taiwan IDEAS"})
+		if err != nil {
+			t.Fatalf("Create collection request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusConflict {
+			t.Errorf("Expected 409 for a duplicate name, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Save Posts", func(t *testing.T) {
+		resp, err := bob.POST(fmt.Sprintf("/posts/%d/bookmark", firstID), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Bookmark failed: %v", err)
+		}
+		resp, err = bob.POST(fmt.Sprintf("/posts/%d/bookmark", secondID), utils.AddBookmarkRequest{CollectionID: collectionID})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Bookmark into collection failed: %v", err)
+		}
+
+		resp, err = alice.POST(fmt.Sprintf("/posts/%d/bookmark", firstID), utils.AddBookmarkRequest{CollectionID: collectionID})
+		if err != nil {
+			t.Fatalf("Bookmark request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when using another user's collection, got %d", resp.StatusCode)
+		}
+
+		resp, err = bob.GET(fmt.Sprintf("/posts/%d", firstID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if !post.BookmarkedByMe {
+			t.Errorf("Expected bookmarked_by_me on a saved post")
+		}
+	})
+
+	t.Run("List And Paginate", func(t *testing.T) {
+		page := getBookmarks("/users/me/bookmarks?limit=1")
+		if len(page.Bookmarks) != 1 || page.Bookmarks[0].PostID != secondID || page.NextCursor == 0 {
+			t.Fatalf("Expected the newest bookmark and a cursor, got %+v", page)
+		}
+		page = getBookmarks(fmt.Sprintf("/users/me/bookmarks?limit=1&cursor=%d", page.NextCursor))
+		if len(page.Bookmarks) != 1 || page.Bookmarks[0].PostID != firstID || page.NextCursor != 0 {
+			t.Errorf("Expected the last bookmark without a cursor, got %+v", page)
+		}
+
+		inCollection := getBookmarks(fmt.Sprintf("/users/me/bookmarks?collection_id=%d", collectionID))
+		if len(inCollection.Bookmarks) != 1 || inCollection.Bookmarks[0].PostID != secondID {
+			t.Errorf("Expected only the second post in the collection, got %+v", inCollection)
+		}
+	})
+
+	t.Run("Invisible Posts Disappear", func(t *testing.T) {
+		hidden := false
+		resp, err := alice.PUT(fmt.Sprintf("/posts/%d", secondID), utils.EditPostRequest{Visible: &hidden})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		if bookmarks := getBookmarks("/users/me/bookmarks"); len(bookmarks.Bookmarks) != 1 {
+			t.Errorf("Expected the hidden post to disappear, got %+v", bookmarks)
+		}
+		if collections := getCollections(); len(collections) != 1 || collections[0].BookmarksCount != 0 {
+			t.Errorf("Expected an empty collection, got %+v", collections)
+		}
+
+		resp, err = alice.DELETE(fmt.Sprintf("/posts/%d", firstID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete post failed: %v", err)
+		}
+		if bookmarks := getBookmarks("/users/me/bookmarks"); len(bookmarks.Bookmarks) != 0 {
+			t.Errorf("Expected the deleted post to disappear, got %+v", bookmarks)
+		}
+	})
+
+	t.Run("Rename And Delete Collection", func(t *testing.T) {
+		path := fmt.Sprintf("/users/me/collections/%d", collectionID)
+		resp, err := bob.PUT(path, utils.BookmarkCollectionRequest{Name: "Taiwan 2025"})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Rename collection failed: %v", err)
+		}
+		if collections := getCollections(); len(collections) != 1 || collections[0].Name != "Taiwan 2025" {
+			t.Errorf("Expected the renamed collection, got %+v", collections)
+		}
+
+		resp, err = bob.DELETE(path)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete collection failed: %v", err)
+		}
+		if collections := getCollections(); len(collections) != 0 {
+			t.Errorf("Expected no collection left, got %+v", collections)
+		}
+	})
+
+	t.Run("Remove Bookmark", func(t *testing.T) {
+		path := fmt.Sprintf("/posts/%d/bookmark", secondID)
+		resp, err := bob.DELETE(path)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Remove bookmark failed: %v", err)
+		}
+		resp, err = bob.DELETE(path)
+		if err != nil {
+			t.Fatalf("Remove bookmark request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when removing a missing bookmark, got %d", resp.StatusCode)
+		}
+	})
+	t.Run("Concurrent Collections Keep Names Unique", func(t *testing.T) {
+		carol, err := utils.CreateIsolatedTestUser("", "", "")
+		if err != nil {
+			t.Fatalf("Failed to create carol: %v", err)
+		}
+
+		// concurrently sends count requests at once and counts their statuses
+		concurrently := func(send func(i int) (*utils.APIResponse, error), count int) map[int]int {
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			statuses := make(map[int]int)
+			for i := 0; i < count; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					resp, err := send(i)
+					if err != nil {
+						t.Errorf("Concurrent request failed: %v", err)
+						return
+					}
+					mu.Lock()
+					defer mu.Unlock()
+					statuses[resp.StatusCode]++
+				}(i)
+			}
+			wg.Wait()
+			return statuses
+		}
+
+		statuses := concurrently(func(i int) (*utils.APIResponse, error) {
+			return carol.POST("/users/me/collections", utils.BookmarkCollectionRequest{Name: "Road trip"})
+		}, 5)
+		if statuses[http.StatusOK] != 1 || statuses[http.StatusConflict] != 4 {
+			t.Errorf("Expected one collection and 4 conflicts, got %v", statuses)
+		}
+
+		var ids []int64
+		for _, name := range []string{"Beaches", "Mountains"} {
+			resp, err := carol.POST("/users/me/collections", utils.BookmarkCollectionRequest{Name: name})
+			if err != nil || !resp.IsSuccess() {
+				t.Fatalf("Create collection failed: %v", err)
+			}
+			var createResp utils.CreateBookmarkCollectionResponse
+			if err := resp.ParseJSON(&createResp); err != nil {
+				t.Fatalf("Failed to parse create collection response: %v", err)
+			}
+			ids = append(ids, createResp.CollectionID)
+		}
+		statuses = concurrently(func(i int) (*utils.APIResponse, error) {
+			return carol.PUT(fmt.Sprintf("/users/me/collections/%d", ids[i]), utils.BookmarkCollectionRequest{Name: "Islands"})
+		}, len(ids))
+		if statuses[http.StatusOK] != 1 || statuses[http.StatusConflict] != 1 {
+			t.Errorf("Expected one rename and one conflict, got %v", statuses)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestDrafts(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	getDrafts := func() []utils.DraftResponse {
+		resp, err := alice.GET("/posts/drafts")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get drafts failed: %v", err)
+		}
+		var drafts utils.DraftsResponse
+		if err := resp.ParseJSON(&drafts); err != nil {
+			t.Fatalf("Failed to parse drafts: %v", err)
+		}
+		return drafts.Drafts
+	}
+
+	var draftID int64
+	t.Run("Save Empty Draft", func(t *testing.T) {
+		resp, err := alice.POST("/posts/drafts", utils.CreateDraftRequest{})
+		if err != nil {
+			t.Fatalf("Create draft request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Create draft failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreateDraftResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create draft response: %v", err)
+		}
+		draftID = createResp.DraftID
+
+		resp, err = alice.POST(fmt.Sprintf("/posts/drafts/%d/publish", draftID), nil)
+		if err != nil {
+			t.Fatalf("Publish draft request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 when publishing an empty draft, got %d", resp.StatusCode)
+		}
+	})
+	draftPath := fmt.Sprintf("/posts/drafts/%d", draftID)
+
+	t.Run("Autosave", func(t *testing.T) {
+		text := "Sunset over the Douro"
+		images := []string{"https://example.com/douro.jpg"}
+		visibility := "followers"
+		resp, err := alice.PUT(draftPath, utils.EditDraftRequest{
+			ContentText:      &text,
+			ContentImagePath: &images,
+			Visibility:       &visibility,
+			Location:         &utils.Location{Latitude: 41.1406, Longitude: -8.6110, PlaceName: "Porto"},
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit draft failed: %v", err)
+		}
+
+		drafts := getDrafts()
+		if len(drafts) != 1 {
+			t.Fatalf("Expected one draft, got %+v", drafts)
+		}
+		draft := drafts[0]
+		if draft.ContentText != text || len(draft.ContentImagePath) != 1 || draft.Visibility != visibility || draft.Location == nil {
+			t.Errorf("Expected the edited draft, got %+v", draft)
+		}
+
+		resp, err = bob.PUT(draftPath, utils.EditDraftRequest{ContentText: &text})
+		if err != nil {
+			t.Fatalf("Edit draft request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when editing another user's draft, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Drafts Stay Private", func(t *testing.T) {
+		resp, err := bob.GET("/posts/drafts")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get drafts failed: %v", err)
+		}
+		var drafts utils.DraftsResponse
+		if err := resp.ParseJSON(&drafts); err != nil {
+			t.Fatalf("Failed to parse drafts: %v", err)
+		}
+		if len(drafts.Drafts) != 0 {
+			t.Errorf("Expected bob to have no drafts, got %+v", drafts.Drafts)
+		}
+	})
+
+	t.Run("Publish", func(t *testing.T) {
+		resp, err := alice.POST(draftPath+"/publish", nil)
+		if err != nil {
+			t.Fatalf("Publish draft request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Publish draft failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var publishResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&publishResp); err != nil {
+			t.Fatalf("Failed to parse publish response: %v", err)
+		}
+
+		resp, err = alice.GET(fmt.Sprintf("/posts/%d", publishResp.PostId))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get published post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.ContentText != "Sunset over the Douro" || post.Visibility != "followers" || post.Location == nil {
+			t.Errorf("Expected the post to carry the draft, got %+v", post)
+		}
+
+		if drafts := getDrafts(); len(drafts) != 0 {
+			t.Errorf("Expected the published draft to be gone, got %+v", drafts)
+		}
+		resp, err = alice.POST(draftPath+"/publish", nil)
+		if err != nil {
+			t.Fatalf("Publish draft request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when publishing a draft twice, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		resp, err := alice.POST("/posts/drafts", utils.CreateDraftRequest{ContentText: "Maybe later"})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create draft failed: %v", err)
+		}
+		var createResp utils.CreateDraftResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create draft response: %v", err)
+		}
+
+		path := fmt.Sprintf("/posts/drafts/%d", createResp.DraftID)
+		resp, err = alice.DELETE(path)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete draft failed: %v", err)
+		}
+		resp, err = alice.DELETE(path)
+		if err != nil {
+			t.Fatalf("Delete draft request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when deleting a missing draft, got %d", resp.StatusCode)
+		}
+	})
+}
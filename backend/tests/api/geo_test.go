@@ -0,0 +1,151 @@
+package api
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestNearbyPosts(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+
+	// Use a random point so posts of earlier runs don't show up in the results
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	latitude := -60 + rng.Float64()*120
+	longitude := -170 + rng.Float64()*340
+
+	createGeotaggedPost := func(text string, location *utils.Location, visibility string) int64 {
+		resp, err := author.POST("/posts", utils.CreatePostRequest{
+			ContentText: text,
+			Visibility:  visibility,
+			Location:    location,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create post: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Post creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+
+	// About 1.1km and 5.5km north of the point
+	closePostID := createGeotaggedPost("Coffee by the harbour",
+		&utils.Location{Latitude: latitude + 0.01, Longitude: longitude, PlaceName: "Harbour"}, "public")
+	fartherPostID := createGeotaggedPost("Sunset from the hill",
+		&utils.Location{Latitude: latitude + 0.05, Longitude: longitude}, "public")
+	privatePostID := createGeotaggedPost("Hidden spot",
+		&utils.Location{Latitude: latitude, Longitude: longitude}, "private")
+
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	getNearby := func(get func(string) (*utils.APIResponse, error), radiusKm float64) []utils.NearbyPostResponse {
+		resp, err := get(fmt.Sprintf("/posts/nearby?lat=%f&lng=%f&radius_km=%f", latitude, longitude, radiusKm))
+		if err != nil {
+			t.Fatalf("Nearby request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Nearby request failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var nearby utils.NearbyPostsResponse
+		if err := resp.ParseJSON(&nearby); err != nil {
+			t.Fatalf("Failed to parse nearby response: %v", err)
+		}
+		return nearby.Posts
+	}
+
+	t.Run("Post Detail Has Location", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/posts/%d", closePostID))
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.Location == nil || post.Location.PlaceName != "Harbour" {
+			t.Errorf("Expected the post location, got %+v", post.Location)
+		}
+	})
+
+	t.Run("Nearby Posts Are Sorted By Distance", func(t *testing.T) {
+		posts := getNearby(anonClient.GET, 10)
+		if len(posts) != 2 || posts[0].PostID != closePostID || posts[1].PostID != fartherPostID {
+			t.Fatalf("Expected posts %d then %d, got %+v", closePostID, fartherPostID, posts)
+		}
+		if posts[0].DistanceKm < 1 || posts[0].DistanceKm > 1.3 {
+			t.Errorf("Expected a distance of about 1.1km, got %f", posts[0].DistanceKm)
+		}
+	})
+
+	t.Run("Radius Limits Results", func(t *testing.T) {
+		posts := getNearby(anonClient.GET, 3)
+		if len(posts) != 1 || posts[0].PostID != closePostID {
+			t.Errorf("Expected only post %d within 3km, got %+v", closePostID, posts)
+		}
+	})
+
+	t.Run("Private Posts Are Only Visible To Their Author", func(t *testing.T) {
+		for _, post := range getNearby(anonClient.GET, 10) {
+			if post.PostID == privatePostID {
+				t.Errorf("Private post %d should not be visible anonymously", privatePostID)
+			}
+		}
+		found := false
+		for _, post := range getNearby(author.GET, 10) {
+			found = found || post.PostID == privatePostID
+		}
+		if !found {
+			t.Errorf("Expected the author to see their private post %d", privatePostID)
+		}
+	})
+
+	t.Run("Location Can Be Removed", func(t *testing.T) {
+		resp, err := author.PUT(fmt.Sprintf("/posts/%d", fartherPostID), utils.EditPostRequest{RemoveLocation: true})
+		if err != nil {
+			t.Fatalf("Edit post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Edit post failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		for _, post := range getNearby(anonClient.GET, 10) {
+			if post.PostID == fartherPostID {
+				t.Errorf("Post %d should no longer be geotagged", fartherPostID)
+			}
+		}
+	})
+
+	t.Run("Invalid Coordinates Are Rejected", func(t *testing.T) {
+		resp, err := anonClient.GET("/posts/nearby?lat=95&lng=0")
+		if err != nil {
+			t.Fatalf("Nearby request failed: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+
+		resp, err = author.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Nowhere",
+			Location:    &utils.Location{Latitude: 0, Longitude: 200},
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+	})
+}
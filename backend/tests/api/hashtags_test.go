@@ -0,0 +1,119 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestHashtags(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+
+	// Use a unique tag so earlier runs don't affect the counts
+	tag := fmt.Sprintf("roadtrip%d", time.Now().UnixNano())
+
+	var publicPostIDs []int64
+	for i := 0; i < 3; i++ {
+		postID, err := author.CreateTestPost(fmt.Sprintf("Day %d of the #%s, next stop #Kyoto", i+1, tag), true)
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+		publicPostIDs = append(publicPostIDs, postID)
+	}
+	privatePostID, err := author.CreateTestPost(fmt.Sprintf("Private notes #%s", tag), false)
+	if err != nil {
+		t.Fatalf("Failed to create private test post: %v", err)
+	}
+
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	t.Run("Hashtag Posts Are Paginated", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/hashtags/%s/posts?limit=2", tag))
+		if err != nil {
+			t.Fatalf("Get hashtag posts request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get hashtag posts failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var firstPage utils.HashtagPostsResponse
+		if err := resp.ParseJSON(&firstPage); err != nil {
+			t.Fatalf("Failed to parse hashtag posts response: %v", err)
+		}
+		if len(firstPage.PostsIDs) != 2 || firstPage.NextCursor == 0 {
+			t.Fatalf("Expected a full first page with a cursor, got %+v", firstPage)
+		}
+		if int64(firstPage.PostsIDs[0]) != publicPostIDs[2] {
+			t.Errorf("Expected newest post %d first, got %d", publicPostIDs[2], firstPage.PostsIDs[0])
+		}
+
+		resp, err = anonClient.GET(fmt.Sprintf("/hashtags/%s/posts?limit=2&cursor=%d", tag, firstPage.NextCursor))
+		if err != nil {
+			t.Fatalf("Get hashtag posts request failed: %v", err)
+		}
+		var secondPage utils.HashtagPostsResponse
+		if err := resp.ParseJSON(&secondPage); err != nil {
+			t.Fatalf("Failed to parse hashtag posts response: %v", err)
+		}
+		if len(secondPage.PostsIDs) != 1 || secondPage.NextCursor != 0 {
+			t.Fatalf("Expected a last page with one post, got %+v", secondPage)
+		}
+		if int64(secondPage.PostsIDs[0]) == privatePostID {
+			t.Error("Expected private post to be hidden from the hashtag page")
+		}
+	})
+
+	t.Run("Hashtag Info", func(t *testing.T) {
+		resp, err := anonClient.GET("/hashtags/" + tag)
+		if err != nil {
+			t.Fatalf("Get hashtag request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get hashtag failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var info utils.HashtagInfoResponse
+		if err := resp.ParseJSON(&info); err != nil {
+			t.Fatalf("Failed to parse hashtag response: %v", err)
+		}
+		if info.Tag != tag || info.PostCount != 3 || info.AuthorCount != 1 {
+			t.Errorf("Unexpected hashtag info: %+v", info)
+		}
+	})
+
+	t.Run("Editing Removes Hashtag", func(t *testing.T) {
+		text := "Day 1, no tags anymore"
+		resp, err := author.PUT(fmt.Sprintf("/posts/%d", publicPostIDs[0]), utils.EditPostRequest{ContentText: &text})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to edit post: %v", err)
+		}
+
+		resp, err = anonClient.GET("/hashtags/" + tag)
+		if err != nil {
+			t.Fatalf("Get hashtag request failed: %v", err)
+		}
+		var info utils.HashtagInfoResponse
+		if err := resp.ParseJSON(&info); err != nil {
+			t.Fatalf("Failed to parse hashtag response: %v", err)
+		}
+		if info.PostCount != 2 {
+			t.Errorf("Expected 2 posts after the edit, got %d", info.PostCount)
+		}
+	})
+
+	t.Run("Unknown Hashtag", func(t *testing.T) {
+		resp, err := anonClient.GET("/hashtags/unknown" + tag)
+		if err != nil {
+			t.Fatalf("Get hashtag request failed: %v", err)
+		}
+		if resp.StatusCode != 404 {
+			t.Errorf("Expected status 404 for an unknown hashtag, got %d", resp.StatusCode)
+		}
+	})
+}
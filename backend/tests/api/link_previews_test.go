@@ -0,0 +1,38 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestLinkPreviews(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+
+	getPost := func(postID int64) utils.PostDetailInfoResponse {
+		resp, err := alice.GET(fmt.Sprintf("/posts/%d", postID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		return post
+	}
+
+	t.Run("Posts Without Links", func(t *testing.T) {
+		postID, err := alice.CreateTestPost("No links in this one", true)
+		if err != nil {
+			t.Fatalf("Failed to create post: %v", err)
+		}
+		post := getPost(postID)
+		if post.LinkPreviews == nil || len(post.LinkPreviews) != 0 {
+			t.Errorf("Expected an empty list of link previews, got %+v", post.LinkPreviews)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestMentions(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	mentioned, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create mentioned user: %v", err)
+	}
+	userName := mentioned.UserData.UserName
+
+	// "é" is a single code point, spans are counted in code points rather than bytes
+	text := fmt.Sprintf("Café with @%s and @nobody_%s, mail me at me@%s.com", userName, userName, userName)
+	postID, err := author.CreateTestPost(text, true)
+	if err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+
+	t.Run("Post Mentions Are Resolved", func(t *testing.T) {
+		resp, err := author.GET(fmt.Sprintf("/posts/%d", postID))
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get post failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post response: %v", err)
+		}
+
+		// Unknown users and e-mail addresses are not mentions
+		if len(post.Mentions) != 1 {
+			t.Fatalf("Expected exactly one mention, got %+v", post.Mentions)
+		}
+		span := post.Mentions[0]
+		if span.UserID != mentioned.UserID || span.UserName != userName {
+			t.Errorf("Unexpected mentioned user: %+v", span)
+		}
+		if span.Start != 10 || span.End != 10+1+len(userName) {
+			t.Errorf("Unexpected mention span %d-%d", span.Start, span.End)
+		}
+	})
+
+	t.Run("Comment Mentions Are Resolved", func(t *testing.T) {
+		commentReq := utils.CreatePostCommentRequest{
+			ContentText: fmt.Sprintf("@%s look at this", userName),
+		}
+		resp, err := author.POST(fmt.Sprintf("/posts/%d", postID), commentReq)
+		if err != nil {
+			t.Fatalf("Create comment request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Create comment failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse comment response: %v", err)
+		}
+		if len(post.Comments) != 1 || len(post.Comments[0].Mentions) != 1 {
+			t.Fatalf("Expected one comment with one mention, got %+v", post.Comments)
+		}
+		span := post.Comments[0].Mentions[0]
+		if span.UserID != mentioned.UserID || span.Start != 0 {
+			t.Errorf("Unexpected comment mention: %+v", span)
+		}
+		if len(post.Mentions) != 1 {
+			t.Errorf("Expected the post mentions to be unchanged, got %+v", post.Mentions)
+		}
+	})
+
+	t.Run("Editing Replaces Mentions", func(t *testing.T) {
+		edited := "No mentions anymore"
+		resp, err := author.PUT(fmt.Sprintf("/posts/%d", postID), utils.EditPostRequest{ContentText: &edited})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to edit post: %v", err)
+		}
+
+		resp, err = author.GET(fmt.Sprintf("/posts/%d", postID))
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post response: %v", err)
+		}
+		if len(post.Mentions) != 0 {
+			t.Errorf("Expected no post mentions after the edit, got %+v", post.Mentions)
+		}
+		if len(post.Comments) != 1 || len(post.Comments[0].Mentions) != 1 {
+			t.Errorf("Expected the comment mention to be kept, got %+v", post.Comments)
+		}
+	})
+}
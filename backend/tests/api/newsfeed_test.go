@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"wandersphere-api-tests/utils"
 )
@@ -240,3 +242,253 @@ func TestNewsfeedValidation(t *testing.T) {
 		}
 	})
 }
+
+func TestHydratedNewsfeed(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	resp, err := bob.POST("/friends/"+alice.GetUserIDStr(), nil)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Follow failed: %v", err)
+	}
+
+	var postIDs []int64
+	for i := 0; i < 3; i++ {
+		postID, err := alice.CreateTestPost(fmt.Sprintf("Hydrated newsfeed post %d", i), true)
+		if err != nil {
+			t.Fatalf("Create post failed: %v", err)
+		}
+		postIDs = append(postIDs, postID)
+	}
+
+	getNewsfeed := func(query string) utils.NewsfeedResponse {
+		resp, err := bob.GET("/newsfeed?" + query)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get newsfeed failed: %v", err)
+		}
+		var newsfeed utils.NewsfeedResponse
+		if err := resp.ParseJSON(&newsfeed); err != nil {
+			t.Fatalf("Failed to parse newsfeed: %v", err)
+		}
+		return newsfeed
+	}
+
+	// Posts reach the newsfeeds of followers asynchronously
+	var newsfeed utils.NewsfeedResponse
+	for attempt := 0; attempt < 20; attempt++ {
+		newsfeed = getNewsfeed("page_size=2")
+		if len(newsfeed.PostsIds) == 2 {
+			break
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	if len(newsfeed.PostsIds) < 2 {
+		t.Skipf("Posts did not reach the newsfeed, got %v", newsfeed.PostsIds)
+	}
+
+	t.Run("Pagination", func(t *testing.T) {
+		if newsfeed.CurrentPage != 1 || newsfeed.TotalPages < 2 {
+			t.Errorf("Expected page 1 of at least 2, got %d of %d", newsfeed.CurrentPage, newsfeed.TotalPages)
+		}
+		second := getNewsfeed("page=2&page_size=2")
+		if second.CurrentPage != 2 || len(second.PostsIds) == 0 {
+			t.Errorf("Expected posts on page 2, got %+v", second)
+		}
+		for _, item := range newsfeed.Items {
+			if item.Post != nil {
+				t.Errorf("Expected items without posts when not hydrated")
+			}
+		}
+	})
+
+	t.Run("Hydrated Pages Follow The Cursor", func(t *testing.T) {
+		first := getNewsfeed("page_size=2&hydrate=true")
+		if len(first.Items) != 2 || first.NextCursor == 0 {
+			t.Fatalf("Expected a full first page with a cursor, got %d items and cursor %d", len(first.Items), first.NextCursor)
+		}
+		second := getNewsfeed(fmt.Sprintf("page_size=2&hydrate=true&cursor=%d", first.NextCursor))
+		if len(second.Items) == 0 {
+			t.Fatalf("Expected items after cursor %d", first.NextCursor)
+		}
+		for _, item := range second.Items {
+			for _, seen := range first.Items {
+				if item.Type == seen.Type && item.ID == seen.ID {
+					t.Errorf("Expected item %d on one page only", item.ID)
+				}
+			}
+		}
+	})
+
+	t.Run("Hydrated Items", func(t *testing.T) {
+		hydrated := getNewsfeed("page_size=2&hydrate=true")
+		if len(hydrated.Items) != 2 {
+			t.Fatalf("Expected 2 items, got %d", len(hydrated.Items))
+		}
+		for _, item := range hydrated.Items {
+			if item.Post == nil || item.Post.PostID != item.ID {
+				t.Fatalf("Expected item %d to carry its post, got %+v", item.ID, item.Post)
+			}
+			if item.Post.Author == nil || item.Post.Author.UserID != int64(alice.UserID) {
+				t.Errorf("Expected alice as the author, got %+v", item.Post.Author)
+			}
+		}
+	})
+
+	var trashed int64
+	t.Run("Deleted Posts Are Skipped", func(t *testing.T) {
+		first := getNewsfeed("page_size=1").PostsIds[0]
+		resp, err := alice.DELETE(fmt.Sprintf("/posts/%d", first))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete post failed: %v", err)
+		}
+		trashed = first
+
+		hydrated := getNewsfeed("page_size=2&hydrate=true")
+		if len(hydrated.Items) != 2 {
+			t.Errorf("Expected the page to keep 2 items, got %d", len(hydrated.Items))
+		}
+		for _, item := range hydrated.Items {
+			if item.ID == first {
+				t.Errorf("Expected the deleted post %d to be skipped", first)
+			}
+		}
+	})
+
+	t.Run("Restored Posts Come Back", func(t *testing.T) {
+		if trashed == 0 {
+			t.Skip("No post was deleted")
+		}
+		// Hidden posts are only left out of the page, they stay in the newsfeed
+		if ids := getNewsfeed("page_size=50").PostsIds; !containsID(ids, trashed) {
+			t.Fatalf("Expected the deleted post %d to stay in the newsfeed, got %v", trashed, ids)
+		}
+		resp, err := alice.POST(fmt.Sprintf("/posts/%d/restore", trashed), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Restore post failed: %v", err)
+		}
+		if ids := getNewsfeed("page_size=50&hydrate=true").PostsIds; !containsID(ids, trashed) {
+			t.Errorf("Expected the restored post %d back in the hydrated newsfeed, got %v", trashed, ids)
+		}
+	})
+
+	t.Run("Reposts Carry The Shared Post", func(t *testing.T) {
+		carol, err := utils.CreateIsolatedTestUser("", "", "")
+		if err != nil {
+			t.Fatalf("Failed to create carol: %v", err)
+		}
+		shared, err := carol.CreateTestPost("Shared through alice", true)
+		if err != nil {
+			t.Fatalf("Create post failed: %v", err)
+		}
+		resp, err := alice.POST(fmt.Sprintf("/posts/%d/repost", shared), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Repost failed: %v", err)
+		}
+
+		var repost *utils.NewsfeedItemResponse
+		for attempt := 0; attempt < 20 && repost == nil; attempt++ {
+			for _, item := range getNewsfeed("page_size=50&hydrate=true").Items {
+				if item.Type == "repost" && item.Post != nil && item.Post.RepostOfPostID == shared {
+					item := item
+					repost = &item
+				}
+			}
+			time.Sleep(250 * time.Millisecond)
+		}
+		if repost == nil {
+			t.Skip("The repost did not reach the newsfeed")
+		}
+		if repost.RepostOf == nil || repost.RepostOf.PostID != shared || repost.RepostOf.ContentText != "Shared through alice" {
+			t.Errorf("Expected the repost to carry post %d, got %+v", shared, repost.RepostOf)
+		}
+	})
+
+	t.Run("Invalid Params", func(t *testing.T) {
+		for _, query := range []string{"page=0", "page_size=abc", "hydrate=maybe", "cursor=-1", "hydrate=true&page=2"} {
+			resp, err := bob.GET("/newsfeed?" + query)
+			if err != nil {
+				t.Fatalf("Get newsfeed request failed: %v", err)
+			}
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 for %s, got %d", query, resp.StatusCode)
+			}
+		}
+	})
+}
+
+func TestNewsfeedPostMadePublic(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	follower, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create follower: %v", err)
+	}
+
+	resp, err := follower.POST("/friends/"+author.GetUserIDStr(), nil)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Follow failed: %v", err)
+	}
+
+	createResp, err := author.POST("/posts", utils.CreatePostRequest{
+		ContentText: "Private until the trip is over",
+		Visibility:  "private",
+	})
+	if err != nil || !createResp.IsSuccess() {
+		t.Fatalf("Create private post failed: %v", err)
+	}
+	var created utils.CreatePostResponse
+	if err := createResp.ParseJSON(&created); err != nil {
+		t.Fatalf("Failed to parse create post response: %v", err)
+	}
+
+	getNewsfeed := func() []int64 {
+		resp, err := follower.GET("/newsfeed?page_size=50")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get newsfeed failed: %v", err)
+		}
+		var newsfeed utils.NewsfeedResponse
+		if err := resp.ParseJSON(&newsfeed); err != nil {
+			t.Fatalf("Failed to parse newsfeed: %v", err)
+		}
+		return newsfeed.PostsIds
+	}
+
+	// Private posts are never fanned out
+	time.Sleep(time.Second)
+	if ids := getNewsfeed(); containsID(ids, created.PostId) {
+		t.Fatalf("Expected the private post %d to stay out of the newsfeed, got %v", created.PostId, ids)
+	}
+
+	public := "public"
+	resp, err = author.PUT(fmt.Sprintf("/posts/%d", created.PostId), utils.EditPostRequest{Visibility: &public})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Edit post failed: %v", err)
+	}
+
+	// Posts reach the newsfeeds of followers asynchronously
+	var ids []int64
+	for attempt := 0; attempt < 20; attempt++ {
+		if ids = getNewsfeed(); containsID(ids, created.PostId) {
+			return
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	t.Errorf("Expected the post %d made public in the newsfeed, got %v", created.PostId, ids)
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
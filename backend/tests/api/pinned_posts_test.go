@@ -0,0 +1,200 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestPinnedPosts(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	createPost := func(visibility string) int64 {
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: "A post worth pinning",
+			Visibility:  visibility,
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create post failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+	pin := func(user *utils.AuthenticatedUser, postID int64) int {
+		resp, err := user.POST(fmt.Sprintf("/posts/%d/pin", postID), nil)
+		if err != nil {
+			t.Fatalf("Pin request failed: %v", err)
+		}
+		return resp.StatusCode
+	}
+	unpin := func(user *utils.AuthenticatedUser, postID int64) int {
+		resp, err := user.DELETE(fmt.Sprintf("/posts/%d/pin", postID))
+		if err != nil {
+			t.Fatalf("Unpin request failed: %v", err)
+		}
+		return resp.StatusCode
+	}
+	pinnedSeenBy := func(viewer *utils.AuthenticatedUser) []int64 {
+		resp, err := viewer.GET(fmt.Sprintf("/users/%d", alice.UserID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get user failed: %v", err)
+		}
+		var user utils.UserDetailInfoResponse
+		if err := resp.ParseJSON(&user); err != nil {
+			t.Fatalf("Failed to parse user: %v", err)
+		}
+		return user.PinnedPostIds
+	}
+
+	first := createPost("public")
+	second := createPost("public")
+	third := createPost("followers")
+	fourth := createPost("public")
+	private := createPost("private")
+
+	t.Run("Pin Limit", func(t *testing.T) {
+		for _, postID := range []int64{first, second, third} {
+			if status := pin(alice, postID); status != http.StatusOK {
+				t.Fatalf("Expected 200 pinning post %d, got %d", postID, status)
+			}
+		}
+		if status := pin(alice, first); status != http.StatusOK {
+			t.Errorf("Expected pinning a pinned post again to succeed, got %d", status)
+		}
+		if status := pin(alice, fourth); status != http.StatusConflict {
+			t.Errorf("Expected 409 pinning a fourth post, got %d", status)
+		}
+
+		pinned := pinnedSeenBy(alice)
+		if len(pinned) != 3 || pinned[0] != third || pinned[2] != first {
+			t.Errorf("Expected pins %v most recent first, got %v", []int64{third, second, first}, pinned)
+		}
+	})
+
+	t.Run("Pins Follow Visibility", func(t *testing.T) {
+		pinned := pinnedSeenBy(bob)
+		if len(pinned) != 2 {
+			t.Errorf("Expected bob to see the 2 public pins only, got %v", pinned)
+		}
+	})
+
+	t.Run("Not Pinnable", func(t *testing.T) {
+		if status := pin(alice, private); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 pinning a private post, got %d", status)
+		}
+		if status := pin(bob, fourth); status != http.StatusForbidden {
+			t.Errorf("Expected 403 pinning the post of another user, got %d", status)
+		}
+		if status := unpin(bob, first); status != http.StatusForbidden {
+			t.Errorf("Expected 403 unpinning the post of another user, got %d", status)
+		}
+		if status := unpin(alice, fourth); status != http.StatusNotFound {
+			t.Errorf("Expected 404 unpinning a post that is not pinned, got %d", status)
+		}
+	})
+
+	t.Run("Deleting Clears The Pin", func(t *testing.T) {
+		resp, err := alice.DELETE(fmt.Sprintf("/posts/%d", second))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete post failed: %v", err)
+		}
+		for _, postID := range pinnedSeenBy(alice) {
+			if postID == second {
+				t.Errorf("Expected the deleted post to be unpinned")
+			}
+		}
+
+		// Restoring the post does not pin it again
+		resp, err = alice.POST(fmt.Sprintf("/posts/%d/restore", second), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Restore post failed: %v", err)
+		}
+		if pinned := pinnedSeenBy(alice); len(pinned) != 2 {
+			t.Errorf("Expected 2 pins after the restore, got %v", pinned)
+		}
+	})
+
+	t.Run("Making Private Clears The Pin", func(t *testing.T) {
+		visible := false
+		resp, err := alice.PUT(fmt.Sprintf("/posts/%d", first), utils.EditPostRequest{Visible: &visible})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		pinned := pinnedSeenBy(alice)
+		if len(pinned) != 1 || pinned[0] != third {
+			t.Errorf("Expected only post %d to stay pinned, got %v", third, pinned)
+		}
+	})
+
+	t.Run("Unpin", func(t *testing.T) {
+		if status := unpin(alice, third); status != http.StatusOK {
+			t.Errorf("Expected 200 unpinning, got %d", status)
+		}
+		if pinned := pinnedSeenBy(alice); len(pinned) != 0 {
+			t.Errorf("Expected no pins, got %v", pinned)
+		}
+	})
+
+	t.Run("Concurrent Pins", func(t *testing.T) {
+		postID := createPost("public")
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if status := pin(alice, postID); status != http.StatusOK {
+					t.Errorf("Expected 200 pinning concurrently, got %d", status)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if pinned := pinnedSeenBy(alice); len(pinned) != 1 || pinned[0] != postID {
+			t.Errorf("Expected post %d pinned once, got %v", postID, pinned)
+		}
+		if status := unpin(alice, postID); status != http.StatusOK {
+			t.Fatalf("Expected 200 unpinning, got %d", status)
+		}
+	})
+
+	t.Run("Made Private While Pinning", func(t *testing.T) {
+		for round := 0; round < 5; round++ {
+			postID := createPost("public")
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				// The pin either wins, and the edit clears it, or finds a private post
+				if status := pin(alice, postID); status != http.StatusOK && status != http.StatusBadRequest {
+					t.Errorf("Expected 200 or 400 pinning a post made private, got %d", status)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				visible := false
+				resp, err := alice.PUT(fmt.Sprintf("/posts/%d", postID), utils.EditPostRequest{Visible: &visible})
+				if err != nil || !resp.IsSuccess() {
+					t.Errorf("Edit post failed: %v", err)
+				}
+			}()
+			wg.Wait()
+
+			if pinned := pinnedSeenBy(alice); len(pinned) != 0 {
+				t.Fatalf("Expected the private post %d to stay unpinned, got %v", postID, pinned)
+			}
+		}
+	})
+}
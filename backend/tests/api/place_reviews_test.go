@@ -0,0 +1,285 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestPlaceReviews(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	resp, err := alice.POST("/places", utils.CreatePlaceRequest{
+		Name:     "Review Test Cafe",
+		Category: "cafe",
+		Country:  "Vietnam",
+		City:     "Hoi An",
+	})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Place creation failed: %v", err)
+	}
+	var placeResp utils.CreatePlaceResponse
+	if err := resp.ParseJSON(&placeResp); err != nil {
+		t.Fatalf("Failed to parse create place response: %v", err)
+	}
+	reviewsPath := fmt.Sprintf("/places/%d/reviews", placeResp.PlaceID)
+
+	review := func(user *utils.AuthenticatedUser, rating int32, text string) int64 {
+		resp, err := user.POST(reviewsPath, utils.CreatePlaceReviewRequest{Rating: rating, ContentText: text})
+		if err != nil {
+			t.Fatalf("Create review request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Review creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePlaceReviewResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create review response: %v", err)
+		}
+		return createResp.ReviewID
+	}
+	aliceReviewID := review(alice, 5, "Best egg coffee in town")
+	bobReviewID := review(bob, 2, "Too crowded")
+
+	getReviews := func(query string) utils.PlaceReviewsResponse {
+		resp, err := anonClient.GET(reviewsPath + query)
+		if err != nil {
+			t.Fatalf("Get reviews request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get reviews failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var reviews utils.PlaceReviewsResponse
+		if err := resp.ParseJSON(&reviews); err != nil {
+			t.Fatalf("Failed to parse reviews: %v", err)
+		}
+		return reviews
+	}
+
+	t.Run("One Review Per User", func(t *testing.T) {
+		resp, err := alice.POST(reviewsPath, utils.CreatePlaceReviewRequest{Rating: 4})
+		if err != nil {
+			t.Fatalf("Create review request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("Expected 409 for a second review, got %d", resp.StatusCode)
+		}
+		var createResp utils.CreatePlaceReviewResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create review response: %v", err)
+		}
+		if createResp.ReviewID != aliceReviewID {
+			t.Errorf("Expected the existing review %d, got %d", aliceReviewID, createResp.ReviewID)
+		}
+	})
+
+	t.Run("Invalid Ratings Are Rejected", func(t *testing.T) {
+		resp, err := alice.POST(reviewsPath, utils.CreatePlaceReviewRequest{Rating: 6})
+		if err != nil {
+			t.Fatalf("Create review request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a rating of 6, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Rating Summary", func(t *testing.T) {
+		reviews := getReviews("")
+		if reviews.Summary.Count != 2 || reviews.Summary.Average != 3.5 {
+			t.Errorf("Expected 2 reviews averaging 3.5, got %+v", reviews.Summary)
+		}
+		if len(reviews.Summary.Counts) != 5 || reviews.Summary.Counts[1] != 1 || reviews.Summary.Counts[4] != 1 {
+			t.Errorf("Unexpected rating distribution %v", reviews.Summary.Counts)
+		}
+
+		resp, err := anonClient.GET(fmt.Sprintf("/places/%d", placeResp.PlaceID))
+		if err != nil {
+			t.Fatalf("Get place request failed: %v", err)
+		}
+		var place utils.PlaceResponse
+		if err := resp.ParseJSON(&place); err != nil {
+			t.Fatalf("Failed to parse place: %v", err)
+		}
+		if place.RatingCount != 2 || place.RatingAverage != 3.5 {
+			t.Errorf("Expected the place to carry its rating, got %+v", place)
+		}
+	})
+
+	t.Run("Helpful Votes", func(t *testing.T) {
+		votePath := fmt.Sprintf("/reviews/%d/helpful", bobReviewID)
+		for i := 0; i < 2; i++ {
+			resp, err := alice.POST(votePath, nil)
+			if err != nil {
+				t.Fatalf("Vote request failed: %v", err)
+			}
+			if !resp.IsSuccess() {
+				t.Fatalf("Vote failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+			}
+		}
+
+		resp, err := bob.POST(votePath, nil)
+		if err != nil {
+			t.Fatalf("Vote request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 when voting on an own review, got %d", resp.StatusCode)
+		}
+
+		resp, err = alice.GET(fmt.Sprintf("/reviews/%d", bobReviewID))
+		if err != nil {
+			t.Fatalf("Get review request failed: %v", err)
+		}
+		var voted utils.PlaceReviewResponse
+		if err := resp.ParseJSON(&voted); err != nil {
+			t.Fatalf("Failed to parse review: %v", err)
+		}
+		if voted.HelpfulCount != 1 || !voted.VotedHelpful {
+			t.Errorf("Expected a single helpful vote from alice, got %+v", voted)
+		}
+
+		reviews := getReviews("?sort=helpful")
+		if len(reviews.Reviews) != 2 || reviews.Reviews[0].ReviewID != bobReviewID {
+			t.Errorf("Expected bob's review first when sorted by helpfulness, got %+v", reviews.Reviews)
+		}
+	})
+
+	t.Run("Sorting And Pagination", func(t *testing.T) {
+		page := getReviews("?sort=lowest&limit=1")
+		if len(page.Reviews) != 1 || page.Reviews[0].ReviewID != bobReviewID || page.NextCursor == "" {
+			t.Fatalf("Expected bob's review and a cursor, got %+v", page)
+		}
+		page = getReviews("?sort=lowest&limit=1&cursor=" + page.NextCursor)
+		if len(page.Reviews) != 1 || page.Reviews[0].ReviewID != aliceReviewID || page.NextCursor != "" {
+			t.Errorf("Expected alice's review on the last page, got %+v", page)
+		}
+
+		resp, err := anonClient.GET(reviewsPath + "?sort=oldest")
+		if err != nil {
+			t.Fatalf("Get reviews request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an unknown sort, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Only The Author Can Edit", func(t *testing.T) {
+		rating := int32(3)
+		reviewPath := fmt.Sprintf("/reviews/%d", aliceReviewID)
+		resp, err := bob.PUT(reviewPath, utils.EditPlaceReviewRequest{Rating: &rating})
+		if err != nil {
+			t.Fatalf("Edit review request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 when editing someone else's review, got %d", resp.StatusCode)
+		}
+
+		resp, err = alice.PUT(reviewPath, utils.EditPlaceReviewRequest{Rating: &rating})
+		if err != nil {
+			t.Fatalf("Edit review request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Edit failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = anonClient.GET(reviewPath)
+		if err != nil {
+			t.Fatalf("Get review request failed: %v", err)
+		}
+		var edited utils.PlaceReviewResponse
+		if err := resp.ParseJSON(&edited); err != nil {
+			t.Fatalf("Failed to parse review: %v", err)
+		}
+		if edited.Rating != 3 || edited.EditedAt == "" || edited.ContentText != "Best egg coffee in town" {
+			t.Errorf("Unexpected edited review %+v", edited)
+		}
+	})
+
+	t.Run("Deleted Reviews Leave The Summary", func(t *testing.T) {
+		reviewPath := fmt.Sprintf("/reviews/%d", bobReviewID)
+		resp, err := alice.DELETE(reviewPath)
+		if err != nil {
+			t.Fatalf("Delete review request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 when deleting someone else's review, got %d", resp.StatusCode)
+		}
+
+		resp, err = bob.DELETE(reviewPath)
+		if err != nil {
+			t.Fatalf("Delete review request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Delete failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = anonClient.GET(reviewPath)
+		if err != nil {
+			t.Fatalf("Get review request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for a deleted review, got %d", resp.StatusCode)
+		}
+
+		reviews := getReviews("")
+		if reviews.Summary.Count != 1 || reviews.Summary.Average != 3 {
+			t.Errorf("Expected a single 3 star review, got %+v", reviews.Summary)
+		}
+
+		// Bob may review the place again once his review is deleted
+		review(bob, 4, "")
+	})
+	t.Run("Concurrent Reviews Create One Review", func(t *testing.T) {
+		carol, err := utils.CreateIsolatedTestUser("", "", "")
+		if err != nil {
+			t.Fatalf("Failed to create carol: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		statuses := make(map[int]int)
+		ids := make(map[int64]bool)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := carol.POST(reviewsPath, utils.CreatePlaceReviewRequest{Rating: 4})
+				if err != nil {
+					t.Errorf("Concurrent review failed: %v", err)
+					return
+				}
+				var createResp utils.CreatePlaceReviewResponse
+				if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+					if err := resp.ParseJSON(&createResp); err != nil {
+						t.Errorf("Failed to parse create review response: %v", err)
+					}
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				statuses[resp.StatusCode]++
+				ids[createResp.ReviewID] = true
+			}()
+		}
+		wg.Wait()
+
+		if statuses[http.StatusOK] != 1 || statuses[http.StatusConflict] != 4 {
+			t.Errorf("Expected one review and 4 conflicts, got %v", statuses)
+		}
+		if len(ids) != 1 || ids[0] {
+			t.Errorf("Expected every response to carry the same review, got %v", ids)
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"fmt"
+	"math/rand"
+	"net/url"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestPlaces(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	visitor, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create visitor: %v", err)
+	}
+
+	// Use a unique name and a random point so places of earlier runs don't show up in the results
+	name := fmt.Sprintf("Lighthouse %d", time.Now().UnixNano())
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	latitude := -60 + rng.Float64()*120
+	longitude := -170 + rng.Float64()*340
+
+	createPlace := func(user *utils.AuthenticatedUser, name string) int64 {
+		resp, err := user.POST("/places", utils.CreatePlaceRequest{
+			Name:      name,
+			Category:  "attraction",
+			Country:   "Norway",
+			City:      "Bergen",
+			Latitude:  &latitude,
+			Longitude: &longitude,
+		})
+		if err != nil {
+			t.Fatalf("Create place request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Place creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePlaceResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create place response: %v", err)
+		}
+		return createResp.PlaceID
+	}
+	checkIn := func(user *utils.AuthenticatedUser, placeID int64, visibility string) int64 {
+		resp, err := user.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Checking in",
+			Visibility:  visibility,
+			PlaceID:     placeID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Post creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+
+	placeID := createPlace(author, name)
+	authorPostID := checkIn(author, placeID, "public")
+	visitorPostID := checkIn(visitor, placeID, "public")
+	privatePostID := checkIn(visitor, placeID, "private")
+
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	t.Run("Place Page Has Check-in Counts", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/places/%d", placeID))
+		if err != nil {
+			t.Fatalf("Get place request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get place failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var place utils.PlaceResponse
+		if err := resp.ParseJSON(&place); err != nil {
+			t.Fatalf("Failed to parse place: %v", err)
+		}
+		if place.Name != name || place.Category != "attraction" || place.City != "Bergen" {
+			t.Errorf("Unexpected place %+v", place)
+		}
+		if place.CheckInCount != 2 || place.VisitorCount != 2 {
+			t.Errorf("Expected 2 public check-ins by 2 visitors, got %d by %d", place.CheckInCount, place.VisitorCount)
+		}
+	})
+
+	t.Run("Place Posts List Visible Check-ins", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/places/%d/posts", placeID))
+		if err != nil {
+			t.Fatalf("Get place posts request failed: %v", err)
+		}
+		var posts utils.PlacePostsResponse
+		if err := resp.ParseJSON(&posts); err != nil {
+			t.Fatalf("Failed to parse place posts: %v", err)
+		}
+		if len(posts.PostsIDs) != 2 || posts.PostsIDs[0] != visitorPostID || posts.PostsIDs[1] != authorPostID {
+			t.Errorf("Expected posts %d and %d, got %v", visitorPostID, authorPostID, posts.PostsIDs)
+		}
+		for _, id := range posts.PostsIDs {
+			if id == privatePostID {
+				t.Errorf("Private post %d should not be listed anonymously", privatePostID)
+			}
+		}
+	})
+
+	t.Run("Check-in Has Place And Location", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/posts/%d", authorPostID))
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.Place == nil || post.Place.PlaceID != placeID {
+			t.Fatalf("Expected the post to be checked in at %d, got %+v", placeID, post.Place)
+		}
+		if post.Location == nil || post.Location.Latitude != latitude || post.Location.PlaceName != name {
+			t.Errorf("Expected the post to take the location of the place, got %+v", post.Location)
+		}
+	})
+
+	t.Run("Places Can Be Searched By Name And Distance", func(t *testing.T) {
+		resp, err := anonClient.GET("/places?q=" + url.QueryEscape(name))
+		if err != nil {
+			t.Fatalf("Search places request failed: %v", err)
+		}
+		var byName utils.PlacesResponse
+		if err := resp.ParseJSON(&byName); err != nil {
+			t.Fatalf("Failed to parse places: %v", err)
+		}
+		if len(byName.Places) == 0 || byName.Places[0].PlaceID != placeID {
+			t.Errorf("Expected place %d first, got %+v", placeID, byName.Places)
+		}
+
+		resp, err = anonClient.GET(fmt.Sprintf("/places?lat=%f&lng=%f&radius_km=1&category=attraction", latitude, longitude))
+		if err != nil {
+			t.Fatalf("Search places request failed: %v", err)
+		}
+		var nearby utils.PlacesResponse
+		if err := resp.ParseJSON(&nearby); err != nil {
+			t.Fatalf("Failed to parse places: %v", err)
+		}
+		found := false
+		for _, place := range nearby.Places {
+			found = found || place.PlaceID == placeID
+		}
+		if !found {
+			t.Errorf("Expected place %d near its own coordinates, got %+v", placeID, nearby.Places)
+		}
+	})
+
+	t.Run("Only Moderators Can Merge Places", func(t *testing.T) {
+		duplicateID := createPlace(visitor, name+" (duplicate)")
+		resp, err := visitor.POST(fmt.Sprintf("/places/%d/merge", duplicateID), utils.MergePlaceRequest{TargetPlaceID: placeID})
+		if err != nil {
+			t.Fatalf("Merge places request failed: %v", err)
+		}
+		if resp.StatusCode != 403 {
+			t.Errorf("Expected status 403, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Invalid Places Are Rejected", func(t *testing.T) {
+		resp, err := author.POST("/places", utils.CreatePlaceRequest{
+			Name:      "Nowhere",
+			Category:  "spaceport",
+			Latitude:  &latitude,
+			Longitude: &longitude,
+		})
+		if err != nil {
+			t.Fatalf("Create place request failed: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400 for an unknown category, got %d", resp.StatusCode)
+		}
+
+		resp, err = author.POST("/posts", utils.CreatePostRequest{ContentText: "Lost", PlaceID: 1 << 50})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400 for a missing place, got %d", resp.StatusCode)
+		}
+	})
+}
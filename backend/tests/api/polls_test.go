@@ -0,0 +1,182 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestPolls(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	createPoll := func(poll utils.PollRequest) (int, int64) {
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Where should we go next?",
+			Visibility:  "public",
+			Poll:        &poll,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if resp.IsSuccess() {
+			if err := resp.ParseJSON(&createResp); err != nil {
+				t.Fatalf("Failed to parse create post response: %v", err)
+			}
+		}
+		return resp.StatusCode, createResp.PostId
+	}
+	getPoll := func(user *utils.AuthenticatedUser, postID int64) *utils.PollResponse {
+		resp, err := user.GET(fmt.Sprintf("/posts/%d", postID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.Poll == nil {
+			t.Fatalf("Expected a poll on post %d", postID)
+		}
+		return post.Poll
+	}
+	vote := func(user *utils.AuthenticatedUser, postID int64, optionIDs ...int64) *utils.APIResponse {
+		resp, err := user.POST(fmt.Sprintf("/posts/%d/poll/vote", postID), utils.VotePollRequest{OptionIDs: optionIDs})
+		if err != nil {
+			t.Fatalf("Vote request failed: %v", err)
+		}
+		return resp
+	}
+
+	t.Run("Invalid Polls", func(t *testing.T) {
+		invalid := []utils.PollRequest{
+			{Options: []string{"Lisbon"}, ExpiresAt: expiresAt},
+			{Options: []string{"Lisbon", "lisbon "}, ExpiresAt: expiresAt},
+			{Options: []string{"Lisbon", "Porto"}, ExpiresAt: time.Now().Add(time.Minute).UTC().Format(time.RFC3339)},
+			{Options: []string{"Lisbon", "Porto"}, ExpiresAt: time.Now().Add(60 * 24 * time.Hour).UTC().Format(time.RFC3339)},
+		}
+		for _, poll := range invalid {
+			if status, _ := createPoll(poll); status != http.StatusBadRequest {
+				t.Errorf("Expected 400 for poll %+v, got %d", poll, status)
+			}
+		}
+	})
+
+	status, postID := createPoll(utils.PollRequest{Options: []string{"Lisbon", "Porto", "Faro"}, ExpiresAt: expiresAt})
+	if status != http.StatusOK {
+		t.Fatalf("Creating a post with a poll failed with status %d", status)
+	}
+	options := getPoll(alice, postID).Options
+	if len(options) != 3 || options[0].Text != "Lisbon" {
+		t.Fatalf("Expected the three options in order, got %+v", options)
+	}
+
+	t.Run("Results Hidden Until Voting", func(t *testing.T) {
+		poll := getPoll(bob, postID)
+		if poll.ResultsVisible || poll.Closed {
+			t.Errorf("Expected hidden results on an open poll, got %+v", poll)
+		}
+	})
+
+	t.Run("Vote And Change Vote", func(t *testing.T) {
+		if resp := vote(bob, postID, options[0].OptionID, options[1].OptionID); resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for two options in a single choice poll, got %d", resp.StatusCode)
+		}
+
+		resp := vote(bob, postID, options[0].OptionID)
+		if !resp.IsSuccess() {
+			t.Fatalf("Vote failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var poll utils.PollResponse
+		if err := resp.ParseJSON(&poll); err != nil {
+			t.Fatalf("Failed to parse poll: %v", err)
+		}
+		if !poll.ResultsVisible || poll.VotersCount != 1 || poll.Options[0].VotesCount != 1 {
+			t.Errorf("Expected the vote to be counted, got %+v", poll)
+		}
+		if len(poll.Options[0].VoterIDs) != 1 || poll.Options[0].VoterIDs[0] != int64(bob.UserID) {
+			t.Errorf("Expected bob among the voters of a public poll, got %+v", poll.Options[0])
+		}
+
+		if resp := vote(bob, postID, options[1].OptionID); !resp.IsSuccess() {
+			t.Fatalf("Changing the vote failed with status %d", resp.StatusCode)
+		}
+		poll = *getPoll(bob, postID)
+		if poll.VotersCount != 1 || poll.Options[0].VotesCount != 0 || poll.Options[1].VotesCount != 1 {
+			t.Errorf("Expected the vote to move to the second option, got %+v", poll)
+		}
+	})
+
+	t.Run("Concurrent Votes Count Once", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(option int64) {
+				defer wg.Done()
+				resp, err := bob.POST(fmt.Sprintf("/posts/%d/poll/vote", postID), utils.VotePollRequest{OptionIDs: []int64{option}})
+				if err != nil || !resp.IsSuccess() {
+					t.Errorf("Concurrent vote failed: %v", err)
+				}
+			}(options[i%3].OptionID)
+		}
+		wg.Wait()
+
+		poll := getPoll(bob, postID)
+		total := int64(0)
+		for _, option := range poll.Options {
+			total += option.VotesCount
+		}
+		if poll.VotersCount != 1 || total != 1 || len(poll.MyOptionIDs) != 1 {
+			t.Errorf("Expected a single vote left, got %+v", poll)
+		}
+	})
+
+	t.Run("Anonymous Multiple Choice Poll", func(t *testing.T) {
+		status, id := createPoll(utils.PollRequest{
+			Options:        []string{"Hiking", "Surfing", "Museums"},
+			ExpiresAt:      expiresAt,
+			MultipleChoice: true,
+			Anonymous:      true,
+		})
+		if status != http.StatusOK {
+			t.Fatalf("Creating an anonymous poll failed with status %d", status)
+		}
+		choices := getPoll(alice, id).Options
+
+		resp := vote(bob, id, choices[0].OptionID, choices[2].OptionID)
+		if !resp.IsSuccess() {
+			t.Fatalf("Vote failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		poll := getPoll(alice, id)
+		if poll.VotersCount != 1 || poll.Options[0].VotesCount != 1 || poll.Options[2].VotesCount != 1 {
+			t.Errorf("Expected both choices to be counted, got %+v", poll)
+		}
+		for _, option := range poll.Options {
+			if len(option.VoterIDs) != 0 {
+				t.Errorf("Expected no voters on an anonymous poll, got %+v", option)
+			}
+		}
+	})
+
+	t.Run("Posts Without Poll", func(t *testing.T) {
+		id, err := alice.CreateTestPost("No poll here", true)
+		if err != nil {
+			t.Fatalf("Failed to create post: %v", err)
+		}
+		if resp := vote(bob, int64(id), 1); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when voting on a post without poll, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestPostMedia(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+
+	media := []utils.Media{
+		{
+			URL:      "https://example.com/porto/ribeira.jpg",
+			MimeType: "image/jpeg",
+			Width:    1080,
+			Height:   1350,
+			AltText:  "Colourful houses along the Douro",
+			Blurhash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj",
+		},
+		{
+			URL:      "https://example.com/porto/tram.mp4",
+			MimeType: "video/mp4",
+		},
+	}
+
+	createPost := func(req utils.CreatePostRequest) int64 {
+		resp, err := author.POST("/posts", req)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create post failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+	getPost := func(postID int64) utils.PostDetailInfoResponse {
+		resp, err := author.GET(fmt.Sprintf("/posts/%d", postID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		return post
+	}
+
+	postID := createPost(utils.CreatePostRequest{
+		ContentText: "A day in Porto",
+		Visibility:  "public",
+		Media:       media,
+	})
+
+	t.Run("Media Keep Their Order And Metadata", func(t *testing.T) {
+		post := getPost(postID)
+		if len(post.Media) != 2 {
+			t.Fatalf("Expected 2 media, got %d", len(post.Media))
+		}
+		if post.Media[0] != media[0] || post.Media[1] != media[1] {
+			t.Errorf("Expected media %v, got %v", media, post.Media)
+		}
+		if len(post.ContentImagePath) != 2 || post.ContentImagePath[0] != media[0].URL {
+			t.Errorf("Expected content_image_path to list the media urls, got %v", post.ContentImagePath)
+		}
+	})
+
+	t.Run("Legacy Image Paths", func(t *testing.T) {
+		legacyID := createPost(utils.CreatePostRequest{
+			ContentText:      "An older client",
+			Visibility:       "public",
+			ContentImagePath: []string{"https://example.com/porto/bridge.jpg"},
+		})
+		post := getPost(legacyID)
+		if len(post.Media) != 1 || post.Media[0].URL != "https://example.com/porto/bridge.jpg" {
+			t.Errorf("Expected the image path to become a media, got %v", post.Media)
+		}
+
+		textID := createPost(utils.CreatePostRequest{ContentText: "Just text", Visibility: "public"})
+		post = getPost(textID)
+		if len(post.Media) != 0 || len(post.ContentImagePath) != 0 {
+			t.Errorf("Expected no media, got %v and %v", post.Media, post.ContentImagePath)
+		}
+	})
+
+	t.Run("Invalid Media", func(t *testing.T) {
+		tooMany := make([]utils.Media, 11)
+		for i := range tooMany {
+			tooMany[i] = utils.Media{URL: fmt.Sprintf("https://example.com/porto/%d.jpg", i)}
+		}
+		for name, invalid := range map[string][]utils.Media{
+			"too many":  tooMany,
+			"no url":    {{MimeType: "image/png"}},
+			"not media": {{URL: "https://example.com/notes.pdf", MimeType: "application/pdf"}},
+		} {
+			resp, err := author.POST("/posts", utils.CreatePostRequest{
+				ContentText: "Invalid media",
+				Visibility:  "public",
+				Media:       invalid,
+			})
+			if err != nil {
+				t.Fatalf("Create post request failed: %v", err)
+			}
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 for %s, got %d", name, resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("Edit Replaces Media", func(t *testing.T) {
+		reordered := []utils.Media{media[1], media[0]}
+		resp, err := author.PUT(fmt.Sprintf("/posts/%d", postID), utils.EditPostRequest{Media: &reordered})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		post := getPost(postID)
+		if len(post.Media) != 2 || post.Media[0] != media[1] {
+			t.Errorf("Expected the media to be reordered, got %v", post.Media)
+		}
+
+		empty := []utils.Media{}
+		resp, err = author.PUT(fmt.Sprintf("/posts/%d", postID), utils.EditPostRequest{Media: &empty})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		if post := getPost(postID); len(post.Media) != 0 {
+			t.Errorf("Expected an empty media list to remove the media, got %v", post.Media)
+		}
+	})
+
+	t.Run("Revisions Keep Their Media", func(t *testing.T) {
+		resp, err := author.GET(fmt.Sprintf("/posts/%d/revisions", postID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get revisions failed: %v", err)
+		}
+		var revisions utils.PostRevisionsResponse
+		if err := resp.ParseJSON(&revisions); err != nil {
+			t.Fatalf("Failed to parse revisions: %v", err)
+		}
+		if len(revisions.Revisions) < 2 {
+			t.Fatalf("Expected the edits to be recorded as revisions, got %d", len(revisions.Revisions))
+		}
+		if original := revisions.Revisions[0]; len(original.Media) != 2 || original.Media[0] != media[0] {
+			t.Errorf("Expected the first revision to keep the original media, got %v", original.Media)
+		}
+	})
+}
+
+func TestUnusedMediaKeepProfilePictures(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+
+	upload := func(name string) utils.UploadBinaryResponse {
+		resp, err := author.Upload("/binaries/upload", name, "image/png", []byte("not really a png: "+name))
+		if err != nil {
+			t.Fatalf("Upload request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Skipf("Binary storage not available: status %d, %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var uploaded utils.UploadBinaryResponse
+		if err := resp.ParseJSON(&uploaded); err != nil {
+			t.Fatalf("Failed to parse upload response: %v", err)
+		}
+		return uploaded
+	}
+	stored := func(uploaded utils.UploadBinaryResponse) bool {
+		resp, err := author.GET("/binaries/" + base64.URLEncoding.EncodeToString([]byte(uploaded.Data.Key)))
+		if err != nil {
+			t.Fatalf("Download request failed: %v", err)
+		}
+		return resp.IsSuccess()
+	}
+	postAndDeleteStory := func(uploaded utils.UploadBinaryResponse) {
+		resp, err := author.POST("/stories", utils.CreateStoryRequest{
+			Media: utils.Media{URL: uploaded.Data.URL, MimeType: "image/png"},
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create story failed: %v", err)
+		}
+		var createResp utils.CreateStoryResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create story response: %v", err)
+		}
+		resp, err = author.DELETE(fmt.Sprintf("/stories/%d", createResp.StoryID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete story failed: %v", err)
+		}
+	}
+
+	photo := upload("photo.png")
+	postAndDeleteStory(photo)
+	if stored(photo) {
+		t.Skip("Media of deleted stories are not removed from the binary storage in this environment")
+	}
+
+	avatar := upload("avatar.png")
+	resp, err := author.PUT("/users/edit", utils.EditUserRequest{ProfilePicture: avatar.Data.URL})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Edit user failed: %v", err)
+	}
+	postAndDeleteStory(avatar)
+	if !stored(avatar) {
+		t.Errorf("Expected the profile picture to be kept once the story using it is deleted")
+	}
+}
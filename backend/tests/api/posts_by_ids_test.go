@@ -0,0 +1,124 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestGetPostsByIds(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+
+	createPost := func(visibility string) int64 {
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Sunrise over the Alfama",
+			Visibility:  visibility,
+			Media:       []utils.Media{{URL: "https://example.com/lisbon/alfama.jpg", MimeType: "image/jpeg"}},
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create post failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+	getPosts := func(viewer *utils.AuthenticatedUser, ids ...int64) utils.PostsResponse {
+		parts := make([]string, 0, len(ids))
+		for _, id := range ids {
+			parts = append(parts, fmt.Sprint(id))
+		}
+		resp, err := viewer.GET("/posts?ids=" + strings.Join(parts, ","))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get posts failed: %v", err)
+		}
+		var posts utils.PostsResponse
+		if err := resp.ParseJSON(&posts); err != nil {
+			t.Fatalf("Failed to parse posts: %v", err)
+		}
+		return posts
+	}
+
+	first := createPost("public")
+	second := createPost("public")
+	private := createPost("private")
+
+	resp, err := bob.POST(fmt.Sprintf("/posts/%d/likes", second), nil)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Like post failed: %v", err)
+	}
+	resp, err = bob.POST(fmt.Sprintf("/posts/%d", second), utils.CreatePostCommentRequest{ContentText: "Beautiful!"})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Comment post failed: %v", err)
+	}
+
+	t.Run("Keeps The Requested Order", func(t *testing.T) {
+		posts := getPosts(bob, second, first, second).Posts
+		if len(posts) != 2 || posts[0].PostID != second || posts[1].PostID != first {
+			t.Fatalf("Expected posts %d then %d, got %+v", second, first, posts)
+		}
+		if posts[0].Author == nil || posts[0].Author.UserID != int64(alice.UserID) {
+			t.Errorf("Expected alice as the author, got %+v", posts[0].Author)
+		}
+		if len(posts[0].Media) != 1 || posts[0].Media[0].MimeType != "image/jpeg" {
+			t.Errorf("Expected the media of the post, got %+v", posts[0].Media)
+		}
+	})
+
+	t.Run("Counts And Viewer Flags", func(t *testing.T) {
+		posts := getPosts(bob, first, second).Posts
+		if len(posts) != 2 {
+			t.Fatalf("Expected 2 posts, got %d", len(posts))
+		}
+		if posts[1].LikeCount != 1 || posts[1].CommentCount != 1 || !posts[1].LikedByMe {
+			t.Errorf("Expected 1 like by bob and 1 comment, got %+v", posts[1])
+		}
+		if posts[0].LikeCount != 0 || posts[0].LikedByMe {
+			t.Errorf("Expected no likes on the first post, got %+v", posts[0])
+		}
+
+		posts = getPosts(alice, second).Posts
+		if len(posts) != 1 || posts[0].LikedByMe {
+			t.Errorf("Expected alice not to have liked the post, got %+v", posts)
+		}
+	})
+
+	t.Run("Skips Hidden Posts", func(t *testing.T) {
+		if posts := getPosts(bob, private, first).Posts; len(posts) != 1 || posts[0].PostID != first {
+			t.Errorf("Expected the private post to be left out, got %+v", posts)
+		}
+		if posts := getPosts(alice, private).Posts; len(posts) != 1 {
+			t.Errorf("Expected alice to read her private post, got %+v", posts)
+		}
+		if posts := getPosts(bob, 999999999).Posts; len(posts) != 0 {
+			t.Errorf("Expected missing posts to be left out, got %+v", posts)
+		}
+	})
+
+	t.Run("Invalid Ids", func(t *testing.T) {
+		tooMany := make([]string, 101)
+		for i := range tooMany {
+			tooMany[i] = fmt.Sprint(i + 1)
+		}
+		for _, ids := range []string{"", "1,abc", strings.Join(tooMany, ",")} {
+			resp, err := bob.GET("/posts?ids=" + ids)
+			if err != nil {
+				t.Fatalf("Get posts request failed: %v", err)
+			}
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 for ids %.20q, got %d", ids, resp.StatusCode)
+			}
+		}
+	})
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -300,6 +301,95 @@ func TestGetPostDetails(t *testing.T) {
 	})
 }
 
+func TestPostVisibility(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	follower, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create follower: %v", err)
+	}
+	stranger, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create stranger: %v", err)
+	}
+
+	resp, err := follower.POST(fmt.Sprintf("/friends/%d", author.UserID), nil)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Failed to follow author: %v", err)
+	}
+
+	createResp, err := author.POST("/posts", utils.CreatePostRequest{
+		ContentText: "Followers-only travel notes",
+		Visibility:  "followers",
+	})
+	if err != nil {
+		t.Fatalf("Create followers-only post request failed: %v", err)
+	}
+	if !createResp.IsSuccess() {
+		t.Fatalf("Create followers-only post failed with status %d: %s", createResp.StatusCode, createResp.GetStringBody())
+	}
+	var created utils.CreatePostResponse
+	if err := createResp.ParseJSON(&created); err != nil {
+		t.Fatalf("Failed to parse create post response: %v", err)
+	}
+	postPath := fmt.Sprintf("/posts/%d", created.PostId)
+
+	t.Run("Author Can Read Own Post", func(t *testing.T) {
+		resp, err := author.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Expected author to read own post, got status %d", resp.StatusCode)
+		}
+
+		var postDetails utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&postDetails); err != nil {
+			t.Fatalf("Failed to parse post details response: %v", err)
+		}
+		if postDetails.Visibility != "followers" {
+			t.Errorf("Expected visibility 'followers', got '%s'", postDetails.Visibility)
+		}
+	})
+
+	t.Run("Follower Can Read Post", func(t *testing.T) {
+		resp, err := follower.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Errorf("Expected follower to read followers-only post, got status %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Stranger Cannot Read Post", func(t *testing.T) {
+		resp, err := stranger.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Error("Expected followers-only post to be hidden from non-followers")
+		}
+	})
+
+	t.Run("Anonymous Viewer Cannot Read Post", func(t *testing.T) {
+		anonClient, err := utils.NewAPIClient()
+		if err != nil {
+			t.Fatalf("Failed to create anonymous client: %v", err)
+		}
+
+		resp, err := anonClient.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Error("Expected followers-only post to be hidden from anonymous viewers")
+		}
+	})
+}
+
 func TestEditPost(t *testing.T) {
 	client, err := utils.NewAPIClient()
 	if err != nil {
@@ -392,6 +482,175 @@ func TestEditPost(t *testing.T) {
 	})
 }
 
+func TestPostRevisions(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	other, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create other user: %v", err)
+	}
+
+	postID, err := author.CreateTestPost("Original content", true)
+	if err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	postPath := fmt.Sprintf("/posts/%d", postID)
+
+	edited := "Edited content"
+	resp, err := author.PUT(postPath, utils.EditPostRequest{ContentText: &edited})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Failed to edit post: %v", err)
+	}
+
+	t.Run("Edited Post Has Edited At", func(t *testing.T) {
+		resp, err := author.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post details response: %v", err)
+		}
+		if post.EditedAt == "" {
+			t.Error("Expected edited_at to be set on an edited post")
+		}
+	})
+
+	t.Run("Author Can List Revisions", func(t *testing.T) {
+		resp, err := author.GET(postPath + "/revisions")
+		if err != nil {
+			t.Fatalf("Get revisions request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get revisions failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var revisions utils.PostRevisionsResponse
+		if err := resp.ParseJSON(&revisions); err != nil {
+			t.Fatalf("Failed to parse revisions response: %v", err)
+		}
+		if len(revisions.Revisions) != 2 {
+			t.Fatalf("Expected 2 revisions, got %d", len(revisions.Revisions))
+		}
+		if revisions.Revisions[0].ContentText != "Original content" || revisions.Revisions[1].ContentText != edited {
+			t.Errorf("Unexpected revisions: %+v", revisions.Revisions)
+		}
+	})
+
+	t.Run("Other Users Cannot List Revisions", func(t *testing.T) {
+		resp, err := other.GET(postPath + "/revisions")
+		if err != nil {
+			t.Fatalf("Get revisions request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Error("Expected revisions to be hidden from other users")
+		}
+	})
+
+	t.Run("Revert To First Revision", func(t *testing.T) {
+		resp, err := author.POST(postPath+"/revisions/1/revert", nil)
+		if err != nil {
+			t.Fatalf("Revert post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Revert post failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = author.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post details response: %v", err)
+		}
+		if post.ContentText != "Original content" {
+			t.Errorf("Expected reverted content, got '%s'", post.ContentText)
+		}
+	})
+
+	t.Run("Concurrent Edits Keep The Pin", func(t *testing.T) {
+		pinnedID, err := author.CreateTestPost("Pinned while edited", true)
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+		pinnedPath := fmt.Sprintf("/posts/%d", pinnedID)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				content := fmt.Sprintf("Edit %d", i)
+				resp, err := author.PUT(pinnedPath, utils.EditPostRequest{ContentText: &content})
+				if err != nil || !resp.IsSuccess() {
+					t.Errorf("Concurrent edit failed: %v", err)
+				}
+			}(i)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := author.POST(pinnedPath+"/pin", nil)
+			if err != nil || !resp.IsSuccess() {
+				t.Errorf("Concurrent pin failed: %v", err)
+			}
+		}()
+		wg.Wait()
+
+		resp, err := author.GET(fmt.Sprintf("/users/%d", author.UserID))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get user failed: %v", err)
+		}
+		var user utils.UserDetailInfoResponse
+		if err := resp.ParseJSON(&user); err != nil {
+			t.Fatalf("Failed to parse user: %v", err)
+		}
+		if !containsID(user.PinnedPostIds, pinnedID) {
+			t.Errorf("Expected the edits to keep post %d pinned, got %v", pinnedID, user.PinnedPostIds)
+		}
+	})
+
+	t.Run("Concurrent Edits Keep The Post Trashed", func(t *testing.T) {
+		trashedID, err := author.CreateTestPost("Trashed while edited", true)
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+		trashedPath := fmt.Sprintf("/posts/%d", trashedID)
+
+		// The edits racing the delete may fail, the post must end up in the trash either way
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				content := fmt.Sprintf("Edit %d", i)
+				if _, err := author.PUT(trashedPath, utils.EditPostRequest{ContentText: &content}); err != nil {
+					t.Errorf("Concurrent edit request failed: %v", err)
+				}
+			}(i)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := author.DELETE(trashedPath)
+			if err != nil || !resp.IsSuccess() {
+				t.Errorf("Concurrent delete failed: %v", err)
+			}
+		}()
+		wg.Wait()
+
+		resp, err := author.GET(trashedPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Errorf("Expected the edits to leave the deleted post in the trash")
+		}
+	})
+}
+
 func TestCommentOnPost(t *testing.T) {
 	client, err := utils.NewAPIClient()
 	if err != nil {
@@ -636,6 +895,90 @@ func TestDeletePost(t *testing.T) {
 	})
 }
 
+func TestPostTrash(t *testing.T) {
+	owner, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create owner: %v", err)
+	}
+	other, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create other user: %v", err)
+	}
+
+	postID, err := owner.CreateTestPost("Test post for the trash bin", true)
+	if err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	postPath := fmt.Sprintf("/posts/%d", postID)
+
+	resp, err := owner.DELETE(postPath)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Failed to delete post: %v", err)
+	}
+
+	t.Run("Deleted Post Is Hidden", func(t *testing.T) {
+		resp, err := owner.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Error("Expected trashed post to be hidden")
+		}
+	})
+
+	t.Run("Deleted Post Is Listed In Trash", func(t *testing.T) {
+		resp, err := owner.GET("/posts/trash")
+		if err != nil {
+			t.Fatalf("Get trash request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get trash failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		var trash utils.TrashedPostsResponse
+		if err := resp.ParseJSON(&trash); err != nil {
+			t.Fatalf("Failed to parse trash response: %v", err)
+		}
+		found := false
+		for _, post := range trash.Posts {
+			if int64(post.PostID) == postID {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected post %d in trash, got %+v", postID, trash.Posts)
+		}
+	})
+
+	t.Run("Only Owner Can Restore", func(t *testing.T) {
+		resp, err := other.POST(postPath+"/restore", nil)
+		if err != nil {
+			t.Fatalf("Restore post request failed: %v", err)
+		}
+		if resp.IsSuccess() {
+			t.Error("Expected restore by another user to fail")
+		}
+	})
+
+	t.Run("Restore Post", func(t *testing.T) {
+		resp, err := owner.POST(postPath+"/restore", nil)
+		if err != nil {
+			t.Fatalf("Restore post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Restore post failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = owner.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Errorf("Expected restored post to be readable, got status %d", resp.StatusCode)
+		}
+	})
+}
+
 func TestPostLifecycle(t *testing.T) {
 	// Create separate clients for each user to avoid session conflicts
 	client1, err := utils.NewAPIClient()
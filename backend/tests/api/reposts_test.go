@@ -0,0 +1,254 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestReposts(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+	carol, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create carol: %v", err)
+	}
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	originalID, err := alice.CreateTestPost("Sunrise over Ha Long Bay", true)
+	if err != nil {
+		t.Fatalf("Failed to create post: %v", err)
+	}
+	originalPath := fmt.Sprintf("/posts/%d", originalID)
+	repostPath := originalPath + "/repost"
+
+	getPost := func(client interface {
+		GET(path string) (*utils.APIResponse, error)
+	}, path string) (int, utils.PostDetailInfoResponse) {
+		resp, err := client.GET(path)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if resp.IsSuccess() {
+			if err := resp.ParseJSON(&post); err != nil {
+				t.Fatalf("Failed to parse post: %v", err)
+			}
+		}
+		return resp.StatusCode, post
+	}
+	repost := func(user *utils.AuthenticatedUser, path string) (int, int64) {
+		resp, err := user.POST(path, nil)
+		if err != nil {
+			t.Fatalf("Repost request failed: %v", err)
+		}
+		var repostResp utils.RepostPostResponse
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+			if err := resp.ParseJSON(&repostResp); err != nil {
+				t.Fatalf("Failed to parse repost response: %v", err)
+			}
+		}
+		return resp.StatusCode, repostResp.RepostID
+	}
+
+	status, repostID := repost(bob, repostPath)
+	if status != http.StatusOK || repostID == 0 {
+		t.Fatalf("Repost failed with status %d", status)
+	}
+	repostDetailPath := fmt.Sprintf("/posts/%d", repostID)
+
+	t.Run("Reposting Twice Returns The Existing Repost", func(t *testing.T) {
+		status, id := repost(bob, repostPath)
+		if status != http.StatusConflict || id != repostID {
+			t.Errorf("Expected 409 with repost %d, got %d with %d", repostID, status, id)
+		}
+
+		// Reposting the repost reposts its original
+		status, id = repost(bob, repostDetailPath+"/repost")
+		if status != http.StatusConflict || id != repostID {
+			t.Errorf("Expected 409 with repost %d when reposting the repost, got %d with %d", repostID, status, id)
+		}
+	})
+
+	t.Run("Only Public Posts Can Be Shared", func(t *testing.T) {
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Just for me",
+			Visibility:  "private",
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to create private post: %v", err)
+		}
+		var privateResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&privateResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		privateID := privateResp.PostId
+		status, _ := repost(alice, fmt.Sprintf("/posts/%d/repost", privateID))
+		if status != http.StatusForbidden {
+			t.Errorf("Expected 403 when reposting a private post, got %d", status)
+		}
+
+		resp, err = carol.POST("/posts", utils.CreatePostRequest{
+			ContentText:   "Can I see this?",
+			QuoteOfPostID: privateID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 when quoting a private post, got %d", resp.StatusCode)
+		}
+	})
+
+	var quoteID int64
+	t.Run("Quotes And Counts", func(t *testing.T) {
+		// Quoting the repost quotes its original
+		resp, err := carol.POST("/posts", utils.CreatePostRequest{
+			ContentText:   "Adding this to my bucket list",
+			QuoteOfPostID: repostID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Quote failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		quoteID = createResp.PostId
+
+		status, quote := getPost(anonClient, fmt.Sprintf("/posts/%d", quoteID))
+		if status != http.StatusOK || quote.QuoteOfPostID != originalID {
+			t.Errorf("Expected a quote of %d, got status %d and %+v", originalID, status, quote)
+		}
+
+		status, reposted := getPost(anonClient, repostDetailPath)
+		if status != http.StatusOK || reposted.RepostOfPostID != originalID || reposted.ContentText != "" {
+			t.Errorf("Expected a repost of %d, got status %d and %+v", originalID, status, reposted)
+		}
+
+		_, original := getPost(bob, originalPath)
+		if original.RepostCount != 1 || original.QuoteCount != 1 || !original.RepostedByMe {
+			t.Errorf("Expected 1 repost by bob and 1 quote, got %+v", original)
+		}
+	})
+
+	t.Run("Reposts Follow The Visibility Of The Original", func(t *testing.T) {
+		hidden := false
+		resp, err := alice.PUT(originalPath, utils.EditPostRequest{Visible: &hidden})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		if status, _ := getPost(anonClient, repostDetailPath); status != http.StatusBadRequest {
+			t.Errorf("Expected the repost of a private post to be hidden, got %d", status)
+		}
+		if status, _ := getPost(bob, repostDetailPath); status != http.StatusBadRequest {
+			t.Errorf("Expected the repost of a private post to be hidden from its author, got %d", status)
+		}
+
+		visible := true
+		resp, err = alice.PUT(originalPath, utils.EditPostRequest{Visible: &visible})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Edit post failed: %v", err)
+		}
+		if status, _ := getPost(anonClient, repostDetailPath); status != http.StatusOK {
+			t.Errorf("Expected the repost to come back with its original, got %d", status)
+		}
+	})
+
+	t.Run("Undo Repost", func(t *testing.T) {
+		resp, err := bob.DELETE(repostPath)
+		if err != nil {
+			t.Fatalf("Undo repost request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Undo repost failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = bob.DELETE(repostPath)
+		if err != nil {
+			t.Fatalf("Undo repost request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 when undoing a missing repost, got %d", resp.StatusCode)
+		}
+
+		_, original := getPost(bob, originalPath)
+		if original.RepostCount != 0 || original.RepostedByMe {
+			t.Errorf("Expected no repost left, got %+v", original)
+		}
+	})
+
+	t.Run("Deleted Originals Take Their Reposts Along", func(t *testing.T) {
+		status, id := repost(bob, repostPath)
+		if status != http.StatusOK {
+			t.Fatalf("Repost failed with status %d", status)
+		}
+
+		resp, err := alice.DELETE(originalPath)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete post failed: %v", err)
+		}
+
+		if status, _ := getPost(anonClient, fmt.Sprintf("/posts/%d", id)); status != http.StatusBadRequest {
+			t.Errorf("Expected the repost of a deleted post to vanish, got %d", status)
+		}
+		if status, _ := getPost(anonClient, fmt.Sprintf("/posts/%d", quoteID)); status != http.StatusOK {
+			t.Errorf("Expected the quote to outlive the quoted post, got %d", status)
+		}
+	})
+	t.Run("Concurrent Reposts Create One Repost", func(t *testing.T) {
+		sharedID, err := alice.CreateTestPost("Reposted with a double tap", true)
+		if err != nil {
+			t.Fatalf("Failed to create post: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		statuses := make(map[int]int)
+		ids := make(map[int64]bool)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := carol.POST(fmt.Sprintf("/posts/%d/repost", sharedID), nil)
+				if err != nil {
+					t.Errorf("Concurrent repost failed: %v", err)
+					return
+				}
+				var repostResp utils.RepostPostResponse
+				if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+					if err := resp.ParseJSON(&repostResp); err != nil {
+						t.Errorf("Failed to parse repost response: %v", err)
+					}
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				statuses[resp.StatusCode]++
+				ids[repostResp.RepostID] = true
+			}()
+		}
+		wg.Wait()
+
+		if statuses[http.StatusOK] != 1 || statuses[http.StatusConflict] != 4 {
+			t.Errorf("Expected one repost and 4 conflicts, got %v", statuses)
+		}
+		if len(ids) != 1 || ids[0] {
+			t.Errorf("Expected every response to carry the same repost, got %v", ids)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestScheduledPosts(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	schedule := func(text string, publishAt time.Time) (int, int64) {
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: text,
+			PublishAt:   publishAt.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if resp.IsSuccess() {
+			if err := resp.ParseJSON(&createResp); err != nil {
+				t.Fatalf("Failed to parse create post response: %v", err)
+			}
+		}
+		return resp.StatusCode, createResp.PostId
+	}
+	getScheduled := func() []utils.ScheduledPostResponse {
+		resp, err := alice.GET("/posts/scheduled")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get scheduled posts failed: %v", err)
+		}
+		var scheduled utils.ScheduledPostsResponse
+		if err := resp.ParseJSON(&scheduled); err != nil {
+			t.Fatalf("Failed to parse scheduled posts: %v", err)
+		}
+		return scheduled.Posts
+	}
+
+	t.Run("Publish Time Must Be In The Future", func(t *testing.T) {
+		if status, _ := schedule("Too late", time.Now().Add(-time.Hour)); status != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a publish_at in the past, got %d", status)
+		}
+	})
+
+	status, postID := schedule("Landing in Lisbon tomorrow!", time.Now().Add(time.Hour))
+	if status != http.StatusOK {
+		t.Fatalf("Scheduling a post failed with status %d", status)
+	}
+	postPath := fmt.Sprintf("/posts/%d", postID)
+
+	t.Run("Scheduled Posts Stay Hidden", func(t *testing.T) {
+		resp, err := anonClient.GET(postPath)
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected a scheduled post to be hidden, got %d", resp.StatusCode)
+		}
+
+		resp, err = alice.GET(postPath)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Expected the author to read the scheduled post: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.PublishAt == "" {
+			t.Errorf("Expected publish_at on a scheduled post")
+		}
+
+		scheduled := getScheduled()
+		if len(scheduled) != 1 || scheduled[0].PostID != postID {
+			t.Errorf("Expected the post in the scheduled posts, got %+v", scheduled)
+		}
+	})
+
+	t.Run("Reschedule", func(t *testing.T) {
+		path := postPath + "/schedule"
+		resp, err := alice.PUT(path, utils.ReschedulePostRequest{PublishAt: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)})
+		if err != nil {
+			t.Fatalf("Reschedule request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 when rescheduling to the past, got %d", resp.StatusCode)
+		}
+
+		publishAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+		resp, err = alice.PUT(path, utils.ReschedulePostRequest{PublishAt: publishAt.Format(time.RFC3339)})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Reschedule failed: %v", err)
+		}
+		scheduled := getScheduled()
+		if len(scheduled) != 1 {
+			t.Fatalf("Expected one scheduled post, got %+v", scheduled)
+		}
+		if got, err := time.Parse(time.RFC3339, scheduled[0].PublishAt); err != nil || !got.Equal(publishAt) {
+			t.Errorf("Expected publish_at %s, got %s", publishAt, scheduled[0].PublishAt)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		path := postPath + "/schedule"
+		resp, err := alice.DELETE(path)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Cancel failed: %v", err)
+		}
+		if scheduled := getScheduled(); len(scheduled) != 0 {
+			t.Errorf("Expected no scheduled post left, got %+v", scheduled)
+		}
+
+		resp, err = alice.GET("/posts/trash")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get trash failed: %v", err)
+		}
+		var trash utils.TrashedPostsResponse
+		if err := resp.ParseJSON(&trash); err != nil {
+			t.Fatalf("Failed to parse trash: %v", err)
+		}
+		found := false
+		for _, post := range trash.Posts {
+			found = found || int64(post.PostID) == postID
+		}
+		if !found {
+			t.Errorf("Expected the cancelled post in the trash")
+		}
+	})
+
+	t.Run("Rescheduling Moves The Poll", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		resp, err := alice.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Which beach tomorrow?",
+			PublishAt:   publishAt.Format(time.RFC3339),
+			Poll: &utils.PollRequest{
+				Options:   []string{"Comporta", "Arrifana"},
+				ExpiresAt: publishAt.Add(24 * time.Hour).Format(time.RFC3339),
+			},
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Scheduling a post with a poll failed: %v", err)
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		path := fmt.Sprintf("/posts/%d", createResp.PostId)
+
+		// Each reschedule moves the poll by the change of its own publish time
+		var wg sync.WaitGroup
+		for i := 2; i <= 5; i++ {
+			wg.Add(1)
+			go func(hours int) {
+				defer wg.Done()
+				resp, err := alice.PUT(path+"/schedule", utils.ReschedulePostRequest{
+					PublishAt: publishAt.Add(time.Duration(hours) * time.Hour).Format(time.RFC3339),
+				})
+				if err != nil || !resp.IsSuccess() {
+					t.Errorf("Concurrent reschedule failed: %v", err)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		resp, err = alice.GET(path)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get post failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.Poll == nil {
+			t.Fatalf("Expected a poll on the rescheduled post")
+		}
+		published, err := time.Parse(time.RFC3339, post.PublishAt)
+		if err != nil {
+			t.Fatalf("Failed to parse publish_at %q: %v", post.PublishAt, err)
+		}
+		expires, err := time.Parse(time.RFC3339, post.Poll.ExpiresAt)
+		if err != nil {
+			t.Fatalf("Failed to parse expires_at %q: %v", post.Poll.ExpiresAt, err)
+		}
+		if open := expires.Sub(published); open != 24*time.Hour {
+			t.Errorf("Expected the poll to stay open 24 hours after publishing, got %s", open)
+		}
+	})
+
+	t.Run("Scheduler Publishes Due Posts", func(t *testing.T) {
+		status, id := schedule("Boarding now", time.Now().Add(2*time.Second))
+		if status != http.StatusOK {
+			t.Fatalf("Scheduling a post failed with status %d", status)
+		}
+
+		// The scheduler runs every 30 seconds
+		deadline := time.Now().Add(45 * time.Second)
+		for {
+			resp, err := anonClient.GET(fmt.Sprintf("/posts/%d", id))
+			if err != nil {
+				t.Fatalf("Get post request failed: %v", err)
+			}
+			if resp.IsSuccess() {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected the post to be published, still got %d", resp.StatusCode)
+			}
+			time.Sleep(time.Second)
+		}
+
+		resp, err := alice.PUT(fmt.Sprintf("/posts/%d/schedule", id), utils.ReschedulePostRequest{
+			PublishAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			t.Fatalf("Reschedule request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusConflict {
+			t.Errorf("Expected 409 when rescheduling a published post, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestSearch(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+
+	// Use a unique word so earlier runs don't show up in the results
+	word := fmt.Sprintf("fjord%d", time.Now().UnixNano())
+
+	bestPostID, err := author.CreateTestPost(fmt.Sprintf("%s %s %s, the best %s of the trip", word, word, word, word), true)
+	if err != nil {
+		t.Fatalf("Failed to create test post: %v", err)
+	}
+	var otherPostIDs []int64
+	for i := 0; i < 2; i++ {
+		postID, err := author.CreateTestPost(fmt.Sprintf("Day %d: hiking near the %s", i+1, word), true)
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+		otherPostIDs = append(otherPostIDs, postID)
+	}
+	privatePostID, err := author.CreateTestPost(fmt.Sprintf("Private notes about the %s", word), false)
+	if err != nil {
+		t.Fatalf("Failed to create private test post: %v", err)
+	}
+
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	t.Run("Posts Are Ranked And Paginated", func(t *testing.T) {
+		resp, err := anonClient.GET(fmt.Sprintf("/search?q=%s&type=posts&limit=2", word))
+		if err != nil {
+			t.Fatalf("Search request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Search failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var firstPage utils.SearchResponse
+		if err := resp.ParseJSON(&firstPage); err != nil {
+			t.Fatalf("Failed to parse search response: %v", err)
+		}
+		if len(firstPage.PostsIDs) != 2 || firstPage.NextCursor == "" {
+			t.Fatalf("Expected a full first page with a cursor, got %+v", firstPage)
+		}
+		if int64(firstPage.PostsIDs[0]) != bestPostID {
+			t.Errorf("Expected best match %d first, got %d", bestPostID, firstPage.PostsIDs[0])
+		}
+
+		resp, err = anonClient.GET(fmt.Sprintf("/search?q=%s&type=posts&limit=2&cursor=%s", word, url.QueryEscape(firstPage.NextCursor)))
+		if err != nil {
+			t.Fatalf("Search request failed: %v", err)
+		}
+		var secondPage utils.SearchResponse
+		if err := resp.ParseJSON(&secondPage); err != nil {
+			t.Fatalf("Failed to parse search response: %v", err)
+		}
+		if len(secondPage.PostsIDs) != 1 || secondPage.NextCursor != "" {
+			t.Fatalf("Expected a last page with one post, got %+v", secondPage)
+		}
+
+		seen := map[int64]bool{}
+		for _, id := range append(firstPage.PostsIDs, secondPage.PostsIDs...) {
+			seen[int64(id)] = true
+		}
+		for _, id := range otherPostIDs {
+			if !seen[id] {
+				t.Errorf("Expected post %d in the results", id)
+			}
+		}
+		if seen[privatePostID] {
+			t.Error("Expected private post to be hidden from anonymous search")
+		}
+	})
+
+	t.Run("Author Finds Own Private Post", func(t *testing.T) {
+		resp, err := author.GET(fmt.Sprintf("/search?q=%s+private", word))
+		if err != nil {
+			t.Fatalf("Search request failed: %v", err)
+		}
+		var result utils.SearchResponse
+		if err := resp.ParseJSON(&result); err != nil {
+			t.Fatalf("Failed to parse search response: %v", err)
+		}
+		if len(result.PostsIDs) != 1 || int64(result.PostsIDs[0]) != privatePostID {
+			t.Errorf("Expected only the private post, got %+v", result.PostsIDs)
+		}
+	})
+
+	t.Run("Users Are Found By Name", func(t *testing.T) {
+		userName := author.UserData.UserName
+		resp, err := anonClient.GET("/search?type=users&q=" + url.QueryEscape(userName))
+		if err != nil {
+			t.Fatalf("Search request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Search failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var result utils.SearchResponse
+		if err := resp.ParseJSON(&result); err != nil {
+			t.Fatalf("Failed to parse search response: %v", err)
+		}
+		if len(result.Users) == 0 || result.Users[0].UserID != author.UserID {
+			t.Fatalf("Expected %s as the best match, got %+v", userName, result.Users)
+		}
+		if result.Users[0].Email != "" {
+			t.Error("Expected email to be hidden from search results")
+		}
+	})
+
+	t.Run("Invalid Requests", func(t *testing.T) {
+		for _, path := range []string{"/search?q=", "/search?q=trip&type=places", "/search?q=trip&cursor=not-a-cursor"} {
+			resp, err := anonClient.GET(path)
+			if err != nil {
+				t.Fatalf("Search request failed: %v", err)
+			}
+			if resp.StatusCode != 400 {
+				t.Errorf("Expected status 400 for %s, got %d", path, resp.StatusCode)
+			}
+		}
+	})
+}
+
+func TestUserAutocomplete(t *testing.T) {
+	// Use a unique prefix so other users don't show up in the results
+	prefix := fmt.Sprintf("ac%d", time.Now().UnixNano()%10000000000)
+
+	first, err := utils.CreateIsolatedTestUser(prefix+"_a", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create first user: %v", err)
+	}
+	second, err := utils.CreateIsolatedTestUser(prefix+"_b", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create second user: %v", err)
+	}
+	viewer, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create viewer: %v", err)
+	}
+
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	t.Run("Matches Are Ordered By Name", func(t *testing.T) {
+		resp, err := anonClient.GET("/users/autocomplete?prefix=@" + strings.ToUpper(prefix))
+		if err != nil {
+			t.Fatalf("Autocomplete request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Autocomplete failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var result utils.AutocompleteUsersResponse
+		if err := resp.ParseJSON(&result); err != nil {
+			t.Fatalf("Failed to parse autocomplete response: %v", err)
+		}
+		if len(result.Users) != 2 || result.Users[0].UserID != first.UserID || result.Users[1].UserID != second.UserID {
+			t.Fatalf("Expected %s_a then %s_b, got %+v", prefix, prefix, result.Users)
+		}
+	})
+
+	t.Run("Followed Users Come First", func(t *testing.T) {
+		resp, err := viewer.POST(fmt.Sprintf("/friends/%d", second.UserID), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to follow user: %v", err)
+		}
+
+		resp, err = viewer.GET("/users/autocomplete?prefix=" + prefix)
+		if err != nil {
+			t.Fatalf("Autocomplete request failed: %v", err)
+		}
+		var result utils.AutocompleteUsersResponse
+		if err := resp.ParseJSON(&result); err != nil {
+			t.Fatalf("Failed to parse autocomplete response: %v", err)
+		}
+		if len(result.Users) != 2 || result.Users[0].UserID != second.UserID || !result.Users[0].Following {
+			t.Fatalf("Expected followed user %s_b first, got %+v", prefix, result.Users)
+		}
+	})
+
+	t.Run("Followed Users Past The Other Matches", func(t *testing.T) {
+		var last *utils.AuthenticatedUser
+		for _, suffix := range []string{"_c", "_d", "_z"} {
+			last, err = utils.CreateIsolatedTestUser(prefix+suffix, "", "")
+			if err != nil {
+				t.Fatalf("Failed to create user: %v", err)
+			}
+		}
+		resp, err := viewer.POST(fmt.Sprintf("/friends/%d", last.UserID), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to follow user: %v", err)
+		}
+
+		resp, err = viewer.GET("/users/autocomplete?limit=2&prefix=" + prefix)
+		if err != nil {
+			t.Fatalf("Autocomplete request failed: %v", err)
+		}
+		var result utils.AutocompleteUsersResponse
+		if err := resp.ParseJSON(&result); err != nil {
+			t.Fatalf("Failed to parse autocomplete response: %v", err)
+		}
+		if len(result.Users) != 2 || result.Users[0].UserID != second.UserID || result.Users[1].UserID != last.UserID {
+			t.Fatalf("Expected the followed users %s_b and %s_z, got %+v", prefix, prefix, result.Users)
+		}
+	})
+
+	t.Run("Empty Prefix", func(t *testing.T) {
+		resp, err := anonClient.GET("/users/autocomplete?prefix=")
+		if err != nil {
+			t.Fatalf("Autocomplete request failed: %v", err)
+		}
+		if resp.StatusCode != 400 {
+			t.Errorf("Expected status 400 for an empty prefix, got %d", resp.StatusCode)
+		}
+	})
+}
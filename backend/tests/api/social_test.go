@@ -613,3 +613,76 @@ func TestSocialInteractions(t *testing.T) {
 		t.Log("🎉 Multi-user social interaction test completed!")
 	})
 }
+
+func TestViewerAwareReads(t *testing.T) {
+	author, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create author: %v", err)
+	}
+	follower, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create follower: %v", err)
+	}
+
+	resp, err := follower.POST(fmt.Sprintf("/friends/%d", author.UserID), nil)
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Failed to follow author: %v", err)
+	}
+
+	t.Run("Relationship Flags", func(t *testing.T) {
+		resp, err := follower.GET(fmt.Sprintf("/users/%d", author.UserID))
+		if err != nil {
+			t.Fatalf("Get user details request failed: %v", err)
+		}
+		var details utils.UserDetailInfoResponse
+		if err := resp.ParseJSON(&details); err != nil {
+			t.Fatalf("Failed to parse user details response: %v", err)
+		}
+		if !details.Following {
+			t.Error("Expected following to be true for a follower")
+		}
+		if details.Email != "" {
+			t.Error("Expected email to be hidden from other users")
+		}
+
+		resp, err = author.GET(fmt.Sprintf("/users/%d", follower.UserID))
+		if err != nil {
+			t.Fatalf("Get user details request failed: %v", err)
+		}
+		details = utils.UserDetailInfoResponse{}
+		if err := resp.ParseJSON(&details); err != nil {
+			t.Fatalf("Failed to parse user details response: %v", err)
+		}
+		if !details.FollowedBy || details.Following {
+			t.Errorf("Expected followed_by only, got following=%v followed_by=%v", details.Following, details.FollowedBy)
+		}
+	})
+
+	t.Run("Liked By Me", func(t *testing.T) {
+		postID, err := author.CreateTestPost("Post for viewer flags", true)
+		if err != nil {
+			t.Fatalf("Failed to create test post: %v", err)
+		}
+		resp, err := follower.POST(fmt.Sprintf("/posts/%d/likes", postID), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Failed to like post: %v", err)
+		}
+
+		for _, tc := range []struct {
+			viewer   *utils.AuthenticatedUser
+			expected bool
+		}{{follower, true}, {author, false}} {
+			resp, err := tc.viewer.GET(fmt.Sprintf("/posts/%d", postID))
+			if err != nil {
+				t.Fatalf("Get post request failed: %v", err)
+			}
+			var post utils.PostDetailInfoResponse
+			if err := resp.ParseJSON(&post); err != nil {
+				t.Fatalf("Failed to parse post details response: %v", err)
+			}
+			if post.LikedByMe != tc.expected {
+				t.Errorf("Expected liked_by_me=%v for user %d, got %v", tc.expected, tc.viewer.UserID, post.LikedByMe)
+			}
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestStories(t *testing.T) {
+	alice, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create alice: %v", err)
+	}
+	carol, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create carol: %v", err)
+	}
+	bob, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create bob: %v", err)
+	}
+	stranger, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create stranger: %v", err)
+	}
+
+	for _, author := range []*utils.AuthenticatedUser{alice, carol} {
+		resp, err := bob.POST("/friends/"+author.GetUserIDStr(), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Follow failed: %v", err)
+		}
+	}
+
+	createStory := func(author *utils.AuthenticatedUser, name string) int64 {
+		resp, err := author.POST("/stories", utils.CreateStoryRequest{
+			Media: utils.Media{URL: "https://example.com/stories/" + name + ".jpg", MimeType: "image/jpeg"},
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Create story failed: %v", err)
+		}
+		var createResp utils.CreateStoryResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create story response: %v", err)
+		}
+		return createResp.StoryID
+	}
+	getTray := func(viewer *utils.AuthenticatedUser) []utils.StoryTrayEntryResponse {
+		resp, err := viewer.GET("/stories")
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get story tray failed: %v", err)
+		}
+		var tray utils.StoryTrayResponse
+		if err := resp.ParseJSON(&tray); err != nil {
+			t.Fatalf("Failed to parse story tray: %v", err)
+		}
+		return tray.Entries
+	}
+
+	aliceStory := createStory(alice, "alfama")
+	carolStory := createStory(carol, "sintra")
+
+	// Stories reach the trays of followers asynchronously
+	var entries []utils.StoryTrayEntryResponse
+	for attempt := 0; attempt < 20; attempt++ {
+		entries = getTray(bob)
+		if len(entries) == 2 {
+			break
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	if len(entries) != 2 {
+		t.Skipf("Stories did not reach the story tray, got %+v", entries)
+	}
+
+	t.Run("Tray Of Followed Users", func(t *testing.T) {
+		// Both are unseen, so the most recent story comes first
+		if entries[0].Author.UserID != int64(carol.UserID) || entries[1].Author.UserID != int64(alice.UserID) {
+			t.Errorf("Expected carol then alice, got %d then %d", entries[0].Author.UserID, entries[1].Author.UserID)
+		}
+		for _, entry := range entries {
+			if !entry.HasUnseen || len(entry.Stories) != 1 || entry.Stories[0].Media.MimeType != "image/jpeg" {
+				t.Errorf("Expected one unseen image story, got %+v", entry)
+			}
+		}
+		if entries := getTray(stranger); len(entries) != 0 {
+			t.Errorf("Expected an empty tray for a user following nobody, got %+v", entries)
+		}
+	})
+
+	t.Run("Seen Stories Move Back", func(t *testing.T) {
+		resp, err := bob.POST(fmt.Sprintf("/stories/%d/view", carolStory), nil)
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("View story failed: %v", err)
+		}
+		entries := getTray(bob)
+		if len(entries) != 2 {
+			t.Fatalf("Expected alice and carol in the tray, got %+v", entries)
+		}
+		if entries[0].HasUnseen && entries[1].HasUnseen {
+			t.Skip("Seen state is not kept without Redis")
+		}
+		if entries[0].Author.UserID != int64(alice.UserID) || !entries[0].HasUnseen {
+			t.Errorf("Expected alice's unseen story first, got %+v", entries[0])
+		}
+		if entries[1].HasUnseen || !entries[1].Stories[0].Seen {
+			t.Errorf("Expected carol's story to be seen, got %+v", entries[1])
+		}
+	})
+
+	t.Run("Own Stories Come First", func(t *testing.T) {
+		entries := getTray(carol)
+		if len(entries) != 1 || entries[0].Author.UserID != int64(carol.UserID) {
+			t.Fatalf("Expected carol's own stories, got %+v", entries)
+		}
+		if entries[0].HasUnseen || !entries[0].Stories[0].Seen {
+			t.Errorf("Expected authors to have seen their own stories, got %+v", entries[0])
+		}
+	})
+
+	t.Run("Viewers", func(t *testing.T) {
+		resp, err := carol.GET(fmt.Sprintf("/stories/%d/viewers", carolStory))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Get story viewers failed: %v", err)
+		}
+		var viewers utils.StoryViewersResponse
+		if err := resp.ParseJSON(&viewers); err != nil {
+			t.Fatalf("Failed to parse story viewers: %v", err)
+		}
+		if len(viewers.Viewers) == 0 {
+			t.Skip("Viewers are not kept without Redis")
+		}
+		if len(viewers.Viewers) != 1 || viewers.Viewers[0].User.UserID != int64(bob.UserID) {
+			t.Errorf("Expected bob as the only viewer, got %+v", viewers.Viewers)
+		}
+
+		resp, err = bob.GET(fmt.Sprintf("/stories/%d/viewers", carolStory))
+		if err != nil {
+			t.Fatalf("Get story viewers request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 for someone else's story, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Only Followers Can View", func(t *testing.T) {
+		resp, err := stranger.POST(fmt.Sprintf("/stories/%d/view", aliceStory), nil)
+		if err != nil {
+			t.Fatalf("View story request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for a user not following the author, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Invalid Media", func(t *testing.T) {
+		for name, media := range map[string]utils.Media{
+			"video":   {URL: "https://example.com/stories/tram.mp4", MimeType: "video/mp4"},
+			"no type": {URL: "https://example.com/stories/tram"},
+			"no url":  {MimeType: "image/png"},
+		} {
+			resp, err := alice.POST("/stories", utils.CreateStoryRequest{Media: media})
+			if err != nil {
+				t.Fatalf("Create story request failed: %v", err)
+			}
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 for %s, got %d", name, resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		resp, err := bob.DELETE(fmt.Sprintf("/stories/%d", aliceStory))
+		if err != nil {
+			t.Fatalf("Delete story request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 for someone else's story, got %d", resp.StatusCode)
+		}
+
+		resp, err = alice.DELETE(fmt.Sprintf("/stories/%d", aliceStory))
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Delete story failed: %v", err)
+		}
+		for _, entry := range getTray(bob) {
+			if entry.Author.UserID == int64(alice.UserID) {
+				t.Errorf("Expected alice to leave the tray with her only story deleted, got %+v", entry)
+			}
+		}
+		resp, err = bob.POST(fmt.Sprintf("/stories/%d/view", aliceStory), nil)
+		if err != nil {
+			t.Fatalf("View story request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for a deleted story, got %d", resp.StatusCode)
+		}
+	})
+}
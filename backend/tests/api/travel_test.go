@@ -0,0 +1,191 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestTravelMap(t *testing.T) {
+	traveler, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create traveler: %v", err)
+	}
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+	mapPath := fmt.Sprintf("/users/%d/travel-map", traveler.UserID)
+	statsPath := fmt.Sprintf("/users/%d/travel-stats", traveler.UserID)
+
+	checkIn := func(country string, city string, latitude float64, longitude float64, visibility string) int64 {
+		resp, err := traveler.POST("/places", utils.CreatePlaceRequest{
+			Name:      city + " station",
+			Category:  "station",
+			Country:   country,
+			City:      city,
+			Latitude:  &latitude,
+			Longitude: &longitude,
+		})
+		if err != nil || !resp.IsSuccess() {
+			t.Fatalf("Place creation failed: %v", err)
+		}
+		var placeResp utils.CreatePlaceResponse
+		if err := resp.ParseJSON(&placeResp); err != nil {
+			t.Fatalf("Failed to parse create place response: %v", err)
+		}
+
+		resp, err = traveler.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Arrived in " + city,
+			Visibility:  visibility,
+			PlaceID:     placeResp.PlaceID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Post creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+	kyotoPostID := checkIn("Japan", "Kyoto", 35.0116, 135.7681, "public")
+	checkIn("France", "Paris", 48.8566, 2.3522, "private")
+
+	resp, err := traveler.POST("/users/travel-visits", utils.AddTravelVisitRequest{
+		Country:   "Vietnam",
+		City:      "Hanoi",
+		VisitedAt: "2019-10-03",
+	})
+	if err != nil {
+		t.Fatalf("Add travel visit request failed: %v", err)
+	}
+	if !resp.IsSuccess() {
+		t.Fatalf("Adding a visit failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+	}
+	var visitResp utils.AddTravelVisitResponse
+	if err := resp.ParseJSON(&visitResp); err != nil {
+		t.Fatalf("Failed to parse add travel visit response: %v", err)
+	}
+
+	getMap := func(client interface {
+		GET(path string) (*utils.APIResponse, error)
+	}) utils.TravelMapResponse {
+		resp, err := client.GET(mapPath)
+		if err != nil {
+			t.Fatalf("Get travel map request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get travel map failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var travelMap utils.TravelMapResponse
+		if err := resp.ParseJSON(&travelMap); err != nil {
+			t.Fatalf("Failed to parse travel map: %v", err)
+		}
+		return travelMap
+	}
+
+	t.Run("Future Visits Are Rejected", func(t *testing.T) {
+		resp, err := traveler.POST("/users/travel-visits", utils.AddTravelVisitRequest{
+			Country:   "Peru",
+			VisitedAt: "2999-01-01",
+		})
+		if err != nil {
+			t.Fatalf("Add travel visit request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for a visit in the future, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Private Check-ins Are Hidden From Others", func(t *testing.T) {
+		travelMap := getMap(anonClient)
+		if len(travelMap.Countries) != 2 || travelMap.Countries[0].Country != "Vietnam" || travelMap.Countries[1].Country != "Japan" {
+			t.Fatalf("Expected [Vietnam Japan], got %+v", travelMap.Countries)
+		}
+		japan := travelMap.Countries[1]
+		if japan.PostsCount != 1 || len(japan.Cities) != 1 || japan.Cities[0].City != "Kyoto" {
+			t.Errorf("Unexpected country %+v", japan)
+		}
+		if len(travelMap.Visits) != 1 || travelMap.Visits[0].VisitID != visitResp.VisitID || travelMap.Visits[0].VisitedAt != "2019-10-03" {
+			t.Errorf("Expected the manual visit, got %+v", travelMap.Visits)
+		}
+
+		resp, err := anonClient.GET(statsPath)
+		if err != nil {
+			t.Fatalf("Get travel stats request failed: %v", err)
+		}
+		var stats utils.TravelStatsResponse
+		if err := resp.ParseJSON(&stats); err != nil {
+			t.Fatalf("Failed to parse travel stats: %v", err)
+		}
+		if stats.CountriesCount != 2 || stats.CitiesCount != 2 || stats.PostsCount != 1 || stats.DistanceKm != 0 {
+			t.Errorf("Unexpected anonymous stats %+v", stats)
+		}
+	})
+
+	t.Run("Owner Sees Every Visit", func(t *testing.T) {
+		travelMap := getMap(traveler)
+		if len(travelMap.Countries) != 3 {
+			t.Errorf("Expected 3 countries, got %+v", travelMap.Countries)
+		}
+
+		resp, err := traveler.GET(statsPath)
+		if err != nil {
+			t.Fatalf("Get travel stats request failed: %v", err)
+		}
+		var stats utils.TravelStatsResponse
+		if err := resp.ParseJSON(&stats); err != nil {
+			t.Fatalf("Failed to parse travel stats: %v", err)
+		}
+		if stats.CountriesCount != 3 || stats.PlacesCount != 2 || stats.PostsCount != 2 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+		// Kyoto to Paris is about 9,600 km
+		if stats.DistanceKm < 9000 || stats.DistanceKm > 10000 {
+			t.Errorf("Expected about 9600 km travelled, got %f", stats.DistanceKm)
+		}
+		if stats.FirstVisitedAt == "" || stats.FirstVisitedAt[:10] != "2019-10-03" {
+			t.Errorf("Expected the first visit on 2019-10-03, got %q", stats.FirstVisitedAt)
+		}
+	})
+
+	t.Run("Deleted Posts Leave The Map", func(t *testing.T) {
+		resp, err := traveler.DELETE(fmt.Sprintf("/posts/%d", kyotoPostID))
+		if err != nil {
+			t.Fatalf("Delete post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Delete failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		travelMap := getMap(anonClient)
+		if len(travelMap.Countries) != 1 || travelMap.Countries[0].Country != "Vietnam" {
+			t.Errorf("Expected [Vietnam], got %+v", travelMap.Countries)
+		}
+	})
+
+	t.Run("Manual Visits Can Be Deleted", func(t *testing.T) {
+		visitPath := fmt.Sprintf("/users/travel-visits/%d", visitResp.VisitID)
+		resp, err := traveler.DELETE(visitPath)
+		if err != nil {
+			t.Fatalf("Delete travel visit request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Delete failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = traveler.DELETE(visitPath)
+		if err != nil {
+			t.Fatalf("Delete travel visit request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when deleting twice, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestTripMembers(t *testing.T) {
+	owner, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create owner: %v", err)
+	}
+	editor, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create editor: %v", err)
+	}
+	viewer, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create viewer: %v", err)
+	}
+
+	resp, err := owner.POST("/trips", utils.CreateTripRequest{
+		Title:     "Road trip",
+		StartDate: "2024-07-01",
+	})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Trip creation failed: %v", err)
+	}
+	var tripResp utils.CreateTripResponse
+	if err := resp.ParseJSON(&tripResp); err != nil {
+		t.Fatalf("Failed to parse create trip response: %v", err)
+	}
+	tripPath := fmt.Sprintf("/trips/%d", tripResp.TripID)
+
+	invite := func(user *utils.AuthenticatedUser, role string) {
+		resp, err := owner.POST(tripPath+"/members", utils.InviteTripMemberRequest{UserID: int64(user.UserID), Role: role})
+		if err != nil {
+			t.Fatalf("Invite request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Invite failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+	}
+	invite(editor, "editor")
+	invite(viewer, "viewer")
+
+	t.Run("Invitees Cannot Post Before Accepting", func(t *testing.T) {
+		resp, err := viewer.POST("/posts", utils.CreatePostRequest{ContentText: "Too early", TripID: tripResp.TripID})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 before accepting the invitation, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Invitations Are Listed And Accepted", func(t *testing.T) {
+		resp, err := viewer.GET("/trips/invitations")
+		if err != nil {
+			t.Fatalf("Get invitations request failed: %v", err)
+		}
+		var invitations utils.TripInvitationsResponse
+		if err := resp.ParseJSON(&invitations); err != nil {
+			t.Fatalf("Failed to parse invitations: %v", err)
+		}
+		if len(invitations.Invitations) != 1 || invitations.Invitations[0].TripID != tripResp.TripID ||
+			invitations.Invitations[0].Role != "viewer" || invitations.Invitations[0].InvitedBy != int64(owner.UserID) {
+			t.Fatalf("Unexpected invitations %+v", invitations.Invitations)
+		}
+
+		for _, user := range []*utils.AuthenticatedUser{editor, viewer} {
+			resp, err := user.POST(tripPath+"/invitation/accept", nil)
+			if err != nil {
+				t.Fatalf("Accept request failed: %v", err)
+			}
+			if !resp.IsSuccess() {
+				t.Fatalf("Accept failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+			}
+		}
+
+		resp, err = viewer.POST(tripPath+"/invitation/accept", nil)
+		if err != nil {
+			t.Fatalf("Accept request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 when accepting twice, got %d", resp.StatusCode)
+		}
+	})
+
+	var viewerPostID int64
+	t.Run("Members Share The Draft Timeline", func(t *testing.T) {
+		resp, err := viewer.POST("/posts", utils.CreatePostRequest{ContentText: "Shared moment", TripID: tripResp.TripID})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Post creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		viewerPostID = createResp.PostId
+
+		resp, err = editor.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Editor could not read the draft: status %d", resp.StatusCode)
+		}
+		var trip utils.TripResponse
+		if err := resp.ParseJSON(&trip); err != nil {
+			t.Fatalf("Failed to parse trip: %v", err)
+		}
+		if len(trip.Timeline) != 1 || trip.Timeline[0].PostID != viewerPostID || trip.Timeline[0].UserID != int64(viewer.UserID) {
+			t.Errorf("Expected the viewer's post in the timeline, got %+v", trip.Timeline)
+		}
+		if len(trip.Members) != 2 {
+			t.Errorf("Expected 2 members, got %+v", trip.Members)
+		}
+	})
+
+	t.Run("Roles Control Editing", func(t *testing.T) {
+		title := "Road trip with friends"
+		resp, err := viewer.PUT(tripPath, utils.EditTripRequest{Title: &title})
+		if err != nil {
+			t.Fatalf("Edit trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 for a viewer editing the trip, got %d", resp.StatusCode)
+		}
+
+		resp, err = editor.PUT(tripPath, utils.EditTripRequest{Title: &title})
+		if err != nil {
+			t.Fatalf("Edit trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Errorf("Editor could not edit the trip: status %d %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = editor.DELETE(fmt.Sprintf("%s/posts/%d", tripPath, viewerPostID))
+		if err != nil {
+			t.Fatalf("Remove trip post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Errorf("Editor could not remove a post: status %d %s", resp.StatusCode, resp.GetStringBody())
+		}
+	})
+
+	t.Run("Members Can Leave", func(t *testing.T) {
+		resp, err := viewer.DELETE(fmt.Sprintf("%s/members/%d", tripPath, viewer.UserID))
+		if err != nil {
+			t.Fatalf("Remove member request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Leaving failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = viewer.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for the draft after leaving, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"wandersphere-api-tests/utils"
+)
+
+func TestTrips(t *testing.T) {
+	owner, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create owner: %v", err)
+	}
+	stranger, err := utils.CreateIsolatedTestUser("", "", "")
+	if err != nil {
+		t.Fatalf("Failed to create stranger: %v", err)
+	}
+	anonClient, err := utils.NewAPIClient()
+	if err != nil {
+		t.Fatalf("Failed to create anonymous client: %v", err)
+	}
+
+	latitude, longitude := 34.9671, 135.7727
+	resp, err := owner.POST("/places", utils.CreatePlaceRequest{
+		Name:      "Fushimi Inari Taisha",
+		Category:  "attraction",
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	})
+	if err != nil || !resp.IsSuccess() {
+		t.Fatalf("Place creation failed: %v", err)
+	}
+	var placeResp utils.CreatePlaceResponse
+	if err := resp.ParseJSON(&placeResp); err != nil {
+		t.Fatalf("Failed to parse create place response: %v", err)
+	}
+
+	resp, err = owner.POST("/trips", utils.CreateTripRequest{
+		Title:     "Two weeks in Japan",
+		StartDate: "2024-04-01",
+		EndDate:   "2024-04-14",
+		PlaceIDs:  []int64{placeResp.PlaceID},
+	})
+	if err != nil {
+		t.Fatalf("Create trip request failed: %v", err)
+	}
+	if !resp.IsSuccess() {
+		t.Fatalf("Trip creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+	}
+	var tripResp utils.CreateTripResponse
+	if err := resp.ParseJSON(&tripResp); err != nil {
+		t.Fatalf("Failed to parse create trip response: %v", err)
+	}
+	tripID := tripResp.TripID
+	tripPath := fmt.Sprintf("/trips/%d", tripID)
+
+	addPost := func(visibility string) int64 {
+		resp, err := owner.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Day on the road",
+			Visibility:  visibility,
+			PlaceID:     placeResp.PlaceID,
+			TripID:      tripID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Post creation failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var createResp utils.CreatePostResponse
+		if err := resp.ParseJSON(&createResp); err != nil {
+			t.Fatalf("Failed to parse create post response: %v", err)
+		}
+		return createResp.PostId
+	}
+	firstPostID := addPost("public")
+	secondPostID := addPost("public")
+	privatePostID := addPost("private")
+
+	t.Run("Invalid Dates Are Rejected", func(t *testing.T) {
+		resp, err := owner.POST("/trips", utils.CreateTripRequest{
+			Title:     "Backwards",
+			StartDate: "2024-04-14",
+			EndDate:   "2024-04-01",
+		})
+		if err != nil {
+			t.Fatalf("Create trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an end date before the start date, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Drafts Are Only Visible To Their Owner", func(t *testing.T) {
+		resp, err := anonClient.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for a draft read anonymously, got %d", resp.StatusCode)
+		}
+
+		resp, err = owner.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Owner could not read the draft: status %d", resp.StatusCode)
+		}
+		var trip utils.TripResponse
+		if err := resp.ParseJSON(&trip); err != nil {
+			t.Fatalf("Failed to parse trip: %v", err)
+		}
+		if trip.PublishedAt != "" || len(trip.Timeline) != 3 {
+			t.Errorf("Expected an unpublished draft with 3 posts, got %+v", trip)
+		}
+	})
+
+	t.Run("Only The Owner Can Add Posts Or Publish", func(t *testing.T) {
+		resp, err := stranger.POST("/posts", utils.CreatePostRequest{
+			ContentText: "Not my trip",
+			TripID:      tripID,
+		})
+		if err != nil {
+			t.Fatalf("Create post request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 when posting to another user's trip, got %d", resp.StatusCode)
+		}
+
+		resp, err = stranger.POST(tripPath+"/publish", nil)
+		if err != nil {
+			t.Fatalf("Publish trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected 403 when publishing another user's trip, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Published Trip Has An Ordered Timeline", func(t *testing.T) {
+		resp, err := owner.POST(tripPath+"/publish", nil)
+		if err != nil {
+			t.Fatalf("Publish trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Publish failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = anonClient.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Get trip failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+		var trip utils.TripResponse
+		if err := resp.ParseJSON(&trip); err != nil {
+			t.Fatalf("Failed to parse trip: %v", err)
+		}
+		if trip.Title != "Two weeks in Japan" || trip.StartDate != "2024-04-01" || trip.EndDate != "2024-04-14" || trip.PublishedAt == "" {
+			t.Errorf("Unexpected trip %+v", trip)
+		}
+		if len(trip.Places) != 1 || trip.Places[0].PlaceID != placeResp.PlaceID {
+			t.Errorf("Expected place %d, got %+v", placeResp.PlaceID, trip.Places)
+		}
+		if len(trip.Timeline) != 2 || trip.Timeline[0].PostID != firstPostID || trip.Timeline[1].PostID != secondPostID {
+			t.Errorf("Expected timeline [%d %d], got %+v", firstPostID, secondPostID, trip.Timeline)
+		}
+		for _, post := range trip.Timeline {
+			if post.PostID == privatePostID {
+				t.Errorf("Private post %d should not be in the anonymous timeline", privatePostID)
+			}
+		}
+	})
+
+	t.Run("Posts Can Leave The Trip", func(t *testing.T) {
+		resp, err := owner.PUT(fmt.Sprintf("/posts/%d", secondPostID), utils.EditPostRequest{RemoveTrip: true})
+		if err != nil {
+			t.Fatalf("Edit post request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Edit failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = anonClient.GET(fmt.Sprintf("/posts/%d", firstPostID))
+		if err != nil {
+			t.Fatalf("Get post request failed: %v", err)
+		}
+		var post utils.PostDetailInfoResponse
+		if err := resp.ParseJSON(&post); err != nil {
+			t.Fatalf("Failed to parse post: %v", err)
+		}
+		if post.TripID != tripID {
+			t.Errorf("Expected post %d to be part of trip %d, got %d", firstPostID, tripID, post.TripID)
+		}
+
+		resp, err = anonClient.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		var trip utils.TripResponse
+		if err := resp.ParseJSON(&trip); err != nil {
+			t.Fatalf("Failed to parse trip: %v", err)
+		}
+		if len(trip.Timeline) != 1 || trip.Timeline[0].PostID != firstPostID {
+			t.Errorf("Expected timeline [%d], got %+v", firstPostID, trip.Timeline)
+		}
+	})
+
+	t.Run("Private Trips Stay Hidden", func(t *testing.T) {
+		visibility := "private"
+		resp, err := owner.PUT(tripPath, utils.EditTripRequest{Visibility: &visibility})
+		if err != nil {
+			t.Fatalf("Edit trip request failed: %v", err)
+		}
+		if !resp.IsSuccess() {
+			t.Fatalf("Edit trip failed with status %d: %s", resp.StatusCode, resp.GetStringBody())
+		}
+
+		resp, err = stranger.GET(tripPath)
+		if err != nil {
+			t.Fatalf("Get trip request failed: %v", err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("Expected 404 for a private trip, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -245,6 +245,14 @@ func (u *AuthenticatedUser) DELETE(path string) (*APIResponse, error) {
 	return u.AuthenticatedRequest("DELETE", path, nil)
 }
 
+// Upload makes an authenticated file upload request
+func (u *AuthenticatedUser) Upload(path, filename, contentType string, data []byte) (*APIResponse, error) {
+	if !u.Client.HasValidSession() {
+		return nil, fmt.Errorf("user is not authenticated")
+	}
+	return u.Client.Upload(path, filename, contentType, data)
+}
+
 // GetUserIDStr returns the user ID as a string for URL path parameters
 func (u *AuthenticatedUser) GetUserIDStr() string {
 	return strconv.Itoa(u.UserID)
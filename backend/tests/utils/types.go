@@ -28,15 +28,115 @@ type EditUserRequest struct {
 }
 
 type CreatePostRequest struct {
-	ContentText      string   `json:"content_text" binding:"required"`
-	ContentImagePath []string `json:"content_image_path,omitempty"`
-	Visible          bool     `json:"visible,omitempty"`
+	ContentText      string       `json:"content_text" binding:"required"`
+	ContentImagePath []string     `json:"content_image_path,omitempty"`
+	Media            []Media      `json:"media,omitempty"`
+	Visible          bool         `json:"visible,omitempty"`
+	Visibility       string       `json:"visibility,omitempty"`
+	Location         *Location    `json:"location,omitempty"`
+	PlaceID          int64        `json:"place_id,omitempty"`
+	TripID           int64        `json:"trip_id,omitempty"`
+	QuoteOfPostID    int64        `json:"quote_of_post_id,omitempty"`
+	PublishAt        string       `json:"publish_at,omitempty"`
+	Poll             *PollRequest `json:"poll,omitempty"`
+}
+
+type Media struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type,omitempty"`
+	Width    int32  `json:"width,omitempty"`
+	Height   int32  `json:"height,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
+	Blurhash string `json:"blurhash,omitempty"`
+}
+
+type PollRequest struct {
+	Options        []string `json:"options"`
+	ExpiresAt      string   `json:"expires_at"`
+	MultipleChoice bool     `json:"multiple_choice,omitempty"`
+	Anonymous      bool     `json:"anonymous,omitempty"`
+}
+
+type VotePollRequest struct {
+	OptionIDs []int64 `json:"option_ids"`
+}
+
+type PollResponse struct {
+	Options        []PollOptionResponse `json:"options"`
+	ExpiresAt      string               `json:"expires_at"`
+	MultipleChoice bool                 `json:"multiple_choice"`
+	Anonymous      bool                 `json:"anonymous"`
+	Closed         bool                 `json:"closed"`
+	VotersCount    int64                `json:"voters_count"`
+	ResultsVisible bool                 `json:"results_visible"`
+	MyOptionIDs    []int64              `json:"my_option_ids"`
+}
+
+type PollOptionResponse struct {
+	OptionID   int64   `json:"option_id"`
+	Text       string  `json:"text"`
+	VotesCount int64   `json:"votes_count"`
+	VoterIDs   []int64 `json:"voter_ids,omitempty"`
+}
+
+type ReschedulePostRequest struct {
+	PublishAt string `json:"publish_at"`
 }
 
 type EditPostRequest struct {
 	ContentText      *string   `json:"content_text,omitempty"`
 	ContentImagePath *[]string `json:"content_image_path,omitempty"`
+	Media            *[]Media  `json:"media,omitempty"`
 	Visible          *bool     `json:"visible,omitempty"`
+	Visibility       *string   `json:"visibility,omitempty"`
+	Location         *Location `json:"location,omitempty"`
+	RemoveLocation   bool      `json:"remove_location,omitempty"`
+	PlaceID          *int64    `json:"place_id,omitempty"`
+	RemovePlace      bool      `json:"remove_place,omitempty"`
+	TripID           *int64    `json:"trip_id,omitempty"`
+	RemoveTrip       bool      `json:"remove_trip,omitempty"`
+}
+
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	PlaceName string  `json:"place_name,omitempty"`
+}
+
+type CreatePlaceRequest struct {
+	Name      string   `json:"name"`
+	Category  string   `json:"category"`
+	Country   string   `json:"country,omitempty"`
+	City      string   `json:"city,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+type MergePlaceRequest struct {
+	TargetPlaceID int64 `json:"target_place_id"`
+}
+
+type CreateTripRequest struct {
+	Title      string  `json:"title"`
+	StartDate  string  `json:"start_date"`
+	EndDate    string  `json:"end_date,omitempty"`
+	CoverImage string  `json:"cover_image,omitempty"`
+	Visibility string  `json:"visibility,omitempty"`
+	PlaceIDs   []int64 `json:"place_ids,omitempty"`
+}
+
+type EditTripRequest struct {
+	Title         *string  `json:"title,omitempty"`
+	StartDate     *string  `json:"start_date,omitempty"`
+	EndDate       *string  `json:"end_date,omitempty"`
+	RemoveEndDate bool     `json:"remove_end_date,omitempty"`
+	Visibility    *string  `json:"visibility,omitempty"`
+	PlaceIDs      *[]int64 `json:"place_ids,omitempty"`
+}
+
+type InviteTripMemberRequest struct {
+	UserID int64  `json:"user_id"`
+	Role   string `json:"role"`
 }
 
 type CreatePostCommentRequest struct {
@@ -67,38 +167,472 @@ type ErrorResponse struct {
 }
 
 type UserDetailInfo struct {
-	UserID         int    `json:"user_id"`
-	UserName       string `json:"user_name"`
-	Email          string `json:"email"`
-	FirstName      string `json:"first_name"`
-	LastName       string `json:"last_name"`
-	DateOfBirth    string `json:"date_of_birth"`
-	ProfilePicture string `json:"profile_picture"`
-	CoverPicture   string `json:"cover_picture"`
+	UserID         int     `json:"user_id"`
+	UserName       string  `json:"user_name"`
+	Email          string  `json:"email"`
+	FirstName      string  `json:"first_name"`
+	LastName       string  `json:"last_name"`
+	DateOfBirth    string  `json:"date_of_birth"`
+	ProfilePicture string  `json:"profile_picture"`
+	CoverPicture   string  `json:"cover_picture"`
+	Following      bool    `json:"following"`
+	FollowedBy     bool    `json:"followed_by"`
+	PinnedPostIds  []int64 `json:"pinned_post_ids"`
 }
 
 type UserDetailInfoResponse UserDetailInfo
 
+type AutocompleteUsersResponse struct {
+	Users []UserDetailInfoResponse `json:"users"`
+}
+
 type LoginResponse struct {
 	Message string         `json:"message"`
 	User    UserDetailInfo `json:"user"`
 }
 
+type TrashedPostResponse struct {
+	PostID           int      `json:"post_id"`
+	ContentText      string   `json:"content_text"`
+	ContentImagePath []string `json:"content_image_path"`
+	Media            []Media  `json:"media"`
+	CreatedAt        string   `json:"created_at"`
+	DeletedAt        string   `json:"deleted_at"`
+	PurgeAt          string   `json:"purge_at"`
+}
+
+type TrashedPostsResponse struct {
+	Posts []TrashedPostResponse `json:"posts"`
+}
+
+type ScheduledPostResponse struct {
+	PostID           int64    `json:"post_id"`
+	ContentText      string   `json:"content_text"`
+	ContentImagePath []string `json:"content_image_path"`
+	Media            []Media  `json:"media"`
+	Visibility       string   `json:"visibility"`
+	CreatedAt        string   `json:"created_at"`
+	PublishAt        string   `json:"publish_at"`
+}
+
+type ScheduledPostsResponse struct {
+	Posts []ScheduledPostResponse `json:"posts"`
+}
+
+type CreateDraftRequest struct {
+	ContentText      string    `json:"content_text,omitempty"`
+	ContentImagePath []string  `json:"content_image_path,omitempty"`
+	Media            []Media   `json:"media,omitempty"`
+	Visibility       string    `json:"visibility,omitempty"`
+	Location         *Location `json:"location,omitempty"`
+	PlaceID          int64     `json:"place_id,omitempty"`
+	TripID           int64     `json:"trip_id,omitempty"`
+	QuoteOfPostID    int64     `json:"quote_of_post_id,omitempty"`
+}
+
+type CreateDraftResponse struct {
+	Message string `json:"message"`
+	DraftID int64  `json:"draft_id"`
+}
+
+type EditDraftRequest struct {
+	ContentText      *string   `json:"content_text,omitempty"`
+	ContentImagePath *[]string `json:"content_image_path,omitempty"`
+	Media            *[]Media  `json:"media,omitempty"`
+	Visibility       *string   `json:"visibility,omitempty"`
+	Location         *Location `json:"location,omitempty"`
+	RemoveLocation   bool      `json:"remove_location,omitempty"`
+	QuoteOfPostID    *int64    `json:"quote_of_post_id,omitempty"`
+	RemoveQuote      bool      `json:"remove_quote,omitempty"`
+}
+
+type PublishDraftRequest struct {
+	PublishAt string `json:"publish_at,omitempty"`
+}
+
+type DraftResponse struct {
+	DraftID          int64     `json:"draft_id"`
+	ContentText      string    `json:"content_text"`
+	ContentImagePath []string  `json:"content_image_path"`
+	Media            []Media   `json:"media"`
+	Visibility       string    `json:"visibility"`
+	Location         *Location `json:"location,omitempty"`
+	PlaceID          int64     `json:"place_id,omitempty"`
+	TripID           int64     `json:"trip_id,omitempty"`
+	QuoteOfPostID    int64     `json:"quote_of_post_id,omitempty"`
+	CreatedAt        string    `json:"created_at"`
+	UpdatedAt        string    `json:"updated_at"`
+}
+
+type DraftsResponse struct {
+	Drafts []DraftResponse `json:"drafts"`
+}
+
+type PostRevisionResponse struct {
+	Revision         int      `json:"revision"`
+	ContentText      string   `json:"content_text"`
+	ContentImagePath []string `json:"content_image_path"`
+	Media            []Media  `json:"media"`
+	Visibility       string   `json:"visibility"`
+	CreatedAt        string   `json:"created_at"`
+}
+
+type PostRevisionsResponse struct {
+	Revisions []PostRevisionResponse `json:"revisions"`
+}
+
+type HashtagInfoResponse struct {
+	Tag             string `json:"tag"`
+	PostCount       int    `json:"post_count"`
+	AuthorCount     int    `json:"author_count"`
+	RecentPostCount int    `json:"recent_post_count"`
+	FirstUsedAt     string `json:"first_used_at"`
+	LastUsedAt      string `json:"last_used_at"`
+}
+
+type CreatePlaceResponse struct {
+	Message string `json:"message"`
+	PlaceID int64  `json:"place_id"`
+}
+
+type PlaceResponse struct {
+	PlaceID       int64   `json:"place_id"`
+	Name          string  `json:"name"`
+	Category      string  `json:"category"`
+	Country       string  `json:"country"`
+	City          string  `json:"city"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	CreatedBy     int64   `json:"created_by"`
+	CheckInCount  int64   `json:"check_in_count"`
+	VisitorCount  int64   `json:"visitor_count"`
+	DistanceKm    float64 `json:"distance_km"`
+	RatingCount   int64   `json:"rating_count"`
+	RatingAverage float64 `json:"rating_average"`
+}
+
+type CreateTripResponse struct {
+	Message string `json:"message"`
+	TripID  int64  `json:"trip_id"`
+}
+
+type TripResponse struct {
+	TripID      int64                `json:"trip_id"`
+	UserID      int64                `json:"user_id"`
+	Title       string               `json:"title"`
+	StartDate   string               `json:"start_date"`
+	EndDate     string               `json:"end_date"`
+	Visibility  string               `json:"visibility"`
+	PublishedAt string               `json:"published_at"`
+	Places      []PlaceResponse      `json:"places"`
+	Timeline    []TripPostResponse   `json:"timeline"`
+	Members     []TripMemberResponse `json:"members"`
+}
+
+type TripPostResponse struct {
+	PostID    int64  `json:"post_id"`
+	UserID    int64  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+	PlaceID   int64  `json:"place_id"`
+}
+
+type TripMemberResponse struct {
+	UserID  int64  `json:"user_id"`
+	Role    string `json:"role"`
+	Pending bool   `json:"pending"`
+}
+
+type TripInvitationResponse struct {
+	TripID    int64  `json:"trip_id"`
+	Title     string `json:"title"`
+	InvitedBy int64  `json:"invited_by"`
+	Role      string `json:"role"`
+}
+
+type TripInvitationsResponse struct {
+	Invitations []TripInvitationResponse `json:"invitations"`
+}
+
+type AddTravelVisitRequest struct {
+	Country    string `json:"country"`
+	City       string `json:"city,omitempty"`
+	VisitedAt  string `json:"visited_at,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+type AddTravelVisitResponse struct {
+	Message string `json:"message"`
+	VisitID int64  `json:"visit_id"`
+}
+
+type VisitedCityResponse struct {
+	City           string `json:"city"`
+	FirstVisitedAt string `json:"first_visited_at"`
+	PostsCount     int64  `json:"posts_count"`
+}
+
+type VisitedCountryResponse struct {
+	Country        string                `json:"country"`
+	FirstVisitedAt string                `json:"first_visited_at"`
+	PostsCount     int64                 `json:"posts_count"`
+	Cities         []VisitedCityResponse `json:"cities"`
+}
+
+type TravelVisitResponse struct {
+	VisitID    int64  `json:"visit_id"`
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	VisitedAt  string `json:"visited_at"`
+	Visibility string `json:"visibility"`
+}
+
+type TravelMapResponse struct {
+	Countries []VisitedCountryResponse `json:"countries"`
+	Visits    []TravelVisitResponse    `json:"visits"`
+}
+
+type TravelStatsResponse struct {
+	CountriesCount int64   `json:"countries_count"`
+	CitiesCount    int64   `json:"cities_count"`
+	PlacesCount    int64   `json:"places_count"`
+	PostsCount     int64   `json:"posts_count"`
+	DistanceKm     float64 `json:"distance_km"`
+	FirstVisitedAt string  `json:"first_visited_at"`
+	LastVisitedAt  string  `json:"last_visited_at"`
+}
+
+type CreatePlaceReviewRequest struct {
+	Rating      int32  `json:"rating"`
+	ContentText string `json:"content_text,omitempty"`
+}
+
+type EditPlaceReviewRequest struct {
+	Rating      *int32  `json:"rating,omitempty"`
+	ContentText *string `json:"content_text,omitempty"`
+}
+
+type CreatePlaceReviewResponse struct {
+	Message  string `json:"message"`
+	ReviewID int64  `json:"review_id"`
+}
+
+type PlaceReviewResponse struct {
+	ReviewID     int64  `json:"review_id"`
+	PlaceID      int64  `json:"place_id"`
+	UserID       int64  `json:"user_id"`
+	Rating       int32  `json:"rating"`
+	ContentText  string `json:"content_text"`
+	HelpfulCount int64  `json:"helpful_count"`
+	VotedHelpful bool   `json:"voted_helpful"`
+	CreatedAt    string `json:"created_at"`
+	EditedAt     string `json:"edited_at"`
+}
+
+type RatingSummaryResponse struct {
+	Count   int64   `json:"count"`
+	Average float64 `json:"average"`
+	Counts  []int64 `json:"counts"`
+}
+
+type PlaceReviewsResponse struct {
+	Reviews    []PlaceReviewResponse `json:"reviews"`
+	Summary    RatingSummaryResponse `json:"summary"`
+	NextCursor string                `json:"next_cursor"`
+}
+
+type PlacesResponse struct {
+	Places []PlaceResponse `json:"places"`
+}
+
+type PlacePostsResponse struct {
+	PostsIDs   []int64 `json:"posts_ids"`
+	NextCursor int64   `json:"next_cursor"`
+}
+
+type HashtagPostsResponse struct {
+	PostsIDs   []int `json:"posts_ids"`
+	NextCursor int64 `json:"next_cursor"`
+}
+
+type SearchResponse struct {
+	Type       string                   `json:"type"`
+	PostsIDs   []int                    `json:"posts_ids"`
+	Users      []UserDetailInfoResponse `json:"users"`
+	NextCursor string                   `json:"next_cursor"`
+}
+
 type CommentResponse struct {
-	CommentID   int    `json:"comment_id"`
-	PostID      int    `json:"post_id"`
-	UserID      int    `json:"user_id"`
-	ContentText string `json:"content_text"`
+	CommentID   int                   `json:"comment_id"`
+	PostID      int                   `json:"post_id"`
+	UserID      int                   `json:"user_id"`
+	ContentText string                `json:"content_text"`
+	Mentions    []MentionSpanResponse `json:"mentions"`
+}
+
+type MentionSpanResponse struct {
+	UserID   int    `json:"user_id"`
+	UserName string `json:"user_name"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
 }
 
 type PostDetailInfoResponse struct {
-	PostID           int               `json:"post_id"`
-	UserID           int               `json:"user_id"`
-	ContentText      string            `json:"content_text"`
-	ContentImagePath []string          `json:"content_image_path"`
-	CreatedAt        string            `json:"created_at"`
-	UsersLiked       []int             `json:"users_liked"`
-	Comments         []CommentResponse `json:"comments"`
+	PostID           int                   `json:"post_id"`
+	UserID           int                   `json:"user_id"`
+	ContentText      string                `json:"content_text"`
+	ContentImagePath []string              `json:"content_image_path"`
+	Media            []Media               `json:"media"`
+	Visibility       string                `json:"visibility"`
+	CreatedAt        string                `json:"created_at"`
+	UsersLiked       []int                 `json:"users_liked"`
+	LikedByMe        bool                  `json:"liked_by_me"`
+	EditedAt         string                `json:"edited_at,omitempty"`
+	Comments         []CommentResponse     `json:"comments"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *Location             `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripID           int64                 `json:"trip_id,omitempty"`
+	RepostOfPostID   int64                 `json:"repost_of_post_id,omitempty"`
+	QuoteOfPostID    int64                 `json:"quote_of_post_id,omitempty"`
+	RepostCount      int64                 `json:"repost_count"`
+	QuoteCount       int64                 `json:"quote_count"`
+	RepostedByMe     bool                  `json:"reposted_by_me"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me"`
+	PublishAt        string                `json:"publish_at,omitempty"`
+	Poll             *PollResponse         `json:"poll,omitempty"`
+	LinkPreviews     []LinkPreviewResponse `json:"link_previews"`
+}
+
+type PostsResponse struct {
+	Posts []PostSummaryResponse `json:"posts"`
+}
+
+type PostSummaryResponse struct {
+	PostID           int64                 `json:"post_id"`
+	UserID           int64                 `json:"user_id"`
+	Author           *PostAuthorResponse   `json:"author,omitempty"`
+	ContentText      string                `json:"content_text"`
+	ContentImagePath []string              `json:"content_image_path"`
+	Media            []Media               `json:"media"`
+	Visibility       string                `json:"visibility"`
+	CreatedAt        string                `json:"created_at"`
+	EditedAt         string                `json:"edited_at,omitempty"`
+	Mentions         []MentionSpanResponse `json:"mentions"`
+	Location         *Location             `json:"location,omitempty"`
+	Place            *PlaceResponse        `json:"place,omitempty"`
+	TripID           int64                 `json:"trip_id,omitempty"`
+	RepostOfPostID   int64                 `json:"repost_of_post_id,omitempty"`
+	QuoteOfPostID    int64                 `json:"quote_of_post_id,omitempty"`
+	LikeCount        int64                 `json:"like_count"`
+	CommentCount     int64                 `json:"comment_count"`
+	RepostCount      int64                 `json:"repost_count"`
+	QuoteCount       int64                 `json:"quote_count"`
+	LikedByMe        bool                  `json:"liked_by_me"`
+	RepostedByMe     bool                  `json:"reposted_by_me"`
+	BookmarkedByMe   bool                  `json:"bookmarked_by_me"`
+}
+
+type PostAuthorResponse struct {
+	UserID         int64  `json:"user_id"`
+	UserName       string `json:"user_name"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+type CreateStoryRequest struct {
+	Media Media `json:"media"`
+}
+
+type CreateStoryResponse struct {
+	StoryID   int64  `json:"story_id"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type StoryTrayResponse struct {
+	Entries []StoryTrayEntryResponse `json:"entries"`
+}
+
+type StoryTrayEntryResponse struct {
+	Author    PostAuthorResponse `json:"author"`
+	Stories   []StoryResponse    `json:"stories"`
+	HasUnseen bool               `json:"has_unseen"`
+}
+
+type StoryResponse struct {
+	StoryID   int64  `json:"story_id"`
+	UserID    int64  `json:"user_id"`
+	Media     Media  `json:"media"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at"`
+	Seen      bool   `json:"seen"`
+	ViewCount int64  `json:"view_count"`
+}
+
+type StoryViewersResponse struct {
+	Viewers []StoryViewerResponse `json:"viewers"`
+}
+
+type StoryViewerResponse struct {
+	User     PostAuthorResponse `json:"user"`
+	ViewedAt string             `json:"viewed_at"`
+}
+
+type LinkPreviewResponse struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+type RepostPostResponse struct {
+	Message  string `json:"message"`
+	RepostID int64  `json:"repost_id"`
+}
+
+type AddBookmarkRequest struct {
+	CollectionID int64 `json:"collection_id"`
+}
+
+type BookmarkCollectionRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateBookmarkCollectionResponse struct {
+	Message      string `json:"message"`
+	CollectionID int64  `json:"collection_id"`
+}
+
+type BookmarkResponse struct {
+	PostID       int64  `json:"post_id"`
+	CollectionID int64  `json:"collection_id"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type BookmarksResponse struct {
+	Bookmarks  []BookmarkResponse `json:"bookmarks"`
+	NextCursor int64              `json:"next_cursor"`
+}
+
+type BookmarkCollectionResponse struct {
+	CollectionID   int64  `json:"collection_id"`
+	Name           string `json:"name"`
+	BookmarksCount int64  `json:"bookmarks_count"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type BookmarkCollectionsResponse struct {
+	Collections []BookmarkCollectionResponse `json:"collections"`
+}
+
+type NearbyPostResponse struct {
+	PostID     int64   `json:"post_id"`
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type NearbyPostsResponse struct {
+	Posts []NearbyPostResponse `json:"posts"`
 }
 
 type UserFollowerResponse struct {
@@ -114,7 +648,18 @@ type UserPostsResponse struct {
 }
 
 type NewsfeedResponse struct {
-	PostsIds []int64 `json:"posts_ids"`
+	PostsIds    []int64                `json:"posts_ids"`
+	Items       []NewsfeedItemResponse `json:"items"`
+	TotalPages  int32                  `json:"total_pages"`
+	CurrentPage int32                  `json:"current_page"`
+	NextCursor  int64                  `json:"next_cursor"`
+}
+
+type NewsfeedItemResponse struct {
+	Type     string               `json:"type"`
+	ID       int64                `json:"id"`
+	Post     *PostSummaryResponse `json:"post,omitempty"`
+	RepostOf *PostSummaryResponse `json:"repost_of,omitempty"`
 }
 
 type GetS3PresignedUrlResponse struct {
@@ -122,6 +667,16 @@ type GetS3PresignedUrlResponse struct {
 	ExpirationTime string `json:"expiration_time"`
 }
 
+type UploadBinaryResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Key         string `json:"key"`
+		URL         string `json:"url"`
+		Size        int64  `json:"size"`
+		ContentType string `json:"content_type"`
+	} `json:"data"`
+}
+
 // Test data structures
 
 type TestUser struct {